@@ -17,6 +17,22 @@ type BGPConfigSpec struct {
 	// AddressFamilies configures supported BGP address families and their Cisco NX-OS specific settings.
 	// +optional
 	AddressFamilies *BGPConfigAddressFamilies `json:"addressFamilies,omitempty"`
+
+	// LogNeighborChanges enables the generation of system messages when the state of a BGP neighbor changes.
+	// +optional
+	LogNeighborChanges bool `json:"logNeighborChanges,omitempty"`
+
+	// Bestpath configures the Cisco NX-OS specific settings of the BGP best path selection.
+	// +optional
+	Bestpath *BGPConfigBestpath `json:"bestpath,omitempty"`
+}
+
+// BGPConfigBestpath defines the Cisco NX-OS specific configuration of the BGP best path selection.
+type BGPConfigBestpath struct {
+	// AsPathMultipathRelax allows load sharing across paths with different AS paths of the same length,
+	// e.g. when multipath is used towards neighbors in different autonomous systems.
+	// +optional
+	AsPathMultipathRelax bool `json:"asPathMultipathRelax,omitempty"`
 }
 
 // BGPConfigAddressFamilies defines the Cisco NX-OS specific configuration for supported BGP address families.
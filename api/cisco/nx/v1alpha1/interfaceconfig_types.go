@@ -30,6 +30,17 @@ type InterfaceConfigSpec struct {
 	// EVPNMultihoming defines EVPN ESI multihoming settings for the interface.
 	// +optional
 	EVPNMultihoming *EVPNMultihoming `json:"evpnMultihoming,omitempty"`
+
+	// EgressQueuing defines the bandwidth allocation of the egress queues of the interface.
+	// Only supported on Physical and Aggregate interfaces.
+	// +optional
+	EgressQueuing *EgressQueuing `json:"egressQueuing,omitempty"`
+
+	// L2ProtocolTunnel defines Layer 2 protocol tunneling for the interface, which forwards the
+	// control protocol PDUs of a customer network transparently across the provider network.
+	// Only supported on Physical and Aggregate interfaces in access mode.
+	// +optional
+	L2ProtocolTunnel *L2ProtocolTunnel `json:"l2ProtocolTunnel,omitempty"`
 }
 
 // SpanningTree defines the spanning tree configuration for an interface.
@@ -40,11 +51,14 @@ type SpanningTree struct {
 
 	// BPDUGuard enables BPDU guard on the interface.
 	// When enabled, the port is shut down if a BPDU is received.
+	// If unset, the global default of the device applies.
 	// +optional
 	BPDUGuard *bool `json:"bpduGuard,omitempty"`
 
 	// BPDUFilter enables BPDU filter on the interface.
 	// When enabled, BPDUs are not sent or received on the port.
+	// It is configured independently of BPDUGuard, which has no effect while BPDUFilter is enabled.
+	// If unset, the global default of the device applies.
 	// +optional
 	BPDUFilter *bool `json:"bpduFilter,omitempty"`
 }
@@ -67,6 +81,105 @@ type EVPNMultihoming struct {
 	CoreTracking bool `json:"coreTracking"`
 }
 
+// EgressQueuing defines a named bandwidth allocation template for the egress queues of an interface.
+// The template is realized as a queuing policy-map on the device, which is attached to the interface
+// as output service policy. Interfaces referencing the same template must use the same allocation,
+// the allocation of a template attached to other interfaces is not changed. The policy-map is removed
+// once the template is no longer attached to any interface.
+type EgressQueuing struct {
+	// Template is the name of the bandwidth allocation template.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=40
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]+$`
+	Template string `json:"template"`
+
+	// Classes defines the share of the bandwidth allocated to each traffic class.
+	// The percentages must not sum up to more than 100.
+	// +required
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Classes []QueuingClass `json:"classes"`
+}
+
+// QueuingClass defines the bandwidth allocated to a traffic class.
+type QueuingClass struct {
+	// Name is the name of the traffic class.
+	// +required
+	Name QueuingClassName `json:"name"`
+
+	// BandwidthPercent is the percentage of the remaining bandwidth allocated to the traffic class.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	BandwidthPercent int32 `json:"bandwidthPercent"`
+}
+
+// QueuingClassName represents a system-defined egress queuing class.
+// +kubebuilder:validation:Enum=c-out-8q-q-default;c-out-8q-q1;c-out-8q-q2;c-out-8q-q3;c-out-8q-q4;c-out-8q-q5;c-out-8q-q6;c-out-8q-q7
+type QueuingClassName string
+
+const (
+	// QueuingClassDefault is the default egress queue.
+	QueuingClassDefault QueuingClassName = "c-out-8q-q-default"
+	// QueuingClassQ1 is the egress queue 1.
+	QueuingClassQ1 QueuingClassName = "c-out-8q-q1"
+	// QueuingClassQ2 is the egress queue 2.
+	QueuingClassQ2 QueuingClassName = "c-out-8q-q2"
+	// QueuingClassQ3 is the egress queue 3.
+	QueuingClassQ3 QueuingClassName = "c-out-8q-q3"
+	// QueuingClassQ4 is the egress queue 4.
+	QueuingClassQ4 QueuingClassName = "c-out-8q-q4"
+	// QueuingClassQ5 is the egress queue 5.
+	QueuingClassQ5 QueuingClassName = "c-out-8q-q5"
+	// QueuingClassQ6 is the egress queue 6.
+	QueuingClassQ6 QueuingClassName = "c-out-8q-q6"
+	// QueuingClassQ7 is the egress queue 7, which is usually reserved for control traffic.
+	QueuingClassQ7 QueuingClassName = "c-out-8q-q7"
+)
+
+// L2ProtocolTunnel defines the Layer 2 protocol tunneling configuration for an interface.
+// +kubebuilder:validation:XValidation:rule="!has(self.dropThreshold) || !has(self.shutdownThreshold) || self.dropThreshold < self.shutdownThreshold",message="shutdownThreshold must be greater than dropThreshold"
+type L2ProtocolTunnel struct {
+	// Protocols is the set of Layer 2 protocols whose PDUs are tunneled.
+	// +required
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=4
+	Protocols []L2ProtocolTunnelProtocol `json:"protocols"`
+
+	// DropThreshold is the rate in packets per second above which tunneled PDUs are dropped.
+	// If not specified, no PDUs are dropped.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4096
+	DropThreshold int32 `json:"dropThreshold,omitempty"`
+
+	// ShutdownThreshold is the rate in packets per second above which the interface is error-disabled.
+	// If not specified, the interface is never shut down. Must be greater than DropThreshold.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4096
+	ShutdownThreshold int32 `json:"shutdownThreshold,omitempty"`
+}
+
+// L2ProtocolTunnelProtocol represents a Layer 2 protocol that can be tunneled.
+// +kubebuilder:validation:Enum=CDP;LLDP;STP;VTP
+type L2ProtocolTunnelProtocol string
+
+const (
+	// L2ProtocolTunnelProtocolCDP tunnels Cisco Discovery Protocol PDUs.
+	L2ProtocolTunnelProtocolCDP L2ProtocolTunnelProtocol = "CDP"
+	// L2ProtocolTunnelProtocolLLDP tunnels Link Layer Discovery Protocol PDUs.
+	L2ProtocolTunnelProtocolLLDP L2ProtocolTunnelProtocol = "LLDP"
+	// L2ProtocolTunnelProtocolSTP tunnels Spanning Tree Protocol BPDUs.
+	L2ProtocolTunnelProtocolSTP L2ProtocolTunnelProtocol = "STP"
+	// L2ProtocolTunnelProtocolVTP tunnels VLAN Trunking Protocol PDUs.
+	L2ProtocolTunnelProtocolVTP L2ProtocolTunnelProtocol = "VTP"
+)
+
 // InterfaceConfigLACP defines LACP options for PortChannel interfaces.
 type InterfaceConfigLACP struct {
 	// VPCConvergence enables faster LACP convergence in a vPC topology.
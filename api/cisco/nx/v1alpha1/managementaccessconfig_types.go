@@ -22,6 +22,13 @@ type ManagementAccessConfigSpec struct {
 	// SSH defines the SSH server configuration for the VTY terminal access on the device.
 	// +optional
 	SSH SSH `json:"ssh,omitzero"`
+
+	// NXAPI defines the configuration for the NX-API server on the device.
+	// Saving the configuration, changing the gRPC port and other operations rely on NX-API,
+	// these fail if it is disabled.
+	// +optional
+	// +kubebuilder:default={enabled:true}
+	NXAPI NXAPI `json:"nxapi,omitzero"`
 }
 
 // Console defines the configuration for the terminal console access on the device.
@@ -45,6 +52,15 @@ type SSH struct {
 	AccessControlListName string `json:"accessControlListName,omitempty"`
 }
 
+// NXAPI defines the configuration for the NX-API server on the device.
+type NXAPI struct {
+	// Enable or disable the NX-API server on the device.
+	// If not specified, the NX-API server is enabled by default.
+	// +optional
+	// +kubebuilder:default=true
+	Enabled bool `json:"enabled"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=managementaccessconfigs
 // +kubebuilder:resource:singular=managementaccessconfig
@@ -41,6 +41,13 @@ type SystemSpec struct {
 	// +optional
 	// +kubebuilder:default=false
 	VlanLongName bool `json:"vlanLongName"`
+
+	// TagNativeVlan enables or disables tagging of native VLAN traffic on trunk ports.
+	// It is only meaningful for trunk ports and has no effect on access ports.
+	// Disabled by default.
+	// +optional
+	// +kubebuilder:default=false
+	TagNativeVlan bool `json:"tagNativeVlan"`
 }
 
 // SystemStatus defines the observed state of System.
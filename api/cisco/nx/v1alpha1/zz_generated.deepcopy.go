@@ -182,6 +182,21 @@ func (in *BGPConfigAddressFamilies) DeepCopy() *BGPConfigAddressFamilies {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPConfigBestpath) DeepCopyInto(out *BGPConfigBestpath) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPConfigBestpath.
+func (in *BGPConfigBestpath) DeepCopy() *BGPConfigBestpath {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPConfigBestpath)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPConfigList) DeepCopyInto(out *BGPConfigList) {
 	*out = *in
@@ -222,6 +237,11 @@ func (in *BGPConfigSpec) DeepCopyInto(out *BGPConfigSpec) {
 		*out = new(BGPConfigAddressFamilies)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Bestpath != nil {
+		in, out := &in.Bestpath, &out.Bestpath
+		*out = new(BGPConfigBestpath)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPConfigSpec.
@@ -445,6 +465,26 @@ func (in *EVPNMultihoming) DeepCopy() *EVPNMultihoming {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressQueuing) DeepCopyInto(out *EgressQueuing) {
+	*out = *in
+	if in.Classes != nil {
+		in, out := &in.Classes, &out.Classes
+		*out = make([]QueuingClass, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressQueuing.
+func (in *EgressQueuing) DeepCopy() *EgressQueuing {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressQueuing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Enabled) DeepCopyInto(out *Enabled) {
 	*out = *in
@@ -582,6 +622,16 @@ func (in *InterfaceConfigSpec) DeepCopyInto(out *InterfaceConfigSpec) {
 		*out = new(EVPNMultihoming)
 		**out = **in
 	}
+	if in.EgressQueuing != nil {
+		in, out := &in.EgressQueuing, &out.EgressQueuing
+		*out = new(EgressQueuing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.L2ProtocolTunnel != nil {
+		in, out := &in.L2ProtocolTunnel, &out.L2ProtocolTunnel
+		*out = new(L2ProtocolTunnel)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceConfigSpec.
@@ -614,6 +664,26 @@ func (in *KeepAlive) DeepCopy() *KeepAlive {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L2ProtocolTunnel) DeepCopyInto(out *L2ProtocolTunnel) {
+	*out = *in
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = make([]L2ProtocolTunnelProtocol, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L2ProtocolTunnel.
+func (in *L2ProtocolTunnel) DeepCopy() *L2ProtocolTunnel {
+	if in == nil {
+		return nil
+	}
+	out := new(L2ProtocolTunnel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LLDPConfig) DeepCopyInto(out *LLDPConfig) {
 	*out = *in
@@ -750,6 +820,7 @@ func (in *ManagementAccessConfigSpec) DeepCopyInto(out *ManagementAccessConfigSp
 	*out = *in
 	out.Console = in.Console
 	out.SSH = in.SSH
+	out.NXAPI = in.NXAPI
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementAccessConfigSpec.
@@ -762,6 +833,21 @@ func (in *ManagementAccessConfigSpec) DeepCopy() *ManagementAccessConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NXAPI) DeepCopyInto(out *NXAPI) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NXAPI.
+func (in *NXAPI) DeepCopy() *NXAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(NXAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkVirtualizationEdgeConfig) DeepCopyInto(out *NetworkVirtualizationEdgeConfig) {
 	*out = *in
@@ -865,6 +951,21 @@ func (in *Peer) DeepCopy() *Peer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuingClass) DeepCopyInto(out *QueuingClass) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuingClass.
+func (in *QueuingClass) DeepCopy() *QueuingClass {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuingClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSH) DeepCopyInto(out *SSH) {
 	*out = *in
@@ -39,8 +39,46 @@ type AccessControlListSpec struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=100
 	Entries []ACLEntry `json:"entries"`
+
+	// ObjectGroups is a list of named address and port groups that can be referenced by the entries
+	// instead of inline addresses and ports. The names must be unique across all object groups on the device,
+	// an object group already declared by another AccessControlList on the device is refused.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	ObjectGroups []ACLObjectGroup `json:"objectGroups,omitempty"`
+}
+
+// ACLObjectGroup defines a named group of addresses or ports.
+// +kubebuilder:validation:XValidation:rule="has(self.addresses) != has(self.ports)",message="exactly one of addresses or ports must be set"
+type ACLObjectGroup struct {
+	// Name is the identifier of the object group on the device.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	Name string `json:"name"`
+
+	// Addresses is the list of IP address prefixes of an address group.
+	// All prefixes must be of the same address family.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Addresses []IPPrefix `json:"addresses,omitempty"`
+
+	// Ports is the list of layer 4 port matches of a port group.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Ports []ACLPortMatch `json:"ports,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="has(self.sourceAddress) != has(self.sourceAddressGroup)",message="exactly one of sourceAddress or sourceAddressGroup must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.destinationAddress) != has(self.destinationAddressGroup)",message="exactly one of destinationAddress or destinationAddressGroup must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.sourcePort) || !has(self.sourcePortGroup)",message="sourcePort and sourcePortGroup are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.destinationPort) || !has(self.destinationPortGroup)",message="destinationPort and destinationPortGroup are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.sourcePort) && !has(self.destinationPort) && !has(self.sourcePortGroup) && !has(self.destinationPortGroup) || self.protocol in ['TCP', 'UDP']",message="port matches are only valid for TCP and UDP"
+// +kubebuilder:validation:XValidation:rule="!has(self.established) || !self.established || self.protocol == 'TCP'",message="established is only valid for TCP"
 type ACLEntry struct {
 	// The sequence number of the ACL entry.
 	// +required
@@ -59,13 +97,60 @@ type ACLEntry struct {
 
 	// Source IP address prefix. Can be IPv4 or IPv6.
 	// Use 0.0.0.0/0 (::/0) to represent 'any'.
-	// +required
-	SourceAddress IPPrefix `json:"sourceAddress"`
+	// Either SourceAddress or SourceAddressGroup must be set.
+	// +optional
+	SourceAddress IPPrefix `json:"sourceAddress,omitzero"`
+
+	// SourceAddressGroup is the name of an address group in ObjectGroups to match as source.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	SourceAddressGroup string `json:"sourceAddressGroup,omitempty"`
 
 	// Destination IP address prefix. Can be IPv4 or IPv6.
 	// Use 0.0.0.0/0 (::/0) to represent 'any'.
-	// +required
-	DestinationAddress IPPrefix `json:"destinationAddress"`
+	// Either DestinationAddress or DestinationAddressGroup must be set.
+	// +optional
+	DestinationAddress IPPrefix `json:"destinationAddress,omitzero"`
+
+	// DestinationAddressGroup is the name of an address group in ObjectGroups to match as destination.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	DestinationAddressGroup string `json:"destinationAddressGroup,omitempty"`
+
+	// SourcePort is the layer 4 source port to match.
+	// Only valid if the protocol is TCP or UDP.
+	// +optional
+	SourcePort *ACLPortMatch `json:"sourcePort,omitempty"`
+
+	// DestinationPort is the layer 4 destination port to match.
+	// Only valid if the protocol is TCP or UDP.
+	// +optional
+	DestinationPort *ACLPortMatch `json:"destinationPort,omitempty"`
+
+	// SourcePortGroup is the name of a port group in ObjectGroups to match as source port.
+	// Only valid if the protocol is TCP or UDP.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	SourcePortGroup string `json:"sourcePortGroup,omitempty"`
+
+	// DestinationPortGroup is the name of a port group in ObjectGroups to match as destination port.
+	// Only valid if the protocol is TCP or UDP.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	DestinationPortGroup string `json:"destinationPortGroup,omitempty"`
+
+	// Established restricts the entry to packets of established TCP connections,
+	// i.e. packets with the ACK or RST flag set. Only valid if the protocol is TCP.
+	// +optional
+	Established bool `json:"established,omitempty"`
+
+	// Log enables logging of packets that match the entry.
+	// +optional
+	Log bool `json:"log,omitempty"`
 
 	// Description provides a human-readable description of the ACL entry.
 	// +optional
@@ -74,6 +159,41 @@ type ACLEntry struct {
 	Description string `json:"description,omitempty"`
 }
 
+// ACLPortMatch defines a match on a layer 4 port or port range.
+// +kubebuilder:validation:XValidation:rule="self.operator == 'Range' ? has(self.endPort) && self.endPort >= self.port : !has(self.endPort)",message="endPort must be set for and only for the Range operator and must not be lower than port"
+type ACLPortMatch struct {
+	// Operator is the comparison operator used to match the port.
+	// +required
+	Operator ACLPortOperator `json:"operator"`
+
+	// Port is the port number to match. For the Range operator, this is the first port of the range.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// EndPort is the last port of the range (inclusive). Required for the Range operator.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	EndPort int32 `json:"endPort,omitempty"`
+}
+
+// ACLPortOperator represents the comparison operator of a port match.
+// +kubebuilder:validation:Enum=Equal;LessThan;GreaterThan;Range
+type ACLPortOperator string
+
+const (
+	// PortOperatorEqual matches the given port only.
+	PortOperatorEqual ACLPortOperator = "Equal"
+	// PortOperatorLessThan matches all ports lower than the given port.
+	PortOperatorLessThan ACLPortOperator = "LessThan"
+	// PortOperatorGreaterThan matches all ports greater than the given port.
+	PortOperatorGreaterThan ACLPortOperator = "GreaterThan"
+	// PortOperatorRange matches all ports from port to endPort (inclusive).
+	PortOperatorRange ACLPortOperator = "Range"
+)
+
 // Protocol represents the protocol type for an ACL entry.
 // +kubebuilder:validation:Enum=ICMP;IP;OSPF;PIM;TCP;UDP
 type Protocol string
@@ -104,6 +224,11 @@ type AccessControlListStatus struct {
 	// +optional
 	EntriesSummary string `json:"entriesSummary,omitempty"`
 
+	// ObjectGroups is the list of names of the object groups configured on the device for this AccessControlList.
+	// +optional
+	// +listType=set
+	ObjectGroups []string `json:"objectGroups,omitempty"`
+
 	// The conditions are a list of status objects that describe the state of the AccessControlList.
 	// +listType=map
 	// +listMapKey=type
@@ -46,6 +46,13 @@ type BGPPeerSpec struct {
 	// +required
 	ASNumber intstr.IntOrString `json:"asNumber"`
 
+	// PeerGroup is the name of a peer group of the referenced BGP instance this peer is a member of.
+	// The peer inherits the configuration of the peer group, e.g. its local address and address families.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	PeerGroup string `json:"peerGroup,omitempty"`
+
 	// Description is an optional human-readable description for this BGP peer.
 	// This field is used for documentation purposes and may be displayed in management interfaces.
 	// +optional
@@ -64,6 +71,64 @@ type BGPPeerSpec struct {
 	// LocalAS configures the local AS number and how it factors into BGP announcements for this peer.
 	// +optional
 	LocalAS *LocalAS `json:"localAS,omitempty"`
+
+	// Password is the TCP MD5 authentication password (RFC 2385) for the BGP session with this peer.
+	// When not specified, the session is not authenticated.
+	// +optional
+	Password *PasswordSource `json:"password,omitempty"`
+
+	// DisableConnectedCheck disables the check whether a single-hop eBGP peer is directly connected,
+	// e.g. for sessions between loopback addresses of adjacent devices. Only applicable to eBGP peers.
+	// +optional
+	DisableConnectedCheck bool `json:"disableConnectedCheck,omitempty"`
+
+	// Capabilities configures the capability negotiation with this peer, e.g. for interoperability
+	// with peers that do not handle specific capabilities correctly.
+	// +optional
+	Capabilities *BGPPeerCapabilities `json:"capabilities,omitempty"`
+
+	// Timers configures the timers of the BGP session with this peer, e.g. to tune the convergence
+	// of specific peers. If not specified, the device defaults are used.
+	// +optional
+	Timers *BGPPeerTimers `json:"timers,omitempty"`
+}
+
+// BGPPeerTimers defines the timers of the BGP session with a peer.
+type BGPPeerTimers struct {
+	// ConnectRetry is the interval between two attempts to establish the session with the peer.
+	// A shorter interval restores the session faster after a failure, at the cost of more frequent
+	// connection attempts towards a peer that remains unreachable.
+	// Must be a whole number of seconds between 1s and 65535s.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s') && duration(self) <= duration('65535s')",message="connectRetry must be between 1s and 65535s"
+	ConnectRetry *metav1.Duration `json:"connectRetry,omitempty"`
+
+	// MinimumAdvertisementInterval is the minimum interval between two updates for the same prefix
+	// sent to the peer (MRAI). A shorter interval propagates routing changes faster and speeds up
+	// convergence, while a longer interval batches the updates of flapping routes and thereby
+	// dampens the churn they cause in the network, favoring stability.
+	// Must be a whole number of seconds between 1s and 600s.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s') && duration(self) <= duration('600s')",message="minimumAdvertisementInterval must be between 1s and 600s"
+	MinimumAdvertisementInterval *metav1.Duration `json:"minimumAdvertisementInterval,omitempty"`
+}
+
+// BGPPeerCapabilities defines the capability negotiation controls of a BGP peer.
+// +kubebuilder:validation:XValidation:rule="!(has(self.dontNegotiate) && self.dontNegotiate && has(self.suppressFourByteAS) && self.suppressFourByteAS)",message="suppressFourByteAS has no effect if dontNegotiate is set"
+type BGPPeerCapabilities struct {
+	// DontNegotiate disables the negotiation of capabilities with the peer entirely,
+	// i.e. no capabilities are advertised in the OPEN message sent to the peer.
+	// +optional
+	DontNegotiate bool `json:"dontNegotiate,omitempty"`
+
+	// SuppressFourByteAS suppresses the advertisement of the 4-byte AS number capability (RFC 6793)
+	// to the peer, e.g. for older peers that only support 2-byte AS numbers.
+	// +optional
+	SuppressFourByteAS bool `json:"suppressFourByteAS,omitempty"`
 }
 
 // LocalAS defines the local AS configuration and how it factors in BGP announcements.
@@ -124,6 +189,9 @@ type BGPPeerAddressFamilies struct {
 }
 
 // BGPPeerAddressFamily defines common configuration for a BGP peer's address family.
+// +kubebuilder:validation:XValidation:rule="!has(self.linkBandwidth) || (has(self.sendCommunity) && self.sendCommunity in ['Extended', 'Both'])",message="linkBandwidth requires sendCommunity to be Extended or Both"
+// +kubebuilder:validation:XValidation:rule="!(has(self.asOverride) && self.asOverride && has(self.allowASIn))",message="asOverride and allowASIn are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="has(self.maxPrefixes) || !(has(self.maxPrefixWarningPercent) || has(self.maxPrefixRestartMinutes))",message="maxPrefixWarningPercent and maxPrefixRestartMinutes require maxPrefixes"
 type BGPPeerAddressFamily struct {
 	// Enabled determines whether this address family is activated for this specific peer.
 	// When false, the address family is not negotiated with this peer.
@@ -150,6 +218,70 @@ type BGPPeerAddressFamily struct {
 	// for this address family.
 	// +optional
 	OutboundRoutingPolicyRef *LocalObjectReference `json:"outboundRoutingPolicyRef,omitempty"`
+
+	// LinkBandwidth configures the link-bandwidth extended community attached to routes advertised
+	// to this peer for this address family, allowing the receiving side to perform weighted ECMP.
+	// Requires the extended community attributes to be sent to this peer.
+	// +optional
+	LinkBandwidth *BGPLinkBandwidth `json:"linkBandwidth,omitempty"`
+
+	// ASOverride replaces the AS number of this peer with the local AS number in the AS path of routes
+	// advertised to this peer for this address family. This allows sites reusing the same AS number,
+	// e.g. CE devices of an MPLS VPN, to accept routes from each other. Mutually exclusive with AllowASIn.
+	// +optional
+	ASOverride bool `json:"asOverride,omitempty"`
+
+	// AllowASIn accepts routes received from this peer for this address family, even if the local AS number
+	// is already contained in their AS path. Mutually exclusive with ASOverride.
+	// +optional
+	AllowASIn *BGPAllowASIn `json:"allowASIn,omitempty"`
+
+	// MaxPrefixes is the maximum number of prefixes accepted from this peer for this address family.
+	// When exceeded, the session is torn down. If not specified, the number of prefixes is not limited.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxPrefixes uint32 `json:"maxPrefixes,omitempty"`
+
+	// MaxPrefixWarningPercent is the percentage of MaxPrefixes at which a warning is logged.
+	// If not specified, the device default is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	MaxPrefixWarningPercent uint8 `json:"maxPrefixWarningPercent,omitempty"`
+
+	// MaxPrefixRestartMinutes is the time in minutes after which a session torn down for exceeding
+	// MaxPrefixes is restarted. If not specified, the session remains down until cleared manually.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	MaxPrefixRestartMinutes uint16 `json:"maxPrefixRestartMinutes,omitempty"`
+}
+
+// BGPAllowASIn defines how often the local AS number may occur in the AS path of routes received from a BGP peer.
+type BGPAllowASIn struct {
+	// Occurrences is the maximum number of times the local AS number may occur in the AS path.
+	// +optional
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	Occurrences int32 `json:"occurrences,omitempty"`
+}
+
+// BGPLinkBandwidth defines the link-bandwidth extended community advertised to a BGP peer.
+// Exactly one of BandwidthMbps or Aggregate must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.bandwidthMbps) != (has(self.aggregate) && self.aggregate)",message="exactly one of bandwidthMbps or aggregate must be set"
+type BGPLinkBandwidth struct {
+	// BandwidthMbps is the link bandwidth in Mbps advertised to the peer.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=25600000
+	BandwidthMbps int32 `json:"bandwidthMbps,omitempty"`
+
+	// Aggregate advertises the sum of the link bandwidths of all multipaths of a route to the peer,
+	// instead of a fixed bandwidth. Requires multipath to be enabled for the address family of the
+	// BGP instance.
+	// +optional
+	Aggregate bool `json:"aggregate,omitempty"`
 }
 
 // BGPPeerStatus defines the observed state of BGPPeer.
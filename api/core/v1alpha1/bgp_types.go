@@ -53,15 +53,50 @@ type BGPSpec struct {
 	// AddressFamilies configures supported BGP address families and their specific settings.
 	// +optional
 	AddressFamilies *BGPAddressFamilies `json:"addressFamilies,omitempty"`
+
+	// PeerGroups configures peer groups holding settings shared by all of their members.
+	// BGPPeers join a peer group by referencing it by name, inheriting its configuration.
+	// Peer group names must be unique across all BGP instances of a device.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	PeerGroups []BGPPeerGroup `json:"peerGroups,omitempty"`
+}
+
+// BGPPeerGroup defines a group of BGP peers sharing a common configuration.
+type BGPPeerGroup struct {
+	// Name is the name of the peer group.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// LocalAddress specifies the local address configuration for the BGP sessions with the peers in this group.
+	// This determines the source address/interface for BGP updates sent to all members of the group.
+	// +optional
+	LocalAddress *BGPPeerLocalAddress `json:"localAddress,omitempty"`
+
+	// AddressFamilies lists the address families activated for the peers in this group.
+	// +optional
+	// +listType=set
+	AddressFamilies []BGPAddressFamilyType `json:"addressFamilies,omitempty"`
 }
 
 // BGPMultipath defines the configuration for BGP multipath behavior.
+// +kubebuilder:validation:XValidation:rule="!has(self.weighted) || !self.weighted || (has(self.enabled) && self.enabled)",message="weighted multipath requires multipath to be enabled"
 type BGPMultipath struct {
 	// Enabled determines whether BGP is allowed to use multiple paths for forwarding.
 	// When false, BGP will only use a single best path regardless of multiple equal-cost paths.
 	// +optional
 	Enabled bool `json:"enabled,omitempty"`
 
+	// Weighted enables weighted ECMP, distributing traffic across the multipaths in proportion to the
+	// bandwidth advertised with the link-bandwidth extended community of each path.
+	// Paths without a link-bandwidth extended community are load balanced equally.
+	// +optional
+	Weighted bool `json:"weighted,omitempty"`
+
 	// Ebgp configures multipath behavior for external BGP (eBGP) paths.
 	// +optional
 	Ebgp *BGPMultipathEbgp `json:"ebgp,omitempty"`
@@ -136,6 +171,14 @@ type BGPUnicastAddressFamily struct {
 	// routes into this BGP address family.
 	// +optional
 	RedistributeDirectRoutes *BGPRedistributeDirectRoutes `json:"redistributeDirectRoutes,omitempty"`
+
+	// Redistribute configures redistribution of routes learned from other
+	// protocols into this BGP address family, e.g. static or OSPF routes.
+	// +optional
+	// +listType=map
+	// +listMapKey=protocol
+	// +kubebuilder:validation:MaxItems=2
+	Redistribute []BGPRedistribution `json:"redistribute,omitempty"`
 }
 
 // BGPRedistributeDirectRoutes configures redistribution of directly connected
@@ -146,6 +189,37 @@ type BGPRedistributeDirectRoutes struct {
 	RoutingPolicyRef LocalObjectReference `json:"routingPolicyRef"`
 }
 
+// BGPRedistribution configures redistribution of the routes of a protocol
+// into a BGP address family.
+// +kubebuilder:validation:XValidation:rule="self.protocol == 'OSPF' ? has(self.instance) : !has(self.instance)",message="instance must be set if and only if the protocol is OSPF"
+type BGPRedistribution struct {
+	// Protocol is the protocol whose routes are redistributed.
+	// +required
+	Protocol BGPRedistributionProtocol `json:"protocol"`
+
+	// Instance is the process tag of the OSPF instance whose routes are redistributed.
+	// Required if the protocol is OSPF.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Instance string `json:"instance,omitempty"`
+
+	// RoutingPolicyRef references a RoutingPolicy to apply during redistribution.
+	// +required
+	RoutingPolicyRef LocalObjectReference `json:"routingPolicyRef"`
+}
+
+// BGPRedistributionProtocol is a protocol whose routes can be redistributed into BGP.
+// +kubebuilder:validation:Enum=Static;OSPF
+type BGPRedistributionProtocol string
+
+const (
+	// BGPRedistributionProtocolStatic redistributes static routes.
+	BGPRedistributionProtocolStatic BGPRedistributionProtocol = "Static"
+	// BGPRedistributionProtocolOSPF redistributes routes learned by an OSPF instance.
+	BGPRedistributionProtocolOSPF BGPRedistributionProtocol = "OSPF"
+)
+
 // BGPL2vpnEvpn defines the configuration for L2VPN EVPN address family.
 type BGPL2vpnEvpn struct {
 	BGPAddressFamily `json:",inline"`
@@ -172,6 +246,12 @@ type BGPStatus struct {
 	// +patchMergeKey=type
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// PeerGroups lists the names of the peer groups configured on the device for this BGP instance.
+	// It is used to remove peer groups from the device once they are removed from the spec.
+	// +listType=set
+	// +optional
+	PeerGroups []string `json:"peerGroups,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -40,6 +40,18 @@ type CertificateSpec struct {
 
 // CertificateStatus defines the observed state of Certificate.
 type CertificateStatus struct {
+	// SerialNumber is the serial number of the certificate installed on the device, as an uppercase hex string.
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// NotBefore is the time from which on the certificate installed on the device is valid.
+	// +optional
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+
+	// NotAfter is the time at which the certificate installed on the device expires.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
 	// The conditions are a list of status objects that describe the state of the Certificate.
 	// +listType=map
 	// +listMapKey=type
@@ -56,6 +68,7 @@ type CertificateStatus struct {
 // +kubebuilder:resource:shortName=cert;netcert
 // +kubebuilder:printcolumn:name="Certificate",type=string,JSONPath=`.spec.id`
 // +kubebuilder:printcolumn:name="Device",type=string,JSONPath=`.spec.deviceRef.name`
+// +kubebuilder:printcolumn:name="Expires",type=date,JSONPath=`.status.notAfter`,priority=1
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Paused",type=string,JSONPath=`.status.conditions[?(@.type=="Paused")].status`,priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
@@ -7,6 +7,7 @@
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"slices"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -14,6 +15,7 @@
 )
 
 // DeviceSpec defines the desired state of Device.
+// +kubebuilder:validation:XValidation:rule="!has(self.autoSaveConfigInterval) || (has(self.autoSaveConfig) && self.autoSaveConfig != 'Never')",message="autoSaveConfigInterval must not be specified if autoSaveConfig is Never"
 type DeviceSpec struct {
 	// Paused can be used to prevent controllers from processing the Device and its associated objects.
 	// +optional
@@ -28,6 +30,176 @@ type DeviceSpec struct {
 	// It can be used to provide initial configuration templates or scripts that are applied during the device provisioning.
 	// +optional
 	Provisioning *Provisioning `json:"provisioning,omitempty"`
+
+	// VRFDerivation configures how route distinguishers and route targets are derived for VRFs
+	// on this device that do not specify them explicitly.
+	// +optional
+	VRFDerivation *VRFDerivation `json:"vrfDerivation,omitempty"`
+
+	// ECMP configures the system-wide equal-cost multi-path (ECMP) settings of the device.
+	// +optional
+	ECMP *DeviceECMP `json:"ecmp,omitempty"`
+
+	// LACP configures the system-wide Link Aggregation Control Protocol (LACP) settings of the device.
+	// +optional
+	LACP *DeviceLACP `json:"lacp,omitempty"`
+
+	// Hostname is the hostname configured on the device. It must be a valid RFC 1123 label.
+	// If not specified, the hostname of the device is not managed.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Hostname string `json:"hostname,omitempty"`
+
+	// AutoSaveConfig specifies when the running configuration is saved to the startup configuration,
+	// so that it persists across reloads of the device.
+	// +optional
+	// +kubebuilder:default=Never
+	AutoSaveConfig AutoSaveConfigPolicy `json:"autoSaveConfig,omitempty"`
+
+	// SaveConfig specifies whether the running configuration is saved to the startup configuration
+	// after it changed, so that it persists across reloads of the device.
+	// Deprecated: Use AutoSaveConfig instead. If true and AutoSaveConfig is Never, the OnChange policy is used.
+	// +optional
+	SaveConfig bool `json:"saveConfig,omitempty"`
+
+	// AutoSaveConfigInterval is the interval of the auto-save policy. For the OnChange policy, it is the
+	// minimum time between two consecutive saves, such that bursts of changes result in a single save.
+	// Defaults to 1m. For the Periodic policy, it is the time between two saves. Defaults to 1h.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('10s')",message="autoSaveConfigInterval must be at least 10s"
+	AutoSaveConfigInterval *metav1.Duration `json:"autoSaveConfigInterval,omitempty"`
+
+	// MaintenanceWindows restricts changes to the configuration of the device to the given windows.
+	// Outside of all windows, changes to the device on behalf of its resources, including their deletion,
+	// are deferred while their status is still observed. Resources with pending changes report the PendingChange condition.
+	// The deferred changes are applied automatically once a window opens.
+	// If not specified, changes are applied immediately.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// Ownership restricts the VLANs and VRFs managed by the operator on the device.
+	// It is intended for devices that are shared with other tools, such that the operator
+	// never modifies or deletes configuration it has not been assigned.
+	// Resources that configure other VLANs or VRFs, e.g. routed VLAN interfaces, interfaces and BGP
+	// instances in a VRF or EVPN instances, are refused.
+	// If not specified, all VLANs and VRFs are managed.
+	// +optional
+	Ownership *DeviceOwnership `json:"ownership,omitempty"`
+}
+
+// DeviceOwnership defines the VLANs and VRFs the operator is allowed to manage on a device.
+type DeviceOwnership struct {
+	// VLANs are the ranges of VLAN IDs managed by the operator, in the format "start..end".
+	// If not specified, all VLANs are managed.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	VLANs []IndexRange `json:"vlans,omitempty"`
+
+	// VRFs are the names of the VRFs managed by the operator.
+	// If not specified, all VRFs are managed.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	VRFs []string `json:"vrfs,omitempty"`
+}
+
+// OwnsVLAN reports whether the VLAN with the given ID may be managed by the operator.
+func (o *DeviceOwnership) OwnsVLAN(id int16) bool {
+	if o == nil || len(o.VLANs) == 0 {
+		return true
+	}
+	for _, r := range o.VLANs {
+		if int64(id) >= r.Start && int64(id) <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+// OwnsVRF reports whether the VRF with the given name may be managed by the operator.
+func (o *DeviceOwnership) OwnsVRF(name string) bool {
+	if o == nil || len(o.VRFs) == 0 {
+		return true
+	}
+	return slices.Contains(o.VRFs, name)
+}
+
+// MaintenanceWindow defines a recurring time window during which the configuration of a device may be changed.
+type MaintenanceWindow struct {
+	// Days are the days of the week on which the window opens. If not specified, the window opens every day.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=7
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start is the time of day in UTC at which the window opens, in the format HH:MM.
+	// +required
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// Duration is the length of the window. It must not exceed 24h.
+	// +required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m') && duration(self) <= duration('24h')",message="duration must be between 1m and 24h"
+	Duration metav1.Duration `json:"duration"`
+}
+
+// Weekday is a day of the week.
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type Weekday string
+
+const (
+	Monday    Weekday = "Monday"
+	Tuesday   Weekday = "Tuesday"
+	Wednesday Weekday = "Wednesday"
+	Thursday  Weekday = "Thursday"
+	Friday    Weekday = "Friday"
+	Saturday  Weekday = "Saturday"
+	Sunday    Weekday = "Sunday"
+)
+
+// AutoSaveConfigPolicy defines when the running configuration of a device is saved to its startup configuration.
+// +kubebuilder:validation:Enum=Never;OnChange;Periodic
+type AutoSaveConfigPolicy string
+
+const (
+	// AutoSaveConfigNever indicates that the configuration is never saved by the operator.
+	AutoSaveConfigNever AutoSaveConfigPolicy = "Never"
+	// AutoSaveConfigOnChange indicates that the configuration is saved whenever the running configuration changed.
+	AutoSaveConfigOnChange AutoSaveConfigPolicy = "OnChange"
+	// AutoSaveConfigPeriodic indicates that the configuration is saved in fixed intervals, if it changed since the last save.
+	AutoSaveConfigPeriodic AutoSaveConfigPolicy = "Periodic"
+)
+
+// DeviceECMP defines the system-wide equal-cost multi-path (ECMP) settings of a device.
+type DeviceECMP struct {
+	// MaximumPaths is the maximum number of equal-cost next-hops that can be installed in hardware for a single route.
+	// The value is validated against the maximum supported by the platform of the device.
+	// Changing this value may require a reload of the device, which is reported by the ReloadRequired condition.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	MaximumPaths int32 `json:"maximumPaths"`
+}
+
+// DeviceLACP defines the system-wide Link Aggregation Control Protocol (LACP) settings of a device.
+type DeviceLACP struct {
+	// SystemPriority is the LACP system priority of the device, which applies to all of its aggregate interfaces.
+	// The system with the lower value decides which member interfaces of an aggregate are active.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	SystemPriority int32 `json:"systemPriority"`
 }
 
 // Endpoint contains the connection information for the device.
@@ -70,6 +242,35 @@ type Provisioning struct {
 	BootScript TemplateSource `json:"bootScript"`
 }
 
+// VRFDerivation defines a scheme to derive route distinguishers and route targets for VRFs.
+// The formats are templates of the form <admin>:<assigned>, in which the following placeholders are substituted:
+//   - {asn}: the AS number of the BGP instance in the default VRF of the device, in plain notation
+//   - {routerId}: the router identifier of the BGP instance in the default VRF of the device
+//   - {vni}: the L3 VNI of the VRF, taken from the Routed EVPNInstance referencing the VRF
+//
+// The derived values must be valid type-0, type-1 or type-2 route distinguishers.
+// +kubebuilder:validation:XValidation:rule="has(self.routeDistinguisher) || has(self.routeTarget)",message="at least one of routeDistinguisher or routeTarget must be specified"
+type VRFDerivation struct {
+	// RouteDistinguisher is the format of the route distinguisher, e.g. "{routerId}:{vni}".
+	// Used when the VRF does not specify a route distinguisher.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(\{asn\}|\{routerId\}|[0-9.]+):(\{vni\}|[0-9]+)$`
+	RouteDistinguisher string `json:"routeDistinguisher,omitempty"`
+
+	// RouteTarget is the format of the route target, e.g. "{asn}:{vni}".
+	// Used when the VRF does not specify any route targets.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(\{asn\}|\{routerId\}|[0-9.]+):(\{vni\}|[0-9]+)$`
+	RouteTarget string `json:"routeTarget,omitempty"`
+
+	// RouteTargetAddressFamilies is the list of address families the derived route target is imported and exported for.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:default={IPv4EVPN,IPv6EVPN}
+	RouteTargetAddressFamilies []RouteTargetAF `json:"routeTargetAddressFamilies,omitempty"`
+}
+
 // ChecksumType defines the type of checksum used for image verification.
 // +kubebuilder:validation:Enum=SHA256;MD5
 type ChecksumType string
@@ -149,10 +350,26 @@ type DeviceStatus struct {
 	// +optional
 	FirmwareVersion string `json:"firmwareVersion,omitempty"`
 
+	// DataModelVersion is the version of the data model exposed by the Device, e.g. the revision of its YANG model.
+	// It usually changes together with the firmware version and is used to detect data model changes after upgrades.
+	// +optional
+	DataModelVersion string `json:"dataModelVersion,omitempty"`
+
 	// LastRebootTime is the timestamp of the last reboot of the Device, if known.
 	// +optional
 	LastRebootTime metav1.Time `json:"lastRebootTime,omitempty"`
 
+	// LastConfigSaveTime is the timestamp of the last time the operator saved the running configuration
+	// of the Device to its startup configuration.
+	// +optional
+	LastConfigSaveTime metav1.Time `json:"lastConfigSaveTime,omitempty"`
+
+	// SavedConfigRevision is the revision of the running configuration at the time it was last saved,
+	// as reported by the device, e.g. the time of its last change.
+	// It is used to detect changes of the running configuration for the OnChange auto-save policy.
+	// +optional
+	SavedConfigRevision string `json:"savedConfigRevision,omitempty"`
+
 	// Provisioning is the list of provisioning attempts for the Device.
 	// +listType=map
 	// +listMapKey=startTime
@@ -230,3 +230,91 @@ func TestDevice_CreateProvisioningEntry(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceOwnership_OwnsVLAN(t *testing.T) {
+	tests := []struct {
+		name      string
+		ownership *DeviceOwnership
+		id        int16
+		want      bool
+	}{
+		{
+			name:      "nil ownership",
+			ownership: nil,
+			id:        100,
+			want:      true,
+		},
+		{
+			name:      "no vlan ranges",
+			ownership: &DeviceOwnership{VRFs: []string{"CC-PROD"}},
+			id:        100,
+			want:      true,
+		},
+		{
+			name:      "within range",
+			ownership: &DeviceOwnership{VLANs: []IndexRange{MustParseIndexRange("100..199"), MustParseIndexRange("300..399")}},
+			id:        300,
+			want:      true,
+		},
+		{
+			name:      "range boundary",
+			ownership: &DeviceOwnership{VLANs: []IndexRange{MustParseIndexRange("100..199")}},
+			id:        199,
+			want:      true,
+		},
+		{
+			name:      "outside of range",
+			ownership: &DeviceOwnership{VLANs: []IndexRange{MustParseIndexRange("100..199")}},
+			id:        200,
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ownership.OwnsVLAN(tt.id); got != tt.want {
+				t.Errorf("OwnsVLAN(%d) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeviceOwnership_OwnsVRF(t *testing.T) {
+	tests := []struct {
+		name      string
+		ownership *DeviceOwnership
+		vrf       string
+		want      bool
+	}{
+		{
+			name:      "nil ownership",
+			ownership: nil,
+			vrf:       "CC-PROD",
+			want:      true,
+		},
+		{
+			name:      "no vrf names",
+			ownership: &DeviceOwnership{VLANs: []IndexRange{MustParseIndexRange("100..199")}},
+			vrf:       "CC-PROD",
+			want:      true,
+		},
+		{
+			name:      "listed vrf",
+			ownership: &DeviceOwnership{VRFs: []string{"CC-PROD", "CC-MGMT"}},
+			vrf:       "CC-MGMT",
+			want:      true,
+		},
+		{
+			name:      "unlisted vrf",
+			ownership: &DeviceOwnership{VRFs: []string{"CC-PROD"}},
+			vrf:       "management",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ownership.OwnsVRF(tt.vrf); got != tt.want {
+				t.Errorf("OwnsVRF(%q) = %v, want %v", tt.vrf, got, tt.want)
+			}
+		})
+	}
+}
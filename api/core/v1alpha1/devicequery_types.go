@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeviceQuerySpec defines the desired state of DeviceQuery.
+// A DeviceQuery is a read-only diagnostic request that is executed once per generation of the resource.
+// To run the query again, update the spec or recreate the resource.
+// +kubebuilder:validation:XValidation:rule="[has(self.path), has(self.route), has(self.runningConfig)].filter(x, x).size() == 1",message="exactly one of path, route or runningConfig must be specified"
+type DeviceQuerySpec struct {
+	// DeviceRef is a reference to the Device this object belongs to. The Device object must exist in the same namespace.
+	// Immutable.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="DeviceRef is immutable"
+	DeviceRef LocalObjectReference `json:"deviceRef"`
+
+	// Path is the xpath of the data to retrieve from the device, e.g. "System/intf-items/phys-items".
+	// The path is passed to the provider as is and must be valid for the data model of the target device.
+	// Exactly one of Path, Route or RunningConfig must be specified.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Path string `json:"path,omitempty"`
+
+	// DataType is the type of data to retrieve from the device. It only applies to queries of a Path.
+	// +optional
+	// +kubebuilder:default=State
+	DataType DeviceQueryDataType `json:"dataType,omitempty"`
+
+	// Route looks up the entry of a prefix in the routing table of a VRF, i.e. its next-hops together with
+	// the protocol, administrative distance and metric of each next-hop.
+	// Exactly one of Path, Route or RunningConfig must be specified.
+	// +optional
+	Route *DeviceQueryRoute `json:"route,omitempty"`
+
+	// RunningConfig retrieves the running configuration of the device in the text form printed by its CLI
+	// and writes it to a ConfigMap, e.g. for human review during audits.
+	// Exactly one of Path, Route or RunningConfig must be specified.
+	// +optional
+	RunningConfig *DeviceQueryRunningConfig `json:"runningConfig,omitempty"`
+}
+
+// DeviceQueryRoute identifies the entry of a prefix in the routing table of a VRF.
+type DeviceQueryRoute struct {
+	// VRF is the name of the VRF whose routing table is queried. If not specified, the default VRF is used.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32
+	VRF string `json:"vrf,omitempty"`
+
+	// Prefix is the destination prefix of the route, e.g. "10.0.0.0/24". It is matched exactly.
+	// +required
+	Prefix IPPrefix `json:"prefix"`
+}
+
+// DeviceQueryRunningConfig identifies the ConfigMap the running configuration of a device is written to.
+type DeviceQueryRunningConfig struct {
+	// ConfigMapName is the name of the ConfigMap in the namespace of the DeviceQuery the running configuration
+	// is written to under the key "running-config". The ConfigMap is created if it does not exist and is owned
+	// by the DeviceQuery. Values of sensitive settings, such as passwords and keys, are redacted.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	ConfigMapName string `json:"configMapName"`
+}
+
+// DeviceQueryRunningConfigKey is the key of the running configuration in the ConfigMap of a DeviceQuery.
+const DeviceQueryRunningConfigKey = "running-config"
+
+// DeviceQueryDataType represents the type of data retrieved by a DeviceQuery.
+// +kubebuilder:validation:Enum=Config;State
+type DeviceQueryDataType string
+
+const (
+	// DeviceQueryDataTypeConfig retrieves the configuration data at the requested path.
+	DeviceQueryDataTypeConfig DeviceQueryDataType = "Config"
+	// DeviceQueryDataTypeState retrieves the operational state data at the requested path.
+	DeviceQueryDataTypeState DeviceQueryDataType = "State"
+)
+
+// DeviceQueryStatus defines the observed state of DeviceQuery.
+type DeviceQueryStatus struct {
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the DeviceQuery resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the generation of the spec the query was last executed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// QueryTime is the time the query was last executed.
+	// +optional
+	QueryTime *metav1.Time `json:"queryTime,omitempty"`
+
+	// Result is the JSON encoded data returned by the device.
+	// Values of sensitive fields, such as passwords and keys, are redacted.
+	// +optional
+	Result string `json:"result,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=devicequeries
+// +kubebuilder:resource:singular=devicequery
+// +kubebuilder:printcolumn:name="Device",type=string,JSONPath=`.spec.deviceRef.name`
+// +kubebuilder:printcolumn:name="Path",type=string,JSONPath=`.spec.path`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.dataType`,priority=1
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DeviceQuery is the Schema for the devicequeries API
+type DeviceQuery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// +required
+	Spec DeviceQuerySpec `json:"spec"`
+
+	// +optional
+	Status DeviceQueryStatus `json:"status,omitzero"`
+}
+
+// GetConditions implements conditions.Getter.
+func (q *DeviceQuery) GetConditions() []metav1.Condition {
+	return q.Status.Conditions
+}
+
+// SetConditions implements conditions.Setter.
+func (q *DeviceQuery) SetConditions(conditions []metav1.Condition) {
+	q.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// DeviceQueryList contains a list of DeviceQuery
+type DeviceQueryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []DeviceQuery `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(func(s *runtime.Scheme) error {
+		s.AddKnownTypes(GroupVersion, &DeviceQuery{}, &DeviceQueryList{})
+		return nil
+	})
+}
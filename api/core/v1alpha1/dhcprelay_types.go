@@ -42,8 +42,35 @@ type DHCPRelaySpec struct {
 	// +listType=atomic
 	// +kubebuilder:validation:MinItems=1
 	InterfaceRefs []LocalObjectReference `json:"interfaceRefs,omitempty"`
+
+	// Option82 configures the insertion of the relay agent information option (option 82) into DHCP messages
+	// relayed on the referenced interfaces, e.g. to identify subscribers on access networks.
+	// The circuit-id and remote-id sub-options use the platform defaults. Some platforms, e.g. Cisco NX-OS,
+	// only support enabling the insertion for the whole device. DHCP snooping is not managed by this resource.
+	// If not specified, option 82 is not inserted.
+	// +optional
+	Option82 *DHCPRelayOption82 `json:"option82,omitempty"`
+}
+
+// DHCPRelayOption82 defines the relay agent information option (option 82) settings of a DHCPRelay.
+type DHCPRelayOption82 struct {
+	// Policy defines how DHCP messages received from clients that already contain option 82 are handled.
+	// If not specified, the platform default is used.
+	// +optional
+	Policy DHCPOption82Policy `json:"policy,omitempty"`
 }
 
+// DHCPOption82Policy represents how DHCP messages already containing option 82 are handled.
+// +kubebuilder:validation:Enum=Keep;Drop
+type DHCPOption82Policy string
+
+const (
+	// DHCPOption82PolicyKeep forwards the message with the existing option 82 unchanged.
+	DHCPOption82PolicyKeep DHCPOption82Policy = "Keep"
+	// DHCPOption82PolicyDrop drops the message.
+	DHCPOption82PolicyDrop DHCPOption82Policy = "Drop"
+)
+
 // DHCPRelayStatus defines the observed state of DHCPRelay.
 type DHCPRelayStatus struct {
 	// For Kubernetes API conventions, see:
@@ -12,6 +12,7 @@
 )
 
 // DNSSpec defines the desired state of DNS
+// +kubebuilder:validation:XValidation:rule="!has(self.resolutionOrder) || self.resolutionOrder.all(v, has(self.servers) && self.servers.exists(s, has(s.vrfName) && s.vrfName == v))",message="each VRF in resolutionOrder must be used by at least one server"
 type DNSSpec struct {
 	// DeviceName is the name of the Device this object belongs to. The Device object must exist in the same namespace.
 	// Immutable.
@@ -36,6 +37,18 @@ type DNSSpec struct {
 	// +kubebuilder:validation:Format=hostname
 	Domain string `json:"domain"`
 
+	// SearchDomains is the ordered list of domains that the device appends to unqualified hostnames
+	// during address resolution, in addition to the default domain. The domains are tried in the order of the list.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=6
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:items:Format=hostname
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y == x))",message="searchDomains must not contain duplicates"
+	SearchDomains []string `json:"searchDomains,omitempty"`
+
 	// A list of DNS servers to use for address resolution.
 	// +optional
 	// +listType=map
@@ -44,6 +57,17 @@ type DNSSpec struct {
 	// +kubebuilder:validation:MaxItems=6
 	Servers []NameServer `json:"servers,omitempty"`
 
+	// ResolutionOrder is the ordered list of VRFs used for address resolution.
+	// The device queries the servers of the first VRF and falls back to the servers of the next VRF on failure.
+	// Each VRF must be used by at least one server and must exist on the device.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=6
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y == x))",message="resolutionOrder must not contain duplicates"
+	ResolutionOrder []string `json:"resolutionOrder,omitempty"`
+
 	// Source interface for all DNS traffic.
 	// +optional
 	// +kubebuilder:validation:MinLength=1
@@ -124,8 +124,6 @@ type EthernetSegmentSpec struct {
 )
 
 // DesignatedForwarder configures the DF election parameters for an Ethernet Segment.
-//
-// +kubebuilder:validation:XValidation:rule="self.electionMode == 'Preference' || !has(self.electionWaitTime)",message="electionWaitTime is only valid when electionMode is Preference"
 type DesignatedForwarder struct {
 	// ElectionMode selects the DF election algorithm.
 	// +kubebuilder:default=Default
@@ -133,9 +131,11 @@ type DesignatedForwarder struct {
 	ElectionMode DFElectionMode `json:"electionMode,omitempty"`
 
 	// ElectionWaitTime is the DF election hold timer. The PE waits this
-	// duration before selecting the DF based on highest preference.
-	// Only applicable when ElectionMode is Preference.
+	// duration after the Ethernet Segment comes up before electing the DF,
+	// allowing the routes of all PEs attached to the segment to be received.
+	// All Ethernet Segments on the same Device must use the same value.
 	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="electionWaitTime must be positive"
 	ElectionWaitTime *metav1.Duration `json:"electionWaitTime,omitempty"`
 }
 
@@ -19,6 +19,9 @@
 // +kubebuilder:validation:XValidation:rule="self.type != 'Bridged' || has(self.vlanRef)",message="VLANRef must be specified when Type is Bridged"
 // +kubebuilder:validation:XValidation:rule="self.type != 'Routed' || has(self.vrfRef)",message="VRFRef must be specified when Type is Routed"
 // +kubebuilder:validation:XValidation:rule="self.type != 'Routed' || !has(self.routeDistinguisher)",message="RouteDistinguisher must not be set when Type is Routed"
+// +kubebuilder:validation:XValidation:rule="self.type != 'Routed' || !has(self.replicationMode)",message="ReplicationMode must not be set when Type is Routed"
+// +kubebuilder:validation:XValidation:rule="!has(self.replicationMode) || self.replicationMode != 'Multicast' || has(self.multicastGroupAddress)",message="MulticastGroupAddress must be specified when ReplicationMode is Multicast"
+// +kubebuilder:validation:XValidation:rule="!has(self.replicationMode) || self.replicationMode != 'IngressReplication' || !has(self.multicastGroupAddress)",message="MulticastGroupAddress must not be set when ReplicationMode is IngressReplication"
 type EVPNInstanceSpec struct {
 	// DeviceName is the name of the Device this object belongs to. The Device object must exist in the same namespace.
 	// Immutable.
@@ -51,6 +54,14 @@ type EVPNInstanceSpec struct {
 	// +kubebuilder:validation:Format=ipv4
 	MulticastGroupAddress string `json:"multicastGroupAddress,omitempty"`
 
+	// ReplicationMode overrides the replication mode of the NetworkVirtualizationEdge for BUM traffic of the VNI.
+	// The Multicast mode requires MulticastGroupAddress to be specified, while the IngressReplication mode
+	// requires the NetworkVirtualizationEdge to use BGP host reachability.
+	// This field is only applicable when Type is Bridged.
+	// If not specified, the replication mode of the NetworkVirtualizationEdge applies.
+	// +optional
+	ReplicationMode ReplicationMode `json:"replicationMode,omitempty"`
+
 	// RouteDistinguisher is the route distinguisher for the EVI.
 	// This field is only applicable when Type is Bridged (MAC-VRF).
 	// For Routed type, the route distinguisher is configured on the referenced VRF instead.
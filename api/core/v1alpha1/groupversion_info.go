@@ -76,6 +76,25 @@
 // to trigger certain disruptive operations, such as reboots or firmware upgrades.
 const DeviceMaintenanceAnnotation = "networking.metal.ironcore.dev/maintenance"
 
+// DeviceRebootSaveConfigAnnotation is an annotation that can be applied to Device objects together with
+// the DeviceMaintenanceReboot action to save the running configuration before the device is rebooted.
+const DeviceRebootSaveConfigAnnotation = "networking.metal.ironcore.dev/reboot-save-config"
+
+// DeviceRebootDelayAnnotation is an annotation that can be applied to Device objects together with
+// the DeviceMaintenanceReboot action to delay the reboot of the device by a duration, e.g. "5m".
+const DeviceRebootDelayAnnotation = "networking.metal.ironcore.dev/reboot-delay"
+
+// DeviceCompatibleVersionAnnotation is an annotation that can be applied to Device objects
+// to declare the operating system release the device is known to be compatible with, e.g. "10.6(2)".
+// Providers use it as a fallback when the device runs a release whose data model is not known to them,
+// such as an adjacent release that has not been validated yet.
+const DeviceCompatibleVersionAnnotation = "networking.metal.ironcore.dev/compatible-version"
+
+// DefaultAdminStateAnnotation is an annotation that can be applied to Interface objects to override
+// the admin state that is defaulted when the interface does not specify one, e.g. "Down" for sites
+// that prefer interfaces to be shut by default. Loopback interfaces are never defaulted.
+const DefaultAdminStateAnnotation = "networking.metal.ironcore.dev/default-admin-state"
+
 // PhysicalInterfaceNeighborLabel identifies the peer Interface resource on the other end of a physical link.
 // The value must be the name of another Interface resource in the same namespace.
 // This label is only valid for interfaces of type Physical.
@@ -137,6 +156,42 @@
 	// This condition is set to True when the controller successfully connects to
 	// the device, and False when the connection attempt fails.
 	ReachableCondition = "Reachable"
+
+	// ReloadRequiredCondition indicates whether the device must be reloaded for its configuration to take effect.
+	// This condition is set to True when configuration has been applied that only becomes effective after a reload,
+	// e.g. hardware resource allocations.
+	ReloadRequiredCondition = "ReloadRequired"
+
+	// ECMPConfiguredCondition indicates whether the system-wide ECMP settings of a device have been applied.
+	// This condition is only set on devices with ECMP settings.
+	ECMPConfiguredCondition = "ECMPConfigured"
+
+	// LACPConfiguredCondition indicates whether the system-wide LACP settings of a device have been applied.
+	// This condition is only set on devices with LACP settings.
+	LACPConfiguredCondition = "LACPConfigured"
+
+	// HostnameConfiguredCondition indicates whether the hostname of a device has been applied.
+	// This condition is only set on devices with a hostname.
+	HostnameConfiguredCondition = "HostnameConfigured"
+
+	// PendingChangeCondition indicates whether changes to the resource are waiting to be applied to the device.
+	// This condition is set to True when the resource has changes that have not been applied yet because
+	// none of the maintenance windows of the device is open.
+	PendingChangeCondition = "PendingChange"
+
+	// MaintenanceWindowCondition indicates whether one of the maintenance windows of a device is open.
+	// This condition is only set on devices with maintenance windows.
+	MaintenanceWindowCondition = "MaintenanceWindow"
+
+	// DataModelChangedCondition indicates whether the data model of the device changed, e.g. after a firmware upgrade.
+	// This condition is set to True when the data model version observed after a reboot differs from the previously
+	// observed one, in which case all resources of the device are reconciled against the new data model.
+	// It remains True until the device is rebooted again without a change of its data model.
+	DataModelChangedCondition = "DataModelChanged"
+
+	// ConfigurationDriftCondition indicates whether the configuration read back from the device differs from the desired state.
+	// This condition is set to True when the configuration on the device drifted, e.g. after it was changed out-of-band.
+	ConfigurationDriftCondition = "ConfigurationDrift"
 )
 
 // Reasons that are used across different objects.
@@ -184,11 +239,20 @@
 	// WaitingForDependenciesReason indicates that the resource is waiting for its dependencies to be ready.
 	WaitingForDependenciesReason = "WaitingForDependencies"
 
+	// ChangePendingReason indicates that changes to the resource are deferred until a maintenance window opens.
+	ChangePendingReason = "ChangePending"
+
+	// NoChangePendingReason indicates that the resource has no changes waiting to be applied.
+	NoChangePendingReason = "NoChangePending"
+
 	// IncompatibleProviderConfigRef indicates that the referenced provider configuration is not compatible with the target platform.
 	IncompatibleProviderConfigRef = "IncompatibleProviderConfigRef"
 
 	// DuplicateResourceOnDevice indicates that a resource of the same type as the one being created already exists on the target device.
 	DuplicateResourceOnDevice = "DuplicateResourceOnDevice"
+
+	// NotOwnedReason indicates that the resource is outside of the ownership ranges configured on the target device.
+	NotOwnedReason = "NotOwned"
 )
 
 // Reasons that are specific to [Interface] objects.
@@ -217,6 +281,12 @@
 	// VRFNotFoundReason indicates that a referenced VRF was not found.
 	VRFNotFoundReason = "VRFNotFound"
 
+	// AccessControlListNotFoundReason indicates that a referenced AccessControlList was not found.
+	AccessControlListNotFoundReason = "AccessControlListNotFound"
+
+	// QoSPolicyNotFoundReason indicates that a referenced QoSPolicy was not found.
+	QoSPolicyNotFoundReason = "QoSPolicyNotFound"
+
 	// ParentInterfaceNotFoundReason indicates that a referenced parent interface for a subinterface was not found.
 	ParentInterfaceNotFoundReason = "ParentInterfaceNotFound"
 
@@ -225,12 +295,44 @@
 
 	// InvalidParentInterfaceTypeReason indicates that a referenced parent interface type is not supported.
 	InvalidParentInterfaceTypeReason = "InvalidParentInterfaceType"
+
+	// SwitchportModeMismatchReason indicates that the switchport mode applied on the device differs from the desired mode.
+	SwitchportModeMismatchReason = "SwitchportModeMismatch"
+
+	// MTUMismatchReason indicates that the MTU of an interface is inconsistent with the MTU of related interfaces,
+	// e.g. the members of an aggregate interface or the switchports carrying the VLAN of a routed VLAN interface.
+	MTUMismatchReason = "MTUMismatch"
 )
 
 // Reasons that are specific to [Device] objects.
 const (
 	// MaintenanceFailedReason indicates that a requested maintenance operation (e.g., reboot or factory reset) failed.
 	MaintenanceFailedReason = "MaintenanceFailed"
+
+	// ReloadRequiredReason indicates that the device must be reloaded for its configuration to take effect.
+	ReloadRequiredReason = "ReloadRequired"
+
+	// ReloadNotRequiredReason indicates that the configuration of the device is effective without a reload.
+	ReloadNotRequiredReason = "ReloadNotRequired"
+
+	// DataModelChangedReason indicates that the data model of the device changed since it was last observed.
+	DataModelChangedReason = "DataModelChanged"
+
+	// DataModelUnchangedReason indicates that the data model of the device did not change since it was last observed.
+	DataModelUnchangedReason = "DataModelUnchanged"
+
+	// MaintenanceWindowOpenReason indicates that one of the maintenance windows of the device is open.
+	MaintenanceWindowOpenReason = "MaintenanceWindowOpen"
+
+	// MaintenanceWindowClosedReason indicates that none of the maintenance windows of the device is open.
+	MaintenanceWindowClosedReason = "MaintenanceWindowClosed"
+
+	// SecretNotFoundReason indicates that the Secret referenced by the device endpoint was not found.
+	SecretNotFoundReason = "SecretNotFound"
+
+	// InvalidCredentialsReason indicates that the Secret referenced by the device endpoint
+	// does not hold valid basic authentication credentials.
+	InvalidCredentialsReason = "InvalidCredentials"
 )
 
 // Reasons that are specific to [RoutingPolicy] objects.
@@ -248,6 +350,18 @@
 
 	// RoutingPolicyNotFoundReason indicates that a referenced RoutingPolicy was not found.
 	RoutingPolicyNotFoundReason = "RoutingPolicyNotFound"
+
+	// PeerGroupNotFoundReason indicates that the peer group referenced by the BGPPeer
+	// is not configured on the referenced BGP instance.
+	PeerGroupNotFoundReason = "PeerGroupNotFound"
+
+	// DuplicatePeerGroupReason indicates that a peer group of the BGP instance is already
+	// configured by another BGP instance of the same device.
+	DuplicatePeerGroupReason = "DuplicatePeerGroup"
+
+	// DuplicateQoSClassReason indicates that a class of the QoS policy is already
+	// used by another QoS policy of the same device.
+	DuplicateQoSClassReason = "DuplicateQoSClass"
 )
 
 // Reasons that are specific to [BorderGateway] objects.
@@ -262,8 +376,56 @@
 	NVEAlreadyExistsReason = "NetworkVirtualizationEdgeAlreadyExists"
 )
 
+// Reasons that are specific to [EthernetSegment] objects.
+const (
+	// DFElectionWaitTimeMismatchReason indicates that another EthernetSegment on the same device
+	// specifies a different Designated Forwarder election wait time.
+	DFElectionWaitTimeMismatchReason = "DFElectionWaitTimeMismatch"
+)
+
+// Reasons that are specific to [VRF] objects.
+const (
+	// RouteDerivationFailedReason indicates that the route distinguisher or route targets of a VRF
+	// could not be derived from the VRFDerivation of its Device.
+	RouteDerivationFailedReason = "RouteDerivationFailed"
+)
+
 // Reasons that are specific to [DHCPRelay] objects.
 const (
 	// IPAddressingNotFoundReason indicates that a referenced interface has no IPv4 addresses configured.
 	IPAddressingNotFoundReason = "IPAddressingNotFound"
 )
+
+// Reasons that are specific to [SNMP] objects.
+const (
+	// ConfigurationDriftReason indicates that the SNMP configuration read back from the device differs from the desired state.
+	ConfigurationDriftReason = "ConfigurationDrift"
+
+	// NoConfigurationDriftReason indicates that the SNMP configuration read back from the device matches the desired state.
+	NoConfigurationDriftReason = "NoConfigurationDrift"
+)
+
+// Condition types that are specific to [Certificate] objects.
+const (
+	// ExpiringCondition indicates whether the certificate installed on the device is about to expire.
+	// This condition is set to True when the certificate expires soon or has already expired.
+	ExpiringCondition = "Expiring"
+)
+
+// Reasons that are specific to [Certificate] objects.
+const (
+	// CertificateValidReason indicates that the certificate installed on the device is not about to expire.
+	CertificateValidReason = "CertificateValid"
+
+	// CertificateExpiringReason indicates that the certificate installed on the device expires soon.
+	CertificateExpiringReason = "CertificateExpiring"
+
+	// CertificateExpiredReason indicates that the certificate installed on the device has expired.
+	CertificateExpiredReason = "CertificateExpired"
+)
+
+// Reasons that are specific to [DeviceQuery] objects.
+const (
+	// ResultTooLargeReason indicates that the data returned by the device exceeds the size that can be stored in the status.
+	ResultTooLargeReason = "ResultTooLarge"
+)
@@ -13,6 +13,7 @@
 
 // InterfaceSpec defines the desired state of Interface.
 // +kubebuilder:validation:XValidation:rule="!has(self.switchport) || !has(self.ipv4)", message="switchport and ipv4 are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.switchport) || !has(self.ipv6)", message="switchport and ipv6 are mutually exclusive"
 // +kubebuilder:validation:XValidation:rule="self.type != 'Loopback' || !has(self.switchport)", message="switchport must not be specified for interfaces of type Loopback"
 // +kubebuilder:validation:XValidation:rule="self.type == 'Physical' || !has(self.ipv4) || !has(self.ipv4.unnumbered)", message="unnumbered ipv4 configuration can only be used for interfaces of type Physical"
 // +kubebuilder:validation:XValidation:rule="self.type != 'Aggregate' || has(self.aggregation)", message="aggregation must be specified for interfaces of type Aggregate"
@@ -31,6 +32,12 @@
 // +kubebuilder:validation:XValidation:rule="self.type != 'Subinterface' || (has(self.encapsulation) && has(self.parentInterfaceRef))", message="encapsulation and parentInterfaceRef must both be specified for subinterfaces"
 // +kubebuilder:validation:XValidation:rule="!has(self.bfd) || !has(self.switchport)", message="bfd must not be specified for interfaces with switchport configuration"
 // +kubebuilder:validation:XValidation:rule="self.type == 'Physical' || !has(self.ethernet)", message="ethernet configuration must only be specified on interfaces of type Physical"
+// +kubebuilder:validation:XValidation:rule="!has(self.ipMtu) || has(self.ipv4)", message="ipMtu must only be specified on interfaces with ipv4 configuration"
+// +kubebuilder:validation:XValidation:rule="!has(self.accessGroups) || has(self.ipv4) || has(self.ipv6)", message="accessGroups must only be specified on interfaces with ipv4 or ipv6 configuration"
+// +kubebuilder:validation:XValidation:rule="!has(self.ipMtu) || !has(self.mtu) || self.ipMtu <= self.mtu", message="ipMtu must be less than or equal to mtu"
+// +kubebuilder:validation:XValidation:rule="self.type != 'Loopback' || !has(self.ipv4) || !has(self.ipv4.arpTimeout)", message="arpTimeout must not be specified for interfaces of type Loopback"
+// +kubebuilder:validation:XValidation:rule="self.type != 'Loopback' || !has(self.servicePolicies)", message="servicePolicies must not be specified for interfaces of type Loopback"
+// +kubebuilder:validation:XValidation:rule="!has(self.portSecurity) || (has(self.switchport) && self.switchport.mode == 'Access' && !has(self.ipv4) && !has(self.ipv6))", message="portSecurity must only be specified on access switchports without ipv4 or ipv6 configuration"
 type InterfaceSpec struct {
 	// DeviceName is the name of the Device this object belongs to. The Device object must exist in the same namespace.
 	// Immutable.
@@ -51,9 +58,10 @@ type InterfaceSpec struct {
 	Name string `json:"name"`
 
 	// AdminState indicates whether the interface is administratively up or down.
+	// If unset, interfaces other than loopbacks default to Up, or to the value of the
+	// DefaultAdminStateAnnotation if present.
 	// +optional
-	// +kubebuilder:default=Up
-	AdminState AdminState `json:"adminState"`
+	AdminState AdminState `json:"adminState,omitempty"`
 
 	// Description provides a human-readable description of the interface.
 	// +optional
@@ -71,15 +79,32 @@ type InterfaceSpec struct {
 	// +kubebuilder:validation:Maximum=9216
 	MTU int32 `json:"mtu,omitempty"`
 
+	// IPMTU specifies the size of the largest IP packet that can be sent over the interface,
+	// independent of the interface MTU. It must not exceed the interface MTU.
+	// This is only applicable for Layer 3 interfaces.
+	// +optional
+	// +kubebuilder:validation:Minimum=576
+	// +kubebuilder:validation:Maximum=9216
+	IPMTU int32 `json:"ipMtu,omitempty"`
+
 	// Switchport defines the switchport configuration for the interface.
 	// This is only applicable for Ethernet and Aggregate interfaces.
 	// +optional
 	Switchport *Switchport `json:"switchport,omitempty"`
 
+	// PortSecurity restricts the MAC addresses allowed to send traffic on the interface.
+	// This is only applicable for access switchports.
+	// +optional
+	PortSecurity *PortSecurity `json:"portSecurity,omitempty"`
+
 	// IPv4 defines the IPv4 configuration for the interface.
 	// +optional
 	IPv4 *InterfaceIPv4 `json:"ipv4,omitempty"`
 
+	// IPv6 defines the IPv6 configuration for the interface.
+	// +optional
+	IPv6 *InterfaceIPv6 `json:"ipv6,omitempty"`
+
 	// Aggregation defines the aggregation (bundle) configuration for the interface.
 	// This is only applicable for interfaces of type Aggregate.
 	// +optional
@@ -98,6 +123,26 @@ type InterfaceSpec struct {
 	// +optional
 	VrfRef *LocalObjectReference `json:"vrfRef,omitempty"`
 
+	// AccessGroups binds access control lists to the interface to filter its ingress or egress traffic.
+	// At most one IPv4 and one IPv6 access control list can be bound per direction.
+	// This is only applicable for Layer 3 interfaces.
+	// The referenced AccessControlLists must exist in the same namespace.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=4
+	AccessGroups []InterfaceAccessGroup `json:"accessGroups,omitempty"`
+
+	// ServicePolicies attaches QoS policies to the interface to classify and mark its input or output traffic.
+	// At most one QoS policy can be attached per direction.
+	// The referenced QoSPolicies must exist in the same namespace.
+	// +optional
+	// +listType=map
+	// +listMapKey=direction
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	ServicePolicies []InterfaceServicePolicy `json:"servicePolicies,omitempty"`
+
 	// BFD defines the Bidirectional Forwarding Detection configuration for the interface.
 	// BFD is only applicable for Layer 3 interfaces.
 	// +optional
@@ -180,6 +225,89 @@ type Switchport struct {
 	AllowedVlans []int32 `json:"allowedVlans,omitempty"`
 }
 
+// PortSecurity defines the port-security configuration of an access switchport.
+type PortSecurity struct {
+	// Enabled indicates whether port-security is enabled on the interface.
+	// +required
+	Enabled bool `json:"enabled"`
+
+	// MaxMACAddresses is the maximum number of secure MAC addresses allowed on the interface.
+	// When not specified, the device default is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1025
+	MaxMACAddresses int32 `json:"maxMacAddresses,omitempty"`
+
+	// ViolationAction is the action taken when traffic from a MAC address that is not secure is received
+	// after the maximum number of secure MAC addresses has been reached.
+	// +optional
+	// +kubebuilder:default=Shutdown
+	ViolationAction PortSecurityViolationAction `json:"violationAction,omitempty"`
+
+	// Sticky indicates whether dynamically learned MAC addresses are retained as secure MAC addresses.
+	// +optional
+	Sticky bool `json:"sticky,omitempty"`
+}
+
+// PortSecurityViolationAction represents the action taken on a port-security violation.
+// +kubebuilder:validation:Enum=Shutdown;Restrict;Protect
+type PortSecurityViolationAction string
+
+const (
+	// PortSecurityViolationActionShutdown shuts down the interface.
+	PortSecurityViolationActionShutdown PortSecurityViolationAction = "Shutdown"
+	// PortSecurityViolationActionRestrict drops traffic from the offending MAC address and logs the violation.
+	PortSecurityViolationActionRestrict PortSecurityViolationAction = "Restrict"
+	// PortSecurityViolationActionProtect silently drops traffic from the offending MAC address.
+	PortSecurityViolationActionProtect PortSecurityViolationAction = "Protect"
+)
+
+// InterfaceAccessGroup binds an access control list to an interface in a given direction.
+type InterfaceAccessGroup struct {
+	// Direction is the direction of the traffic the access control list is applied to.
+	// +required
+	Direction AccessGroupDirection `json:"direction"`
+
+	// AccessControlListRef is a reference to the AccessControlList resource to apply.
+	// The referenced AccessControlList must belong to the same device as the interface.
+	// +required
+	AccessControlListRef LocalObjectReference `json:"accessControlListRef"`
+}
+
+// AccessGroupDirection represents the direction of traffic an access control list is applied to.
+// +kubebuilder:validation:Enum=Ingress;Egress
+type AccessGroupDirection string
+
+const (
+	// AccessGroupDirectionIngress applies the access control list to traffic received on the interface.
+	AccessGroupDirectionIngress AccessGroupDirection = "Ingress"
+	// AccessGroupDirectionEgress applies the access control list to traffic sent out of the interface.
+	AccessGroupDirectionEgress AccessGroupDirection = "Egress"
+)
+
+// InterfaceServicePolicy attaches a QoS policy to an interface in a given direction.
+type InterfaceServicePolicy struct {
+	// Direction is the direction of the traffic the QoS policy is applied to.
+	// +required
+	Direction ServicePolicyDirection `json:"direction"`
+
+	// QoSPolicyRef is a reference to the QoSPolicy resource to apply.
+	// The referenced QoSPolicy must belong to the same device as the interface.
+	// +required
+	QoSPolicyRef LocalObjectReference `json:"qosPolicyRef"`
+}
+
+// ServicePolicyDirection represents the direction of traffic a QoS policy is applied to.
+// +kubebuilder:validation:Enum=Input;Output
+type ServicePolicyDirection string
+
+const (
+	// ServicePolicyDirectionInput applies the QoS policy to traffic received on the interface.
+	ServicePolicyDirectionInput ServicePolicyDirection = "Input"
+	// ServicePolicyDirectionOutput applies the QoS policy to traffic sent out of the interface.
+	ServicePolicyDirectionOutput ServicePolicyDirection = "Output"
+)
+
 // +kubebuilder:validation:Enum="802.1q";"802.1ad"
 type EncapType string
 
@@ -254,6 +382,18 @@ type InterfaceIPv4 struct {
 	// +optional
 	// +kubebuilder:default=false
 	AnycastGateway bool `json:"anycastGateway,omitempty"`
+
+	// ARPTimeout is the time after which dynamically learned ARP entries of the interface expire.
+	// It must be a whole number of seconds between 60s and 8h.
+	// Only applicable for routed interfaces, i.e. not for Loopback interfaces.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	ARPTimeout *metav1.Duration `json:"arpTimeout,omitempty"`
+
+	// URPF enables the unicast reverse path forwarding check for IPv4 packets received on the interface.
+	// +optional
+	URPF *URPF `json:"urpf,omitempty"`
 }
 
 // InterfaceIPv4Unnumbered defines the unnumbered interface configuration.
@@ -266,6 +406,68 @@ type InterfaceIPv4Unnumbered struct {
 	InterfaceRef LocalObjectReference `json:"interfaceRef"`
 }
 
+// InterfaceIPv6 defines the IPv6 configuration for an interface.
+// If neither addresses nor autoconfig are set, IPv6 is enabled on the interface with only a link-local address,
+// e.g. for BGP sessions using link-local addresses.
+// +kubebuilder:validation:XValidation:rule="!has(self.addresses) || !self.autoconfig", message="addresses and autoconfig are mutually exclusive"
+type InterfaceIPv6 struct {
+	// Addresses defines the list of global IPv6 addresses assigned to the interface.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	Addresses []IPPrefix `json:"addresses,omitempty"`
+
+	// LinkLocalAddress is the IPv6 link-local address of the interface, which must be within fe80::/10.
+	// If not specified, the link-local address is derived from the MAC address of the interface.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="ip(self).family() == 6 && ip(self).isLinkLocalUnicast()", message="linkLocalAddress must be an IPv6 address within fe80::/10"
+	LinkLocalAddress IPAddr `json:"linkLocalAddress,omitzero"`
+
+	// Autoconfig enables stateless address autoconfiguration (SLAAC) of a global address
+	// from the router advertisements received on the interface.
+	// +optional
+	// +kubebuilder:default=false
+	Autoconfig bool `json:"autoconfig,omitempty"`
+
+	// URPF enables the unicast reverse path forwarding check for IPv6 packets received on the interface.
+	// +optional
+	URPF *URPF `json:"urpf,omitempty"`
+}
+
+// URPF defines the unicast reverse path forwarding (uRPF) check of an interface.
+// Packets are dropped if their source address fails the check against the routing table.
+// +kubebuilder:validation:XValidation:rule="!self.allowDefault || self.mode == 'Loose'", message="allowDefault is only supported in Loose mode"
+// +kubebuilder:validation:XValidation:rule="!self.allowSelfPing || self.mode == 'Strict'", message="allowSelfPing is only supported in Strict mode"
+type URPF struct {
+	// Mode is the uRPF mode of the interface.
+	// +required
+	Mode URPFMode `json:"mode"`
+
+	// AllowDefault lets packets pass whose source address is only reachable via the default route.
+	// Only applicable in Loose mode.
+	// +optional
+	// +kubebuilder:default=false
+	AllowDefault bool `json:"allowDefault,omitempty"`
+
+	// AllowSelfPing lets pings pass that the device sends to its own interface addresses,
+	// which would otherwise be dropped by the check.
+	// Only applicable in Strict mode.
+	// +optional
+	// +kubebuilder:default=false
+	AllowSelfPing bool `json:"allowSelfPing,omitempty"`
+}
+
+// URPFMode represents the mode of the unicast reverse path forwarding check.
+// +kubebuilder:validation:Enum=Strict;Loose
+type URPFMode string
+
+const (
+	// URPFModeStrict requires the source address to be reachable via the interface the packet was received on.
+	URPFModeStrict URPFMode = "Strict"
+	// URPFModeLoose requires the source address to be reachable via any interface.
+	URPFModeLoose URPFMode = "Loose"
+)
+
 // BFD defines the Bidirectional Forwarding Detection configuration for an interface.
 type BFD struct {
 	// Enabled indicates whether BFD is enabled on the interface.
@@ -300,12 +502,71 @@ type BFD struct {
 }
 
 // Ethernet defines the ethernet-specific configuration for physical interfaces.
+// +kubebuilder:validation:XValidation:rule="!has(self.duplex) || self.duplex == 'Auto' || has(self.speed)",message="duplex can only be forced together with a speed"
+// +kubebuilder:validation:XValidation:rule="!has(self.duplex) || self.duplex != 'Half' || self.speed == '100M'",message="half duplex is only supported at a speed of 100M"
 type Ethernet struct {
+	// Duplex forces the duplex mode of the interface.
+	// When not specified, the duplex mode is negotiated with the peer.
+	// +optional
+	Duplex Duplex `json:"duplex,omitempty"`
+
 	// FECMode specifies the Forward Error Correction mode for the interface.
 	// FEC provides error detection and correction at the physical layer, improving link reliability.
 	// When not specified, the FEC mode defaults to "auto" where the device negotiates the appropriate mode.
 	// +optional
 	FECMode FECMode `json:"fecMode,omitempty"`
+
+	// LACPPortPriority is the LACP port priority of the interface when it is a member of an aggregate interface.
+	// Member interfaces with a lower value are preferred as active links when not all members can be active.
+	// When not specified, the device default is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	LACPPortPriority uint16 `json:"lacpPortPriority,omitempty"`
+
+	// Speed forces the speed of the interface, e.g. to connect a legacy peer at a lower speed than
+	// the speed of the transceiver. The speed must be supported by the port.
+	// When not specified, the speed is negotiated with the peer.
+	// +optional
+	Speed Speed `json:"speed,omitempty"`
+
+	// StormControl limits the broadcast, multicast and unknown unicast traffic received on the interface.
+	// Traffic of a type exceeding its threshold is dropped until its rate falls below the threshold again.
+	// When not specified, storm-control is disabled.
+	// +optional
+	StormControl *StormControl `json:"stormControl,omitempty"`
+}
+
+// StormControl defines the storm-control thresholds of an interface per traffic type.
+// +kubebuilder:validation:XValidation:rule="has(self.broadcast) || has(self.multicast) || has(self.unknownUnicast)",message="at least one of broadcast, multicast or unknownUnicast must be set"
+type StormControl struct {
+	// Broadcast is the threshold for broadcast traffic.
+	// +optional
+	Broadcast *StormControlThreshold `json:"broadcast,omitempty"`
+
+	// Multicast is the threshold for multicast traffic.
+	// +optional
+	Multicast *StormControlThreshold `json:"multicast,omitempty"`
+
+	// UnknownUnicast is the threshold for unicast traffic to destination MAC addresses that have not been learned.
+	// +optional
+	UnknownUnicast *StormControlThreshold `json:"unknownUnicast,omitempty"`
+}
+
+// StormControlThreshold defines the rate of a traffic type above which the traffic is suppressed.
+// +kubebuilder:validation:XValidation:rule="has(self.percent) != has(self.packetsPerSecond)",message="exactly one of percent or packetsPerSecond must be set"
+type StormControlThreshold struct {
+	// Percent is the threshold as a percentage of the interface bandwidth.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percent *int32 `json:"percent,omitempty"`
+
+	// PacketsPerSecond is the threshold as a number of packets per second.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=200000000
+	PacketsPerSecond *int32 `json:"packetsPerSecond,omitempty"`
 }
 
 // FECMode represents the Forward Error Correction mode for Ethernet Interfaces.
@@ -321,6 +582,36 @@ type Ethernet struct {
 	FECModeDisabled FECMode = "Disabled"
 )
 
+// Speed represents the speed of an Ethernet interface.
+// +kubebuilder:validation:Enum=100M;1G;10G;25G;40G;50G;100G;200G;400G
+type Speed string
+
+const (
+	Speed100M Speed = "100M"
+	Speed1G   Speed = "1G"
+	Speed10G  Speed = "10G"
+	Speed25G  Speed = "25G"
+	Speed40G  Speed = "40G"
+	Speed50G  Speed = "50G"
+	Speed100G Speed = "100G"
+	Speed200G Speed = "200G"
+	Speed400G Speed = "400G"
+)
+
+// Duplex represents the duplex mode of an Ethernet interface.
+// +kubebuilder:validation:Enum=Auto;Full;Half
+type Duplex string
+
+const (
+	// DuplexAuto negotiates the duplex mode with the peer.
+	DuplexAuto Duplex = "Auto"
+	// DuplexFull sends and receives at the same time.
+	DuplexFull Duplex = "Full"
+	// DuplexHalf either sends or receives at a time.
+	DuplexHalf Duplex = "Half"
+)
+
+// +kubebuilder:validation:XValidation:rule="!has(self.minLinks) || self.minLinks <= size(self.memberInterfaceRefs)",message="minLinks must not exceed the number of member interfaces"
 type Aggregation struct {
 	// MemberInterfaceRefs is a list of interface references that are part of the aggregate interface.
 	// +required
@@ -328,6 +619,13 @@ type Aggregation struct {
 	// +kubebuilder:validation:MaxItems=32
 	MemberInterfaceRefs []LocalObjectReference `json:"memberInterfaceRefs"`
 
+	// MinLinks is the minimum number of member interfaces that must be up for the aggregate interface to be up.
+	// If not specified, the aggregate interface is up as long as at least one member interface is up.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32
+	MinLinks uint8 `json:"minLinks,omitempty"`
+
 	// ControlProtocol defines the lacp configuration for the aggregate interface.
 	// +optional
 	// +kubebuilder:default={mode: Active}
@@ -342,6 +640,11 @@ type ControlProtocol struct {
 	// Mode defines the LACP mode for the aggregate interface.
 	// +required
 	Mode LACPMode `json:"mode"`
+
+	// Rate defines the rate at which LACP packets are requested from the partner on the member interfaces.
+	// If not specified, the normal rate is used.
+	// +optional
+	Rate LACPRate `json:"rate,omitempty"`
 }
 
 // LACPMode represents the LACP mode of an interface.
@@ -355,6 +658,17 @@ type ControlProtocol struct {
 	LACPModePassive LACPMode = "Passive"
 )
 
+// LACPRate represents the rate at which LACP packets are exchanged.
+// +kubebuilder:validation:Enum=Fast;Normal
+type LACPRate string
+
+const (
+	// LACPRateFast indicates that LACP packets are sent every second.
+	LACPRateFast LACPRate = "Fast"
+	// LACPRateNormal indicates that LACP packets are sent every 30 seconds.
+	LACPRateNormal LACPRate = "Normal"
+)
+
 type MultiChassis struct {
 	// Enabled indicates whether the aggregate interface is part of a multichassis setup.
 	// +required
@@ -387,6 +701,64 @@ type InterfaceStatus struct {
 	// If a single interface has multiple neighbor adjacencies, we validate each adjacency against the same one label/annotation.
 	// +optional
 	Neighbors []Neighbor `json:"neighbors,omitempty"`
+
+	// SwitchportMode is the switchport mode operationally applied on the device.
+	// This field only applies to interfaces with switchport configuration. A mismatch with the desired
+	// mode is reported by the Configured condition.
+	// +optional
+	SwitchportMode SwitchportMode `json:"switchportMode,omitempty"`
+
+	// FEC contains the forward error correction (FEC) statistics of the interface as reported by the device.
+	// A rising number of corrected codewords or a rising pre-FEC bit error rate indicates degrading optics
+	// before errors become uncorrectable. This field only applies to physical interfaces with FEC enabled.
+	// +optional
+	FEC *InterfaceFECStatus `json:"fec,omitempty"`
+
+	// OperMTU is the MTU operationally in effect on the interface as reported by the device.
+	// It may differ from the configured MTU, e.g. if jumbo frames are constrained by the system QoS policy.
+	// +optional
+	OperMTU int32 `json:"operMtu,omitempty"`
+
+	// LACP contains the LACP system parameters the device presents to the partner on the aggregate interface.
+	// The peers of a multi-chassis aggregate must present the same system ID to the downstream device.
+	// This field only applies to aggregate interfaces.
+	// +optional
+	LACP *InterfaceLACPStatus `json:"lacp,omitempty"`
+}
+
+// InterfaceFECStatus represents the forward error correction (FEC) statistics of an interface.
+type InterfaceFECStatus struct {
+	// CorrectedCodewords is the number of codewords received with errors that were corrected by FEC.
+	// +required
+	CorrectedCodewords int64 `json:"correctedCodewords"`
+
+	// UncorrectedCodewords is the number of codewords received with errors that could not be corrected by FEC.
+	// +required
+	UncorrectedCodewords int64 `json:"uncorrectedCodewords"`
+
+	// PreFECBER is the bit error rate before FEC is applied, in scientific notation, e.g. "1.50e-08".
+	// +optional
+	PreFECBER string `json:"preFecBer,omitempty"`
+
+	// PostFECBER is the bit error rate after FEC is applied, in scientific notation, e.g. "0.00e+00".
+	// +optional
+	PostFECBER string `json:"postFecBer,omitempty"`
+
+	// LastUpdateTime is the time the statistics were last updated. As the counters change continuously,
+	// the statistics are refreshed at most every 5 minutes.
+	// +required
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// InterfaceLACPStatus represents the LACP system parameters in use on an aggregate interface.
+type InterfaceLACPStatus struct {
+	// SystemID is the MAC address identifying the system to the LACP partner, e.g. "00:23:04:ee:be:0a".
+	// +required
+	SystemID string `json:"systemId"`
+
+	// SystemPriority is the LACP system priority in use.
+	// +required
+	SystemPriority int32 `json:"systemPriority"`
 }
 
 // Neighbor represents an LLDP neighbor discovered on an interface.
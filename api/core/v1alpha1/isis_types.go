@@ -94,6 +94,27 @@ type ISISSpec struct {
 
 // ISISStatus defines the observed state of ISIS.
 type ISISStatus struct {
+	// AdjacencySummary provides a human-readable summary of adjacencies
+	// by state (e.g., "3 Up, 1 Initializing").
+	// This field is computed by the controller from the Adjacencies field.
+	// +optional
+	AdjacencySummary string `json:"adjacencySummary,omitempty"`
+
+	// ObservedGeneration reflects the .metadata.generation that was last processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Adjacencies is a list of ISIS adjacencies and their states.
+	// +optional
+	// +listType=atomic
+	Adjacencies []ISISAdjacency `json:"adjacencies,omitempty"`
+
+	// LSPDatabase is a summary of the link-state database per level.
+	// +optional
+	// +listType=map
+	// +listMapKey=level
+	LSPDatabase []ISISLSPDatabaseSummary `json:"lspDatabase,omitempty"`
+
 	// The conditions are a list of status objects that describe the state of the ISIS.
 	// +listType=map
 	// +listMapKey=type
@@ -103,6 +124,60 @@ type ISISStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// ISISAdjacency represents an ISIS adjacency with a neighboring system.
+type ISISAdjacency struct {
+	// SystemID is the system identifier of the neighboring ISIS system.
+	// +required
+	SystemID string `json:"systemId"`
+
+	// InterfaceRef is a reference to the local interface through which this adjacency is formed.
+	// +required
+	InterfaceRef LocalObjectReference `json:"interfaceRef"`
+
+	// Level is the ISIS level of the adjacency.
+	// +required
+	Level ISISLevel `json:"level"`
+
+	// LastTransitionTime is the timestamp when the adjacency last changed its state.
+	// A frequently changing timestamp indicates adjacency instability (flapping).
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// State is the current state of the adjacency.
+	// +optional
+	State ISISAdjacencyState `json:"state,omitempty"`
+}
+
+// ISISAdjacencyState represents the state of an ISIS adjacency.
+// +kubebuilder:validation:Enum=Unknown;Down;Initializing;Up
+type ISISAdjacencyState string
+
+const (
+	// ISISAdjacencyStateUnknown indicates an unknown or undefined state.
+	ISISAdjacencyStateUnknown ISISAdjacencyState = "Unknown"
+
+	// ISISAdjacencyStateDown indicates that no hellos have been received from the neighbor.
+	ISISAdjacencyStateDown ISISAdjacencyState = "Down"
+
+	// ISISAdjacencyStateInitializing indicates that hellos have been received from the neighbor
+	// but the two-way handshake has not yet been completed.
+	ISISAdjacencyStateInitializing ISISAdjacencyState = "Initializing"
+
+	// ISISAdjacencyStateUp indicates that the adjacency is fully established.
+	ISISAdjacencyStateUp ISISAdjacencyState = "Up"
+)
+
+// ISISLSPDatabaseSummary summarizes the link-state database of a single ISIS level.
+type ISISLSPDatabaseSummary struct {
+	// Level is the ISIS level of the link-state database.
+	// +required
+	Level ISISLevel `json:"level"`
+
+	// LSPCount is the number of LSPs in the link-state database.
+	// +required
+	LSPCount int32 `json:"lspCount"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=isis
@@ -112,7 +187,10 @@ type ISISStatus struct {
 // +kubebuilder:printcolumn:name="NET",type=string,JSONPath=`.spec.networkEntityTitle`
 // +kubebuilder:printcolumn:name="Level",type=string,JSONPath=`.spec.type`,priority=1
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Configured",type=string,JSONPath=`.status.conditions[?(@.type=="Configured")].status`,priority=1
+// +kubebuilder:printcolumn:name="Operational",type=string,JSONPath=`.status.conditions[?(@.type=="Operational")].status`,priority=1
 // +kubebuilder:printcolumn:name="Paused",type=string,JSONPath=`.status.conditions[?(@.type=="Paused")].status`,priority=1
+// +kubebuilder:printcolumn:name="Adjacencies",type=string,JSONPath=`.status.adjacencySummary`,priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // ISIS is the Schema for the isis API
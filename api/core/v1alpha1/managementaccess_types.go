@@ -26,14 +26,26 @@ type ManagementAccessSpec struct {
 
 	// Configuration for the gRPC server on the device.
 	// Currently, only a single "default" gRPC server is supported.
+	// The gRPC server is used by the operator to manage the device and must stay enabled.
 	// +optional
 	// +kubebuilder:default={enabled:true, port:9339}
+	// +kubebuilder:validation:XValidation:rule="self.enabled",message="gRPC must be enabled, it is used to manage the device"
 	GRPC GRPC `json:"grpc,omitzero"`
 
 	// Configuration for the SSH server on the device.
 	// +optional
 	// +kubebuilder:default={enabled:true, timeout:"10m", sessionLimit:32}
 	SSH SSH `json:"ssh,omitzero"`
+
+	// Configuration for the NETCONF server on the device.
+	// If not specified, the NETCONF server is disabled.
+	// +optional
+	NETCONF NETCONF `json:"netconf,omitzero"`
+
+	// Configuration for the RESTCONF server on the device.
+	// If not specified, the RESTCONF server is disabled.
+	// +optional
+	RESTCONF RESTCONF `json:"restconf,omitzero"`
 }
 
 type GRPC struct {
@@ -46,6 +58,8 @@ type GRPC struct {
 	// The TCP port on which the gRPC server should listen.
 	// The range of port-id is from 1024 to 65535.
 	// Port 9339 is the default.
+	// Changing the port is only considered complete once the gRPC server is reachable on the new port,
+	// otherwise the previous port is restored. The Device endpoint is updated to the new port afterwards.
 	// +optional
 	// +kubebuilder:default=9339
 	// +kubebuilder:validation:Minimum=1024
@@ -119,6 +133,18 @@ type SSH struct {
 	SessionLimit int8 `json:"sessionLimit,omitempty"`
 }
 
+type NETCONF struct {
+	// Enable or disable the NETCONF server on the device.
+	// +optional
+	Enabled bool `json:"enabled"`
+}
+
+type RESTCONF struct {
+	// Enable or disable the RESTCONF server on the device.
+	// +optional
+	Enabled bool `json:"enabled"`
+}
+
 // ManagementAccessStatus defines the observed state of ManagementAccess.
 type ManagementAccessStatus struct {
 	// The conditions are a list of status objects that describe the state of the ManagementAccess.
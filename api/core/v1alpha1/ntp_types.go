@@ -60,8 +60,25 @@ type NTPServer struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=63
 	VrfName string `json:"vrfName,omitempty"`
+
+	// Mode is the association mode used with the NTP server.
+	// Server configures a client/server association, Peer configures a symmetric active association.
+	// +optional
+	// +kubebuilder:default=Server
+	Mode NTPServerMode `json:"mode,omitempty"`
 }
 
+// NTPServerMode represents the association mode of an NTP server.
+// +kubebuilder:validation:Enum=Server;Peer
+type NTPServerMode string
+
+const (
+	// NTPServerModeServer synchronizes the local clock from the remote server.
+	NTPServerModeServer NTPServerMode = "Server"
+	// NTPServerModePeer allows the local clock and the remote peer to synchronize each other.
+	NTPServerModePeer NTPServerMode = "Peer"
+)
+
 // NTPStatus defines the observed state of NTP.
 type NTPStatus struct {
 	// The conditions are a list of status objects that describe the state of the NTP.
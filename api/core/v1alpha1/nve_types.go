@@ -13,6 +13,8 @@
 
 // NetworkVirtualizationEdgeSpec defines the desired state of a Network Virtualization Edge (NVE).
 // +kubebuilder:validation:XValidation:rule="!has(self.anycastSourceInterfaceRef) || self.anycastSourceInterfaceRef.name != self.sourceInterfaceRef.name",message="anycastSourceInterfaceRef.name must differ from sourceInterfaceRef.name"
+// +kubebuilder:validation:XValidation:rule="!has(self.replicationMode) || self.replicationMode != 'Multicast' || (has(self.multicastGroups) && has(self.multicastGroups.l2))",message="multicastGroups.l2 must be specified when replicationMode is Multicast"
+// +kubebuilder:validation:XValidation:rule="!has(self.replicationMode) || self.replicationMode != 'IngressReplication' || self.hostReachability == 'BGP'",message="hostReachability must be BGP when replicationMode is IngressReplication"
 type NetworkVirtualizationEdgeSpec struct {
 	// DeviceName is the name of the Device this object belongs to. The Device object must exist in the same namespace.
 	// Immutable.
@@ -50,6 +52,13 @@ type NetworkVirtualizationEdgeSpec struct {
 	// +optional
 	MulticastGroups *MulticastGroups `json:"multicastGroups,omitzero"`
 
+	// ReplicationMode is the default mode used to replicate BUM traffic of the Layer 2 VNIs of the NVE.
+	// It can be overridden per VNI by the EVPNInstance of the VNI.
+	// The Multicast mode requires the L2 multicast group to be configured, while the IngressReplication mode
+	// requires BGP host reachability. If not specified, the default of the target platform is used.
+	// +optional
+	ReplicationMode ReplicationMode `json:"replicationMode,omitempty"`
+
 	// AnycastGateway defines the distributed anycast gateway configuration.
 	// This enables multiple NVEs to share the same gateway IP and MAC
 	// for active-active first-hop redundancy.
@@ -68,6 +77,17 @@ type NetworkVirtualizationEdgeSpec struct {
 	HostReachabilityTypeFloodAndLearn HostReachabilityType = "FloodAndLearn"
 )
 
+// ReplicationMode defines how BUM (Broadcast, Unknown unicast, Multicast) traffic is replicated to remote NVEs.
+// +kubebuilder:validation:Enum=Multicast;IngressReplication
+type ReplicationMode string
+
+const (
+	// ReplicationModeMulticast replicates BUM traffic through a multicast group of the underlay.
+	ReplicationModeMulticast ReplicationMode = "Multicast"
+	// ReplicationModeIngressReplication replicates BUM traffic as unicast to each remote NVE discovered through BGP EVPN.
+	ReplicationModeIngressReplication ReplicationMode = "IngressReplication"
+)
+
 // MulticastGroups defines multicast group addresses for overlay BUM traffic.
 // Only supports IPv4 multicast addresses.
 type MulticastGroups struct {
@@ -87,9 +107,11 @@ type AnycastGateway struct {
 	// VirtualMAC is the shared MAC address used by all NVEs in the fabric
 	// for anycast gateway functionality on RoutedVLAN (SVI) interfaces.
 	// All switches in the fabric must use the same MAC address.
-	// Format: IEEE 802 MAC-48 address (e.g., "00:00:5E:00:01:01")
+	// Format: IEEE 802 MAC-48 unicast address (e.g., "00:00:5E:00:01:01")
 	// +required
 	// +kubebuilder:validation:Pattern=`^([0-9A-Fa-f]{2}:){5}[0-9A-Fa-f]{2}$`
+	// +kubebuilder:validation:XValidation:rule="self.matches('^.[02468aceACE]')",message="virtualMAC must be a unicast MAC address"
+	// +kubebuilder:validation:XValidation:rule="self != '00:00:00:00:00:00'",message="virtualMAC must not be the zero address"
 	VirtualMAC string `json:"virtualMAC"`
 }
 
@@ -122,8 +144,51 @@ type NetworkVirtualizationEdgeStatus struct {
 
 	// HostReachability indicates the actual method used for host reachability.
 	HostReachability string `json:"hostReachability,omitempty"`
+
+	// PeerSummary provides a human-readable summary of the VTEP peers
+	// by state (e.g., "3 Up, 1 Down").
+	// This field is computed by the controller from the Peers field.
+	// +optional
+	PeerSummary string `json:"peerSummary,omitempty"`
+
+	// Peers is a list of the remote VTEPs discovered by the NVE and their states.
+	// +optional
+	// +listType=map
+	// +listMapKey=address
+	Peers []NVEPeer `json:"peers,omitempty"`
+
+	// VNISummary provides a human-readable summary of the VNIs configured
+	// on the NVE by operational state (e.g., "10 Up, 2 Down").
+	// +optional
+	VNISummary string `json:"vniSummary,omitempty"`
+}
+
+// NVEPeer represents a remote VTEP discovered by the NVE.
+type NVEPeer struct {
+	// Address is the IP address of the remote VTEP.
+	// +required
+	Address string `json:"address"`
+
+	// State is the current state of the peer.
+	// +optional
+	State NVEPeerState `json:"state,omitempty"`
 }
 
+// NVEPeerState represents the state of a remote VTEP.
+// +kubebuilder:validation:Enum=Unknown;Down;Up
+type NVEPeerState string
+
+const (
+	// NVEPeerStateUnknown indicates an unknown or undefined state.
+	NVEPeerStateUnknown NVEPeerState = "Unknown"
+
+	// NVEPeerStateDown indicates that the peer is not reachable.
+	NVEPeerStateDown NVEPeerState = "Down"
+
+	// NVEPeerStateUp indicates that the peer is reachable and traffic can be tunneled to it.
+	NVEPeerStateUp NVEPeerState = "Up"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=networkvirtualizationedges
@@ -138,6 +203,7 @@ type NetworkVirtualizationEdgeStatus struct {
 // +kubebuilder:printcolumn:name="Source Interface",type=string,JSONPath=`.status.sourceInterfaceName`
 // +kubebuilder:printcolumn:name="Anycast Interface",type=string,JSONPath=`.status.anycastSourceInterfaceName`
 // +kubebuilder:printcolumn:name="HostReachability",type=string,JSONPath=`.status.hostReachability`
+// +kubebuilder:printcolumn:name="Peers",type=string,JSONPath=`.status.peerSummary`,priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // NetworkVirtualizationEdge is the Schema for the networkvirtualizationedges API
@@ -41,6 +41,16 @@ type PIMSpec struct {
 	// +listType=atomic
 	// +kubebuilder:validation:MinItems=1
 	InterfaceRefs []PIMInterface `json:"interfaceRefs,omitempty"`
+
+	// BSR configures the bootstrap router (BSR) mechanism, which distributes the set of candidate
+	// rendezvous points to all routers of the multicast domain and fails over between them.
+	// +optional
+	BSR *PIMBSR `json:"bsr,omitempty"`
+
+	// AutoRP configures the Auto-RP mechanism, which distributes the group-to-RP mappings of the
+	// candidate rendezvous points via a mapping agent and fails over between them.
+	// +optional
+	AutoRP *PIMAutoRP `json:"autoRP,omitempty"`
 }
 
 type RendezvousPoint struct {
@@ -61,6 +71,86 @@ type RendezvousPoint struct {
 	AnycastAddresses []string `json:"anycastAddresses,omitempty"`
 }
 
+// PIMBSR defines the bootstrap router (BSR) configuration of a PIM instance.
+type PIMBSR struct {
+	// Listen enables receiving and forwarding of BSR messages. It must be enabled on all routers
+	// that learn the rendezvous points from the bootstrap router.
+	// +optional
+	Listen bool `json:"listen,omitempty"`
+
+	// CandidateBSR configures the device as a candidate bootstrap router.
+	// +optional
+	CandidateBSR *PIMCandidateBSR `json:"candidateBSR,omitempty"`
+
+	// CandidateRP configures the device as a candidate rendezvous point announced to the bootstrap router.
+	// +optional
+	CandidateRP *PIMCandidateRP `json:"candidateRP,omitempty"`
+}
+
+// PIMCandidateBSR defines a candidate bootstrap router.
+type PIMCandidateBSR struct {
+	// InterfaceRef references the interface whose IPv4 address is used as the address of the candidate BSR.
+	// +required
+	InterfaceRef LocalObjectReference `json:"interfaceRef"`
+
+	// Priority is the priority of the candidate BSR. The candidate with the highest priority is elected.
+	// If not specified, the device default is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Priority *int32 `json:"priority,omitempty"`
+
+	// HashMaskLength is the length of the mask used to distribute the multicast groups among the
+	// rendezvous points of the same group range. If not specified, the device default is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=32
+	HashMaskLength *int32 `json:"hashMaskLength,omitempty"`
+}
+
+// PIMCandidateRP defines a candidate rendezvous point.
+type PIMCandidateRP struct {
+	// InterfaceRef references the interface whose IPv4 address is used as the address of the candidate RP.
+	// +required
+	InterfaceRef LocalObjectReference `json:"interfaceRef"`
+
+	// MulticastGroups is the list of multicast IPv4 address ranges for which the device is a candidate RP.
+	// If not specified, the device is a candidate RP for all multicast groups.
+	// +optional
+	MulticastGroups []IPPrefix `json:"multicastGroups,omitempty"`
+
+	// Priority is the priority of the candidate RP. The candidate with the lowest priority is preferred.
+	// Only supported with BSR. If not specified, the device default is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Priority *int32 `json:"priority,omitempty"`
+}
+
+// PIMAutoRP defines the Auto-RP configuration of a PIM instance.
+type PIMAutoRP struct {
+	// Listen enables receiving and forwarding of Auto-RP messages. It must be enabled on all routers
+	// that learn the rendezvous points from the mapping agent.
+	// +optional
+	Listen bool `json:"listen,omitempty"`
+
+	// CandidateRP configures the device as a candidate rendezvous point announced to the mapping agent.
+	// +optional
+	CandidateRP *PIMCandidateRP `json:"candidateRP,omitempty"`
+
+	// MappingAgent configures the device as mapping agent, which elects the rendezvous points among
+	// the candidates and announces the group-to-RP mappings.
+	// +optional
+	MappingAgent *PIMAutoRPMappingAgent `json:"mappingAgent,omitempty"`
+}
+
+// PIMAutoRPMappingAgent defines an Auto-RP mapping agent.
+type PIMAutoRPMappingAgent struct {
+	// InterfaceRef references the interface whose IPv4 address is used as the address of the mapping agent.
+	// +required
+	InterfaceRef LocalObjectReference `json:"interfaceRef"`
+}
+
 type PIMInterface struct {
 	LocalObjectReference `json:",inline"`
 
@@ -68,8 +158,62 @@ type PIMInterface struct {
 	// +optional
 	// +kubebuilder:default=Sparse
 	Mode PIMInterfaceMode `json:"mode"`
+
+	// HelloInterval is the interval at which PIM hello messages are sent on the interface.
+	// Must be a whole number of milliseconds. If not specified, the device default is used.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s') && duration(self) <= duration('18724286ms')",message="helloInterval must be between 1s and 18724286ms"
+	HelloInterval *metav1.Duration `json:"helloInterval,omitempty"`
+
+	// DRPriority is the priority of the interface in the designated router (DR) election.
+	// The router with the highest priority on a network segment becomes the DR.
+	// If not specified, the device default is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4294967295
+	DRPriority *int64 `json:"drPriority,omitempty"`
+
+	// IGMP defines the IGMP querier parameters of the interface, e.g. for directly attached multicast receivers.
+	// The interface must be a Layer 3 interface. If not specified, the device defaults are used.
+	// +optional
+	IGMP *IGMPQuerier `json:"igmp,omitempty"`
 }
 
+// IGMPQuerier defines the IGMP querier parameters of an interface.
+type IGMPQuerier struct {
+	// Version is the IGMP version used by the querier.
+	// +optional
+	// +kubebuilder:default=v2
+	Version IGMPVersion `json:"version,omitempty"`
+
+	// QueryInterval is the interval at which the querier sends general queries.
+	// Must be a whole number of seconds between 1s and 18000s. Defaults to 125s.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s') && duration(self) <= duration('18000s')",message="queryInterval must be between 1s and 18000s"
+	QueryInterval *metav1.Duration `json:"queryInterval,omitempty"`
+
+	// RobustnessVariable is the number of queries that may be lost without a group membership
+	// being considered expired.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=7
+	// +kubebuilder:default=2
+	RobustnessVariable int32 `json:"robustnessVariable,omitempty"`
+}
+
+// IGMPVersion represents the version of the IGMP protocol.
+// +kubebuilder:validation:Enum=v2;v3
+type IGMPVersion string
+
+const (
+	IGMPVersion2 IGMPVersion = "v2"
+	IGMPVersion3 IGMPVersion = "v3"
+)
+
 // PIMInterfaceMode represents the mode of a PIM interface.
 // +kubebuilder:validation:Enum=Sparse;Dense
 type PIMInterfaceMode string
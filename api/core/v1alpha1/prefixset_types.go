@@ -55,10 +55,28 @@ type PrefixEntry struct {
 
 	// Optional mask length range for the prefix.
 	// If not specified, only the exact prefix length is matched.
+	// The range must be within the prefix length and the maximum length of the address family.
 	// +optional
 	MaskLengthRange *MaskLengthRange `json:"maskLengthRange,omitempty"`
+
+	// Action is the action applied to routes matching the entry.
+	// +optional
+	// +kubebuilder:default=Permit
+	Action PrefixEntryAction `json:"action,omitempty"`
 }
 
+// PrefixEntryAction represents the action of a prefix entry.
+// +kubebuilder:validation:Enum=Permit;Deny
+type PrefixEntryAction string
+
+const (
+	// PrefixEntryActionPermit matches routes covered by the entry.
+	PrefixEntryActionPermit PrefixEntryAction = "Permit"
+	// PrefixEntryActionDeny excludes routes covered by the entry from the prefix set.
+	PrefixEntryActionDeny PrefixEntryAction = "Deny"
+)
+
+// +kubebuilder:validation:XValidation:rule="self.min <= self.max",message="min must not be greater than max"
 type MaskLengthRange struct {
 	// Minimum mask length.
 	// +required
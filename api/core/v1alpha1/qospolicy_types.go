@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// QoSPolicySpec defines the desired state of QoSPolicy
+type QoSPolicySpec struct {
+	// DeviceName is the name of the Device this object belongs to. The Device object must exist in the same namespace.
+	// Immutable.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="DeviceRef is immutable"
+	DeviceRef LocalObjectReference `json:"deviceRef"`
+
+	// ProviderConfigRef is a reference to a resource holding the provider-specific configuration of this QoS policy.
+	// This reference is used to link the QoSPolicy to its provider-specific configuration.
+	// +optional
+	ProviderConfigRef *TypedLocalObjectReference `json:"providerConfigRef,omitempty"`
+
+	// Name is the identifier of the QoSPolicy on the device, i.e. the name of its policy-map.
+	// Immutable.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=40
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Name is immutable"
+	Name string `json:"name"`
+
+	// Classes is the list of traffic classes of the policy and the actions applied to the traffic of each class.
+	// Each class is realized as a class-map on the device, so the names must be unique across all QoSPolicies of the device.
+	// +required
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	Classes []QoSClass `json:"classes"`
+}
+
+// QoSClass defines a class of traffic and the actions applied to it.
+type QoSClass struct {
+	// Name is the identifier of the class on the device, i.e. the name of its class-map.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=40
+	Name string `json:"name"`
+
+	// Match defines the criteria that traffic must meet to belong to the class.
+	// +required
+	Match QoSClassMatch `json:"match"`
+
+	// Set defines the markings applied to the traffic of the class.
+	// +optional
+	Set *QoSClassSet `json:"set,omitempty"`
+}
+
+// QoSClassMatch defines the criteria of a traffic class. Traffic belongs to the class if it meets any of the criteria.
+// +kubebuilder:validation:XValidation:rule="has(self.dscp) || has(self.cos)",message="at least one of dscp or cos must be set"
+type QoSClassMatch struct {
+	// DSCP is the list of Differentiated Services Code Point values to match.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:items:Minimum=0
+	// +kubebuilder:validation:items:Maximum=63
+	DSCP []int32 `json:"dscp,omitempty"`
+
+	// CoS is the list of IEEE 802.1p Class of Service values to match.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:items:Minimum=0
+	// +kubebuilder:validation:items:Maximum=7
+	CoS []int32 `json:"cos,omitempty"`
+}
+
+// QoSClassSet defines the markings applied to the traffic of a class.
+// +kubebuilder:validation:XValidation:rule="has(self.dscp) || has(self.cos) || has(self.qosGroup)",message="at least one of dscp, cos or qosGroup must be set"
+type QoSClassSet struct {
+	// DSCP is the Differentiated Services Code Point value to mark the traffic with.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=63
+	DSCP *int32 `json:"dscp,omitempty"`
+
+	// CoS is the IEEE 802.1p Class of Service value to mark the traffic with.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=7
+	CoS *int32 `json:"cos,omitempty"`
+
+	// QoSGroup is the internal QoS group to assign the traffic to.
+	// The QoS group selects the egress queue the traffic is placed in on the device.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=7
+	QoSGroup *int32 `json:"qosGroup,omitempty"`
+}
+
+// QoSPolicyStatus defines the observed state of QoSPolicy.
+type QoSPolicyStatus struct {
+	// The conditions are a list of status objects that describe the state of the QoSPolicy.
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=qospolicies
+// +kubebuilder:resource:singular=qospolicy
+// +kubebuilder:printcolumn:name="Policy",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Device",type=string,JSONPath=`.spec.deviceRef.name`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Paused",type=string,JSONPath=`.status.conditions[?(@.type=="Paused")].status`,priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// QoSPolicy is the Schema for the qospolicies API
+type QoSPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired state of the resource.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +required
+	Spec QoSPolicySpec `json:"spec,omitempty"`
+
+	// Status of the resource. This is set and updated automatically.
+	// Read-only.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status QoSPolicyStatus `json:"status,omitzero"`
+}
+
+// GetConditions implements conditions.Getter.
+func (p *QoSPolicy) GetConditions() []metav1.Condition {
+	return p.Status.Conditions
+}
+
+// SetConditions implements conditions.Setter.
+func (p *QoSPolicy) SetConditions(conditions []metav1.Condition) {
+	p.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// QoSPolicyList contains a list of QoSPolicy
+type QoSPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []QoSPolicy `json:"items"`
+}
+
+var (
+	QoSPolicyDependencies   []schema.GroupVersionKind
+	qosPolicyDependenciesMu sync.Mutex
+)
+
+func RegisterQoSPolicyDependency(gvk schema.GroupVersionKind) {
+	qosPolicyDependenciesMu.Lock()
+	defer qosPolicyDependenciesMu.Unlock()
+	QoSPolicyDependencies = append(QoSPolicyDependencies, gvk)
+}
+
+func init() {
+	SchemeBuilder.Register(func(s *runtime.Scheme) error {
+		s.AddKnownTypes(GroupVersion, &QoSPolicy{}, &QoSPolicyList{})
+		return nil
+	})
+}
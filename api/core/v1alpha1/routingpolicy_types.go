@@ -63,6 +63,25 @@ type PolicyConditions struct {
 	// MatchPrefixSet matches routes against a PrefixSet resource.
 	// +optional
 	MatchPrefixSet *PrefixSetMatchCondition `json:"matchPrefixSet,omitempty"`
+
+	// MatchCommunityLists matches routes with communities permitted by any of the named community-lists.
+	// The community-lists must exist on the device.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=63
+	MatchCommunityLists []string `json:"matchCommunityLists,omitempty"`
+
+	// MatchTags matches routes carrying any of the given route tags.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Minimum=0
+	// +kubebuilder:validation:items:Maximum=4294967295
+	MatchTags []int64 `json:"matchTags,omitempty"`
 }
 
 // PrefixSetMatchCondition defines the condition for matching against a PrefixSet.
@@ -75,6 +94,7 @@ type PrefixSetMatchCondition struct {
 
 // PolicyActions defines the actions to take when a policy statement matches.
 // +kubebuilder:validation:XValidation:rule="self.routeDisposition == 'AcceptRoute' || !has(self.bgpActions)",message="bgpActions cannot be specified when routeDisposition is RejectRoute"
+// +kubebuilder:validation:XValidation:rule="self.routeDisposition == 'AcceptRoute' || (!has(self.setMetric) && !has(self.setNextHop))",message="setMetric and setNextHop cannot be specified when routeDisposition is RejectRoute"
 type PolicyActions struct {
 	// RouteDisposition specifies whether to accept or reject the route.
 	// +required
@@ -84,6 +104,18 @@ type PolicyActions struct {
 	// Only applicable when RouteDisposition is AcceptRoute.
 	// +optional
 	BgpActions *BgpActions `json:"bgpActions,omitempty"`
+
+	// SetMetric sets the metric of the route, e.g. the metric of redistributed routes or the MED of BGP routes.
+	// Only applicable when RouteDisposition is AcceptRoute.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4294967295
+	SetMetric *int64 `json:"setMetric,omitempty"`
+
+	// SetNextHop sets the next-hop address of the route.
+	// Only applicable when RouteDisposition is AcceptRoute.
+	// +optional
+	SetNextHop IPAddr `json:"setNextHop,omitzero"`
 }
 
 // RouteDisposition defines the final disposition of a route.
@@ -98,7 +130,7 @@ type PolicyActions struct {
 )
 
 // BgpActions defines BGP-specific actions for a policy statement.
-// +kubebuilder:validation:XValidation:rule="has(self.setCommunity) || has(self.setExtCommunity) || has(self.setASPath)",message="at least one BGP action must be specified"
+// +kubebuilder:validation:XValidation:rule="has(self.setCommunity) || has(self.setExtCommunity) || has(self.setASPath) || has(self.setLocalPreference)",message="at least one BGP action must be specified"
 type BgpActions struct {
 	// SetCommunity configures BGP standard community attributes.
 	// +optional
@@ -111,6 +143,12 @@ type BgpActions struct {
 	// SetASPath configures modifications to the BGP AS path attribute.
 	// +optional
 	SetASPath *SetASPathAction `json:"setASPath,omitempty"`
+
+	// SetLocalPreference sets the BGP local preference of the route.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4294967295
+	SetLocalPreference *int64 `json:"setLocalPreference,omitempty"`
 }
 
 // SetASPathAction defines actions to modify the BGP AS path attribute.
@@ -126,6 +126,16 @@ type SNMPStatus struct {
 	// +patchMergeKey=type
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Communities contains the names of the SNMP communities in the spec that are configured on the device.
+	// +optional
+	// +listType=atomic
+	Communities []string `json:"communities,omitempty"`
+
+	// Hosts contains the addresses of the SNMP hosts configured on the device.
+	// +optional
+	// +listType=atomic
+	Hosts []string `json:"hosts,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -110,6 +110,17 @@ type RouteTarget struct {
 
 // VRFStatus defines the observed state of VRF.
 type VRFStatus struct {
+	// RouteDistinguisher is the route distinguisher configured for the VRF.
+	// Either taken from the spec or derived from the VRFDerivation of the Device.
+	// +optional
+	RouteDistinguisher string `json:"routeDistinguisher,omitempty"`
+
+	// RouteTargets is the list of route targets configured for the VRF.
+	// Either taken from the spec or derived from the VRFDerivation of the Device.
+	// +optional
+	// +listType=atomic
+	RouteTargets []RouteTarget `json:"routeTargets,omitempty"`
+
 	// The conditions are a list of status objects that describe the state of the VRF.
 	// +listType=map
 	// +listMapKey=type
@@ -125,7 +136,7 @@ type VRFStatus struct {
 // +kubebuilder:resource:singular=vrf
 // +kubebuilder:printcolumn:name="VRF",type=string,JSONPath=`.spec.name`
 // +kubebuilder:printcolumn:name="Device",type=string,JSONPath=`.spec.deviceRef.name`
-// +kubebuilder:printcolumn:name="Route Distinguisher",type=string,JSONPath=`.spec.routeDistinguisher`,priority=1
+// +kubebuilder:printcolumn:name="Route Distinguisher",type=string,JSONPath=`.status.routeDistinguisher`,priority=1
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Paused",type=string,JSONPath=`.status.conditions[?(@.type=="Paused")].status`,priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
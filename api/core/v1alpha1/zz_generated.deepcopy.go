@@ -301,6 +301,16 @@ func (in *ACLEntry) DeepCopyInto(out *ACLEntry) {
 	*out = *in
 	in.SourceAddress.DeepCopyInto(&out.SourceAddress)
 	in.DestinationAddress.DeepCopyInto(&out.DestinationAddress)
+	if in.SourcePort != nil {
+		in, out := &in.SourcePort, &out.SourcePort
+		*out = new(ACLPortMatch)
+		**out = **in
+	}
+	if in.DestinationPort != nil {
+		in, out := &in.DestinationPort, &out.DestinationPort
+		*out = new(ACLPortMatch)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACLEntry.
@@ -313,6 +323,48 @@ func (in *ACLEntry) DeepCopy() *ACLEntry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACLObjectGroup) DeepCopyInto(out *ACLObjectGroup) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]IPPrefix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]ACLPortMatch, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACLObjectGroup.
+func (in *ACLObjectGroup) DeepCopy() *ACLObjectGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ACLObjectGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACLPortMatch) DeepCopyInto(out *ACLPortMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACLPortMatch.
+func (in *ACLPortMatch) DeepCopy() *ACLPortMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ACLPortMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessControlList) DeepCopyInto(out *AccessControlList) {
 	*out = *in
@@ -388,6 +440,13 @@ func (in *AccessControlListSpec) DeepCopyInto(out *AccessControlListSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ObjectGroups != nil {
+		in, out := &in.ObjectGroups, &out.ObjectGroups
+		*out = make([]ACLObjectGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlListSpec.
@@ -403,6 +462,11 @@ func (in *AccessControlListSpec) DeepCopy() *AccessControlListSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessControlListStatus) DeepCopyInto(out *AccessControlListStatus) {
 	*out = *in
+	if in.ObjectGroups != nil {
+		in, out := &in.ObjectGroups, &out.ObjectGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -585,6 +649,21 @@ func (in *BGPAddressFamily) DeepCopy() *BGPAddressFamily {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPAllowASIn) DeepCopyInto(out *BGPAllowASIn) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPAllowASIn.
+func (in *BGPAllowASIn) DeepCopy() *BGPAllowASIn {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPAllowASIn)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPL2vpnEvpn) DeepCopyInto(out *BGPL2vpnEvpn) {
 	*out = *in
@@ -606,6 +685,21 @@ func (in *BGPL2vpnEvpn) DeepCopy() *BGPL2vpnEvpn {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPLinkBandwidth) DeepCopyInto(out *BGPLinkBandwidth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPLinkBandwidth.
+func (in *BGPLinkBandwidth) DeepCopy() *BGPLinkBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPLinkBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPList) DeepCopyInto(out *BGPList) {
 	*out = *in
@@ -763,6 +857,16 @@ func (in *BGPPeerAddressFamily) DeepCopyInto(out *BGPPeerAddressFamily) {
 		*out = new(LocalObjectReference)
 		**out = **in
 	}
+	if in.LinkBandwidth != nil {
+		in, out := &in.LinkBandwidth, &out.LinkBandwidth
+		*out = new(BGPLinkBandwidth)
+		**out = **in
+	}
+	if in.AllowASIn != nil {
+		in, out := &in.AllowASIn, &out.AllowASIn
+		*out = new(BGPAllowASIn)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerAddressFamily.
@@ -775,6 +879,46 @@ func (in *BGPPeerAddressFamily) DeepCopy() *BGPPeerAddressFamily {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeerCapabilities) DeepCopyInto(out *BGPPeerCapabilities) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerCapabilities.
+func (in *BGPPeerCapabilities) DeepCopy() *BGPPeerCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeerCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeerGroup) DeepCopyInto(out *BGPPeerGroup) {
+	*out = *in
+	if in.LocalAddress != nil {
+		in, out := &in.LocalAddress, &out.LocalAddress
+		*out = new(BGPPeerLocalAddress)
+		**out = **in
+	}
+	if in.AddressFamilies != nil {
+		in, out := &in.AddressFamilies, &out.AddressFamilies
+		*out = make([]BGPAddressFamilyType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerGroup.
+func (in *BGPPeerGroup) DeepCopy() *BGPPeerGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeerGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPPeerList) DeepCopyInto(out *BGPPeerList) {
 	*out = *in
@@ -849,6 +993,21 @@ func (in *BGPPeerSpec) DeepCopyInto(out *BGPPeerSpec) {
 		*out = new(LocalAS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(PasswordSource)
+		**out = **in
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(BGPPeerCapabilities)
+		**out = **in
+	}
+	if in.Timers != nil {
+		in, out := &in.Timers, &out.Timers
+		*out = new(BGPPeerTimers)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerSpec.
@@ -908,6 +1067,22 @@ func (in *BGPRedistributeDirectRoutes) DeepCopy() *BGPRedistributeDirectRoutes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPRedistribution) DeepCopyInto(out *BGPRedistribution) {
+	*out = *in
+	out.RoutingPolicyRef = in.RoutingPolicyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPRedistribution.
+func (in *BGPRedistribution) DeepCopy() *BGPRedistribution {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPRedistribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPRouteTargetPolicy) DeepCopyInto(out *BGPRouteTargetPolicy) {
 	*out = *in
@@ -923,6 +1098,31 @@ func (in *BGPRouteTargetPolicy) DeepCopy() *BGPRouteTargetPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeerTimers) DeepCopyInto(out *BGPPeerTimers) {
+	*out = *in
+	if in.ConnectRetry != nil {
+		in, out := &in.ConnectRetry, &out.ConnectRetry
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MinimumAdvertisementInterval != nil {
+		in, out := &in.MinimumAdvertisementInterval, &out.MinimumAdvertisementInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerTimers.
+func (in *BGPPeerTimers) DeepCopy() *BGPPeerTimers {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeerTimers)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPSpec) DeepCopyInto(out *BGPSpec) {
 	*out = *in
@@ -943,6 +1143,13 @@ func (in *BGPSpec) DeepCopyInto(out *BGPSpec) {
 		*out = new(BGPAddressFamilies)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PeerGroups != nil {
+		in, out := &in.PeerGroups, &out.PeerGroups
+		*out = make([]BGPPeerGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPSpec.
@@ -965,6 +1172,11 @@ func (in *BGPStatus) DeepCopyInto(out *BGPStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PeerGroups != nil {
+		in, out := &in.PeerGroups, &out.PeerGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPStatus.
@@ -986,6 +1198,11 @@ func (in *BGPUnicastAddressFamily) DeepCopyInto(out *BGPUnicastAddressFamily) {
 		*out = new(BGPRedistributeDirectRoutes)
 		**out = **in
 	}
+	if in.Redistribute != nil {
+		in, out := &in.Redistribute, &out.Redistribute
+		*out = make([]BGPRedistribution, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPUnicastAddressFamily.
@@ -1119,6 +1336,11 @@ func (in *BgpActions) DeepCopyInto(out *BgpActions) {
 		*out = new(SetASPathAction)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SetLocalPreference != nil {
+		in, out := &in.SetLocalPreference, &out.SetLocalPreference
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BgpActions.
@@ -1231,6 +1453,14 @@ func (in *CertificateSpec) DeepCopy() *CertificateSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 	*out = *in
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1355,6 +1585,21 @@ func (in *DHCPRelayList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPRelayOption82) DeepCopyInto(out *DHCPRelayOption82) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPRelayOption82.
+func (in *DHCPRelayOption82) DeepCopy() *DHCPRelayOption82 {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPRelayOption82)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DHCPRelaySpec) DeepCopyInto(out *DHCPRelaySpec) {
 	*out = *in
@@ -1379,6 +1624,11 @@ func (in *DHCPRelaySpec) DeepCopyInto(out *DHCPRelaySpec) {
 		*out = make([]LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.Option82 != nil {
+		in, out := &in.Option82, &out.Option82
+		*out = new(DHCPRelayOption82)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPRelaySpec.
@@ -1486,11 +1736,21 @@ func (in *DNSSpec) DeepCopyInto(out *DNSSpec) {
 		*out = new(TypedLocalObjectReference)
 		**out = **in
 	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Servers != nil {
 		in, out := &in.Servers, &out.Servers
 		*out = make([]NameServer, len(*in))
 		copy(*out, *in)
 	}
+	if in.ResolutionOrder != nil {
+		in, out := &in.ResolutionOrder, &out.ResolutionOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSpec.
@@ -1572,6 +1832,36 @@ func (in *Device) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceECMP) DeepCopyInto(out *DeviceECMP) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceECMP.
+func (in *DeviceECMP) DeepCopy() *DeviceECMP {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceECMP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceLACP) DeepCopyInto(out *DeviceLACP) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceLACP.
+func (in *DeviceLACP) DeepCopy() *DeviceLACP {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceLACP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeviceList) DeepCopyInto(out *DeviceList) {
 	*out = *in
@@ -1604,6 +1894,33 @@ func (in *DeviceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceOwnership) DeepCopyInto(out *DeviceOwnership) {
+	*out = *in
+	if in.VLANs != nil {
+		in, out := &in.VLANs, &out.VLANs
+		*out = make([]IndexRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VRFs != nil {
+		in, out := &in.VRFs, &out.VRFs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceOwnership.
+func (in *DeviceOwnership) DeepCopy() *DeviceOwnership {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceOwnership)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DevicePort) DeepCopyInto(out *DevicePort) {
 	*out = *in
@@ -1629,6 +1946,148 @@ func (in *DevicePort) DeepCopy() *DevicePort {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceQuery) DeepCopyInto(out *DeviceQuery) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceQuery.
+func (in *DeviceQuery) DeepCopy() *DeviceQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceQuery) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceQueryList) DeepCopyInto(out *DeviceQueryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeviceQuery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceQueryList.
+func (in *DeviceQueryList) DeepCopy() *DeviceQueryList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceQueryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceQueryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceQueryRoute) DeepCopyInto(out *DeviceQueryRoute) {
+	*out = *in
+	in.Prefix.DeepCopyInto(&out.Prefix)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceQueryRoute.
+func (in *DeviceQueryRoute) DeepCopy() *DeviceQueryRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceQueryRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceQueryRunningConfig) DeepCopyInto(out *DeviceQueryRunningConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceQueryRunningConfig.
+func (in *DeviceQueryRunningConfig) DeepCopy() *DeviceQueryRunningConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceQueryRunningConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceQuerySpec) DeepCopyInto(out *DeviceQuerySpec) {
+	*out = *in
+	out.DeviceRef = in.DeviceRef
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(DeviceQueryRoute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunningConfig != nil {
+		in, out := &in.RunningConfig, &out.RunningConfig
+		*out = new(DeviceQueryRunningConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceQuerySpec.
+func (in *DeviceQuerySpec) DeepCopy() *DeviceQuerySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceQuerySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceQueryStatus) DeepCopyInto(out *DeviceQueryStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QueryTime != nil {
+		in, out := &in.QueryTime, &out.QueryTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceQueryStatus.
+func (in *DeviceQueryStatus) DeepCopy() *DeviceQueryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceQueryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeviceSpec) DeepCopyInto(out *DeviceSpec) {
 	*out = *in
@@ -1638,6 +2097,38 @@ func (in *DeviceSpec) DeepCopyInto(out *DeviceSpec) {
 		*out = new(Provisioning)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VRFDerivation != nil {
+		in, out := &in.VRFDerivation, &out.VRFDerivation
+		*out = new(VRFDerivation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ECMP != nil {
+		in, out := &in.ECMP, &out.ECMP
+		*out = new(DeviceECMP)
+		**out = **in
+	}
+	if in.LACP != nil {
+		in, out := &in.LACP, &out.LACP
+		*out = new(DeviceLACP)
+		**out = **in
+	}
+	if in.AutoSaveConfigInterval != nil {
+		in, out := &in.AutoSaveConfigInterval, &out.AutoSaveConfigInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ownership != nil {
+		in, out := &in.Ownership, &out.Ownership
+		*out = new(DeviceOwnership)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceSpec.
@@ -1654,6 +2145,7 @@ func (in *DeviceSpec) DeepCopy() *DeviceSpec {
 func (in *DeviceStatus) DeepCopyInto(out *DeviceStatus) {
 	*out = *in
 	in.LastRebootTime.DeepCopyInto(&out.LastRebootTime)
+	in.LastConfigSaveTime.DeepCopyInto(&out.LastConfigSaveTime)
 	if in.Provisioning != nil {
 		in, out := &in.Provisioning, &out.Provisioning
 		*out = make([]ProvisioningInfo, len(*in))
@@ -1862,6 +2354,11 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ethernet) DeepCopyInto(out *Ethernet) {
 	*out = *in
+	if in.StormControl != nil {
+		in, out := &in.StormControl, &out.StormControl
+		*out = new(StormControl)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ethernet.
@@ -2014,6 +2511,26 @@ func (in *GRPC) DeepCopy() *GRPC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IGMPQuerier) DeepCopyInto(out *IGMPQuerier) {
+	*out = *in
+	if in.QueryInterval != nil {
+		in, out := &in.QueryInterval, &out.QueryInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IGMPQuerier.
+func (in *IGMPQuerier) DeepCopy() *IGMPQuerier {
+	if in == nil {
+		return nil
+	}
+	out := new(IGMPQuerier)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ISIS) DeepCopyInto(out *ISIS) {
 	*out = *in
@@ -2041,6 +2558,41 @@ func (in *ISIS) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ISISAdjacency) DeepCopyInto(out *ISISAdjacency) {
+	*out = *in
+	out.InterfaceRef = in.InterfaceRef
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ISISAdjacency.
+func (in *ISISAdjacency) DeepCopy() *ISISAdjacency {
+	if in == nil {
+		return nil
+	}
+	out := new(ISISAdjacency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ISISLSPDatabaseSummary) DeepCopyInto(out *ISISLSPDatabaseSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ISISLSPDatabaseSummary.
+func (in *ISISLSPDatabaseSummary) DeepCopy() *ISISLSPDatabaseSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ISISLSPDatabaseSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ISISList) DeepCopyInto(out *ISISList) {
 	*out = *in
@@ -2107,6 +2659,18 @@ func (in *ISISSpec) DeepCopy() *ISISSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ISISStatus) DeepCopyInto(out *ISISStatus) {
 	*out = *in
+	if in.Adjacencies != nil {
+		in, out := &in.Adjacencies, &out.Adjacencies
+		*out = make([]ISISAdjacency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LSPDatabase != nil {
+		in, out := &in.LSPDatabase, &out.LSPDatabase
+		*out = make([]ISISLSPDatabaseSummary, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -2168,6 +2732,38 @@ func (in *Interface) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceAccessGroup) DeepCopyInto(out *InterfaceAccessGroup) {
+	*out = *in
+	out.AccessControlListRef = in.AccessControlListRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceAccessGroup.
+func (in *InterfaceAccessGroup) DeepCopy() *InterfaceAccessGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceAccessGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceFECStatus) DeepCopyInto(out *InterfaceFECStatus) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceFECStatus.
+func (in *InterfaceFECStatus) DeepCopy() *InterfaceFECStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceFECStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceIPv4) DeepCopyInto(out *InterfaceIPv4) {
 	*out = *in
@@ -2183,6 +2779,16 @@ func (in *InterfaceIPv4) DeepCopyInto(out *InterfaceIPv4) {
 		*out = new(InterfaceIPv4Unnumbered)
 		**out = **in
 	}
+	if in.ARPTimeout != nil {
+		in, out := &in.ARPTimeout, &out.ARPTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.URPF != nil {
+		in, out := &in.URPF, &out.URPF
+		*out = new(URPF)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceIPv4.
@@ -2211,6 +2817,49 @@ func (in *InterfaceIPv4Unnumbered) DeepCopy() *InterfaceIPv4Unnumbered {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceIPv6) DeepCopyInto(out *InterfaceIPv6) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]IPPrefix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LinkLocalAddress.DeepCopyInto(&out.LinkLocalAddress)
+	if in.URPF != nil {
+		in, out := &in.URPF, &out.URPF
+		*out = new(URPF)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceIPv6.
+func (in *InterfaceIPv6) DeepCopy() *InterfaceIPv6 {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceIPv6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceLACPStatus) DeepCopyInto(out *InterfaceLACPStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceLACPStatus.
+func (in *InterfaceLACPStatus) DeepCopy() *InterfaceLACPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceLACPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceList) DeepCopyInto(out *InterfaceList) {
 	*out = *in
@@ -2243,6 +2892,22 @@ func (in *InterfaceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceServicePolicy) DeepCopyInto(out *InterfaceServicePolicy) {
+	*out = *in
+	out.QoSPolicyRef = in.QoSPolicyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceServicePolicy.
+func (in *InterfaceServicePolicy) DeepCopy() *InterfaceServicePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceServicePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceSpec) DeepCopyInto(out *InterfaceSpec) {
 	*out = *in
@@ -2257,11 +2922,21 @@ func (in *InterfaceSpec) DeepCopyInto(out *InterfaceSpec) {
 		*out = new(Switchport)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PortSecurity != nil {
+		in, out := &in.PortSecurity, &out.PortSecurity
+		*out = new(PortSecurity)
+		**out = **in
+	}
 	if in.IPv4 != nil {
 		in, out := &in.IPv4, &out.IPv4
 		*out = new(InterfaceIPv4)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(InterfaceIPv6)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Aggregation != nil {
 		in, out := &in.Aggregation, &out.Aggregation
 		*out = new(Aggregation)
@@ -2277,6 +2952,16 @@ func (in *InterfaceSpec) DeepCopyInto(out *InterfaceSpec) {
 		*out = new(LocalObjectReference)
 		**out = **in
 	}
+	if in.AccessGroups != nil {
+		in, out := &in.AccessGroups, &out.AccessGroups
+		*out = make([]InterfaceAccessGroup, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServicePolicies != nil {
+		in, out := &in.ServicePolicies, &out.ServicePolicies
+		*out = make([]InterfaceServicePolicy, len(*in))
+		copy(*out, *in)
+	}
 	if in.BFD != nil {
 		in, out := &in.BFD, &out.BFD
 		*out = new(BFD)
@@ -2285,7 +2970,7 @@ func (in *InterfaceSpec) DeepCopyInto(out *InterfaceSpec) {
 	if in.Ethernet != nil {
 		in, out := &in.Ethernet, &out.Ethernet
 		*out = new(Ethernet)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Encapsulation != nil {
 		in, out := &in.Encapsulation, &out.Encapsulation
@@ -2331,6 +3016,16 @@ func (in *InterfaceStatus) DeepCopyInto(out *InterfaceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FEC != nil {
+		in, out := &in.FEC, &out.FEC
+		*out = new(InterfaceFECStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LACP != nil {
+		in, out := &in.LACP, &out.LACP
+		*out = new(InterfaceLACPStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceStatus.
@@ -2537,6 +3232,27 @@ func (in *LogServer) DeepCopy() *LogServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagementAccess) DeepCopyInto(out *ManagementAccess) {
 	*out = *in
@@ -2607,6 +3323,8 @@ func (in *ManagementAccessSpec) DeepCopyInto(out *ManagementAccessSpec) {
 	}
 	out.GRPC = in.GRPC
 	out.SSH = in.SSH
+	out.NETCONF = in.NETCONF
+	out.RESTCONF = in.RESTCONF
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementAccessSpec.
@@ -2694,6 +3412,21 @@ func (in *MulticastGroups) DeepCopy() *MulticastGroups {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NETCONF) DeepCopyInto(out *NETCONF) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NETCONF.
+func (in *NETCONF) DeepCopy() *NETCONF {
+	if in == nil {
+		return nil
+	}
+	out := new(NETCONF)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NTP) DeepCopyInto(out *NTP) {
 	*out = *in
@@ -2816,6 +3549,21 @@ func (in *NTPStatus) DeepCopy() *NTPStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NVEPeer) DeepCopyInto(out *NVEPeer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NVEPeer.
+func (in *NVEPeer) DeepCopy() *NVEPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(NVEPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NameServer) DeepCopyInto(out *NameServer) {
 	*out = *in
@@ -2953,6 +3701,11 @@ func (in *NetworkVirtualizationEdgeStatus) DeepCopyInto(out *NetworkVirtualizati
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]NVEPeer, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkVirtualizationEdgeStatus.
@@ -3159,10 +3912,145 @@ func (in *PIM) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PIMAutoRP) DeepCopyInto(out *PIMAutoRP) {
+	*out = *in
+	if in.CandidateRP != nil {
+		in, out := &in.CandidateRP, &out.CandidateRP
+		*out = new(PIMCandidateRP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MappingAgent != nil {
+		in, out := &in.MappingAgent, &out.MappingAgent
+		*out = new(PIMAutoRPMappingAgent)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PIMAutoRP.
+func (in *PIMAutoRP) DeepCopy() *PIMAutoRP {
+	if in == nil {
+		return nil
+	}
+	out := new(PIMAutoRP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PIMAutoRPMappingAgent) DeepCopyInto(out *PIMAutoRPMappingAgent) {
+	*out = *in
+	out.InterfaceRef = in.InterfaceRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PIMAutoRPMappingAgent.
+func (in *PIMAutoRPMappingAgent) DeepCopy() *PIMAutoRPMappingAgent {
+	if in == nil {
+		return nil
+	}
+	out := new(PIMAutoRPMappingAgent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PIMBSR) DeepCopyInto(out *PIMBSR) {
+	*out = *in
+	if in.CandidateBSR != nil {
+		in, out := &in.CandidateBSR, &out.CandidateBSR
+		*out = new(PIMCandidateBSR)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CandidateRP != nil {
+		in, out := &in.CandidateRP, &out.CandidateRP
+		*out = new(PIMCandidateRP)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PIMBSR.
+func (in *PIMBSR) DeepCopy() *PIMBSR {
+	if in == nil {
+		return nil
+	}
+	out := new(PIMBSR)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PIMCandidateBSR) DeepCopyInto(out *PIMCandidateBSR) {
+	*out = *in
+	out.InterfaceRef = in.InterfaceRef
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HashMaskLength != nil {
+		in, out := &in.HashMaskLength, &out.HashMaskLength
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PIMCandidateBSR.
+func (in *PIMCandidateBSR) DeepCopy() *PIMCandidateBSR {
+	if in == nil {
+		return nil
+	}
+	out := new(PIMCandidateBSR)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PIMCandidateRP) DeepCopyInto(out *PIMCandidateRP) {
+	*out = *in
+	out.InterfaceRef = in.InterfaceRef
+	if in.MulticastGroups != nil {
+		in, out := &in.MulticastGroups, &out.MulticastGroups
+		*out = make([]IPPrefix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PIMCandidateRP.
+func (in *PIMCandidateRP) DeepCopy() *PIMCandidateRP {
+	if in == nil {
+		return nil
+	}
+	out := new(PIMCandidateRP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PIMInterface) DeepCopyInto(out *PIMInterface) {
 	*out = *in
 	out.LocalObjectReference = in.LocalObjectReference
+	if in.HelloInterval != nil {
+		in, out := &in.HelloInterval, &out.HelloInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DRPriority != nil {
+		in, out := &in.DRPriority, &out.DRPriority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IGMP != nil {
+		in, out := &in.IGMP, &out.IGMP
+		*out = new(IGMPQuerier)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PIMInterface.
@@ -3226,7 +4114,19 @@ func (in *PIMSpec) DeepCopyInto(out *PIMSpec) {
 	if in.InterfaceRefs != nil {
 		in, out := &in.InterfaceRefs, &out.InterfaceRefs
 		*out = make([]PIMInterface, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BSR != nil {
+		in, out := &in.BSR, &out.BSR
+		*out = new(PIMBSR)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoRP != nil {
+		in, out := &in.AutoRP, &out.AutoRP
+		*out = new(PIMAutoRP)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -3286,6 +4186,12 @@ func (in *PolicyActions) DeepCopyInto(out *PolicyActions) {
 		*out = new(BgpActions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SetMetric != nil {
+		in, out := &in.SetMetric, &out.SetMetric
+		*out = new(int64)
+		**out = **in
+	}
+	in.SetNextHop.DeepCopyInto(&out.SetNextHop)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyActions.
@@ -3306,6 +4212,16 @@ func (in *PolicyConditions) DeepCopyInto(out *PolicyConditions) {
 		*out = new(PrefixSetMatchCondition)
 		**out = **in
 	}
+	if in.MatchCommunityLists != nil {
+		in, out := &in.MatchCommunityLists, &out.MatchCommunityLists
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MatchTags != nil {
+		in, out := &in.MatchTags, &out.MatchTags
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyConditions.
@@ -3339,6 +4255,21 @@ func (in *PolicyStatement) DeepCopy() *PolicyStatement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortSecurity) DeepCopyInto(out *PortSecurity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortSecurity.
+func (in *PortSecurity) DeepCopy() *PortSecurity {
+	if in == nil {
+		return nil
+	}
+	out := new(PortSecurity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrefixEntry) DeepCopyInto(out *PrefixEntry) {
 	*out = *in
@@ -3520,6 +4451,206 @@ func (in *ProvisioningInfo) DeepCopy() *ProvisioningInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSClass) DeepCopyInto(out *QoSClass) {
+	*out = *in
+	in.Match.DeepCopyInto(&out.Match)
+	if in.Set != nil {
+		in, out := &in.Set, &out.Set
+		*out = new(QoSClassSet)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSClass.
+func (in *QoSClass) DeepCopy() *QoSClass {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSClassMatch) DeepCopyInto(out *QoSClassMatch) {
+	*out = *in
+	if in.DSCP != nil {
+		in, out := &in.DSCP, &out.DSCP
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.CoS != nil {
+		in, out := &in.CoS, &out.CoS
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSClassMatch.
+func (in *QoSClassMatch) DeepCopy() *QoSClassMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSClassMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSClassSet) DeepCopyInto(out *QoSClassSet) {
+	*out = *in
+	if in.DSCP != nil {
+		in, out := &in.DSCP, &out.DSCP
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CoS != nil {
+		in, out := &in.CoS, &out.CoS
+		*out = new(int32)
+		**out = **in
+	}
+	if in.QoSGroup != nil {
+		in, out := &in.QoSGroup, &out.QoSGroup
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSClassSet.
+func (in *QoSClassSet) DeepCopy() *QoSClassSet {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSClassSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSPolicy) DeepCopyInto(out *QoSPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSPolicy.
+func (in *QoSPolicy) DeepCopy() *QoSPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QoSPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSPolicyList) DeepCopyInto(out *QoSPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QoSPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSPolicyList.
+func (in *QoSPolicyList) DeepCopy() *QoSPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QoSPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSPolicySpec) DeepCopyInto(out *QoSPolicySpec) {
+	*out = *in
+	out.DeviceRef = in.DeviceRef
+	if in.ProviderConfigRef != nil {
+		in, out := &in.ProviderConfigRef, &out.ProviderConfigRef
+		*out = new(TypedLocalObjectReference)
+		**out = **in
+	}
+	if in.Classes != nil {
+		in, out := &in.Classes, &out.Classes
+		*out = make([]QoSClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSPolicySpec.
+func (in *QoSPolicySpec) DeepCopy() *QoSPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSPolicyStatus) DeepCopyInto(out *QoSPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSPolicyStatus.
+func (in *QoSPolicyStatus) DeepCopy() *QoSPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RESTCONF) DeepCopyInto(out *RESTCONF) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RESTCONF.
+func (in *RESTCONF) DeepCopy() *RESTCONF {
+	if in == nil {
+		return nil
+	}
+	out := new(RESTCONF)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RendezvousPoint) DeepCopyInto(out *RendezvousPoint) {
 	*out = *in
@@ -3811,6 +4942,16 @@ func (in *SNMPStatus) DeepCopyInto(out *SNMPStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNMPStatus.
@@ -4002,6 +5143,61 @@ func (in *SetExtCommunityAction) DeepCopy() *SetExtCommunityAction {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StormControl) DeepCopyInto(out *StormControl) {
+	*out = *in
+	if in.Broadcast != nil {
+		in, out := &in.Broadcast, &out.Broadcast
+		*out = new(StormControlThreshold)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Multicast != nil {
+		in, out := &in.Multicast, &out.Multicast
+		*out = new(StormControlThreshold)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnknownUnicast != nil {
+		in, out := &in.UnknownUnicast, &out.UnknownUnicast
+		*out = new(StormControlThreshold)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StormControl.
+func (in *StormControl) DeepCopy() *StormControl {
+	if in == nil {
+		return nil
+	}
+	out := new(StormControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StormControlThreshold) DeepCopyInto(out *StormControlThreshold) {
+	*out = *in
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PacketsPerSecond != nil {
+		in, out := &in.PacketsPerSecond, &out.PacketsPerSecond
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StormControlThreshold.
+func (in *StormControlThreshold) DeepCopy() *StormControlThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(StormControlThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Switchport) DeepCopyInto(out *Switchport) {
 	*out = *in
@@ -4200,6 +5396,21 @@ func (in *TypedLocalObjectReference) DeepCopy() *TypedLocalObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *URPF) DeepCopyInto(out *URPF) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new URPF.
+func (in *URPF) DeepCopy() *URPF {
+	if in == nil {
+		return nil
+	}
+	out := new(URPF)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
@@ -4467,6 +5678,26 @@ func (in *VRF) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VRFDerivation) DeepCopyInto(out *VRFDerivation) {
+	*out = *in
+	if in.RouteTargetAddressFamilies != nil {
+		in, out := &in.RouteTargetAddressFamilies, &out.RouteTargetAddressFamilies
+		*out = make([]RouteTargetAF, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRFDerivation.
+func (in *VRFDerivation) DeepCopy() *VRFDerivation {
+	if in == nil {
+		return nil
+	}
+	out := new(VRFDerivation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VRFList) DeepCopyInto(out *VRFList) {
 	*out = *in
@@ -4530,6 +5761,13 @@ func (in *VRFSpec) DeepCopy() *VRFSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VRFStatus) DeepCopyInto(out *VRFStatus) {
 	*out = *in
+	if in.RouteTargets != nil {
+		in, out := &in.RouteTargets, &out.RouteTargets
+		*out = make([]RouteTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
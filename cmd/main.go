@@ -10,6 +10,7 @@
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -30,10 +31,12 @@
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	// Import all supported provider implementations.
@@ -50,6 +53,7 @@
 	poolcontroller "github.com/ironcore-dev/network-operator/internal/controller/pool"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/provisioning"
+	"github.com/ironcore-dev/network-operator/internal/ratelimit"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
 	tftpserver "github.com/ironcore-dev/network-operator/internal/tftp"
 	webhooknxv1alpha1 "github.com/ironcore-dev/network-operator/internal/webhook/cisco/nx/v1alpha1"
@@ -96,10 +100,16 @@ func main() { //nolint:gocyclo
 	var watchFilterValue string
 	var providerName string
 	var requeueInterval time.Duration
+	var backoff ratelimit.Backoff
 	var heartbeatInterval time.Duration
+	var deviceEvents bool
 	var tftpPort int
 	var tftpValidateSource bool
 	var maxConcurrentReconciles int
+	controllerConcurrency := map[string]int{}
+	var maxConcurrentDeviceRequests int
+	var minDeviceChangeInterval time.Duration
+	var maxPathsPerRequest int
 	var leaderElectionNamespace string
 	var lockerNamespace string
 	var lockerDuration time.Duration
@@ -122,10 +132,31 @@ func main() { //nolint:gocyclo
 	flag.StringVar(&watchFilterValue, "watch-filter", "", fmt.Sprintf("Label value that the controller watches to reconcile api objects. Label key is always %q. If unspecified, the controller watches for all api objects.", v1alpha1.WatchLabel))
 	flag.StringVar(&providerName, "provider", "openconfig", "The provider to use for the controller. If not specified, the default provider is used. Available providers: "+strings.Join(provider.Providers(), ", "))
 	flag.DurationVar(&requeueInterval, "requeue-interval", time.Hour, "The interval after which Kubernetes resources should be reconciled again regardless of whether they have changed.")
+	flag.DurationVar(&backoff.BaseDelay, "backoff-base-delay", ratelimit.DefaultBackoff.BaseDelay, "The delay before the first retry of a failed reconciliation. Failed reconciliations are retried with exponential backoff instead of after --requeue-interval, which only applies to successful reconciliations.")
+	flag.DurationVar(&backoff.MaxDelay, "backoff-max-delay", ratelimit.DefaultBackoff.MaxDelay, "The maximum delay between two retries of a failed reconciliation. If it exceeds --requeue-interval, failing resources may be retried less frequently than healthy resources are reconciled.")
+	flag.Float64Var(&backoff.Multiplier, "backoff-multiplier", ratelimit.DefaultBackoff.Multiplier, "The factor by which the delay between two retries of a failed reconciliation grows after each failure. Use 1 to retry with a constant delay.")
 	flag.DurationVar(&heartbeatInterval, "heartbeat-interval", 30*time.Second, "The interval after which the controller retries a reachability check on each device.")
+	flag.BoolVar(&deviceEvents, "device-events", false, "If set, the controller subscribes to device-originated events (e.g. interface or BGP session state changes) and records them as Events on the matching resources.")
 	flag.IntVar(&tftpPort, "tftp-port", 1069, "The port on which the inline TFTP server listens. Set to 0 to disable the TFTP server.")
 	flag.BoolVar(&tftpValidateSource, "tftp-validate-source", false, "If set, the TFTP server validates the source IP and requested serial-based filename against the same Device.")
 	flag.IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", 1, "The maximum number of concurrent reconciles per controller. Defaults to 1.")
+	flag.Func("controller-max-concurrent-reconciles", "Comma-separated list of controller=count pairs overriding --max-concurrent-reconciles for individual controllers, e.g. interface=8,vrf=1. Controller names are case-insensitive.", func(v string) error {
+		for pair := range strings.SplitSeq(v, ",") {
+			name, count, ok := strings.Cut(pair, "=")
+			if !ok {
+				return fmt.Errorf("invalid entry %q, expected controller=count", pair)
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(count))
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid count %q for controller %q, expected a positive integer", count, name)
+			}
+			controllerConcurrency[strings.ToLower(strings.TrimSpace(name))] = n
+		}
+		return nil
+	})
+	flag.IntVar(&maxConcurrentDeviceRequests, "max-concurrent-device-requests", 4, "The maximum number of concurrent gNMI requests sent to a single device across all controllers. Set to 0 to disable the limit.")
+	flag.DurationVar(&minDeviceChangeInterval, "min-device-change-interval", 0, "The minimum time between two gNMI configuration changes applied to a single device across all controllers, e.g. to protect devices with weak management CPUs. Pending changes are queued and applied at the allowed rate. Set to 0 to disable the limit.")
+	flag.IntVar(&maxPathsPerRequest, "max-paths-per-request", 0, "The maximum number of paths sent to a device in a single gNMI Set request. Larger configuration changes are split into multiple requests. Set to 0 to disable the limit.")
 	flag.StringVar(&lockerNamespace, "locker-namespace", "", "The namespace to use for resource locker coordination. If not specified, uses the namespace the manager is deployed in, or 'default' if undetectable.")
 	flag.DurationVar(&lockerDuration, "locker-duration", 5*time.Second, "The duration of the resource locker lease.")
 	flag.DurationVar(&lockerRenewInterval, "locker-renew-interval", time.Second, "The interval at which the resource locker lease is renewed.")
@@ -140,6 +171,26 @@ func main() { //nolint:gocyclo
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if err := backoff.Validate(); err != nil {
+		setupLog.Error(err, "invalid backoff parameters")
+		os.Exit(1)
+	}
+
+	// controllerOptions returns the options of the named controller. Each controller gets its own
+	// rate limiter, as failures are tracked per item. The concurrency falls back to the manager-wide
+	// --max-concurrent-reconciles unless it is overridden for the controller.
+	knownControllers := map[string]bool{}
+	controllerOptions := func(name string) controller.Options {
+		knownControllers[name] = true
+		return controller.Options{
+			RateLimiter:             ratelimit.New[reconcile.Request](backoff),
+			MaxConcurrentReconciles: controllerConcurrency[name],
+		}
+	}
+
+	// Identify the operator in the device-side session logs of all gRPC requests.
+	provider.SetVersion(version)
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -257,6 +308,9 @@ func main() { //nolint:gocyclo
 	}
 
 	setupLog.Info("Using provider", "provider", providerName)
+	provider.SetMaxConcurrentRequestsPerDevice(maxConcurrentDeviceRequests)
+	provider.SetMinChangeIntervalPerDevice(minDeviceChangeInterval)
+	provider.SetMaxPathsPerRequest(maxPathsPerRequest)
 	prov, err := provider.Get(providerName)
 	if err != nil {
 		setupLog.Error(err, "failed to get provider", "provider", providerName)
@@ -275,7 +329,15 @@ func main() { //nolint:gocyclo
 		}
 	}
 
-	locker, err := resourcelock.NewResourceLocker(mgr.GetClient(), lockerNamespace, lockerDuration, lockerRenewInterval)
+	// The hostname is the name of the pod, which distinguishes the lease holders of
+	// multiple instances of the operator, e.g. during a rolling upgrade.
+	identity, err := os.Hostname()
+	if err != nil {
+		setupLog.Error(err, "unable to determine hostname for resource locker")
+		os.Exit(1)
+	}
+
+	locker, err := resourcelock.NewResourceLocker(mgr.GetClient(), lockerNamespace, lockerDuration, lockerRenewInterval, resourcelock.WithIdentity(identity))
 	if err != nil {
 		setupLog.Error(err, "unable to create resource locker")
 		os.Exit(1)
@@ -311,7 +373,9 @@ func main() { //nolint:gocyclo
 		Recorder:          mgr.GetEventRecorder("device-controller"),
 		WatchFilterValue:  watchFilterValue,
 		Provider:          prov,
+		Locker:            locker,
 		HeartbeatInterval: heartbeatInterval,
+		Options:           controllerOptions("device"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Device")
 		os.Exit(1)
@@ -325,6 +389,7 @@ func main() { //nolint:gocyclo
 		Provider:         prov,
 		Locker:           locker,
 		RequeueInterval:  requeueInterval,
+		Options:          controllerOptions("interface"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Interface")
 		os.Exit(1)
@@ -337,6 +402,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("banner"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Banner")
 		os.Exit(1)
@@ -349,6 +415,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("user"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "User")
 		os.Exit(1)
@@ -361,6 +428,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("dns"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DNS")
 		os.Exit(1)
@@ -373,6 +441,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("ntp"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "NTP")
 		os.Exit(1)
@@ -385,6 +454,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("accesscontrollist"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AccessControlList")
 		os.Exit(1)
@@ -397,6 +467,8 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		RequeueInterval:  requeueInterval,
+		Options:          controllerOptions("certificate"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Certificate")
 		os.Exit(1)
@@ -409,6 +481,8 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		RequeueInterval:  requeueInterval,
+		Options:          controllerOptions("snmp"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "SNMP")
 		os.Exit(1)
@@ -421,6 +495,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("syslog"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Syslog")
 		os.Exit(1)
@@ -433,6 +508,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("managementaccess"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ManagementAccess")
 		os.Exit(1)
@@ -445,6 +521,8 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		RequeueInterval:  requeueInterval,
+		Options:          controllerOptions("isis"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ISIS")
 		os.Exit(1)
@@ -457,6 +535,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("pim"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "PIM")
 		os.Exit(1)
@@ -470,6 +549,7 @@ func main() { //nolint:gocyclo
 		Provider:         prov,
 		Locker:           locker,
 		RequeueInterval:  requeueInterval,
+		Options:          controllerOptions("bgp"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "BGP")
 		os.Exit(1)
@@ -483,6 +563,7 @@ func main() { //nolint:gocyclo
 		Provider:         prov,
 		Locker:           locker,
 		RequeueInterval:  requeueInterval,
+		Options:          controllerOptions("bgppeer"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "BGPPeer")
 		os.Exit(1)
@@ -496,6 +577,7 @@ func main() { //nolint:gocyclo
 		Provider:         prov,
 		Locker:           locker,
 		RequeueInterval:  requeueInterval,
+		Options:          controllerOptions("lldp"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "LLDP")
 		os.Exit(1)
@@ -509,6 +591,7 @@ func main() { //nolint:gocyclo
 		Provider:         prov,
 		Locker:           locker,
 		RequeueInterval:  requeueInterval,
+		Options:          controllerOptions("ospf"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "OSPF")
 		os.Exit(1)
@@ -522,6 +605,7 @@ func main() { //nolint:gocyclo
 		Provider:         prov,
 		Locker:           locker,
 		RequeueInterval:  requeueInterval,
+		Options:          controllerOptions("vlan"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "VLAN")
 		os.Exit(1)
@@ -534,6 +618,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("vrf"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "VRF")
 		os.Exit(1)
@@ -547,6 +632,7 @@ func main() { //nolint:gocyclo
 		Provider:         prov,
 		Locker:           locker,
 		RequeueInterval:  requeueInterval,
+		Options:          controllerOptions("vpcdomain"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "VPCDomain")
 		os.Exit(1)
@@ -560,6 +646,7 @@ func main() { //nolint:gocyclo
 		Provider:         prov,
 		Locker:           locker,
 		RequeueInterval:  requeueInterval,
+		Options:          controllerOptions("networkvirtualizationedge"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "NetworkVirtualizationEdge")
 		os.Exit(1)
@@ -572,6 +659,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("system"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "System")
 		os.Exit(1)
@@ -584,6 +672,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("evpninstance"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "EVPNInstance")
 		os.Exit(1)
@@ -596,6 +685,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("aaa"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AAA")
 		os.Exit(1)
@@ -608,11 +698,25 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("prefixset"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "PrefixSet")
 		os.Exit(1)
 	}
 
+	if err := (&corecontroller.QoSPolicyReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		Recorder:         mgr.GetEventRecorder("qospolicy-controller"),
+		WatchFilterValue: watchFilterValue,
+		Provider:         prov,
+		Locker:           locker,
+		Options:          controllerOptions("qospolicy"),
+	}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "QoSPolicy")
+		os.Exit(1)
+	}
+
 	if err := (&corecontroller.RoutingPolicyReconciler{
 		Client:           mgr.GetClient(),
 		Scheme:           mgr.GetScheme(),
@@ -620,6 +724,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("routingpolicy"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "RoutingPolicy")
 		os.Exit(1)
@@ -632,6 +737,7 @@ func main() { //nolint:gocyclo
 		WatchFilterValue: watchFilterValue,
 		Provider:         prov,
 		Locker:           locker,
+		Options:          controllerOptions("bordergateway"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "BorderGateway")
 		os.Exit(1)
@@ -645,6 +751,7 @@ func main() { //nolint:gocyclo
 		Provider:         prov,
 		Locker:           locker,
 		RequeueInterval:  requeueInterval,
+		Options:          controllerOptions("dhcprelay"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DHCPRelay")
 		os.Exit(1)
@@ -658,66 +765,95 @@ func main() { //nolint:gocyclo
 		Provider:         prov,
 		Locker:           locker,
 		RequeueInterval:  requeueInterval,
+		Options:          controllerOptions("ethernetsegment"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "EthernetSegment")
 		os.Exit(1)
 	}
 
+	if err := (&corecontroller.DeviceQueryReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		Recorder:         mgr.GetEventRecorder("devicequery-controller"),
+		WatchFilterValue: watchFilterValue,
+		Provider:         prov,
+		Locker:           locker,
+		Options:          controllerOptions("devicequery"),
+	}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DeviceQuery")
+		os.Exit(1)
+	}
+
 	if err := (&poolcontroller.IndexPoolReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Options: controllerOptions("indexpool"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "Failed to create controller", "controller", "IndexPool")
 		os.Exit(1)
 	}
 
 	if err := (&poolcontroller.IPAddressPoolReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Options: controllerOptions("ipaddresspool"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "Failed to create controller", "controller", "IPAddressPool")
 		os.Exit(1)
 	}
 
 	if err := (&poolcontroller.IPPrefixPoolReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Options: controllerOptions("ipprefixpool"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "Failed to create controller", "controller", "IPPrefixPool")
 		os.Exit(1)
 	}
 
 	if err := (&poolcontroller.ClaimReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Options: controllerOptions("claim"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "Failed to create controller", "controller", "Claim")
 		os.Exit(1)
 	}
 
 	if err := (&poolcontroller.IndexReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Options: controllerOptions("pool-index"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "Failed to create controller", "controller", "pool-index")
 		os.Exit(1)
 	}
 
 	if err := (&poolcontroller.IPAddressReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Options: controllerOptions("pool-ipaddress"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "Failed to create controller", "controller", "pool-ipaddress")
 		os.Exit(1)
 	}
 
 	if err := (&poolcontroller.IPPrefixReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Options: controllerOptions("pool-ipprefix"),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "Failed to create controller", "controller", "pool-ipprefix")
 		os.Exit(1)
 	}
+
+	for name := range controllerConcurrency {
+		if !knownControllers[name] {
+			setupLog.Error(fmt.Errorf("unknown controller %q", name), "invalid --controller-max-concurrent-reconciles")
+			os.Exit(1)
+		}
+	}
+
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
 		if err := webhookv1alpha1.SetupVRFWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "VRF")
@@ -759,6 +895,11 @@ func main() { //nolint:gocyclo
 			os.Exit(1)
 		}
 
+		if err := webhooknxv1alpha1.SetupInterfaceConfigWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "Failed to create webhook", "webhook", "InterfaceConfig")
+			os.Exit(1)
+		}
+
 		if err := webhookpoolv1alpha1.SetupIndexPoolWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "Failed to create webhook", "webhook", "IndexPool")
 			os.Exit(1)
@@ -796,6 +937,23 @@ func main() { //nolint:gocyclo
 		}
 	}
 
+	// Subscribe to device-originated events when enabled.
+	// Failed subscriptions are reestablished at the heartbeat interval.
+	if deviceEvents {
+		watcher := &corecontroller.DeviceEventWatcher{
+			Client:           mgr.GetClient(),
+			WatchFilterValue: watchFilterValue,
+			Recorder:         mgr.GetEventRecorder("device-event-watcher"),
+			Provider:         prov,
+			ResyncInterval:   heartbeatInterval,
+		}
+		setupLog.Info("Adding device event watcher to manager")
+		if err := mgr.Add(watcher); err != nil {
+			setupLog.Error(err, "unable to add device event watcher to manager")
+			os.Exit(1)
+		}
+	}
+
 	// Start inline TFTP server when the configured port is non-zero.
 	if tftpPort != 0 {
 		srv := &tftpserver.Server{
@@ -123,7 +123,7 @@ func RecomputeReady(target Setter) (changed bool) {
 
 	conditions := target.GetConditions()
 	for _, condition := range conditions {
-		if condition.Type != v1alpha1.ReadyCondition && condition.Type != v1alpha1.PausedCondition && condition.Status != metav1.ConditionTrue {
+		if condition.Type != v1alpha1.ReadyCondition && condition.Type != v1alpha1.PausedCondition && condition.Type != v1alpha1.PendingChangeCondition && condition.Status != metav1.ConditionTrue {
 			status = metav1.ConditionFalse
 			reason = v1alpha1.NotReadyReason
 			message = "One or more conditions are not ready"
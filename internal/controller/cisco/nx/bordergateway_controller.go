@@ -21,6 +21,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -33,6 +34,7 @@
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	corecontroller "github.com/ironcore-dev/network-operator/internal/controller/core"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/provider/cisco/nxos"
@@ -56,6 +58,9 @@ type BorderGatewayReconciler struct {
 
 	// Locker is used to synchronize operations on resources targeting the same device.
 	Locker *resourcelock.ResourceLocker
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=nx.cisco.networking.metal.ironcore.dev,resources=bordergateways,verbs=get;list;watch;create;update;patch;delete
@@ -139,6 +144,9 @@ func (r *BorderGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -252,6 +260,7 @@ func (r *BorderGatewayReconciler) SetupWithManager(ctx context.Context, mgr ctrl
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&nxv1alpha1.BorderGateway{}).
 		Named("bordergateway").
+		WithOptions(r.Options).
 		WithEventFilter(filter).
 		// Watches enqueues BorderGateways for updates in referenced source Interface resources.
 		// Only triggers on create and delete events since interface names are immutable.
@@ -300,14 +309,7 @@ func (r *BorderGatewayReconciler) SetupWithManager(ctx context.Context, mgr ctrl
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToBorderGateways),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		Complete(r)
 }
@@ -349,6 +351,11 @@ func (r *BorderGatewayReconciler) reconcile(ctx context.Context, s *borderGatewa
 		return err
 	}
 
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if maintenance.DeferChanges(s.Device, s.BorderGateway) {
+		return nil
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
@@ -227,7 +227,9 @@ func (p *MockProvider) GetDeviceInfo(context.Context) (*provider.DeviceInfo, err
 }
 
 func (p *MockProvider) GetLastRebootTime(context.Context) (time.Time, error) { return time.Time{}, nil }
-func (p *MockProvider) Reboot(context.Context, *deviceutil.Connection) error { return nil }
+func (p *MockProvider) Reboot(context.Context, *deviceutil.Connection, *provider.RebootOptions) error {
+	return nil
+}
 func (p *MockProvider) FactoryReset(context.Context, *deviceutil.Connection) error {
 	return nil
 }
@@ -20,8 +20,8 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
-	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
@@ -31,6 +31,7 @@
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	corecontroller "github.com/ironcore-dev/network-operator/internal/controller/core"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -53,6 +54,9 @@ type SystemReconciler struct {
 
 	// Locker is used to synchronize operations on resources targeting the same device.
 	Locker *resourcelock.ResourceLocker
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=nx.cisco.networking.metal.ironcore.dev,resources=systems,verbs=get;list;watch;create;update;patch;delete
@@ -136,6 +140,9 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ c
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -214,20 +221,14 @@ func (r *SystemReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manage
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&nxv1alpha1.System{}).
 		Named("system").
+		WithOptions(r.Options).
 		WithEventFilter(filter).
 		// Watches enqueues Systems for updates in referenced Device resources.
 		// Triggers on create, delete, and update events when the device's effective pause state changes.
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToSystems),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		Complete(r)
 }
@@ -254,6 +255,11 @@ func (r *SystemReconciler) reconcile(ctx context.Context, s *systemScope) (reter
 		}
 	}
 
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if maintenance.DeferChanges(s.Device, s.System) {
+		return nil
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
@@ -21,6 +21,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -29,6 +30,7 @@
 
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -60,6 +62,9 @@ type VPCDomainReconciler struct {
 	// RequeueInterval is the duration after which the controller should requeue the reconciliation,
 	// regardless of changes.
 	RequeueInterval time.Duration
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=nx.cisco.networking.metal.ironcore.dev,resources=vpcdomains,verbs=get;list;watch;create;update;patch;delete
@@ -138,6 +143,9 @@ func (r *VPCDomainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -226,6 +234,7 @@ func (r *VPCDomainReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Man
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&nxv1alpha1.VPCDomain{}).
 		Named("vpcdomain").
+		WithOptions(r.Options).
 		WithEventFilter(filter).
 		// Trigger reconciliation for changes in the operational status of the referenced interface: The device can shut down the port-channel by itself
 		// in certain failure scenarios, e.g., incompatible configuration.
@@ -250,14 +259,7 @@ func (r *VPCDomainReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Man
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToVPCDomains),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		Complete(r)
 }
@@ -314,12 +316,15 @@ func (r *VPCDomainReconciler) reconcile(ctx context.Context, s *vpcdomainScope)
 		return reterr
 	}
 
-	//  Realize the vPC via the provider and update configuration status
-	err = s.Provider.EnsureVPCDomain(ctx, s.VPCDomain, vrf, peerLink)
-	cond := conditions.FromError(err)
-	conditions.Set(s.VPCDomain, cond)
-	if err != nil {
-		reterr = kerrors.NewAggregate([]error{reterr, fmt.Errorf("failed to reconcile resource: %w", err)})
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if !maintenance.DeferChanges(s.Device, s.VPCDomain) {
+		//  Realize the vPC via the provider and update configuration status
+		err = s.Provider.EnsureVPCDomain(ctx, s.VPCDomain, vrf, peerLink)
+		cond := conditions.FromError(err)
+		conditions.Set(s.VPCDomain, cond)
+		if err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, fmt.Errorf("failed to reconcile resource: %w", err)})
+		}
 	}
 
 	// Retrieve and update status from the device, nil out on error
@@ -360,7 +365,7 @@ func (r *VPCDomainReconciler) reconcile(ctx context.Context, s *vpcdomainScope)
 		}
 	}
 
-	cond = metav1.Condition{
+	cond := metav1.Condition{
 		Type:    v1alpha1.OperationalCondition,
 		Status:  metav1.ConditionTrue,
 		Reason:  v1alpha1.OperationalReason,
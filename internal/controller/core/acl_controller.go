@@ -7,6 +7,7 @@
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -22,8 +23,8 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
-	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -32,6 +33,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -54,6 +56,9 @@ type AccessControlListReconciler struct {
 
 	// Locker is used to synchronize operations on resources targeting the same device.
 	Locker *resourcelock.ResourceLocker
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=accesscontrollists,verbs=get;list;watch;create;update;patch;delete
@@ -146,6 +151,9 @@ func (r *AccessControlListReconciler) Reconcile(ctx context.Context, req ctrl.Re
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -224,6 +232,7 @@ func (r *AccessControlListReconciler) SetupWithManager(ctx context.Context, mgr
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.AccessControlList{}).
 		Named("accesscontrollist").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.AccessControlListDependencies {
@@ -243,14 +252,7 @@ func (r *AccessControlListReconciler) SetupWithManager(ctx context.Context, mgr
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToAccessControlLists),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		Complete(r)
 }
@@ -284,6 +286,11 @@ func (r *AccessControlListReconciler) reconcile(ctx context.Context, s *aclScope
 		s.ACL.Status.EntriesSummary = fmt.Sprintf("%d entries", len(s.ACL.Spec.Entries))
 	}
 
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if maintenance.DeferChanges(s.Device, s.ACL) {
+		return nil
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
@@ -294,10 +301,7 @@ func (r *AccessControlListReconciler) reconcile(ctx context.Context, s *aclScope
 	}()
 
 	// Ensure the AccessControlList is realized on the provider.
-	err := s.Provider.EnsureACL(ctx, &provider.EnsureACLRequest{
-		ACL:            s.ACL,
-		ProviderConfig: s.ProviderConfig,
-	})
+	err := r.ensureACL(ctx, s)
 
 	cond := conditions.FromError(err)
 	// As this resource is configuration only, we use the Configured condition as top-level Ready condition.
@@ -317,10 +321,160 @@ func (r *AccessControlListReconciler) finalize(ctx context.Context, s *aclScope)
 		}
 	}()
 
-	return s.Provider.DeleteACL(ctx, &provider.DeleteACLRequest{
+	if err := s.Provider.DeleteACL(ctx, &provider.DeleteACLRequest{
 		Name:           s.ACL.Spec.Name,
 		ProviderConfig: s.ProviderConfig,
-	})
+	}); err != nil {
+		return err
+	}
+
+	for _, name := range s.ACL.Status.ObjectGroups {
+		if err := s.Provider.DeleteObjectGroup(ctx, &provider.DeleteObjectGroupRequest{
+			Name:           name,
+			ProviderConfig: s.ProviderConfig,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureACL realizes the AccessControlList and its object groups on the provider.
+// Object groups are created before and removed after the entries referencing them are updated.
+func (r *AccessControlListReconciler) ensureACL(ctx context.Context, s *aclScope) error {
+	if err := validateObjectGroups(s.ACL); err != nil {
+		return err
+	}
+	if err := r.ensureUniqueObjectGroups(ctx, s.ACL); err != nil {
+		return err
+	}
+
+	for i := range s.ACL.Spec.ObjectGroups {
+		og := &s.ACL.Spec.ObjectGroups[i]
+		if err := s.Provider.EnsureObjectGroup(ctx, &provider.EnsureObjectGroupRequest{
+			ObjectGroup:    og,
+			ProviderConfig: s.ProviderConfig,
+		}); err != nil {
+			return err
+		}
+		if !slices.Contains(s.ACL.Status.ObjectGroups, og.Name) {
+			s.ACL.Status.ObjectGroups = append(s.ACL.Status.ObjectGroups, og.Name)
+		}
+	}
+
+	if err := s.Provider.EnsureACL(ctx, &provider.EnsureACLRequest{
+		ACL:            s.ACL,
+		ProviderConfig: s.ProviderConfig,
+	}); err != nil {
+		return err
+	}
+
+	var errs []error
+	kept := make([]string, 0, len(s.ACL.Spec.ObjectGroups))
+	for _, name := range s.ACL.Status.ObjectGroups {
+		if slices.ContainsFunc(s.ACL.Spec.ObjectGroups, func(og v1alpha1.ACLObjectGroup) bool { return og.Name == name }) {
+			kept = append(kept, name)
+			continue
+		}
+		if err := s.Provider.DeleteObjectGroup(ctx, &provider.DeleteObjectGroupRequest{
+			Name:           name,
+			ProviderConfig: s.ProviderConfig,
+		}); err != nil {
+			kept = append(kept, name)
+			errs = append(errs, err)
+		}
+	}
+	s.ACL.Status.ObjectGroups = kept
+
+	return kerrors.NewAggregate(errs)
+}
+
+// ensureUniqueObjectGroups verifies that none of the object groups of the AccessControlList is declared by
+// another AccessControlList on the same device, as both would configure the same object group on the device.
+// The object group remains with the AccessControlList that was created first.
+func (r *AccessControlListReconciler) ensureUniqueObjectGroups(ctx context.Context, acl *v1alpha1.AccessControlList) error {
+	if len(acl.Spec.ObjectGroups) == 0 {
+		return nil
+	}
+
+	list := new(v1alpha1.AccessControlListList)
+	if err := r.List(ctx, list,
+		client.InNamespace(acl.Namespace),
+		client.MatchingFields{v1alpha1.DeviceRefIndexKey: acl.Spec.DeviceRef.Name},
+	); err != nil {
+		return fmt.Errorf("failed to list access control lists: %w", err)
+	}
+
+	for _, other := range list.Items {
+		if other.UID == acl.UID || !precedes(&other, acl) {
+			continue
+		}
+		for _, og := range acl.Spec.ObjectGroups {
+			if slices.ContainsFunc(other.Spec.ObjectGroups, func(o v1alpha1.ACLObjectGroup) bool { return o.Name == og.Name }) {
+				return apistatus.NewFailedPreconditionError(fmt.Sprintf("object group %q is already declared by AccessControlList %q", og.Name, other.Name))
+			}
+		}
+	}
+	return nil
+}
+
+// precedes reports whether a was created before b, using the name to break ties.
+func precedes(a, b client.Object) bool {
+	ta, tb := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !ta.Equal(&tb) {
+		return ta.Before(&tb)
+	}
+	return a.GetName() < b.GetName()
+}
+
+// validateObjectGroups verifies that all object groups referenced by the entries of the
+// AccessControlList are defined in its object groups and are of the expected type.
+func validateObjectGroups(acl *v1alpha1.AccessControlList) error {
+	addrs := make(map[string]bool, len(acl.Spec.ObjectGroups))
+	for _, og := range acl.Spec.ObjectGroups {
+		addrs[og.Name] = len(og.Addresses) > 0
+	}
+
+	var violations []apistatus.FieldViolation
+	for i, e := range acl.Spec.Entries {
+		for _, ref := range []struct {
+			field string
+			name  string
+			addr  bool
+		}{
+			{"sourceAddressGroup", e.SourceAddressGroup, true},
+			{"destinationAddressGroup", e.DestinationAddressGroup, true},
+			{"sourcePortGroup", e.SourcePortGroup, false},
+			{"destinationPortGroup", e.DestinationPortGroup, false},
+		} {
+			if ref.name == "" {
+				continue
+			}
+			addr, ok := addrs[ref.name]
+			switch {
+			case !ok:
+				violations = append(violations, apistatus.FieldViolation{
+					Field:       fmt.Sprintf("spec.entries[%d].%s", i, ref.field),
+					Description: fmt.Sprintf("object group %q is not defined", ref.name),
+				})
+			case addr != ref.addr:
+				kind := "a port group"
+				if addr {
+					kind = "an address group"
+				}
+				violations = append(violations, apistatus.FieldViolation{
+					Field:       fmt.Sprintf("spec.entries[%d].%s", i, ref.field),
+					Description: fmt.Sprintf("object group %q is %s", ref.name, kind),
+				})
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return apistatus.NewInvalidArgumentError(violations...)
+	}
+	return nil
 }
 
 // deviceToAccessControlLists is a [handler.MapFunc] to be used to enqueue requests for reconciliation
@@ -13,6 +13,8 @@
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/conditions"
 )
 
 var _ = Describe("AccessControlList Controller", func() {
@@ -132,5 +134,105 @@
 				g.Expect(testProvider.ACLs.Has(name)).To(BeTrue(), "Provider should have AccessControlList configured")
 			}).Should(Succeed())
 		})
+
+		It("Should configure and remove object groups", func() {
+			group := name + "-ports"
+
+			By("Adding an object group referenced by an entry")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.AccessControlList{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				resource.Spec.ObjectGroups = []v1alpha1.ACLObjectGroup{{
+					Name:  group,
+					Ports: []v1alpha1.ACLPortMatch{{Operator: v1alpha1.PortOperatorEqual, Port: 443}},
+				}}
+				resource.Spec.Entries = append(resource.Spec.Entries, v1alpha1.ACLEntry{
+					Sequence:             30,
+					Action:               v1alpha1.ActionPermit,
+					Protocol:             v1alpha1.ProtocolTCP,
+					SourceAddress:        v1alpha1.IPPrefix{Prefix: netip.MustParsePrefix("0.0.0.0/0")},
+					DestinationAddress:   v1alpha1.IPPrefix{Prefix: netip.MustParsePrefix("0.0.0.0/0")},
+					DestinationPortGroup: group,
+				})
+				g.Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			}).Should(Succeed())
+
+			By("Ensuring the object group is created in the provider")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.AccessControlList{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.ObjectGroups).To(ConsistOf(group))
+				g.Expect(testProvider.ObjectGroups.Has(group)).To(BeTrue(), "Provider should have object group configured")
+			}).Should(Succeed())
+
+			By("Removing the object group and the referencing entry")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.AccessControlList{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				resource.Spec.ObjectGroups = nil
+				resource.Spec.Entries = resource.Spec.Entries[:2]
+				g.Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			}).Should(Succeed())
+
+			By("Ensuring the object group is removed from the provider")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.AccessControlList{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.ObjectGroups).To(BeEmpty())
+				g.Expect(testProvider.ObjectGroups.Has(group)).To(BeFalse(), "Provider shouldn't have object group configured anymore")
+			}).Should(Succeed())
+		})
+
+		It("Should refuse an object group declared by another AccessControlList", func() {
+			group := name + "-shared"
+			objectGroups := []v1alpha1.ACLObjectGroup{{
+				Name:      group,
+				Addresses: []v1alpha1.IPPrefix{{Prefix: netip.MustParsePrefix("10.0.0.0/8")}},
+			}}
+
+			By("Adding an object group to the first AccessControlList")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.AccessControlList{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				resource.Spec.ObjectGroups = objectGroups
+				g.Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			}).Should(Succeed())
+
+			By("Creating a second AccessControlList declaring the same object group")
+			other := &v1alpha1.AccessControlList{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name + "-other",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.AccessControlListSpec{
+					DeviceRef:    v1alpha1.LocalObjectReference{Name: name},
+					Name:         name + "-other",
+					ObjectGroups: objectGroups,
+					Entries: []v1alpha1.ACLEntry{{
+						Sequence:           10,
+						Action:             v1alpha1.ActionPermit,
+						Protocol:           v1alpha1.ProtocolIP,
+						SourceAddressGroup: group,
+						DestinationAddress: v1alpha1.IPPrefix{Prefix: netip.MustParsePrefix("0.0.0.0/0")},
+					}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, other)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, other))).To(Succeed())
+			})
+
+			By("Verifying the second AccessControlList is refused")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.AccessControlList{}
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(other), resource)).To(Succeed())
+				cond := conditions.Get(resource, v1alpha1.ReadyCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(cond.Reason).To(Equal(apistatus.CodeFailedPrecondition.String()))
+				g.Expect(resource.Status.ObjectGroups).To(BeEmpty())
+				g.Expect(testProvider.ACLs.Has(other.Spec.Name)).To(BeFalse())
+			}).Should(Succeed())
+		})
 	})
 })
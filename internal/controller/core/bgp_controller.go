@@ -7,6 +7,7 @@
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -22,6 +23,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -32,6 +34,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -40,9 +43,9 @@
 // bgpVrfRefIndexKey is the field index key for BGP.Spec.VrfRef.Name.
 const bgpVrfRefIndexKey = ".spec.vrfRef.name"
 
-// bgpRedistributeDirectRoutePolicyIndexKey is the field index key for all
+// bgpRedistributePolicyIndexKey is the field index key for all
 // RoutingPolicy names referenced by BGP address families.
-const bgpRedistributeDirectRoutePolicyIndexKey = ".spec.addressFamilies.redistributeDirectRoutePolicyRefs"
+const bgpRedistributePolicyIndexKey = ".spec.addressFamilies.redistributePolicyRefs"
 
 // BGPReconciler reconciles a BGP object
 type BGPReconciler struct {
@@ -65,12 +68,16 @@ type BGPReconciler struct {
 	// RequeueInterval is the duration after which the controller should requeue the reconciliation,
 	// regardless of changes.
 	RequeueInterval time.Duration
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=bgp,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=bgp/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=bgp/finalizers,verbs=update
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=vrfs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=interfaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=routingpolicies,verbs=get;list;watch
 // +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
@@ -159,6 +166,9 @@ func (r *BGPReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -248,7 +258,7 @@ func (r *BGPReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 		return err
 	}
 
-	if err := mgr.GetFieldIndexer().IndexField(ctx, &v1alpha1.BGP{}, bgpRedistributeDirectRoutePolicyIndexKey, func(obj client.Object) []string {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &v1alpha1.BGP{}, bgpRedistributePolicyIndexKey, func(obj client.Object) []string {
 		o := obj.(*v1alpha1.BGP)
 		if o.Spec.AddressFamilies == nil {
 			return nil
@@ -258,10 +268,15 @@ func (r *BGPReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 			o.Spec.AddressFamilies.Ipv4Unicast,
 			o.Spec.AddressFamilies.Ipv6Unicast,
 		} {
-			if af == nil || af.RedistributeDirectRoutes == nil {
+			if af == nil {
 				continue
 			}
-			names = append(names, af.RedistributeDirectRoutes.RoutingPolicyRef.Name)
+			if af.RedistributeDirectRoutes != nil {
+				names = append(names, af.RedistributeDirectRoutes.RoutingPolicyRef.Name)
+			}
+			for _, rd := range af.Redistribute {
+				names = append(names, rd.RoutingPolicyRef.Name)
+			}
 		}
 		return names
 	}); err != nil {
@@ -271,6 +286,7 @@ func (r *BGPReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.BGP{}).
 		Named("bgp").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.BGPDependencies {
@@ -286,18 +302,12 @@ func (r *BGPReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 
 	return bldr.
 		// Watches enqueues BGPs for updates in referenced Device resources.
-		// Triggers on create, delete, and update events when the device's effective pause state changes.
+		// Triggers on create, delete, and update events when the device's effective pause state
+		// or its ownership ranges change.
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToBGPs),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate(ownershipChanged)),
 		).
 		// Watches enqueues BGPs for updates in referenced VRF resources.
 		// Triggers on create, delete, and update events when the VRF's ready state changes.
@@ -362,14 +372,37 @@ func (r *BGPReconciler) reconcile(ctx context.Context, s *bgpScope) (reterr erro
 		if err != nil {
 			return err
 		}
+		// Refuse to touch VRFs not assigned to the operator on shared devices.
+		if err := ensureOwned(s.Device, s.BGP, v1alpha1.ReadyCondition, nil, []string{vrf.Spec.Name}); err != nil {
+			return err
+		}
 	}
 
 	var redistPolicies map[v1alpha1.BGPAddressFamilyType]*v1alpha1.RoutingPolicy
+	var redists map[v1alpha1.BGPAddressFamilyType][]provider.BGPRedistribution
 	if s.BGP.Spec.AddressFamilies != nil {
 		redistPolicies, err = r.reconcileRedistributeDirectPolicies(ctx, s.BGP, s.Device)
 		if err != nil {
 			return err
 		}
+		redists, err = r.reconcileRedistributions(ctx, s.BGP, s.Device)
+		if err != nil {
+			return err
+		}
+	}
+
+	sourceInterfaces, err := r.reconcilePeerGroupInterfaces(ctx, s.BGP, s.Device)
+	if err != nil {
+		return err
+	}
+
+	if err := r.validateUniquePeerGroups(ctx, s); err != nil {
+		return err
+	}
+
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if maintenance.DeferChanges(s.Device, s.BGP) {
+		return nil
 	}
 
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
@@ -382,12 +415,28 @@ func (r *BGPReconciler) reconcile(ctx context.Context, s *bgpScope) (reterr erro
 	}()
 
 	// Ensure the BGP is realized on the provider.
-	err = s.Provider.EnsureBGP(ctx, &provider.EnsureBGPRequest{
-		BGP:                             s.BGP,
-		ProviderConfig:                  s.ProviderConfig,
-		VRF:                             vrf,
-		RedistributeDirectRoutePolicies: redistPolicies,
-	})
+	ensure := func(ctx context.Context, p provider.BGPProvider) error {
+		if err := p.EnsureBGP(ctx, &provider.EnsureBGPRequest{
+			BGP:                             s.BGP,
+			ProviderConfig:                  s.ProviderConfig,
+			VRF:                             vrf,
+			RedistributeDirectRoutePolicies: redistPolicies,
+			Redistributions:                 redists,
+		}); err != nil {
+			return err
+		}
+		return r.ensurePeerGroups(ctx, s, p, vrf, sourceInterfaces)
+	}
+
+	// The BGP instance and its peer groups are applied in a single transaction if supported by the provider,
+	// so that a failure doesn't leave a partially configured BGP instance on the device.
+	peerGroups := slices.Clone(s.BGP.Status.PeerGroups)
+	if err = inTransaction(ctx, s.Provider, ensure); err != nil {
+		if _, ok := s.Provider.(provider.TransactionProvider); ok {
+			// A failed transaction leaves the device unchanged, and with it the peer groups configured on it.
+			s.BGP.Status.PeerGroups = peerGroups
+		}
+	}
 
 	cond := conditions.FromError(err)
 	// As this resource is configuration only, we use the Configured condition as top-level Ready condition.
@@ -414,6 +463,10 @@ func (r *BGPReconciler) finalize(ctx context.Context, s *bgpScope) (reterr error
 			// on the provider. Allow the finalizer to be removed.
 			return nil
 		}
+		if !s.Device.Spec.Ownership.OwnsVRF(vrf.Spec.Name) {
+			ctrl.LoggerFrom(ctx).Info("VRF is not owned by the operator, skipping deletion on the device", "Name", vrf.Spec.Name)
+			return nil
+		}
 	}
 
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
@@ -425,6 +478,16 @@ func (r *BGPReconciler) finalize(ctx context.Context, s *bgpScope) (reterr error
 		}
 	}()
 
+	for _, name := range s.BGP.Status.PeerGroups {
+		if err := s.Provider.DeleteBGPPeerGroup(ctx, &provider.DeleteBGPPeerGroupRequest{
+			Name:           name,
+			ProviderConfig: s.ProviderConfig,
+			VRF:            vrf,
+		}); err != nil {
+			return err
+		}
+	}
+
 	return s.Provider.DeleteBGP(ctx, &provider.DeleteBGPRequest{
 		BGP:            s.BGP,
 		ProviderConfig: s.ProviderConfig,
@@ -432,6 +495,125 @@ func (r *BGPReconciler) finalize(ctx context.Context, s *bgpScope) (reterr error
 	})
 }
 
+// ensurePeerGroups realizes the peer groups of the BGP on the provider and removes
+// the peer groups that were configured previously but are no longer part of the spec.
+func (r *BGPReconciler) ensurePeerGroups(ctx context.Context, s *bgpScope, p provider.BGPProvider, vrf *v1alpha1.VRF, sourceInterfaces map[string]string) error {
+	for i := range s.BGP.Spec.PeerGroups {
+		pg := &s.BGP.Spec.PeerGroups[i]
+		if err := p.EnsureBGPPeerGroup(ctx, &provider.EnsureBGPPeerGroupRequest{
+			PeerGroup:       pg,
+			ProviderConfig:  s.ProviderConfig,
+			BGP:             s.BGP,
+			VRF:             vrf,
+			SourceInterface: sourceInterfaces[pg.Name],
+		}); err != nil {
+			return err
+		}
+		if !slices.Contains(s.BGP.Status.PeerGroups, pg.Name) {
+			s.BGP.Status.PeerGroups = append(s.BGP.Status.PeerGroups, pg.Name)
+		}
+	}
+
+	var errs []error
+	kept := make([]string, 0, len(s.BGP.Spec.PeerGroups))
+	for _, name := range s.BGP.Status.PeerGroups {
+		if slices.ContainsFunc(s.BGP.Spec.PeerGroups, func(pg v1alpha1.BGPPeerGroup) bool { return pg.Name == name }) {
+			kept = append(kept, name)
+			continue
+		}
+		if err := p.DeleteBGPPeerGroup(ctx, &provider.DeleteBGPPeerGroupRequest{
+			Name:           name,
+			ProviderConfig: s.ProviderConfig,
+			VRF:            vrf,
+		}); err != nil {
+			kept = append(kept, name)
+			errs = append(errs, err)
+		}
+	}
+	s.BGP.Status.PeerGroups = kept
+
+	return kerrors.NewAggregate(errs)
+}
+
+// validateUniquePeerGroups ensures that the peer groups of the BGP are not used by another BGP of the same device.
+// Peer groups are global to the device on some platforms, e.g. NX-OS, where peers of any VRF inherit from them by
+// name. A peer group belongs to the BGP that has it configured on the device or, if none does, the oldest one.
+func (r *BGPReconciler) validateUniquePeerGroups(ctx context.Context, s *bgpScope) error {
+	if len(s.BGP.Spec.PeerGroups) == 0 {
+		return nil
+	}
+
+	list := new(v1alpha1.BGPList)
+	if err := r.List(
+		ctx, list,
+		client.InNamespace(s.BGP.Namespace),
+		client.MatchingFields{v1alpha1.DeviceRefIndexKey: s.Device.Name},
+	); err != nil {
+		return err
+	}
+
+	for _, other := range list.Items {
+		if other.Name == s.BGP.Name {
+			continue
+		}
+		for _, pg := range s.BGP.Spec.PeerGroups {
+			if slices.Contains(s.BGP.Status.PeerGroups, pg.Name) {
+				continue
+			}
+			owned := slices.Contains(other.Status.PeerGroups, pg.Name) || (other.CreationTimestamp.Before(&s.BGP.CreationTimestamp) &&
+				slices.ContainsFunc(other.Spec.PeerGroups, func(g v1alpha1.BGPPeerGroup) bool { return g.Name == pg.Name }))
+			if owned {
+				conditions.Set(s.BGP, metav1.Condition{
+					Type:    v1alpha1.ReadyCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  v1alpha1.DuplicatePeerGroupReason,
+					Message: fmt.Sprintf("Peer group %q is already used by BGP %q", pg.Name, other.Name),
+				})
+				return reconcile.TerminalError(fmt.Errorf("peer group %q is already used by bgp %s", pg.Name, other.Name))
+			}
+		}
+	}
+
+	return nil
+}
+
+// reconcilePeerGroupInterfaces resolves the source interfaces referenced by the local address of the peer groups.
+// Returns a map of peer group names to the device-level name of their source interface.
+// Sets ReadyCondition and returns a terminal error when an interface is not found or belongs to a different device.
+func (r *BGPReconciler) reconcilePeerGroupInterfaces(ctx context.Context, bgp *v1alpha1.BGP, device *v1alpha1.Device) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, pg := range bgp.Spec.PeerGroups {
+		if pg.LocalAddress == nil {
+			continue
+		}
+		name := pg.LocalAddress.InterfaceRef.Name
+		intf := new(v1alpha1.Interface)
+		if err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: bgp.Namespace}, intf); err != nil {
+			if apierrors.IsNotFound(err) {
+				conditions.Set(bgp, metav1.Condition{
+					Type:    v1alpha1.ReadyCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  v1alpha1.InterfaceNotFoundReason,
+					Message: fmt.Sprintf("source interface %q of peer group %q not found", name, pg.Name),
+				})
+				return nil, reconcile.TerminalError(fmt.Errorf("source interface %q of peer group %q not found", name, pg.Name))
+			}
+			return nil, fmt.Errorf("failed to get source interface %q: %w", name, err)
+		}
+		if intf.Spec.DeviceRef.Name != device.Name {
+			conditions.Set(bgp, metav1.Condition{
+				Type:    v1alpha1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  v1alpha1.CrossDeviceReferenceReason,
+				Message: fmt.Sprintf("source interface %q of peer group %q does not belong to device %q", name, pg.Name, device.Name),
+			})
+			return nil, reconcile.TerminalError(fmt.Errorf("source interface %q of peer group %q does not belong to device %q", name, pg.Name, device.Name))
+		}
+		result[pg.Name] = intf.Spec.Name
+	}
+	return result, nil
+}
+
 // reconcileVRF resolves the VRF referenced by the BGP's VrfRef field.
 // Returns nil when no VrfRef is set, meaning the default VRF should be used.
 // Sets ReadyCondition and returns a terminal error when the VRF is not found or belongs to a different device.
@@ -482,44 +664,82 @@ func (r *BGPReconciler) reconcileVRF(ctx context.Context, bgp *v1alpha1.BGP, dev
 // Sets ReadyCondition and returns a terminal error when a referenced policy
 // is not found or belongs to a different device.
 func (r *BGPReconciler) reconcileRedistributeDirectPolicies(ctx context.Context, bgp *v1alpha1.BGP, device *v1alpha1.Device) (map[v1alpha1.BGPAddressFamilyType]*v1alpha1.RoutingPolicy, error) {
-	afs := map[v1alpha1.BGPAddressFamilyType]*v1alpha1.BGPUnicastAddressFamily{
-		v1alpha1.BGPAddressFamilyIpv4Unicast: bgp.Spec.AddressFamilies.Ipv4Unicast,
-		v1alpha1.BGPAddressFamilyIpv6Unicast: bgp.Spec.AddressFamilies.Ipv6Unicast,
-	}
-
 	policies := make(map[v1alpha1.BGPAddressFamilyType]*v1alpha1.RoutingPolicy, 2)
-	for afType, af := range afs {
+	for afType, af := range unicastAddressFamilies(bgp) {
 		if af == nil || af.RedistributeDirectRoutes == nil {
 			continue
 		}
-		ref := af.RedistributeDirectRoutes.RoutingPolicyRef
-		rp := new(v1alpha1.RoutingPolicy)
-		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: bgp.Namespace}, rp); err != nil {
-			if apierrors.IsNotFound(err) {
-				conditions.Set(bgp, metav1.Condition{
-					Type:    v1alpha1.ReadyCondition,
-					Status:  metav1.ConditionFalse,
-					Reason:  v1alpha1.WaitingForDependenciesReason,
-					Message: fmt.Sprintf("RoutingPolicy %s not found", ref.Name),
-				})
-				return nil, reconcile.TerminalError(fmt.Errorf("routing policy %s not found", ref.Name))
+		rp, err := r.reconcileRedistributionPolicy(ctx, bgp, device, af.RedistributeDirectRoutes.RoutingPolicyRef)
+		if err != nil {
+			return nil, err
+		}
+		policies[afType] = rp
+	}
+
+	return policies, nil
+}
+
+// reconcileRedistributions resolves the RoutingPolicyRef of each redistribution
+// of routes of other protocols on each address family of the BGP instance.
+// Sets ReadyCondition and returns a terminal error when a referenced policy
+// is not found or belongs to a different device.
+func (r *BGPReconciler) reconcileRedistributions(ctx context.Context, bgp *v1alpha1.BGP, device *v1alpha1.Device) (map[v1alpha1.BGPAddressFamilyType][]provider.BGPRedistribution, error) {
+	redists := make(map[v1alpha1.BGPAddressFamilyType][]provider.BGPRedistribution, 2)
+	for afType, af := range unicastAddressFamilies(bgp) {
+		if af == nil {
+			continue
+		}
+		for _, rd := range af.Redistribute {
+			rp, err := r.reconcileRedistributionPolicy(ctx, bgp, device, rd.RoutingPolicyRef)
+			if err != nil {
+				return nil, err
 			}
-			return nil, fmt.Errorf("failed to get routing policy %s: %w", ref.Name, err)
+			redists[afType] = append(redists[afType], provider.BGPRedistribution{
+				Protocol:      rd.Protocol,
+				Instance:      rd.Instance,
+				RoutingPolicy: rp,
+			})
 		}
+	}
+
+	return redists, nil
+}
 
-		if rp.Spec.DeviceRef.Name != device.Name {
+// reconcileRedistributionPolicy resolves a RoutingPolicy referenced for redistribution into the BGP instance.
+func (r *BGPReconciler) reconcileRedistributionPolicy(ctx context.Context, bgp *v1alpha1.BGP, device *v1alpha1.Device, ref v1alpha1.LocalObjectReference) (*v1alpha1.RoutingPolicy, error) {
+	rp := new(v1alpha1.RoutingPolicy)
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: bgp.Namespace}, rp); err != nil {
+		if apierrors.IsNotFound(err) {
 			conditions.Set(bgp, metav1.Condition{
 				Type:    v1alpha1.ReadyCondition,
 				Status:  metav1.ConditionFalse,
-				Reason:  v1alpha1.CrossDeviceReferenceReason,
-				Message: fmt.Sprintf("RoutingPolicy %s belongs to device %s, not %s", ref.Name, rp.Spec.DeviceRef.Name, device.Name),
+				Reason:  v1alpha1.WaitingForDependenciesReason,
+				Message: fmt.Sprintf("RoutingPolicy %s not found", ref.Name),
 			})
-			return nil, reconcile.TerminalError(fmt.Errorf("routing policy %s belongs to different device", ref.Name))
+			return nil, reconcile.TerminalError(fmt.Errorf("routing policy %s not found", ref.Name))
 		}
-		policies[afType] = rp
+		return nil, fmt.Errorf("failed to get routing policy %s: %w", ref.Name, err)
 	}
 
-	return policies, nil
+	if rp.Spec.DeviceRef.Name != device.Name {
+		conditions.Set(bgp, metav1.Condition{
+			Type:    v1alpha1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1alpha1.CrossDeviceReferenceReason,
+			Message: fmt.Sprintf("RoutingPolicy %s belongs to device %s, not %s", ref.Name, rp.Spec.DeviceRef.Name, device.Name),
+		})
+		return nil, reconcile.TerminalError(fmt.Errorf("routing policy %s belongs to different device", ref.Name))
+	}
+
+	return rp, nil
+}
+
+// unicastAddressFamilies returns the unicast address families of the BGP instance by their type.
+func unicastAddressFamilies(bgp *v1alpha1.BGP) map[v1alpha1.BGPAddressFamilyType]*v1alpha1.BGPUnicastAddressFamily {
+	return map[v1alpha1.BGPAddressFamilyType]*v1alpha1.BGPUnicastAddressFamily{
+		v1alpha1.BGPAddressFamilyIpv4Unicast: bgp.Spec.AddressFamilies.Ipv4Unicast,
+		v1alpha1.BGPAddressFamilyIpv6Unicast: bgp.Spec.AddressFamilies.Ipv6Unicast,
+	}
 }
 
 // deviceToBGPs is a [handler.MapFunc] to be used to enqueue requests for reconciliation
@@ -635,7 +855,7 @@ func (r *BGPReconciler) routingPolicyToBGPs(ctx context.Context, obj client.Obje
 	if err := r.List(
 		ctx, list,
 		client.InNamespace(rp.Namespace),
-		client.MatchingFields{bgpRedistributeDirectRoutePolicyIndexKey: rp.Name},
+		client.MatchingFields{bgpRedistributePolicyIndexKey: rp.Name},
 	); err != nil {
 		log.Error(err, "Failed to list BGPs")
 		return nil
@@ -141,6 +141,66 @@
 			}).Should(Succeed())
 		})
 
+		It("Should refuse a BGP in a VRF not owned by the operator", func() {
+			By("Restricting the VRFs owned by the operator on the device")
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(device), device)).To(Succeed())
+			device.Spec.Ownership = &v1alpha1.DeviceOwnership{VRFs: []string{"OTHER"}}
+			Expect(k8sClient.Update(ctx, device)).To(Succeed())
+
+			By("Creating a VRF")
+			vrf := &v1alpha1.VRF{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-vrf-",
+					Namespace:    metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.VRFSpec{
+					DeviceRef: v1alpha1.LocalObjectReference{Name: device.Name},
+					Name:      "CC-MGMT",
+				},
+			}
+			Expect(k8sClient.Create(ctx, vrf)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, vrf)).To(Succeed())
+			})
+
+			By("Creating a BGP with the vrfRef set")
+			bgp := &v1alpha1.BGP{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-bgp-",
+					Namespace:    metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.BGPSpec{
+					DeviceRef: v1alpha1.LocalObjectReference{Name: device.Name},
+					ASNumber:  intstr.FromInt(65000),
+					RouterID:  "10.0.0.13",
+					VrfRef:    &v1alpha1.LocalObjectReference{Name: vrf.Name},
+				},
+			}
+			Expect(k8sClient.Create(ctx, bgp)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, bgp)).To(Succeed())
+				Eventually(func(g Gomega) {
+					b := &v1alpha1.BGP{}
+					g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(bgp), b))).To(BeTrue())
+				}).Should(Succeed())
+			})
+
+			By("Expecting ReadyCondition to be False with NotOwnedReason reason")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.BGP{}
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(bgp), resource)).To(Succeed())
+				cond := conditions.Get(resource, v1alpha1.ReadyCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(cond.Reason).To(Equal(v1alpha1.NotOwnedReason))
+			}).Should(Succeed())
+
+			By("Ensuring the resource is not configured in the provider")
+			Consistently(func(g Gomega) {
+				g.Expect(testProvider.BGP).To(BeNil(), "Provider shouldn't have BGP instance configured")
+			}).Should(Succeed())
+		})
+
 		It("Should pass VRF to the provider when vrfRef is set", func() {
 			By("Creating a VRF")
 			vrf := &v1alpha1.VRF{
@@ -196,6 +256,112 @@
 			}).Should(Succeed())
 		})
 
+		It("Should configure and remove peer groups", func() {
+			By("Creating a BGP with a peer group")
+			bgp := &v1alpha1.BGP{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-bgp-",
+					Namespace:    metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.BGPSpec{
+					DeviceRef: v1alpha1.LocalObjectReference{Name: device.Name},
+					ASNumber:  intstr.FromInt(65000),
+					RouterID:  "10.0.0.14",
+					PeerGroups: []v1alpha1.BGPPeerGroup{{
+						Name:            "SPINES",
+						AddressFamilies: []v1alpha1.BGPAddressFamilyType{v1alpha1.BGPAddressFamilyL2vpnEvpn},
+					}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, bgp)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, bgp)).To(Succeed())
+				Eventually(func(g Gomega) {
+					b := &v1alpha1.BGP{}
+					g.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(bgp), b))).To(BeTrue())
+				}).Should(Succeed())
+			})
+
+			By("Ensuring the peer group is created in the provider")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.BGP{}
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(bgp), resource)).To(Succeed())
+				g.Expect(resource.Status.PeerGroups).To(ConsistOf("SPINES"))
+				g.Expect(testProvider.BGPPeerGroups.Has("SPINES")).To(BeTrue(), "Provider should have peer group configured")
+			}).Should(Succeed())
+
+			By("Removing the peer group")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.BGP{}
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(bgp), resource)).To(Succeed())
+				resource.Spec.PeerGroups = nil
+				g.Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			}).Should(Succeed())
+
+			By("Ensuring the peer group is removed from the provider")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.BGP{}
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(bgp), resource)).To(Succeed())
+				g.Expect(resource.Status.PeerGroups).To(BeEmpty())
+				g.Expect(testProvider.BGPPeerGroups.Has("SPINES")).To(BeFalse(), "Provider shouldn't have peer group configured anymore")
+			}).Should(Succeed())
+		})
+
+		It("Should reject a peer group used by another BGP of the same device", func() {
+			By("Creating a BGP with a peer group")
+			bgp := &v1alpha1.BGP{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-bgp-",
+					Namespace:    metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.BGPSpec{
+					DeviceRef:  v1alpha1.LocalObjectReference{Name: device.Name},
+					ASNumber:   intstr.FromInt(65000),
+					RouterID:   "10.0.0.15",
+					PeerGroups: []v1alpha1.BGPPeerGroup{{Name: "LEAVES"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, bgp)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, bgp)).To(Succeed())
+			})
+
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.BGP{}
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(bgp), resource)).To(Succeed())
+				g.Expect(resource.Status.PeerGroups).To(ConsistOf("LEAVES"))
+			}).Should(Succeed())
+
+			By("Creating another BGP with the same peer group")
+			duplicate := &v1alpha1.BGP{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-bgp-",
+					Namespace:    metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.BGPSpec{
+					DeviceRef:  v1alpha1.LocalObjectReference{Name: device.Name},
+					ASNumber:   intstr.FromInt(65000),
+					RouterID:   "10.0.0.16",
+					PeerGroups: []v1alpha1.BGPPeerGroup{{Name: "LEAVES"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, duplicate)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, duplicate)).To(Succeed())
+			})
+
+			By("Ensuring the duplicate is not configured")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.BGP{}
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(duplicate), resource)).To(Succeed())
+				cond := conditions.Get(resource, v1alpha1.ReadyCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(cond.Reason).To(Equal(v1alpha1.DuplicatePeerGroupReason))
+				g.Expect(resource.Status.PeerGroups).To(BeEmpty())
+			}).Should(Succeed())
+		})
+
 		It("Should reconcile BGP when a referenced RoutingPolicy is created", func() {
 			By("Creating a BGP with a redistributeDirectRoutes ref pointing to a non-existent RoutingPolicy")
 			bgp := &v1alpha1.BGP{
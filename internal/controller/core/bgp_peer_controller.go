@@ -12,6 +12,7 @@
 	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -25,6 +26,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -33,8 +35,10 @@
 
 	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/clientutil"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -68,6 +72,9 @@ type BGPPeerReconciler struct {
 	// RequeueInterval is the duration after which the controller should requeue the reconciliation,
 	// regardless of changes.
 	RequeueInterval time.Duration
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=bgppeers,verbs=get;list;watch;create;update;patch;delete
@@ -77,6 +84,7 @@ type BGPPeerReconciler struct {
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=vrfs,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=routingpolicies,verbs=get;list;watch
 // +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -163,6 +171,9 @@ func (r *BGPPeerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -278,6 +289,7 @@ func (r *BGPPeerReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manag
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.BGPPeer{}).
 		Named("bgppeer").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.BGPPeerDependencies {
@@ -297,17 +309,11 @@ func (r *BGPPeerReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manag
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToBGPPeers),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		// Watches enqueues BGPPeers for updates in BGP resources on the same device.
-		// Only triggers on create, delete and update events when the BGP ready state changes.
+		// Only triggers on create, delete and update events when the BGP ready state
+		// or the set of peer groups configured by the BGP changes.
 		Watches(
 			&v1alpha1.BGP{},
 			handler.EnqueueRequestsFromMapFunc(r.bgpToBGPPeers),
@@ -315,7 +321,8 @@ func (r *BGPPeerReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manag
 				UpdateFunc: func(e event.UpdateEvent) bool {
 					oldBGP := e.ObjectOld.(*v1alpha1.BGP)
 					newBGP := e.ObjectNew.(*v1alpha1.BGP)
-					return conditions.IsReady(oldBGP) != conditions.IsReady(newBGP)
+					return conditions.IsReady(oldBGP) != conditions.IsReady(newBGP) ||
+						!slices.Equal(oldBGP.Status.PeerGroups, newBGP.Status.PeerGroups)
 				},
 				GenericFunc: func(e event.GenericEvent) bool {
 					return false
@@ -338,6 +345,12 @@ func (r *BGPPeerReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manag
 				},
 			}),
 		).
+		// Watches enqueues BGPPeers for updates in referenced Secret resources.
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.secretToBGPPeers),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		// Watches enqueues BGPPeers when a referenced RoutingPolicy is created or deleted.
 		// Only triggers on create and delete events since RoutingPolicy names are immutable.
 		Watches(
@@ -399,6 +412,17 @@ func (r *BGPPeerReconciler) reconcile(ctx context.Context, s *bgpPeerScope) (ret
 		return nil
 	}
 
+	// The peer group must have been configured on the device by the BGP before the peer can inherit from it.
+	if pg := s.BGPPeer.Spec.PeerGroup; pg != "" && !slices.Contains(bgp.Status.PeerGroups, pg) {
+		conditions.Set(s.BGPPeer, metav1.Condition{
+			Type:    v1alpha1.ConfiguredCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1alpha1.PeerGroupNotFoundReason,
+			Message: fmt.Sprintf("peer group %q is not configured on BGP %s", pg, bgp.Name),
+		})
+		return reconcile.TerminalError(fmt.Errorf("peer group %q is not configured on bgp %s", pg, bgp.Name))
+	}
+
 	var vrf *v1alpha1.VRF
 	if bgp.Spec.VrfRef != nil {
 		vrf, err = r.reconcileVRF(ctx, s.BGPPeer, bgp, s.Device)
@@ -453,6 +477,15 @@ func (r *BGPPeerReconciler) reconcile(ctx context.Context, s *bgpPeerScope) (ret
 		return reconcile.TerminalError(errors.New("local-as cannot be configured on iBGP peers"))
 	}
 
+	var password string
+	if s.BGPPeer.Spec.Password != nil {
+		pwd, err := clientutil.NewClient(r, s.BGPPeer.Namespace).Secret(ctx, &s.BGPPeer.Spec.Password.SecretKeyRef)
+		if err != nil {
+			return err
+		}
+		password = string(pwd)
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
@@ -462,22 +495,26 @@ func (r *BGPPeerReconciler) reconcile(ctx context.Context, s *bgpPeerScope) (ret
 		}
 	}()
 
-	// Ensure the BGPPeer is realized on the provider.
-	err = s.Provider.EnsureBGPPeer(ctx, &provider.EnsureBGPPeerRequest{
-		BGPPeer:                 s.BGPPeer,
-		ProviderConfig:          s.ProviderConfig,
-		SourceInterface:         sourceInterface,
-		BGP:                     bgp,
-		VRF:                     vrf,
-		InboundRoutingPolicies:  inbound,
-		OutboundRoutingPolicies: outbound,
-	})
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if !maintenance.DeferChanges(s.Device, s.BGPPeer) {
+		// Ensure the BGPPeer is realized on the provider.
+		err = s.Provider.EnsureBGPPeer(ctx, &provider.EnsureBGPPeerRequest{
+			BGPPeer:                 s.BGPPeer,
+			ProviderConfig:          s.ProviderConfig,
+			SourceInterface:         sourceInterface,
+			BGP:                     bgp,
+			VRF:                     vrf,
+			InboundRoutingPolicies:  inbound,
+			OutboundRoutingPolicies: outbound,
+			Password:                password,
+		})
 
-	cond := conditions.FromError(err)
-	conditions.Set(s.BGPPeer, cond)
+		cond := conditions.FromError(err)
+		conditions.Set(s.BGPPeer, cond)
 
-	if err != nil {
-		return err
+		if err != nil {
+			return err
+		}
 	}
 
 	status, err := s.Provider.GetPeerStatus(ctx, &provider.BGPPeerStatusRequest{
@@ -489,7 +526,7 @@ func (r *BGPPeerReconciler) reconcile(ctx context.Context, s *bgpPeerScope) (ret
 		return fmt.Errorf("failed to get bgp peer status: %w", err)
 	}
 
-	cond = metav1.Condition{
+	cond := metav1.Condition{
 		Type:    v1alpha1.OperationalCondition,
 		Status:  metav1.ConditionTrue,
 		Reason:  v1alpha1.OperationalReason,
@@ -852,6 +889,38 @@ func (r *BGPPeerReconciler) vrfToBGPPeers(ctx context.Context, obj client.Object
 	return requests
 }
 
+// secretToBGPPeers is a [handler.MapFunc] to be used to enqueue requests for reconciliation
+// for a BGPPeer to update when its referenced password Secret gets updated.
+func (r *BGPPeerReconciler) secretToBGPPeers(ctx context.Context, obj client.Object) []ctrl.Request {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		panic(fmt.Sprintf("Expected a Secret but got a %T", obj))
+	}
+
+	log := ctrl.LoggerFrom(ctx, "Secret", klog.KObj(secret))
+
+	peers := new(v1alpha1.BGPPeerList)
+	if err := r.List(ctx, peers, client.InNamespace(secret.Namespace)); err != nil {
+		log.Error(err, "Failed to list BGPPeers")
+		return nil
+	}
+
+	requests := []ctrl.Request{}
+	for _, p := range peers.Items {
+		if p.Spec.Password != nil && p.Spec.Password.SecretKeyRef.Name == secret.Name {
+			log.V(2).Info("Enqueuing BGPPeer for reconciliation", "BGPPeer", klog.KObj(&p))
+			requests = append(requests, ctrl.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      p.Name,
+					Namespace: p.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
 // routingPolicyToBGPPeers is a [handler.MapFunc] to be used to enqueue requests for reconciliation
 // for BGPPeers when a RoutingPolicy referenced by one of their address families is created or deleted.
 func (r *BGPPeerReconciler) routingPolicyToBGPPeers(ctx context.Context, obj client.Object) []ctrl.Request {
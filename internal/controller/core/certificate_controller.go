@@ -23,8 +23,8 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
-	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -34,6 +34,7 @@
 	"github.com/ironcore-dev/network-operator/internal/clientutil"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -56,6 +57,13 @@ type CertificateReconciler struct {
 
 	// Locker is used to synchronize operations on resources targeting the same device.
 	Locker *resourcelock.ResourceLocker
+
+	// RequeueInterval is the duration after which the controller should requeue the reconciliation,
+	// regardless of changes.
+	RequeueInterval time.Duration
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=certificates,verbs=get;list;watch;create;update;patch;delete
@@ -149,6 +157,9 @@ func (r *CertificateReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -202,11 +213,15 @@ func (r *CertificateReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, apistatus.WrapTerminalError(err)
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: Jitter(r.RequeueInterval)}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *CertificateReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	if r.RequeueInterval == 0 {
+		return errors.New("requeue interval must not be 0")
+	}
+
 	labelSelector := metav1.LabelSelector{}
 	if r.WatchFilterValue != "" {
 		labelSelector.MatchLabels = map[string]string{v1alpha1.WatchLabel: r.WatchFilterValue}
@@ -227,6 +242,7 @@ func (r *CertificateReconciler) SetupWithManager(ctx context.Context, mgr ctrl.M
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Certificate{}).
 		Named("certificate").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.CertificateDependencies {
@@ -252,14 +268,7 @@ func (r *CertificateReconciler) SetupWithManager(ctx context.Context, mgr ctrl.M
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToCertificates),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		Complete(r)
 }
@@ -301,19 +310,73 @@ func (r *CertificateReconciler) reconcile(ctx context.Context, s *certificateSco
 		return err
 	}
 
-	// Ensure the Certificate is realized on the provider.
-	err = s.Provider.EnsureCertificate(ctx, &provider.EnsureCertificateRequest{
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if !maintenance.DeferChanges(s.Device, s.Certificate) {
+		// Ensure the Certificate is realized on the provider.
+		err = s.Provider.EnsureCertificate(ctx, &provider.EnsureCertificateRequest{
+			ID:             s.Certificate.Spec.ID,
+			Certificate:    cert,
+			ProviderConfig: s.ProviderConfig,
+		})
+
+		cond := conditions.FromError(err)
+		// As this resource is configuration only, we use the Configured condition as top-level Ready condition.
+		cond.Type = v1alpha1.ReadyCondition
+		conditions.Set(s.Certificate, cond)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	info, err := s.Provider.GetCertificate(ctx, &provider.GetCertificateRequest{
 		ID:             s.Certificate.Spec.ID,
-		Certificate:    cert,
 		ProviderConfig: s.ProviderConfig,
 	})
+	if err != nil {
+		return fmt.Errorf("failed to get certificate: %w", err)
+	}
 
-	cond := conditions.FromError(err)
-	// As this resource is configuration only, we use the Configured condition as top-level Ready condition.
-	cond.Type = v1alpha1.ReadyCondition
-	conditions.Set(s.Certificate, cond)
+	r.reconcileCertificateStatus(s, info)
+
+	return nil
+}
 
-	return err
+// certificateExpiryThreshold is the remaining validity below which a certificate is reported as expiring.
+const certificateExpiryThreshold = 30 * 24 * time.Hour
+
+// reconcileCertificateStatus updates the Certificate status with the certificate installed on the device
+// and reports through the Expiring condition whether it is about to expire.
+func (r *CertificateReconciler) reconcileCertificateStatus(s *certificateScope, c *provider.CertificateInfo) {
+	if c == nil {
+		s.Certificate.Status.SerialNumber = ""
+		s.Certificate.Status.NotBefore = nil
+		s.Certificate.Status.NotAfter = nil
+		conditions.Del(s.Certificate, v1alpha1.ExpiringCondition)
+		return
+	}
+
+	s.Certificate.Status.SerialNumber = c.SerialNumber
+	s.Certificate.Status.NotBefore = new(metav1.NewTime(c.NotBefore))
+	s.Certificate.Status.NotAfter = new(metav1.NewTime(c.NotAfter))
+
+	cond := metav1.Condition{
+		Type:    v1alpha1.ExpiringCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  v1alpha1.CertificateValidReason,
+		Message: "Certificate is valid until " + c.NotAfter.UTC().Format(time.RFC3339),
+	}
+	switch remaining := time.Until(c.NotAfter); {
+	case remaining <= 0:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = v1alpha1.CertificateExpiredReason
+		cond.Message = "Certificate expired at " + c.NotAfter.UTC().Format(time.RFC3339)
+	case remaining < certificateExpiryThreshold:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = v1alpha1.CertificateExpiringReason
+		cond.Message = "Certificate expires at " + c.NotAfter.UTC().Format(time.RFC3339)
+	}
+	conditions.Set(s.Certificate, cond)
 }
 
 func (r *CertificateReconciler) finalize(ctx context.Context, s *certificateScope) (reterr error) {
@@ -129,11 +129,24 @@
 			Eventually(func(g Gomega) {
 				resource := &v1alpha1.Certificate{}
 				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
-				g.Expect(resource.Status.Conditions).To(HaveLen(2))
+				g.Expect(resource.Status.Conditions).To(HaveLen(3))
 				g.Expect(resource.Status.Conditions[0].Type).To(Equal(v1alpha1.ReadyCondition))
 				g.Expect(resource.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
 				g.Expect(resource.Status.Conditions[1].Type).To(Equal(v1alpha1.PausedCondition))
 				g.Expect(resource.Status.Conditions[1].Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(resource.Status.Conditions[2].Type).To(Equal(v1alpha1.ExpiringCondition))
+				g.Expect(resource.Status.Conditions[2].Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(resource.Status.Conditions[2].Reason).To(Equal(v1alpha1.CertificateValidReason))
+			}).Should(Succeed())
+
+			By("Reporting the certificate installed on the device")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Certificate{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.SerialNumber).To(Equal("1"))
+				g.Expect(resource.Status.NotBefore).NotTo(BeNil())
+				g.Expect(resource.Status.NotAfter).NotTo(BeNil())
+				g.Expect(resource.Status.NotAfter.Time).To(BeTemporally(">", time.Now().Add(certificateExpiryThreshold)))
 			}).Should(Succeed())
 
 			By("Ensuring the resource is created in the provider")
@@ -11,6 +11,7 @@
 	"math/rand/v2"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,16 +26,21 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/clientutil"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
+	"github.com/ironcore-dev/network-operator/internal/resourcelock"
 )
 
 // DeviceReconciler reconciles a Device object
@@ -52,9 +58,15 @@ type DeviceReconciler struct {
 	// Provider is the driver that will be used to create & delete the interface.
 	Provider provider.ProviderFunc
 
+	// Locker is used to synchronize operations on resources targeting the same device.
+	Locker *resourcelock.ResourceLocker
+
 	// HeartbeatInterval is the duration after which the controller requeues the reconciliation,
 	// regardless of changes.
 	HeartbeatInterval time.Duration
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=devices,verbs=get;list;watch;create;update;patch;delete
@@ -93,11 +105,6 @@ func (r *DeviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ c
 		return ctrl.Result{Requeue: requeue}, err
 	}
 
-	conn, err := deviceutil.GetDeviceConnection(ctx, r, obj)
-	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to obtain device connection: %w", err)
-	}
-
 	orig := obj.DeepCopy()
 	if conditions.InitializeConditions(obj, v1alpha1.ReadyCondition, v1alpha1.ReachableCondition) {
 		log.V(1).Info("Initializing status conditions")
@@ -121,6 +128,20 @@ func (r *DeviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ c
 		}
 	}()
 
+	// Validate the endpoint credentials up front, so that a missing or malformed Secret
+	// surfaces as a clear condition instead of failing deep inside the provider calls.
+	if ok, err := r.reconcileCredentials(ctx, obj); !ok || err != nil {
+		// Secret updates do not bump the generation and are therefore filtered by the
+		// Secret watch, so periodically requeue to pick up a fixed Secret.
+		return ctrl.Result{RequeueAfter: r.HeartbeatInterval}, err
+	}
+
+	conn, err := deviceutil.GetDeviceConnection(ctx, r, obj)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to obtain device connection: %w", err)
+	}
+
+	heartbeat := r.HeartbeatInterval
 	switch obj.Status.Phase {
 	case v1alpha1.DevicePhasePending:
 		if obj.Spec.Provisioning == nil {
@@ -203,11 +224,36 @@ func (r *DeviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ c
 		return ctrl.Result{}, nil
 
 	case v1alpha1.DevicePhaseRunning:
+		if next := r.reconcileMaintenanceWindow(obj, time.Now()); !next.IsZero() {
+			heartbeat = min(heartbeat, time.Until(next))
+		}
+
 		if prov, ok := r.Provider().(provider.DeviceProvider); ok {
-			if err := r.reconcile(ctx, obj, prov, conn); err != nil {
+			// The device settings, e.g. ECMP, are configured on the device and must
+			// not be pushed concurrently with the configuration of other resources.
+			if err := r.Locker.AcquireLock(ctx, obj.Name, "device-controller"); err != nil {
+				if errors.Is(err, resourcelock.ErrLockAlreadyHeld) {
+					log.V(3).Info("Device is already locked, requeuing reconciliation")
+					return ctrl.Result{RequeueAfter: Jitter(time.Second), Priority: new(LockWaitPriorityDefault)}, nil
+				}
+				log.Error(err, "Failed to acquire device lock")
+				return ctrl.Result{}, err
+			}
+			defer func() {
+				if err := r.Locker.ReleaseLock(ctx, obj.Name, "device-controller"); err != nil {
+					log.Error(err, "Failed to release device lock")
+					reterr = kerrors.NewAggregate([]error{reterr, err})
+				}
+			}()
+
+			requeueAfter, err := r.reconcile(ctx, obj, prov, conn)
+			if err != nil {
 				log.Error(err, "Failed to reconcile resource")
 				return ctrl.Result{}, err
 			}
+			if requeueAfter > 0 {
+				heartbeat = min(heartbeat, requeueAfter)
+			}
 		} else {
 			if err := r.reconcileMinimal(ctx, obj, conn); err != nil {
 				return ctrl.Result{}, err
@@ -231,7 +277,7 @@ func (r *DeviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ c
 		return ctrl.Result{}, reconcile.TerminalError(err)
 	}
 
-	return ctrl.Result{RequeueAfter: r.HeartbeatInterval}, nil
+	return ctrl.Result{RequeueAfter: heartbeat}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -253,6 +299,7 @@ func (r *DeviceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Device{}).
 		Named("device").
+		WithOptions(r.Options).
 		WithEventFilter(filter).
 		// Watches enqueues Devices for referenced Secret resources.
 		Watches(
@@ -274,21 +321,48 @@ func (r *DeviceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *DeviceReconciler) reconcile(ctx context.Context, device *v1alpha1.Device, prov provider.DeviceProvider, conn *deviceutil.Connection) (reterr error) {
-	if err := prov.Connect(ctx, conn); err != nil {
+// reconcileCredentials verifies that the Secret referenced by the device endpoint exists and
+// holds basic authentication credentials. It returns false and sets the Ready condition accordingly
+// if it does not, in which case no provider calls must be attempted.
+func (r *DeviceReconciler) reconcileCredentials(ctx context.Context, device *v1alpha1.Device) (bool, error) {
+	ref := device.Spec.Endpoint.SecretRef
+	if ref == nil {
+		return true, nil
+	}
+
+	key := client.ObjectKey{Name: ref.Name, Namespace: cmp.Or(ref.Namespace, device.Namespace)}
+	if err := r.Get(ctx, key, new(corev1.Secret)); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to get secret %q: %w", key.String(), err)
+		}
 		conditions.Set(device, metav1.Condition{
-			Type:    v1alpha1.ReachableCondition,
+			Type:    v1alpha1.ReadyCondition,
 			Status:  metav1.ConditionFalse,
-			Reason:  v1alpha1.UnreachableReason,
-			Message: fmt.Sprintf("Failed to connect to device: %v", err),
+			Reason:  v1alpha1.SecretNotFoundReason,
+			Message: fmt.Sprintf("Secret %q referenced by the endpoint was not found", key.String()),
 		})
+		return false, nil
+	}
+
+	if _, _, err := clientutil.NewClient(r, device.Namespace).BasicAuth(ctx, ref); err != nil {
 		conditions.Set(device, metav1.Condition{
 			Type:    v1alpha1.ReadyCondition,
-			Status:  metav1.ConditionUnknown,
-			Reason:  v1alpha1.UnreachableReason,
-			Message: "Device is not reachable",
+			Status:  metav1.ConditionFalse,
+			Reason:  v1alpha1.InvalidCredentialsReason,
+			Message: fmt.Sprintf("Secret %q referenced by the endpoint holds invalid credentials: %v", key.String(), err),
 		})
-		return nil
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// reconcile reconciles the Device against the provider. It returns the duration after which
+// the reconciliation should be requeued at the latest, or zero to use the heartbeat interval.
+func (r *DeviceReconciler) reconcile(ctx context.Context, device *v1alpha1.Device, prov provider.DeviceProvider, conn *deviceutil.Connection) (_ time.Duration, reterr error) {
+	if err := prov.Connect(ctx, conn); err != nil {
+		setUnreachable(device, fmt.Sprintf("Failed to connect to device: %v", err))
+		return 0, nil
 	}
 	defer func() {
 		if err := prov.Disconnect(ctx, conn); err != nil {
@@ -296,6 +370,11 @@ func (r *DeviceReconciler) reconcile(ctx context.Context, device *v1alpha1.Devic
 		}
 	}()
 
+	if err := ping(ctx, prov); err != nil {
+		setUnreachable(device, fmt.Sprintf("Device did not respond: %v", err))
+		return 0, nil
+	}
+
 	conditions.Set(device, metav1.Condition{
 		Type:    v1alpha1.ReachableCondition,
 		Status:  metav1.ConditionTrue,
@@ -307,13 +386,15 @@ func (r *DeviceReconciler) reconcile(ctx context.Context, device *v1alpha1.Devic
 	// has rebooted since the last observed reboot time, or on first connection.
 	lastReboot, err := prov.GetLastRebootTime(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get last reboot time: %w", err)
+		return 0, fmt.Errorf("failed to get last reboot time: %w", err)
 	}
 
+	// The last reboot time is truncated to the precision with which it is stored in the status.
+	lastReboot = lastReboot.Truncate(time.Second)
 	if device.Status.LastRebootTime.IsZero() || lastReboot.After(device.Status.LastRebootTime.Time) {
 		info, err := prov.GetDeviceInfo(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to get device info: %w", err)
+			return 0, fmt.Errorf("failed to get device info: %w", err)
 		}
 		device.Status.Hostname = info.Hostname
 		device.Status.Manufacturer = info.Manufacturer
@@ -322,9 +403,28 @@ func (r *DeviceReconciler) reconcile(ctx context.Context, device *v1alpha1.Devic
 		device.Status.FirmwareVersion = info.FirmwareVersion
 		device.Status.LastRebootTime = metav1.NewTime(lastReboot)
 
+		// A changed data model version, e.g. after a firmware upgrade, causes all resources
+		// of the device to be reconciled again, see [paused.DevicePredicate].
+		cond := metav1.Condition{
+			Type:    v1alpha1.DataModelChangedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1alpha1.DataModelUnchangedReason,
+			Message: "Device data model is unchanged",
+		}
+		if prev := device.Status.DataModelVersion; prev != "" && prev != info.DataModelVersion {
+			cond.Status = metav1.ConditionTrue
+			cond.Reason = v1alpha1.DataModelChangedReason
+			cond.Message = fmt.Sprintf("Device data model changed from %q to %q", prev, info.DataModelVersion)
+			r.Recorder.Eventf(device, nil, "Normal", "DataModelChanged", "Reconcile", "%s", cond.Message)
+		}
+		if info.DataModelVersion != "" {
+			conditions.Set(device, cond)
+		}
+		device.Status.DataModelVersion = info.DataModelVersion
+
 		ports, err := prov.ListPorts(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to list device ports: %w", err)
+			return 0, fmt.Errorf("failed to list device ports: %w", err)
 		}
 		device.Status.Ports = make([]v1alpha1.DevicePort, len(ports))
 		for i, p := range ports {
@@ -354,7 +454,7 @@ func (r *DeviceReconciler) reconcile(ctx context.Context, device *v1alpha1.Devic
 	// Always rebuild InterfaceRef mappings from the local Interface list.
 	interfaces := new(v1alpha1.InterfaceList)
 	if err := r.List(ctx, interfaces, client.InNamespace(device.Namespace), client.MatchingFields{v1alpha1.DeviceRefIndexKey: device.Name}); err != nil {
-		return fmt.Errorf("failed to list interface resources for device: %w", err)
+		return 0, fmt.Errorf("failed to list interface resources for device: %w", err)
 	}
 
 	m := make(map[string]string) // port ID => Interface resource name
@@ -373,6 +473,27 @@ func (r *DeviceReconciler) reconcile(ctx context.Context, device *v1alpha1.Devic
 
 	device.Status.PortSummary = PortSummary(device.Status.Ports)
 
+	// Changes to the device settings are deferred until a maintenance window opens, just
+	// like the changes to the resources associated with the device.
+	if cond := conditions.Get(device, v1alpha1.MaintenanceWindowCondition); cond == nil || cond.Status == metav1.ConditionTrue {
+		if err := r.reconcileECMP(ctx, device, prov); err != nil {
+			return 0, err
+		}
+
+		if err := r.reconcileLACP(ctx, device, prov); err != nil {
+			return 0, err
+		}
+
+		if err := r.reconcileHostname(ctx, device, prov); err != nil {
+			return 0, err
+		}
+	}
+
+	requeueAfter, err := r.reconcileSaveConfig(ctx, device, prov)
+	if err != nil {
+		return 0, err
+	}
+
 	conditions.Set(device, metav1.Condition{
 		Type:    v1alpha1.ReadyCondition,
 		Status:  metav1.ConditionTrue,
@@ -380,32 +501,302 @@ func (r *DeviceReconciler) reconcile(ctx context.Context, device *v1alpha1.Devic
 		Message: "Device is healthy",
 	})
 
+	return requeueAfter, nil
+}
+
+const (
+	// DefaultAutoSaveConfigDebounce is the default minimum time between two saves for the OnChange auto-save policy.
+	DefaultAutoSaveConfigDebounce = time.Minute
+	// DefaultAutoSaveConfigInterval is the default time between two saves for the Periodic auto-save policy.
+	DefaultAutoSaveConfigInterval = time.Hour
+)
+
+// reconcileSaveConfig saves the running configuration of the device to its startup configuration
+// according to the auto-save policy of the device. It returns the duration after which the next
+// save is due, or zero if no further save is scheduled.
+func (r *DeviceReconciler) reconcileSaveConfig(ctx context.Context, device *v1alpha1.Device, prov provider.DeviceProvider) (time.Duration, error) {
+	policy := device.Spec.AutoSaveConfig
+	if (policy == "" || policy == v1alpha1.AutoSaveConfigNever) && device.Spec.SaveConfig {
+		policy = v1alpha1.AutoSaveConfigOnChange
+	}
+	if policy == "" || policy == v1alpha1.AutoSaveConfigNever {
+		device.Status.SavedConfigRevision = ""
+		return 0, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+
+	cs, ok := prov.(provider.ConfigSaveProvider)
+	if !ok {
+		log.Info("Provider does not implement provider.ConfigSaveProvider, skipping saving the device configuration")
+		return 0, nil
+	}
+
+	interval := DefaultAutoSaveConfigInterval
+	if policy == v1alpha1.AutoSaveConfigOnChange {
+		interval = DefaultAutoSaveConfigDebounce
+	}
+	if device.Spec.AutoSaveConfigInterval != nil {
+		interval = device.Spec.AutoSaveConfigInterval.Duration
+	}
+
+	// The running configuration is only saved after it changed since the last save. Providers that can't
+	// detect changes are only supported by the Periodic policy, for which every interval is considered a change.
+	var revision string
+	if rp, ok := prov.(provider.ConfigRevisionProvider); ok {
+		rev, err := rp.GetConfigRevision(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get running configuration revision: %w", err)
+		}
+		if rev == device.Status.SavedConfigRevision && !device.Status.LastConfigSaveTime.IsZero() {
+			log.V(3).Info("Running configuration has not changed since the last save", "revision", rev)
+			if policy == v1alpha1.AutoSaveConfigPeriodic {
+				return interval, nil
+			}
+			return 0, nil
+		}
+		revision = rev
+	} else if policy == v1alpha1.AutoSaveConfigOnChange {
+		log.Info("Provider does not implement provider.ConfigRevisionProvider, skipping saving the device configuration on change")
+		return 0, nil
+	}
+
+	if last := device.Status.LastConfigSaveTime; !last.IsZero() {
+		if wait := time.Until(last.Add(interval)); wait > 0 {
+			log.V(3).Info("Postponing saving the device configuration", "after", wait)
+			return wait, nil
+		}
+	}
+
+	log.Info("Saving the device configuration", "policy", policy)
+	if err := cs.SaveConfig(ctx); err != nil {
+		return 0, fmt.Errorf("failed to save device configuration: %w", err)
+	}
+	device.Status.LastConfigSaveTime = metav1.Now()
+	device.Status.SavedConfigRevision = revision
+
+	if policy == v1alpha1.AutoSaveConfigPeriodic {
+		return interval, nil
+	}
+	return 0, nil
+}
+
+// reconcileECMP ensures the system-wide ECMP settings of the device and reports
+// whether a reload is required for them to take effect.
+func (r *DeviceReconciler) reconcileECMP(ctx context.Context, device *v1alpha1.Device, prov provider.DeviceProvider) error {
+	if device.Spec.ECMP == nil {
+		conditions.Del(device, v1alpha1.ECMPConfiguredCondition)
+		conditions.Del(device, v1alpha1.ReloadRequiredCondition)
+		return nil
+	}
+
+	ecmp, ok := prov.(provider.ECMPProvider)
+	if !ok {
+		conditions.Set(device, metav1.Condition{
+			Type:    v1alpha1.ECMPConfiguredCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1alpha1.NotImplementedReason,
+			Message: "Provider does not implement provider.ECMPProvider",
+		})
+		return nil
+	}
+
+	reload, err := ecmp.EnsureECMP(ctx, &provider.EnsureECMPRequest{
+		MaximumPaths: device.Spec.ECMP.MaximumPaths,
+	})
+	cond := conditions.FromError(err)
+	cond.Type = v1alpha1.ECMPConfiguredCondition
+	conditions.Set(device, cond)
+	if err != nil {
+		// Invalid settings are reported in the ECMPConfigured condition and must not
+		// block the reconciliation of the device itself.
+		if _, ok := apistatus.FromError(err); ok {
+			return nil
+		}
+		return fmt.Errorf("failed to ensure ecmp settings: %w", err)
+	}
+
+	cond = metav1.Condition{
+		Type:    v1alpha1.ReloadRequiredCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  v1alpha1.ReloadNotRequiredReason,
+		Message: "Configuration is effective",
+	}
+	if reload {
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = v1alpha1.ReloadRequiredReason
+		cond.Message = "Device must be reloaded for the ECMP settings to take effect"
+	}
+	conditions.Set(device, cond)
 	return nil
 }
 
-func (r *DeviceReconciler) reconcileMinimal(ctx context.Context, device *v1alpha1.Device, conn *deviceutil.Connection) (reterr error) {
-	prov := r.Provider()
-	if err := prov.Connect(ctx, conn); err != nil {
+// reconcileLACP ensures the system-wide LACP settings of the device. Once the settings are removed
+// from the spec, the default settings of the device are restored.
+func (r *DeviceReconciler) reconcileLACP(ctx context.Context, device *v1alpha1.Device, prov provider.DeviceProvider) error {
+	if device.Spec.LACP == nil && conditions.Get(device, v1alpha1.LACPConfiguredCondition) == nil {
+		return nil
+	}
+
+	lp, ok := prov.(provider.LACPProvider)
+	if !ok {
 		conditions.Set(device, metav1.Condition{
-			Type:    v1alpha1.ReachableCondition,
+			Type:    v1alpha1.LACPConfiguredCondition,
 			Status:  metav1.ConditionFalse,
-			Reason:  v1alpha1.UnreachableReason,
-			Message: fmt.Sprintf("Failed to connect to device: %v", err),
+			Reason:  v1alpha1.NotImplementedReason,
+			Message: "Provider does not implement provider.LACPProvider",
 		})
+		return nil
+	}
+
+	req := new(provider.EnsureLACPRequest)
+	if device.Spec.LACP != nil {
+		req.SystemPriority = device.Spec.LACP.SystemPriority
+	}
+
+	err := lp.EnsureLACP(ctx, req)
+	if err == nil && device.Spec.LACP == nil {
+		conditions.Del(device, v1alpha1.LACPConfiguredCondition)
+		return nil
+	}
+
+	cond := conditions.FromError(err)
+	cond.Type = v1alpha1.LACPConfiguredCondition
+	conditions.Set(device, cond)
+	if err != nil {
+		// Invalid settings are reported in the LACPConfigured condition and must not
+		// block the reconciliation of the device itself.
+		if _, ok := apistatus.FromError(err); ok {
+			return nil
+		}
+		return fmt.Errorf("failed to ensure lacp settings: %w", err)
+	}
+	return nil
+}
+
+// reconcileHostname ensures the hostname of the device, if managed. Once the hostname is removed
+// from the spec, it is left unchanged on the device.
+func (r *DeviceReconciler) reconcileHostname(ctx context.Context, device *v1alpha1.Device, prov provider.DeviceProvider) error {
+	if device.Spec.Hostname == "" {
+		conditions.Del(device, v1alpha1.HostnameConfiguredCondition)
+		return nil
+	}
+
+	hp, ok := prov.(provider.HostnameProvider)
+	if !ok {
 		conditions.Set(device, metav1.Condition{
-			Type:    v1alpha1.ReadyCondition,
-			Status:  metav1.ConditionUnknown,
-			Reason:  v1alpha1.UnreachableReason,
-			Message: "Device is not reachable",
+			Type:    v1alpha1.HostnameConfiguredCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1alpha1.NotImplementedReason,
+			Message: "Provider does not implement provider.HostnameProvider",
 		})
 		return nil
 	}
+
+	current, err := hp.GetHostname(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get hostname: %w", err)
+	}
+	if current != device.Spec.Hostname {
+		err = hp.EnsureHostname(ctx, device.Spec.Hostname)
+	}
+
+	cond := conditions.FromError(err)
+	cond.Type = v1alpha1.HostnameConfiguredCondition
+	conditions.Set(device, cond)
+	if err != nil {
+		// Invalid settings are reported in the HostnameConfigured condition and must not
+		// block the reconciliation of the device itself.
+		if _, ok := apistatus.FromError(err); ok {
+			return nil
+		}
+		return fmt.Errorf("failed to ensure hostname: %w", err)
+	}
+	device.Status.Hostname = device.Spec.Hostname
+	return nil
+}
+
+// reconcileMaintenanceWindow sets the MaintenanceWindow condition of the device according to its
+// maintenance windows. It returns the time at which the next window opens or the current one closes,
+// or the zero time if the device has no maintenance windows.
+func (r *DeviceReconciler) reconcileMaintenanceWindow(device *v1alpha1.Device, now time.Time) time.Time {
+	if len(device.Spec.MaintenanceWindows) == 0 {
+		conditions.Del(device, v1alpha1.MaintenanceWindowCondition)
+		return time.Time{}
+	}
+
+	next := maintenance.NextTransition(device.Spec.MaintenanceWindows, now)
+	if maintenance.IsOpen(device.Spec.MaintenanceWindows, now) {
+		msg := "Maintenance window is open"
+		if !next.IsZero() {
+			// The windows may overlap such that they never close.
+			msg += " until " + next.Format(time.RFC3339)
+		}
+		conditions.Set(device, metav1.Condition{
+			Type:    v1alpha1.MaintenanceWindowCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  v1alpha1.MaintenanceWindowOpenReason,
+			Message: msg,
+		})
+		return next
+	}
+
+	conditions.Set(device, metav1.Condition{
+		Type:    v1alpha1.MaintenanceWindowCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  v1alpha1.MaintenanceWindowClosedReason,
+		Message: "Changes are deferred until the next maintenance window opens at " + next.Format(time.RFC3339),
+	})
+	return next
+}
+
+// pingTimeout bounds the time a device has to respond to a reachability probe.
+const pingTimeout = 10 * time.Second
+
+// ping probes whether the device is reachable and responsive, if supported by the provider.
+func ping(ctx context.Context, prov provider.Provider) error {
+	p, ok := prov.(provider.PingProvider)
+	if !ok {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+	return p.Ping(ctx)
+}
+
+// setUnreachable reports that the device is not reachable, with the given message describing the cause.
+func setUnreachable(device *v1alpha1.Device, msg string) {
+	conditions.Set(device, metav1.Condition{
+		Type:    v1alpha1.ReachableCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  v1alpha1.UnreachableReason,
+		Message: msg,
+	})
+	conditions.Set(device, metav1.Condition{
+		Type:    v1alpha1.ReadyCondition,
+		Status:  metav1.ConditionUnknown,
+		Reason:  v1alpha1.UnreachableReason,
+		Message: "Device is not reachable",
+	})
+}
+
+func (r *DeviceReconciler) reconcileMinimal(ctx context.Context, device *v1alpha1.Device, conn *deviceutil.Connection) (reterr error) {
+	prov := r.Provider()
+	if err := prov.Connect(ctx, conn); err != nil {
+		setUnreachable(device, fmt.Sprintf("Failed to connect to device: %v", err))
+		return nil
+	}
 	defer func() {
 		if err := prov.Disconnect(ctx, conn); err != nil {
 			reterr = kerrors.NewAggregate([]error{reterr, err})
 		}
 	}()
 
+	if err := ping(ctx, prov); err != nil {
+		setUnreachable(device, fmt.Sprintf("Device did not respond: %v", err))
+		return nil
+	}
+
 	conditions.Set(device, metav1.Condition{
 		Type:    v1alpha1.ReachableCondition,
 		Status:  metav1.ConditionTrue,
@@ -453,10 +844,15 @@ func (r *DeviceReconciler) reconcileMaintenance(ctx context.Context, obj *v1alph
 				r.Recorder.Eventf(obj, nil, "Warning", "MaintenanceUnsupported", "Maintenance", "Provider does not support maintenance operation: %s", action)
 				return nil
 			}
+			opts, err := rebootOptions(obj)
+			if err != nil {
+				r.Recorder.Eventf(obj, nil, "Warning", "InvalidRebootOptions", "Maintenance", "Invalid reboot options: %v", err)
+				return reconcile.TerminalError(err)
+			}
 			// Reboot triggers a device restart. The device remains in its current phase
 			// and will resume normal operation after the reboot completes.
 			r.Recorder.Eventf(obj, nil, "Normal", "RebootRequested", "Maintenance", "Device reboot has been requested")
-			if err := mp.Reboot(ctx, conn); err != nil {
+			if err := mp.Reboot(ctx, conn, opts); err != nil {
 				conditions.Set(obj, metav1.Condition{
 					Type:    v1alpha1.ReadyCondition,
 					Status:  metav1.ConditionFalse,
@@ -464,6 +860,9 @@ func (r *DeviceReconciler) reconcileMaintenance(ctx context.Context, obj *v1alph
 					Message: fmt.Sprintf("Failed to reboot device: %v", err),
 				})
 				r.Recorder.Eventf(obj, nil, "Warning", "RebootFailed", "Maintenance", "Device reboot has failed: %v", err)
+				if _, ok := apistatus.FromError(err); ok {
+					return reconcile.TerminalError(fmt.Errorf("failed to reboot device: %w", err))
+				}
 				return fmt.Errorf("failed to reboot device: %w", err)
 			}
 
@@ -518,9 +917,31 @@ func (r *DeviceReconciler) reconcileMaintenance(ctx context.Context, obj *v1alph
 	// Only remove the annotation after the operation succeeds so that
 	// failed actions are retried on the next reconciliation.
 	delete(obj.Annotations, v1alpha1.DeviceMaintenanceAnnotation)
+	delete(obj.Annotations, v1alpha1.DeviceRebootSaveConfigAnnotation)
+	delete(obj.Annotations, v1alpha1.DeviceRebootDelayAnnotation)
 	return nil
 }
 
+// rebootOptions returns the reboot options requested via the annotations of the device.
+func rebootOptions(obj *v1alpha1.Device) (*provider.RebootOptions, error) {
+	opts := new(provider.RebootOptions)
+	if v, ok := obj.Annotations[v1alpha1.DeviceRebootSaveConfigAnnotation]; ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for annotation %s: %w", v, v1alpha1.DeviceRebootSaveConfigAnnotation, err)
+		}
+		opts.SaveConfig = b
+	}
+	if v, ok := obj.Annotations[v1alpha1.DeviceRebootDelayAnnotation]; ok {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return nil, fmt.Errorf("invalid value %q for annotation %s: must be a non-negative duration", v, v1alpha1.DeviceRebootDelayAnnotation)
+		}
+		opts.Delay = d
+	}
+	return opts, nil
+}
+
 // secretToDevices is a [handler.MapFunc] to be used to enqueue requests for reconciliation
 // for a Device to update when one of its referenced Secrets gets updated.
 func (r *DeviceReconciler) secretToDevices(ctx context.Context, obj client.Object) []ctrl.Request {
@@ -15,6 +15,8 @@
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/conditions"
+	"github.com/ironcore-dev/network-operator/internal/provider"
 )
 
 var _ = Describe("Device Controller", func() {
@@ -335,6 +337,395 @@
 			}).Should(Succeed())
 		})
 
+		It("Should report a required reload after configuring the ECMP settings", func() {
+			By("Creating the custom resource for the Kind Device with ECMP settings")
+			device := &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+						SecretRef: &v1alpha1.SecretReference{
+							Name: name,
+						},
+					},
+					ECMP: &v1alpha1.DeviceECMP{MaximumPaths: 32},
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+
+			By("Verifying the ECMP settings are configured and a reload is required")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.Phase).To(Equal(v1alpha1.DevicePhaseRunning))
+
+				cond := conditions.Get(resource, v1alpha1.ECMPConfiguredCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+
+				cond = conditions.Get(resource, v1alpha1.ReloadRequiredCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(cond.Reason).To(Equal(v1alpha1.ReloadRequiredReason))
+			}).Should(Succeed())
+
+			testProvider.Lock()
+			Expect(testProvider.ECMP).To(Equal(int32(32)))
+			testProvider.Unlock()
+		})
+
+		It("Should configure and restore the LACP settings of the device", func() {
+			By("Creating the custom resource for the Kind Device with LACP settings")
+			device := &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+						SecretRef: &v1alpha1.SecretReference{
+							Name: name,
+						},
+					},
+					LACP: &v1alpha1.DeviceLACP{SystemPriority: 4096},
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+
+			By("Verifying the LACP settings are configured")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.Phase).To(Equal(v1alpha1.DevicePhaseRunning))
+
+				cond := conditions.Get(resource, v1alpha1.LACPConfiguredCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			}).Should(Succeed())
+
+			testProvider.Lock()
+			Expect(testProvider.LACPPriority).To(Equal(int32(4096)))
+			testProvider.Unlock()
+
+			By("Removing the LACP settings")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				resource.Spec.LACP = nil
+				g.Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			}).Should(Succeed())
+
+			By("Verifying the default LACP settings are restored")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(conditions.Get(resource, v1alpha1.LACPConfiguredCondition)).To(BeNil())
+
+				testProvider.Lock()
+				defer testProvider.Unlock()
+				g.Expect(testProvider.LACPPriority).To(BeZero())
+			}).Should(Succeed())
+		})
+
+		It("Should configure the hostname of the device", func() {
+			By("Creating the custom resource for the Kind Device with a hostname")
+			device := &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+						SecretRef: &v1alpha1.SecretReference{
+							Name: name,
+						},
+					},
+					Hostname: "leaf1",
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+
+			By("Verifying the hostname is configured and reported")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.Phase).To(Equal(v1alpha1.DevicePhaseRunning))
+				g.Expect(resource.Status.Hostname).To(Equal("leaf1"))
+
+				cond := conditions.Get(resource, v1alpha1.HostnameConfiguredCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			}).Should(Succeed())
+
+			testProvider.Lock()
+			Expect(testProvider.Hostname).To(Equal("leaf1"))
+			testProvider.Unlock()
+		})
+
+		It("Should report whether the maintenance window of the device is open", func() {
+			By("Creating the custom resource for the Kind Device with a maintenance window that is closed")
+			device := &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+						SecretRef: &v1alpha1.SecretReference{
+							Name: name,
+						},
+					},
+					MaintenanceWindows: []v1alpha1.MaintenanceWindow{{
+						Start:    time.Now().UTC().Add(2 * time.Hour).Format("15:04"),
+						Duration: metav1.Duration{Duration: time.Hour},
+					}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+
+			By("Verifying the maintenance window is reported as closed")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				cond := conditions.Get(resource, v1alpha1.MaintenanceWindowCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(cond.Reason).To(Equal(v1alpha1.MaintenanceWindowClosedReason))
+			}).Should(Succeed())
+
+			By("Opening the maintenance window")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				resource.Spec.MaintenanceWindows[0].Start = time.Now().UTC().Add(-time.Minute).Format("15:04")
+				g.Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			}).Should(Succeed())
+
+			By("Verifying the maintenance window is reported as open")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				cond := conditions.Get(resource, v1alpha1.MaintenanceWindowCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(cond.Reason).To(Equal(v1alpha1.MaintenanceWindowOpenReason))
+			}).Should(Succeed())
+		})
+
+		It("Should save the running configuration periodically when enabled", func() {
+			testProvider.Lock()
+			saves := testProvider.ConfigSaves
+			testProvider.Unlock()
+
+			By("Creating the custom resource for the Kind Device with saving enabled")
+			device := &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+						SecretRef: &v1alpha1.SecretReference{
+							Name: name,
+						},
+					},
+					AutoSaveConfig:         v1alpha1.AutoSaveConfigPeriodic,
+					AutoSaveConfigInterval: &metav1.Duration{Duration: 10 * time.Second},
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+
+			By("Verifying the running configuration is saved")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.Phase).To(Equal(v1alpha1.DevicePhaseRunning))
+
+				g.Expect(resource.Status.LastConfigSaveTime.IsZero()).To(BeFalse())
+
+				testProvider.Lock()
+				defer testProvider.Unlock()
+				g.Expect(testProvider.ConfigSaves).To(BeNumerically(">", saves))
+			}).Should(Succeed())
+		})
+
+		It("Should skip the periodic saves while the running configuration is unchanged", func() {
+			testProvider.Lock()
+			testProvider.ConfigRevision = "rev-periodic-1"
+			saves := testProvider.ConfigSaves
+			testProvider.Unlock()
+
+			By("Creating the custom resource for the Kind Device with periodic saving")
+			device := &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+						SecretRef: &v1alpha1.SecretReference{
+							Name: name,
+						},
+					},
+					AutoSaveConfig:         v1alpha1.AutoSaveConfigPeriodic,
+					AutoSaveConfigInterval: &metav1.Duration{Duration: 10 * time.Second},
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+
+			By("Verifying the running configuration is saved once")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.SavedConfigRevision).To(Equal("rev-periodic-1"))
+
+				testProvider.Lock()
+				defer testProvider.Unlock()
+				g.Expect(testProvider.ConfigSaves).To(Equal(saves + 1))
+			}).Should(Succeed())
+
+			By("Verifying the running configuration is not saved again after the interval")
+			Consistently(func(g Gomega) {
+				testProvider.Lock()
+				defer testProvider.Unlock()
+				g.Expect(testProvider.ConfigSaves).To(Equal(saves + 1))
+			}, 15*time.Second, time.Second).Should(Succeed())
+
+			By("Changing the running configuration")
+			testProvider.Lock()
+			testProvider.ConfigRevision = "rev-periodic-2"
+			testProvider.Unlock()
+
+			By("Verifying the running configuration is saved in the next interval")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.SavedConfigRevision).To(Equal("rev-periodic-2"))
+
+				testProvider.Lock()
+				defer testProvider.Unlock()
+				g.Expect(testProvider.ConfigSaves).To(Equal(saves + 2))
+			}).Should(Succeed())
+		})
+
+		It("Should save the running configuration only after it changed", func() {
+			testProvider.Lock()
+			testProvider.ConfigRevision = "rev-1"
+			saves := testProvider.ConfigSaves
+			testProvider.Unlock()
+
+			By("Creating the custom resource for the Kind Device with saving on change")
+			device := &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+						SecretRef: &v1alpha1.SecretReference{
+							Name: name,
+						},
+					},
+					AutoSaveConfig:         v1alpha1.AutoSaveConfigOnChange,
+					AutoSaveConfigInterval: &metav1.Duration{Duration: 10 * time.Second},
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+
+			By("Verifying the running configuration is saved once")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.SavedConfigRevision).To(Equal("rev-1"))
+
+				testProvider.Lock()
+				defer testProvider.Unlock()
+				g.Expect(testProvider.ConfigSaves).To(Equal(saves + 1))
+			}).Should(Succeed())
+
+			By("Verifying the running configuration is not saved again while it is unchanged")
+			Expect(k8sClient.Get(ctx, key, device)).To(Succeed())
+			orig := device.DeepCopy()
+			device.Labels = map[string]string{"test": "unchanged"}
+			Expect(k8sClient.Patch(ctx, device, client.MergeFrom(orig))).To(Succeed())
+			Consistently(func(g Gomega) {
+				testProvider.Lock()
+				defer testProvider.Unlock()
+				g.Expect(testProvider.ConfigSaves).To(Equal(saves + 1))
+			}, 12*time.Second, time.Second).Should(Succeed())
+
+			By("Changing the running configuration")
+			testProvider.Lock()
+			testProvider.ConfigRevision = "rev-2"
+			testProvider.Unlock()
+			Expect(k8sClient.Get(ctx, key, device)).To(Succeed())
+			orig = device.DeepCopy()
+			device.Labels = map[string]string{"test": "changed"}
+			Expect(k8sClient.Patch(ctx, device, client.MergeFrom(orig))).To(Succeed())
+
+			By("Verifying the running configuration is saved again")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.SavedConfigRevision).To(Equal("rev-2"))
+
+				testProvider.Lock()
+				defer testProvider.Unlock()
+				g.Expect(testProvider.ConfigSaves).To(Equal(saves + 2))
+			}).Should(Succeed())
+		})
+
+		It("Should not save the running configuration when disabled", func() {
+			testProvider.Lock()
+			saves := testProvider.ConfigSaves
+			testProvider.Unlock()
+
+			By("Creating the custom resource for the Kind Device with saving disabled")
+			device := &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+						SecretRef: &v1alpha1.SecretReference{
+							Name: name,
+						},
+					},
+					AutoSaveConfig: v1alpha1.AutoSaveConfigNever,
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+
+			By("Verifying the device is running")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.Phase).To(Equal(v1alpha1.DevicePhaseRunning))
+			}).Should(Succeed())
+
+			By("Verifying the running configuration is not saved")
+			Consistently(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.LastConfigSaveTime.IsZero()).To(BeTrue())
+
+				testProvider.Lock()
+				defer testProvider.Unlock()
+				g.Expect(testProvider.ConfigSaves).To(Equal(saves))
+			}, 5*time.Second, time.Second).Should(Succeed())
+		})
+
 		It("Should transition from ProvisioningCompleted to Running", func() {
 			By("Creating a Device")
 			device := &v1alpha1.Device{
@@ -470,6 +861,62 @@
 			}).Should(Succeed())
 		})
 
+		It("Should reboot the device with the requested options", func() {
+			By("Creating the custom resource for the Kind Device")
+			device := &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+						SecretRef: &v1alpha1.SecretReference{
+							Name: name,
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.Phase).To(Equal(v1alpha1.DevicePhaseRunning))
+			}).Should(Succeed())
+
+			testProvider.Lock()
+			reboots := len(testProvider.Reboots)
+			testProvider.Unlock()
+
+			By("Adding the reboot annotations to the device")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				patch := resource.DeepCopy()
+				patch.SetAnnotations(map[string]string{
+					v1alpha1.DeviceMaintenanceAnnotation:      v1alpha1.DeviceMaintenanceReboot,
+					v1alpha1.DeviceRebootSaveConfigAnnotation: "true",
+					v1alpha1.DeviceRebootDelayAnnotation:      "1m",
+				})
+				g.Expect(k8sClient.Patch(ctx, patch, client.MergeFrom(resource))).To(Succeed())
+			}).Should(Succeed())
+
+			By("Verifying the device is rebooted and the annotations are removed")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Annotations).ToNot(HaveKey(v1alpha1.DeviceMaintenanceAnnotation))
+				g.Expect(resource.Annotations).ToNot(HaveKey(v1alpha1.DeviceRebootSaveConfigAnnotation))
+				g.Expect(resource.Annotations).ToNot(HaveKey(v1alpha1.DeviceRebootDelayAnnotation))
+
+				testProvider.Lock()
+				defer testProvider.Unlock()
+				g.Expect(testProvider.Reboots).To(HaveLen(reboots + 1))
+				g.Expect(testProvider.Reboots[reboots]).To(Equal(provider.RebootOptions{SaveConfig: true, Delay: time.Minute}))
+			}).Should(Succeed())
+		})
+
 		It("Should set Reachable=False and Ready=Unknown when the device is unreachable", func() {
 			By("Making the provider return a connect error")
 			testProvider.SetConnectError(errors.New("connection refused"))
@@ -525,6 +972,82 @@
 			}).Should(Succeed())
 		})
 
+		It("Should set Ready=False with reason SecretNotFound when the referenced Secret does not exist", func() {
+			By("Creating the custom resource for the Kind Device referencing a missing Secret")
+			device := &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+						SecretRef: &v1alpha1.SecretReference{
+							Name: name + "-missing",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+
+			By("Verifying the Ready condition reports the missing Secret")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				cond := conditions.Get(resource, v1alpha1.ReadyCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(cond.Reason).To(Equal(v1alpha1.SecretNotFoundReason))
+			}).Should(Succeed())
+		})
+
+		It("Should set Ready=False with reason InvalidCredentials when the referenced Secret is malformed", func() {
+			By("Removing the password from the endpoint credentials")
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, key, secret)).To(Succeed())
+			delete(secret.Data, corev1.BasicAuthPasswordKey)
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+
+			By("Creating the custom resource for the Kind Device")
+			device := &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+						SecretRef: &v1alpha1.SecretReference{
+							Name: name,
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+
+			By("Verifying the Ready condition reports the invalid credentials")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				cond := conditions.Get(resource, v1alpha1.ReadyCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(cond.Reason).To(Equal(v1alpha1.InvalidCredentialsReason))
+			}).Should(Succeed())
+
+			By("Restoring the password of the endpoint credentials")
+			Expect(k8sClient.Get(ctx, key, secret)).To(Succeed())
+			secret.Data[corev1.BasicAuthPasswordKey] = []byte("password")
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+
+			By("Verifying the device becomes ready once the Secret is fixed")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(conditions.IsReady(resource)).To(BeTrue())
+			}).Should(Succeed())
+		})
+
 		It("Should reset to Pending when Spec.Provisioning is removed before provisioning agent makes a request", func() {
 			By("Creating a Device with provisioning configured")
 			device := &v1alpha1.Device{
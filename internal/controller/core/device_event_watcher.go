@@ -0,0 +1,217 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
+// DeviceEventWatcher subscribes to events on all running devices and records them as
+// Kubernetes Events on the matching Interface and BGPPeer resources.
+type DeviceEventWatcher struct {
+	client.Client
+
+	// WatchFilterValue is the label value used to filter devices to watch.
+	WatchFilterValue string
+
+	// Recorder is used to record the device events.
+	Recorder events.EventRecorder
+
+	// Provider is the driver that will be used to subscribe to device events.
+	Provider provider.ProviderFunc
+
+	// ResyncInterval is the interval at which the set of watched devices is synchronized
+	// and failed subscriptions are reestablished.
+	ResyncInterval time.Duration
+
+	mu      sync.Mutex
+	watches map[types.NamespacedName]context.CancelFunc
+}
+
+// Start implements manager.Runnable and blocks until the context is cancelled.
+func (w *DeviceEventWatcher) Start(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx).WithName("device-event-watcher")
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	if _, ok := w.Provider().(provider.DeviceEventProvider); !ok {
+		log.Info("Provider does not implement provider.DeviceEventProvider, not watching device events")
+		return nil
+	}
+
+	w.mu.Lock()
+	w.watches = make(map[types.NamespacedName]context.CancelFunc)
+	w.mu.Unlock()
+
+	ticker := time.NewTicker(w.ResyncInterval)
+	defer ticker.Stop()
+
+	for {
+		w.sync(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, so that events are recorded only once.
+func (w *DeviceEventWatcher) NeedLeaderElection() bool {
+	return true
+}
+
+// sync starts watches for running devices that are not watched yet and stops
+// watches for devices that are gone, paused or no longer running.
+func (w *DeviceEventWatcher) sync(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx)
+
+	var opts []client.ListOption
+	if w.WatchFilterValue != "" {
+		opts = append(opts, client.MatchingLabels{v1alpha1.WatchLabel: w.WatchFilterValue})
+	}
+
+	list := new(v1alpha1.DeviceList)
+	if err := w.List(ctx, list, opts...); err != nil {
+		log.Error(err, "Failed to list devices")
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	active := make(map[types.NamespacedName]bool, len(list.Items))
+	for i := range list.Items {
+		device := &list.Items[i]
+		if device.Spec.Paused || device.Status.Phase != v1alpha1.DevicePhaseRunning || !device.DeletionTimestamp.IsZero() {
+			continue
+		}
+		key := client.ObjectKeyFromObject(device)
+		active[key] = true
+		if _, ok := w.watches[key]; ok {
+			continue
+		}
+		watchCtx, cancel := context.WithCancel(ctx)
+		w.watches[key] = cancel
+		go w.watch(watchCtx, device.DeepCopy())
+	}
+
+	for key, cancel := range w.watches {
+		if !active[key] {
+			cancel()
+			delete(w.watches, key)
+		}
+	}
+}
+
+// watch subscribes to the events of a single device until the context is cancelled or the subscription fails.
+// A failed subscription is reestablished on the next sync.
+func (w *DeviceEventWatcher) watch(ctx context.Context, device *v1alpha1.Device) {
+	key := client.ObjectKeyFromObject(device)
+	log := ctrl.LoggerFrom(ctx, "Device", klog.KObj(device))
+
+	defer func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if cancel, ok := w.watches[key]; ok && ctx.Err() == nil {
+			cancel()
+			delete(w.watches, key)
+		}
+	}()
+
+	prov, ok := w.Provider().(provider.DeviceEventProvider)
+	if !ok {
+		return
+	}
+
+	conn, err := deviceutil.GetDeviceConnection(ctx, w, device)
+	if err != nil {
+		log.Error(err, "Failed to get device connection")
+		return
+	}
+
+	if err := prov.Connect(ctx, conn); err != nil {
+		log.Error(err, "Failed to connect to device")
+		return
+	}
+	defer func() {
+		if err := prov.Disconnect(ctx, conn); err != nil {
+			log.Error(err, "Failed to disconnect from device")
+		}
+	}()
+
+	log.V(1).Info("Watching device events")
+	if err := prov.WatchDeviceEvents(ctx, func(ev provider.DeviceEvent) {
+		w.record(ctx, prov, device, ev)
+	}); err != nil {
+		log.Error(err, "Device event subscription failed")
+	}
+}
+
+// record records the device event on the resources on the device matching the event.
+func (w *DeviceEventWatcher) record(ctx context.Context, prov provider.DeviceEventProvider, device *v1alpha1.Device, ev provider.DeviceEvent) {
+	log := ctrl.LoggerFrom(ctx)
+
+	opts := []client.ListOption{
+		client.InNamespace(device.Namespace),
+		client.MatchingFields{v1alpha1.DeviceRefIndexKey: device.Name},
+	}
+
+	var objs []client.Object
+	switch ev.Kind {
+	case provider.DeviceEventKindInterface:
+		list := new(v1alpha1.InterfaceList)
+		if err := w.List(ctx, list, opts...); err != nil {
+			log.Error(err, "Failed to list interfaces")
+			return
+		}
+		// The device may report the interface by another form of its name than the one of the resource.
+		normalize := func(name string) string {
+			if n, err := prov.NormalizeInterfaceName(name); err == nil {
+				return n
+			}
+			return name
+		}
+		name := normalize(ev.Name)
+		for i := range list.Items {
+			if strings.EqualFold(normalize(list.Items[i].Spec.Name), name) {
+				objs = append(objs, &list.Items[i])
+			}
+		}
+	case provider.DeviceEventKindBGPPeer:
+		list := new(v1alpha1.BGPPeerList)
+		if err := w.List(ctx, list, opts...); err != nil {
+			log.Error(err, "Failed to list BGP peers")
+			return
+		}
+		for i := range list.Items {
+			if list.Items[i].Spec.Address == ev.Name {
+				objs = append(objs, &list.Items[i])
+			}
+		}
+	}
+
+	eventType, reason := corev1.EventTypeWarning, "DeviceReportedDown"
+	if ev.Up {
+		eventType, reason = corev1.EventTypeNormal, "DeviceReportedUp"
+	}
+
+	for _, obj := range objs {
+		log.V(2).Info("Recording device event", "Object", klog.KObj(obj), "Reason", reason)
+		w.Recorder.Eventf(obj, device, eventType, reason, "DeviceEvent", "%s", ev.Message)
+	}
+}
@@ -0,0 +1,489 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/conditions"
+	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/paused"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+	"github.com/ironcore-dev/network-operator/internal/resourcelock"
+)
+
+// MaxDeviceQueryResultSize is the maximum size in bytes of a query result stored in the status of a DeviceQuery.
+const MaxDeviceQueryResultSize = 256 << 10
+
+// MaxDeviceQueryRunningConfigSize is the maximum size in bytes of a running configuration written to the ConfigMap
+// of a DeviceQuery. It leaves some headroom below the 1 MiB size limit of the ConfigMap object as a whole.
+const MaxDeviceQueryRunningConfigSize = 1000 << 10
+
+// DeviceQueryReconciler reconciles a DeviceQuery object
+type DeviceQueryReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+
+	// Recorder is used to record events for the controller.
+	// More info: https://book.kubebuilder.io/reference/raising-events
+	Recorder events.EventRecorder
+
+	// Provider is the driver that will be used to query the device.
+	Provider provider.ProviderFunc
+
+	// Locker is used to synchronize operations on resources targeting the same device.
+	Locker *resourcelock.ResourceLocker
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
+}
+
+// +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=devicequeries,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=devicequeries/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.22.1/pkg/reconcile
+//
+// For more details about the method shape, read up here:
+// - https://ahmet.im/blog/controller-pitfalls/#reconcile-method-shape
+func (r *DeviceQueryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(3).Info("Reconciling resource")
+
+	obj := new(v1alpha1.DeviceQuery)
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			// If the custom resource is not found then it usually means that it was deleted or not created
+			// In this way, we will stop the reconciliation
+			log.V(3).Info("Resource not found. Ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		log.Error(err, "Failed to get resource")
+		return ctrl.Result{}, err
+	}
+
+	if !obj.DeletionTimestamp.IsZero() {
+		log.V(3).Info("Resource is being deleted, skipping reconciliation")
+		return ctrl.Result{}, nil
+	}
+
+	// The query is executed only once per generation.
+	if obj.Status.ObservedGeneration == obj.Generation {
+		log.V(3).Info("Query already executed for the current generation, skipping reconciliation")
+		return ctrl.Result{}, nil
+	}
+
+	prov, ok := r.Provider().(provider.DeviceQueryProvider)
+	if !ok {
+		if meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:    v1alpha1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1alpha1.NotImplementedReason,
+			Message: "Provider does not implement provider.DeviceQueryProvider",
+		}) {
+			return ctrl.Result{}, r.Status().Update(ctx, obj)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	device, err := deviceutil.GetDeviceByName(ctx, r, obj.Namespace, obj.Spec.DeviceRef.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if isPaused, requeue, err := paused.EnsureCondition(ctx, r.Client, device, obj); isPaused || requeue || err != nil {
+		return ctrl.Result{Requeue: requeue}, err
+	}
+
+	if err := r.Locker.AcquireLock(ctx, device.Name, "devicequery-controller"); err != nil {
+		if errors.Is(err, resourcelock.ErrLockAlreadyHeld) {
+			log.V(3).Info("Device is already locked, requeuing reconciliation")
+			return ctrl.Result{RequeueAfter: Jitter(time.Second), Priority: new(LockWaitPriorityDefault)}, nil
+		}
+		log.Error(err, "Failed to acquire device lock")
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		if err := r.Locker.ReleaseLock(ctx, device.Name, "devicequery-controller"); err != nil {
+			log.Error(err, "Failed to release device lock")
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	conn, err := deviceutil.GetDeviceConnection(ctx, r, device)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	s := &devicequeryScope{
+		Device:      device,
+		DeviceQuery: obj,
+		Connection:  conn,
+		Provider:    prov,
+	}
+
+	orig := obj.DeepCopy()
+	if conditions.InitializeConditions(obj, v1alpha1.ReadyCondition) {
+		log.V(1).Info("Initializing status conditions")
+		return ctrl.Result{}, r.Status().Update(ctx, obj)
+	}
+
+	// Always attempt to update the metadata/status after reconciliation
+	defer func() {
+		if !equality.Semantic.DeepEqual(orig.ObjectMeta, obj.ObjectMeta) {
+			// Pass obj.DeepCopy() to avoid Patch() modifying obj and interfering with status update below
+			if err := r.Patch(ctx, obj.DeepCopy(), client.MergeFrom(orig)); err != nil {
+				log.Error(err, "Failed to update resource metadata")
+				reterr = kerrors.NewAggregate([]error{reterr, err})
+			}
+		}
+		if !equality.Semantic.DeepEqual(orig.Status, obj.Status) {
+			if err := r.Status().Patch(ctx, obj, client.MergeFrom(orig)); err != nil {
+				log.Error(err, "Failed to update status")
+				reterr = kerrors.NewAggregate([]error{reterr, err})
+			}
+		}
+	}()
+
+	if err := r.reconcile(ctx, s); err != nil {
+		log.Error(err, "Failed to reconcile resource")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// scope holds the different objects that are read and used during the reconcile.
+type devicequeryScope struct {
+	Device      *v1alpha1.Device
+	DeviceQuery *v1alpha1.DeviceQuery
+	Connection  *deviceutil.Connection
+	Provider    provider.DeviceQueryProvider
+}
+
+func (r *DeviceQueryReconciler) reconcile(ctx context.Context, s *devicequeryScope) (reterr error) {
+	if s.DeviceQuery.Labels == nil {
+		s.DeviceQuery.Labels = make(map[string]string)
+	}
+	s.DeviceQuery.Labels[v1alpha1.DeviceLabel] = s.Device.Name
+
+	// Ensure the DeviceQuery is owned by the Device.
+	if !controllerutil.HasControllerReference(s.DeviceQuery) {
+		if err := controllerutil.SetOwnerReference(s.Device, s.DeviceQuery, r.Scheme, controllerutil.WithBlockOwnerDeletion(true)); err != nil {
+			return err
+		}
+	}
+
+	// Connect to remote device using the provider.
+	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
+		return fmt.Errorf("failed to connect to provider: %w", err)
+	}
+	defer func() {
+		if err := s.Provider.Disconnect(ctx, s.Connection); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	var res []byte
+	var err error
+	if route := s.DeviceQuery.Spec.Route; route != nil {
+		lp, ok := s.Provider.(provider.RouteLookupProvider)
+		if !ok {
+			conditions.Set(s.DeviceQuery, metav1.Condition{
+				Type:    v1alpha1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  v1alpha1.NotImplementedReason,
+				Message: "Provider does not implement provider.RouteLookupProvider",
+			})
+			s.DeviceQuery.Status.ObservedGeneration = s.DeviceQuery.Generation
+			return nil
+		}
+		var entry *provider.RouteEntry
+		entry, err = lp.LookupRoute(ctx, route.VRF, route.Prefix.Prefix)
+		if err == nil {
+			res, err = json.Marshal(entry)
+		}
+	} else if rc := s.DeviceQuery.Spec.RunningConfig; rc != nil {
+		cp, ok := s.Provider.(provider.RunningConfigCLIProvider)
+		if !ok {
+			conditions.Set(s.DeviceQuery, metav1.Condition{
+				Type:    v1alpha1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  v1alpha1.NotImplementedReason,
+				Message: "Provider does not implement provider.RunningConfigCLIProvider",
+			})
+			s.DeviceQuery.Status.ObservedGeneration = s.DeviceQuery.Generation
+			return nil
+		}
+		var cfg string
+		cfg, err = cp.GetRunningConfigCLI(ctx)
+		if err == nil {
+			cfg = redactRunningConfig(cfg)
+			if len(cfg) > MaxDeviceQueryRunningConfigSize {
+				err = fmt.Errorf("running configuration of %d bytes exceeds the maximum size of %d bytes", len(cfg), MaxDeviceQueryRunningConfigSize)
+			} else {
+				err = r.writeRunningConfig(ctx, s, cfg)
+			}
+		}
+	} else {
+		res, err = s.Provider.QueryDevice(ctx, &provider.DeviceQueryRequest{
+			DeviceQuery: s.DeviceQuery,
+		})
+		if err == nil {
+			res, err = redactDeviceQueryResult(s.DeviceQuery.Spec.Path, res)
+		}
+	}
+
+	now := metav1.Now()
+	s.DeviceQuery.Status.QueryTime = &now
+	s.DeviceQuery.Status.ObservedGeneration = s.DeviceQuery.Generation
+	s.DeviceQuery.Status.Result = ""
+
+	cond := conditions.FromError(err)
+	cond.Type = v1alpha1.ReadyCondition
+	if err == nil {
+		cond.Message = "Query executed successfully"
+		if rc := s.DeviceQuery.Spec.RunningConfig; rc != nil {
+			cond.Message = fmt.Sprintf("Running configuration written to ConfigMap %q", rc.ConfigMapName)
+		}
+		if len(res) > MaxDeviceQueryResultSize {
+			cond.Status = metav1.ConditionFalse
+			cond.Reason = v1alpha1.ResultTooLargeReason
+			cond.Message = fmt.Sprintf("Query result of %d bytes exceeds the maximum size of %d bytes, use a more specific path", len(res), MaxDeviceQueryResultSize)
+			res = nil
+		}
+		s.DeviceQuery.Status.Result = string(res)
+	}
+	conditions.Set(s.DeviceQuery, cond)
+
+	// Failed queries are not retried, as they are usually caused by an invalid path.
+	// The error is reported in the Ready condition instead.
+	return nil
+}
+
+// writeRunningConfig creates or updates the ConfigMap of the DeviceQuery with the given running configuration.
+// An existing ConfigMap that is not owned by the DeviceQuery is never overwritten.
+func (r *DeviceQueryReconciler) writeRunningConfig(ctx context.Context, s *devicequeryScope, cfg string) error {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.DeviceQuery.Spec.RunningConfig.ConfigMapName,
+			Namespace: s.DeviceQuery.Namespace,
+		},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, cm, func() error {
+		if !cm.CreationTimestamp.IsZero() && !metav1.IsControlledBy(cm, s.DeviceQuery) {
+			return fmt.Errorf("configmap %q already exists and is not owned by the device query", cm.Name)
+		}
+		if cm.Labels == nil {
+			cm.Labels = make(map[string]string)
+		}
+		cm.Labels[v1alpha1.DeviceLabel] = s.Device.Name
+		cm.Data = map[string]string{v1alpha1.DeviceQueryRunningConfigKey: cfg}
+		return controllerutil.SetControllerReference(s.DeviceQuery, cm, r.Scheme)
+	})
+	return err
+}
+
+// sensitiveCLIArgs matches the arguments of CLI commands that hold sensitive values, e.g. the password of a
+// user, the key of a TACACS+ server or the message digest key of an OSPF interface, together with their optional
+// encryption type.
+var sensitiveCLIArgs = regexp.MustCompile(`(?i)(\b(?:password|secret|key-string|authentication-key|key|community)|\bmessage-digest-key[ \t]+[0-9]+[ \t]+md5|\bauth[ \t]+(?:md5|sha\S*)|\bpriv(?:[ \t]+aes-128)?)((?:[ \t]+[0-9])?[ \t]+)("[^"]*"|\S+)`)
+
+// sensitiveCLIKeywords matches any line of the running configuration that may hold a sensitive value.
+var sensitiveCLIKeywords = regexp.MustCompile(`(?i)password|secret|key|community`)
+
+// redactRunningConfig replaces the sensitive values in the CLI text of the running configuration with a placeholder.
+// Lines that contain a sensitive keyword but whose value cannot be located are dropped entirely.
+func redactRunningConfig(cfg string) string {
+	lines := strings.SplitAfter(cfg, "\n")
+	out := lines[:0]
+	for _, line := range lines {
+		if !sensitiveCLIArgs.MatchString(line) {
+			if sensitiveCLIKeywords.MatchString(line) {
+				continue
+			}
+			out = append(out, line)
+			continue
+		}
+		out = append(out, sensitiveCLIArgs.ReplaceAllString(line, "$1$2<redacted>"))
+	}
+	return strings.Join(out, "")
+}
+
+// sensitiveKeys is a list of substrings of JSON object keys that identify sensitive values, e.g.
+// passwords, shared secrets or SNMP communities, which are redacted from the result of a DeviceQuery.
+var sensitiveKeys = []string{"pwd", "passw", "secret", "key", "community"}
+
+// redactDeviceQueryResult replaces the values of all object keys in the JSON document b that
+// match any of the [sensitiveKeys] with a placeholder. If the last element of the queried path
+// is sensitive itself, e.g. for a query of a password leaf, the whole document is replaced.
+func redactDeviceQueryResult(path string, b []byte) ([]byte, error) {
+	if len(b) == 0 {
+		return b, nil
+	}
+	if isSensitiveKey(lastPathElem(path)) {
+		return json.Marshal("<redacted>")
+	}
+	var v any
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to decode query result: %w", err)
+	}
+	return json.Marshal(redact(v))
+}
+
+func redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if isSensitiveKey(k) {
+				v[k] = "<redacted>"
+				continue
+			}
+			v[k] = redact(val)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redact(v[i])
+		}
+	}
+	return v
+}
+
+// lastPathElem returns the name of the last element of the xpath p, without its
+// key predicates, e.g. "pwd" for "System/userext-items/user-items/User-list[name=admin]/pwd".
+func lastPathElem(p string) string {
+	var elem strings.Builder
+	var depth int
+	for _, r := range p {
+		switch {
+		case r == '[':
+			depth++
+		case r == ']' && depth > 0:
+			depth--
+		case depth > 0:
+		case r == '/':
+			elem.Reset()
+		default:
+			elem.WriteRune(r)
+		}
+	}
+	name := elem.String()
+	if _, after, ok := strings.Cut(name, ":"); ok {
+		name = after
+	}
+	return name
+}
+
+func isSensitiveKey(k string) bool {
+	k = strings.ToLower(k)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DeviceQueryReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	labelSelector := metav1.LabelSelector{}
+	if r.WatchFilterValue != "" {
+		labelSelector.MatchLabels = map[string]string{v1alpha1.WatchLabel: r.WatchFilterValue}
+	}
+
+	filter, err := predicate.LabelSelectorPredicate(labelSelector)
+	if err != nil {
+		return fmt.Errorf("failed to create label selector predicate: %w", err)
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &v1alpha1.DeviceQuery{}, v1alpha1.DeviceRefIndexKey, func(obj client.Object) []string {
+		o := obj.(*v1alpha1.DeviceQuery)
+		return []string{o.Spec.DeviceRef.Name}
+	}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.DeviceQuery{}).
+		Named("devicequery").
+		WithOptions(r.Options).
+		WithEventFilter(filter).
+		// Watches enqueues DeviceQueries for updates in referenced Device resources.
+		// Triggers on create, delete, and update events when the device's effective pause state changes.
+		Watches(
+			&v1alpha1.Device{},
+			handler.EnqueueRequestsFromMapFunc(r.deviceToDeviceQueries),
+			builder.WithPredicates(paused.DevicePredicate()),
+		).
+		Complete(r)
+}
+
+// deviceToDeviceQueries is a [handler.MapFunc] to be used to enqueue requests for reconciliation
+func (r *DeviceQueryReconciler) deviceToDeviceQueries(ctx context.Context, obj client.Object) []ctrl.Request {
+	device, ok := obj.(*v1alpha1.Device)
+	if !ok {
+		panic(fmt.Sprintf("Expected a Device but got a %T", obj))
+	}
+
+	log := ctrl.LoggerFrom(ctx, "Device", klog.KObj(device))
+
+	list := new(v1alpha1.DeviceQueryList)
+	if err := r.List(
+		ctx, list,
+		client.InNamespace(device.Namespace),
+		client.MatchingFields{v1alpha1.DeviceRefIndexKey: device.Name},
+	); err != nil {
+		log.Error(err, "Failed to list DeviceQueries")
+		return nil
+	}
+
+	requests := make([]ctrl.Request, 0, len(list.Items))
+	for _, i := range list.Items {
+		log.V(2).Info("Enqueuing DeviceQuery for reconciliation", "DeviceQuery", klog.KObj(&i))
+		requests = append(requests, ctrl.Request{
+			NamespacedName: client.ObjectKey{
+				Name:      i.Name,
+				Namespace: i.Namespace,
+			},
+		})
+	}
+
+	return requests
+}
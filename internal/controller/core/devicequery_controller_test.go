@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+)
+
+var _ = Describe("DeviceQuery Controller", func() {
+	Context("When reconciling a resource", func() {
+		var (
+			name string
+			key  client.ObjectKey
+		)
+
+		BeforeEach(func() {
+			By("Creating the custom resource for the Kind Device")
+			device := &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-devicequery-",
+					Namespace:    metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+			name = device.Name
+			key = client.ObjectKey{Name: name, Namespace: metav1.NamespaceDefault}
+		})
+
+		AfterEach(func() {
+			var resource client.Object = &v1alpha1.DeviceQuery{}
+			err := k8sClient.Get(ctx, key, resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Cleanup the specific resource instance DeviceQuery")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+
+			resource = &v1alpha1.Device{}
+			err = k8sClient.Get(ctx, key, resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Cleanup the specific resource instance Device")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("Should successfully execute the query and redact sensitive values", func() {
+			By("Creating the custom resource for the Kind DeviceQuery")
+			query := &v1alpha1.DeviceQuery{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceQuerySpec{
+					DeviceRef: v1alpha1.LocalObjectReference{Name: name},
+					Path:      "System/userext-items/user-items",
+					DataType:  v1alpha1.DeviceQueryDataTypeConfig,
+				},
+			}
+			Expect(k8sClient.Create(ctx, query)).To(Succeed())
+
+			By("Adding the device label to the resource")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.DeviceQuery{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Labels).To(HaveKeyWithValue(v1alpha1.DeviceLabel, name))
+			}).Should(Succeed())
+
+			By("Updating the resource status")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.DeviceQuery{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				cond := meta.FindStatusCondition(resource.Status.Conditions, v1alpha1.ReadyCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(resource.Status.ObservedGeneration).To(Equal(resource.Generation))
+				g.Expect(resource.Status.QueryTime).ToNot(BeNil())
+				g.Expect(resource.Status.Result).To(MatchJSON(`{"name":"admin","pwdHash":"<redacted>","role":"network-admin"}`))
+			}).Should(Succeed())
+		})
+
+		It("Should redact the result of a query of a sensitive leaf", func() {
+			By("Creating the custom resource for the Kind DeviceQuery")
+			query := &v1alpha1.DeviceQuery{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceQuerySpec{
+					DeviceRef: v1alpha1.LocalObjectReference{Name: name},
+					Path:      "System/userext-items/user-items/User-list[name=admin]/pwd",
+					DataType:  v1alpha1.DeviceQueryDataTypeConfig,
+				},
+			}
+			Expect(k8sClient.Create(ctx, query)).To(Succeed())
+
+			By("Updating the resource status")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.DeviceQuery{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				cond := meta.FindStatusCondition(resource.Status.Conditions, v1alpha1.ReadyCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(resource.Status.Result).To(MatchJSON(`"<redacted>"`))
+			}).Should(Succeed())
+		})
+
+		It("Should write the redacted running configuration to a ConfigMap", func() {
+			By("Creating the custom resource for the Kind DeviceQuery")
+			query := &v1alpha1.DeviceQuery{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceQuerySpec{
+					DeviceRef:     v1alpha1.LocalObjectReference{Name: name},
+					RunningConfig: &v1alpha1.DeviceQueryRunningConfig{ConfigMapName: name},
+				},
+			}
+			Expect(k8sClient.Create(ctx, query)).To(Succeed())
+
+			By("Updating the resource status")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.DeviceQuery{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				cond := meta.FindStatusCondition(resource.Status.Conditions, v1alpha1.ReadyCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(resource.Status.ObservedGeneration).To(Equal(resource.Generation))
+				g.Expect(resource.Status.Result).To(BeEmpty())
+			}).Should(Succeed())
+
+			By("Writing the running configuration to the ConfigMap")
+			cm := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, key, cm)).To(Succeed())
+			Expect(cm.Data).To(HaveKeyWithValue(v1alpha1.DeviceQueryRunningConfigKey, "hostname leaf1\nusername admin password 5 <redacted> role network-admin\n"))
+			Expect(cm.OwnerReferences).To(ContainElement(HaveField("Name", name)))
+		})
+
+		It("Should report a failed query in the Ready condition", func() {
+			By("Creating the custom resource for the Kind DeviceQuery")
+			query := &v1alpha1.DeviceQuery{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceQuerySpec{
+					DeviceRef: v1alpha1.LocalObjectReference{Name: name},
+					Path:      "invalid",
+				},
+			}
+			Expect(k8sClient.Create(ctx, query)).To(Succeed())
+
+			By("Updating the resource status")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.DeviceQuery{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				cond := meta.FindStatusCondition(resource.Status.Conditions, v1alpha1.ReadyCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(resource.Status.ObservedGeneration).To(Equal(resource.Generation))
+				g.Expect(resource.Status.Result).To(BeEmpty())
+			}).Should(Succeed())
+		})
+	})
+})
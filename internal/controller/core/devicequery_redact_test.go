@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRedactDeviceQueryResult(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		in   string
+		want string
+	}{
+		{
+			name: "object keys",
+			path: "System/userext-items/user-items",
+			in:   `{"name":"admin","pwdHash":"$5$secret"}`,
+			want: `{"name":"admin","pwdHash":"<redacted>"}`,
+		},
+		{
+			name: "sensitive leaf",
+			path: "System/userext-items/user-items/User-list[name=admin]/pwd",
+			in:   `"$5$secret"`,
+			want: `"<redacted>"`,
+		},
+		{
+			name: "sensitive leaf with module prefix",
+			path: "Cisco-NX-OS-device:System/snmp-items/inst-items/community-items/CommSecP-list[name=public]/Cisco-NX-OS-device:commAcess",
+			in:   `"unspecified"`,
+			want: `"unspecified"`,
+		},
+		{
+			name: "sensitive key predicate",
+			path: "System/intf-items/phys-items/PhysIf-list[id=eth1/1,descr=key/secret]/descr",
+			in:   `"uplink"`,
+			want: `"uplink"`,
+		},
+		{
+			name: "sensitive container",
+			path: "System/snmp-items/inst-items/community-items",
+			in:   `{"CommSecP-list":[{"name":"public"}]}`,
+			want: `"<redacted>"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := redactDeviceQueryResult(test.path, []byte(test.in))
+			if err != nil {
+				t.Fatalf("redactDeviceQueryResult() error = %v", err)
+			}
+			var gotV, wantV any
+			if err := json.Unmarshal(got, &gotV); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(test.want), &wantV); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(gotV, wantV) {
+				t.Errorf("redactDeviceQueryResult() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRedactRunningConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "user password with encryption type",
+			in:   "username admin password 5 $5$secret role network-admin\n",
+			want: "username admin password 5 <redacted> role network-admin\n",
+		},
+		{
+			name: "tacacs server key quoted",
+			in:   "tacacs-server host 10.0.0.1 key 7 \"S3CR3T\"\n",
+			want: "tacacs-server host 10.0.0.1 key 7 <redacted>\n",
+		},
+		{
+			name: "ospf message digest key",
+			in:   "  ip ospf message-digest-key 1 md5 3 S3CR3T\n",
+			want: "  ip ospf message-digest-key 1 md5 3 <redacted>\n",
+		},
+		{
+			name: "ospf message digest key without encryption type",
+			in:   "  ip ospf message-digest-key 1 md5 S3CR3T\n",
+			want: "  ip ospf message-digest-key 1 md5 <redacted>\n",
+		},
+		{
+			name: "ospf authentication key",
+			in:   "  ip ospf authentication-key 3 S3CR3T\n",
+			want: "  ip ospf authentication-key 3 <redacted>\n",
+		},
+		{
+			name: "key chain key string",
+			in:   "    key-string 7 S3CR3T\n",
+			want: "    key-string 7 <redacted>\n",
+		},
+		{
+			name: "key string without encryption type",
+			in:   "    key-string S3CR3T\n",
+			want: "    key-string <redacted>\n",
+		},
+		{
+			name: "bgp neighbor password",
+			in:   "    password 3 S3CR3T\n",
+			want: "    password 3 <redacted>\n",
+		},
+		{
+			name: "snmp user",
+			in:   "snmp-server user admin network-admin auth sha 0xabcd priv aes-128 0xef01 localizedkey\n",
+			want: "snmp-server user admin network-admin auth sha <redacted> priv aes-128 <redacted> localizedkey\n",
+		},
+		{
+			name: "snmp community",
+			in:   "snmp-server community S3CR3T group network-operator\n",
+			want: "snmp-server community <redacted> group network-operator\n",
+		},
+		{
+			name: "unparseable sensitive line is dropped",
+			in:   "hostname leaf1\n  ip ospf authentication key-chain S3CR3T\ninterface Ethernet1/1\n",
+			want: "hostname leaf1\ninterface Ethernet1/1\n",
+		},
+		{
+			name: "keyword without value is dropped",
+			in:   "hostname leaf1\nsecret\n",
+			want: "hostname leaf1\n",
+		},
+		{
+			name: "no sensitive values",
+			in:   "hostname leaf1\ninterface Ethernet1/1\n  no shutdown",
+			want: "hostname leaf1\ninterface Ethernet1/1\n  no shutdown",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := redactRunningConfig(test.in); got != test.want {
+				t.Errorf("redactRunningConfig() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -34,6 +35,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -60,6 +62,9 @@ type DHCPRelayReconciler struct {
 	// RequeueInterval is the duration after which the controller should requeue the reconciliation,
 	// regardless of changes.
 	RequeueInterval time.Duration
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=dhcprelays,verbs=get;list;watch;create;update;patch;delete
@@ -152,6 +157,9 @@ func (r *DHCPRelayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -261,21 +269,24 @@ func (r *DHCPRelayReconciler) reconcile(ctx context.Context, s *dhcprelayScope)
 		}
 	}()
 
-	// Ensure the DHCPRelay is realized on the remote device.
-	err = s.Provider.EnsureDHCPRelay(ctx, &provider.DHCPRelayRequest{
-		DHCPRelay:      s.DHCPRelay,
-		ProviderConfig: s.ProviderConfig,
-		Interfaces:     interfaces,
-		VRF:            vrf,
-	})
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if !maintenance.DeferChanges(s.Device, s.DHCPRelay) {
+		// Ensure the DHCPRelay is realized on the remote device.
+		err = s.Provider.EnsureDHCPRelay(ctx, &provider.DHCPRelayRequest{
+			DHCPRelay:      s.DHCPRelay,
+			ProviderConfig: s.ProviderConfig,
+			Interfaces:     interfaces,
+			VRF:            vrf,
+		})
 
-	cond := conditions.FromError(err)
-	// As this resource is configuration only, we use the Configured condition as top-level Ready condition.
-	cond.Type = v1alpha1.ReadyCondition
-	conditions.Set(s.DHCPRelay, cond)
+		cond := conditions.FromError(err)
+		// As this resource is configuration only, we use the Configured condition as top-level Ready condition.
+		cond.Type = v1alpha1.ReadyCondition
+		conditions.Set(s.DHCPRelay, cond)
 
-	if err != nil {
-		return err
+		if err != nil {
+			return err
+		}
 	}
 
 	// Retrieve and update the status from the device; this include the list of interfaces that are actually configured on the device.
@@ -319,6 +330,7 @@ func (r *DHCPRelayReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Man
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.DHCPRelay{}).
 		Named("dhcprelay").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.DHCPRelayDependencies {
@@ -338,14 +350,7 @@ func (r *DHCPRelayReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Man
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToDHCPRelays),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		// Watches enqueues DHCPRelays when referenced Interface resources are configured.
 		Watches(
@@ -602,6 +607,12 @@ func (r *DHCPRelayReconciler) mapProviderConfigToDHCPRelay(ctx context.Context,
 }
 
 func (r *DHCPRelayReconciler) finalize(ctx context.Context, s *dhcprelayScope) (reterr error) {
+	// The DHCP relay configuration of a duplicate was never applied. It belongs to the DHCPRelay
+	// accepted for the device, which must not lose it when the duplicate is deleted.
+	if cond := conditions.Get(s.DHCPRelay, v1alpha1.ConfiguredCondition); cond != nil && cond.Reason == v1alpha1.DuplicateResourceOnDevice {
+		return nil
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
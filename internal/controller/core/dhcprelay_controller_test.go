@@ -272,6 +272,14 @@
 
 			By("Cleaning up the duplicate DHCPRelay resource")
 			Expect(k8sClient.Delete(ctx, duplicateDHCPRelay)).To(Succeed())
+
+			By("Verifying the configuration of the first DHCPRelay is retained in the provider")
+			Eventually(func(g Gomega) {
+				err := k8sClient.Get(ctx, duplicateKey, &v1alpha1.DHCPRelay{})
+				g.Expect(errors.IsNotFound(err)).To(BeTrue())
+			}).Should(Succeed())
+			Expect(testProvider.DHCPRelay).ToNot(BeNil(), "Provider DHCPRelay should not be nil")
+			Expect(testProvider.DHCPRelay.GetName()).To(Equal(resourceName))
 		})
 
 		It("Should properly handle deletion and cleanup", func() {
@@ -22,6 +22,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -32,6 +33,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -58,6 +60,9 @@ type EthernetSegmentReconciler struct {
 	// RequeueInterval is the duration after which the controller should requeue the reconciliation,
 	// in order to periodically reconcile the resource.
 	RequeueInterval time.Duration
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=ethernetsegments,verbs=get;list;watch;create;update;patch;delete
@@ -150,6 +155,9 @@ func (r *EthernetSegmentReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -239,6 +247,7 @@ func (r *EthernetSegmentReconciler) SetupWithManager(ctx context.Context, mgr ct
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.EthernetSegment{}).
 		Named("ethernetsegment").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.EthernetSegmentDependencies {
@@ -274,14 +283,7 @@ func (r *EthernetSegmentReconciler) SetupWithManager(ctx context.Context, mgr ct
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToEthernetSegments),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		Complete(r)
 }
@@ -318,6 +320,10 @@ func (r *EthernetSegmentReconciler) reconcile(ctx context.Context, s *ethernetSe
 		return err
 	}
 
+	if err := r.validateElectionWaitTime(ctx, s); err != nil {
+		return err
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
@@ -327,18 +333,21 @@ func (r *EthernetSegmentReconciler) reconcile(ctx context.Context, s *ethernetSe
 		}
 	}()
 
-	// Ensure the EthernetSegment is realized on the provider.
-	err = s.Provider.EnsureEthernetSegment(ctx, &provider.EnsureEthernetSegmentRequest{
-		EthernetSegment: s.EthernetSegment,
-		Interface:       intf,
-		ProviderConfig:  s.ProviderConfig,
-	})
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if !maintenance.DeferChanges(s.Device, s.EthernetSegment) {
+		// Ensure the EthernetSegment is realized on the provider.
+		err = s.Provider.EnsureEthernetSegment(ctx, &provider.EnsureEthernetSegmentRequest{
+			EthernetSegment: s.EthernetSegment,
+			Interface:       intf,
+			ProviderConfig:  s.ProviderConfig,
+		})
 
-	cond := conditions.FromError(err)
-	conditions.Set(s.EthernetSegment, cond)
+		cond := conditions.FromError(err)
+		conditions.Set(s.EthernetSegment, cond)
 
-	if err != nil {
-		return err
+		if err != nil {
+			return err
+		}
 	}
 
 	status, err := s.Provider.GetEthernetSegmentStatus(ctx, &provider.EthernetSegmentStatusRequest{
@@ -353,7 +362,7 @@ func (r *EthernetSegmentReconciler) reconcile(ctx context.Context, s *ethernetSe
 	s.EthernetSegment.Status.ESI = status.ESI
 	s.EthernetSegment.Status.ESIType = esiTypeFromValue(status.ESI)
 
-	cond = metav1.Condition{
+	cond := metav1.Condition{
 		Type:    v1alpha1.OperationalCondition,
 		Status:  metav1.ConditionTrue,
 		Reason:  v1alpha1.OperationalReason,
@@ -369,6 +378,41 @@ func (r *EthernetSegmentReconciler) reconcile(ctx context.Context, s *ethernetSe
 	return nil
 }
 
+// validateElectionWaitTime ensures that the Designated Forwarder election wait time
+// is consistent with all other EthernetSegments on the same device.
+func (r *EthernetSegmentReconciler) validateElectionWaitTime(ctx context.Context, s *ethernetSegmentScope) error {
+	df := s.EthernetSegment.Spec.DesignatedForwarder
+	if df == nil || df.ElectionWaitTime == nil {
+		return nil
+	}
+
+	var list v1alpha1.EthernetSegmentList
+	if err := r.List(
+		ctx, &list,
+		client.InNamespace(s.EthernetSegment.Namespace),
+		client.MatchingFields{v1alpha1.DeviceRefIndexKey: s.EthernetSegment.Spec.DeviceRef.Name},
+	); err != nil {
+		return err
+	}
+
+	for _, es := range list.Items {
+		if es.Name == s.EthernetSegment.Name || es.Spec.DesignatedForwarder == nil || es.Spec.DesignatedForwarder.ElectionWaitTime == nil {
+			continue
+		}
+		if es.Spec.DesignatedForwarder.ElectionWaitTime.Duration != df.ElectionWaitTime.Duration {
+			conditions.Set(s.EthernetSegment, metav1.Condition{
+				Type:    v1alpha1.ConfiguredCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  v1alpha1.DFElectionWaitTimeMismatchReason,
+				Message: fmt.Sprintf("EthernetSegment %s uses a different DF election wait time (%s)", es.Name, es.Spec.DesignatedForwarder.ElectionWaitTime.Duration),
+			})
+			return reconcile.TerminalError(fmt.Errorf("df election wait time %s conflicts with ethernet segment %s", df.ElectionWaitTime.Duration, es.Name))
+		}
+	}
+
+	return nil
+}
+
 func esiTypeFromValue(esi string) v1alpha1.ESIType {
 	if len(esi) < 2 {
 		return ""
@@ -4,6 +4,8 @@
 package core
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -399,5 +401,81 @@
 				g.Expect(resource.Status.ESIType).To(Equal(v1alpha1.ESITypeMAC))
 			}).Should(Succeed())
 		})
+
+		It("Should reject an EthernetSegment with a conflicting DF election wait time", func() {
+			By("Creating an Aggregate Interface with switchport config")
+			intf := &v1alpha1.Interface{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.InterfaceSpec{
+					DeviceRef:  v1alpha1.LocalObjectReference{Name: name},
+					Name:       "port-channel30",
+					Type:       v1alpha1.InterfaceTypeAggregate,
+					AdminState: v1alpha1.AdminStateUp,
+					Switchport: &v1alpha1.Switchport{
+						Mode: v1alpha1.SwitchportModeTrunk,
+					},
+					Aggregation: &v1alpha1.Aggregation{
+						MemberInterfaceRefs: []v1alpha1.LocalObjectReference{{Name: "eth1"}},
+						ControlProtocol:     v1alpha1.ControlProtocol{Mode: v1alpha1.LACPModeActive},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, intf)).To(Succeed())
+
+			By("Creating an EthernetSegment with a DF election wait time of 3s")
+			other := &v1alpha1.EthernetSegment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name + "-other",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.EthernetSegmentSpec{
+					DeviceRef:      v1alpha1.LocalObjectReference{Name: name},
+					InterfaceRef:   v1alpha1.LocalObjectReference{Name: "non-existent-intf"},
+					ESIType:        v1alpha1.ESITypeArbitrary,
+					ESI:            "00:11:22:33:44:55:66:77:88:02",
+					RedundancyMode: v1alpha1.RedundancyModeAllActive,
+					DesignatedForwarder: &v1alpha1.DesignatedForwarder{
+						ElectionMode:     v1alpha1.DFElectionModeDefault,
+						ElectionWaitTime: &metav1.Duration{Duration: 3 * time.Second},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, other)).To(Succeed())
+
+			By("Creating an EthernetSegment with a DF election wait time of 5s")
+			es := &v1alpha1.EthernetSegment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.EthernetSegmentSpec{
+					DeviceRef:      v1alpha1.LocalObjectReference{Name: name},
+					InterfaceRef:   v1alpha1.LocalObjectReference{Name: name},
+					ESIType:        v1alpha1.ESITypeArbitrary,
+					ESI:            esi,
+					RedundancyMode: v1alpha1.RedundancyModeAllActive,
+					DesignatedForwarder: &v1alpha1.DesignatedForwarder{
+						ElectionMode:     v1alpha1.DFElectionModeDefault,
+						ElectionWaitTime: &metav1.Duration{Duration: 5 * time.Second},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, es)).To(Succeed())
+
+			By("Verifying the controller sets DFElectionWaitTimeMismatch status")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.EthernetSegment{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.Conditions).To(HaveLen(4))
+				g.Expect(resource.Status.Conditions[0].Type).To(Equal(v1alpha1.ReadyCondition))
+				g.Expect(resource.Status.Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(resource.Status.Conditions[1].Type).To(Equal(v1alpha1.ConfiguredCondition))
+				g.Expect(resource.Status.Conditions[1].Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(resource.Status.Conditions[1].Reason).To(Equal(v1alpha1.DFElectionWaitTimeMismatchReason))
+			}).Should(Succeed())
+		})
 	})
 })
@@ -22,6 +22,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -32,6 +33,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -54,6 +56,9 @@ type EVPNInstanceReconciler struct {
 
 	// Locker is used to synchronize operations on resources targeting the same device.
 	Locker *resourcelock.ResourceLocker
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=evpninstances,verbs=get;list;watch;create;update;patch;delete
@@ -148,6 +153,9 @@ func (r *EVPNInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -251,6 +259,7 @@ func (r *EVPNInstanceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.EVPNInstance{}).
 		Named("evpninstance").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.EVPNInstanceDependencies {
@@ -294,18 +303,12 @@ func (r *EVPNInstanceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.
 			}),
 		).
 		// Watches enqueues EVPNInstances for updates in referenced Device resources.
-		// Triggers on create, delete, and update events when the device's effective pause state changes.
+		// Triggers on create, delete, and update events when the device's effective pause state
+		// or its ownership ranges change.
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToEVPNInstances),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate(ownershipChanged)),
 		).
 		Complete(r)
 }
@@ -351,6 +354,24 @@ func (r *EVPNInstanceReconciler) reconcile(ctx context.Context, s *eviScope) (re
 		}
 	}
 
+	// Refuse to touch VLANs and VRFs not assigned to the operator on shared devices.
+	var vlans []int16
+	if vlan != nil {
+		vlans = append(vlans, vlan.Spec.ID)
+	}
+	var vrfs []string
+	if vrf != nil {
+		vrfs = append(vrfs, vrf.Spec.Name)
+	}
+	if err := ensureOwned(s.Device, s.EVPNInstance, v1alpha1.ReadyCondition, vlans, vrfs); err != nil {
+		return err
+	}
+
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if maintenance.DeferChanges(s.Device, s.EVPNInstance) {
+		return nil
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
@@ -493,6 +514,11 @@ func (r *EVPNInstanceReconciler) finalize(ctx context.Context, s *eviScope) (ret
 		}
 	}
 
+	if refusedAsNotOwned(s.EVPNInstance, v1alpha1.ReadyCondition) {
+		ctrl.LoggerFrom(ctx).Info("EVPNInstance uses a VLAN or VRF not owned by the operator, skipping deletion on the device")
+		return nil
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
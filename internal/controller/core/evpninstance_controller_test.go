@@ -156,6 +156,67 @@
 			}).Should(Succeed())
 		})
 
+		It("Should refuse an EVPNInstance for a VLAN not owned by the operator", func() {
+			By("Restricting the VLANs owned by the operator on the device")
+			device := &v1alpha1.Device{}
+			Expect(k8sClient.Get(ctx, key, device)).To(Succeed())
+			device.Spec.Ownership = &v1alpha1.DeviceOwnership{VLANs: []v1alpha1.IndexRange{{Start: 100, End: 199}}}
+			Expect(k8sClient.Update(ctx, device)).To(Succeed())
+
+			By("Creating a VLAN outside of the owned ranges")
+			vlan := &v1alpha1.VLAN{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.VLANSpec{
+					DeviceRef:  v1alpha1.LocalObjectReference{Name: name},
+					ID:         10,
+					Name:       "vlan-10",
+					AdminState: v1alpha1.AdminStateUp,
+				},
+			}
+			Expect(k8sClient.Create(ctx, vlan)).To(Succeed())
+
+			By("Creating an EVPNInstance referencing the VLAN")
+			evi := &v1alpha1.EVPNInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.EVPNInstanceSpec{
+					DeviceRef:             v1alpha1.LocalObjectReference{Name: name},
+					VNI:                   vni,
+					Type:                  v1alpha1.EVPNInstanceTypeBridged,
+					MulticastGroupAddress: "239.1.1.100",
+					RouteDistinguisher:    "10.0.0.10:65000",
+					RouteTargets: []v1alpha1.EVPNRouteTarget{
+						{
+							Value:  "65000:100010",
+							Action: v1alpha1.RouteTargetActionBoth,
+						},
+					},
+					VLANRef: &v1alpha1.LocalObjectReference{Name: name},
+				},
+			}
+			Expect(k8sClient.Create(ctx, evi)).To(Succeed())
+
+			By("Verifying the controller sets not owned status")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.EVPNInstance{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.Conditions).ToNot(BeEmpty())
+				g.Expect(resource.Status.Conditions[0].Type).To(Equal(v1alpha1.ReadyCondition))
+				g.Expect(resource.Status.Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(resource.Status.Conditions[0].Reason).To(Equal(v1alpha1.NotOwnedReason))
+			}).Should(Succeed())
+
+			By("Verifying the EVPNInstance is not configured in the provider")
+			Consistently(func(g Gomega) {
+				g.Expect(testProvider.EVIs.Has(vni)).To(BeFalse(), "Provider shouldn't have VNI configured")
+			}).Should(Succeed())
+		})
+
 		It("Should handle EVPNInstance referencing non-existent VLAN", func() {
 			By("Creating an EVPNInstance referencing a non-existent VLAN")
 			evi := &v1alpha1.EVPNInstance{
@@ -7,8 +7,10 @@
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/netip"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,6 +29,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -37,6 +40,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -63,6 +67,9 @@ type InterfaceReconciler struct {
 	// RequeueInterval is the duration after which the controller should requeue the reconciliation,
 	// regardless of changes.
 	RequeueInterval time.Duration
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=interfaces,verbs=get;list;watch;create;update;patch;delete
@@ -71,6 +78,8 @@ type InterfaceReconciler struct {
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=vlans,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=vlans/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=vrfs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=accesscontrollists,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=qospolicies,verbs=get;list;watch
 // +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -158,6 +167,9 @@ func (r *InterfaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -219,6 +231,8 @@ func (r *InterfaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	interfaceUnnumberedRefKey = ".spec.ipv4.unnumbered.interfaceRef.name"
 	interfaceVlanRefKey       = ".spec.vlanRef.name"
 	interfaceVrfRefKey        = ".spec.vrfRef.name"
+	interfaceACLRefKey        = ".spec.accessGroups.accessControlListRef.name"
+	interfaceQoSPolicyRefKey  = ".spec.servicePolicies.qosPolicyRef.name"
 	interfaceParentRefKey     = ".spec.parentInterfaceRef.name"
 )
 
@@ -275,6 +289,28 @@ func (r *InterfaceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Man
 		return err
 	}
 
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &v1alpha1.Interface{}, interfaceACLRefKey, func(obj client.Object) []string {
+		intf := obj.(*v1alpha1.Interface)
+		names := make([]string, 0, len(intf.Spec.AccessGroups))
+		for _, ag := range intf.Spec.AccessGroups {
+			names = append(names, ag.AccessControlListRef.Name)
+		}
+		return names
+	}); err != nil {
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &v1alpha1.Interface{}, interfaceQoSPolicyRefKey, func(obj client.Object) []string {
+		intf := obj.(*v1alpha1.Interface)
+		names := make([]string, 0, len(intf.Spec.ServicePolicies))
+		for _, sp := range intf.Spec.ServicePolicies {
+			names = append(names, sp.QoSPolicyRef.Name)
+		}
+		return names
+	}); err != nil {
+		return err
+	}
+
 	if err := mgr.GetFieldIndexer().IndexField(ctx, &v1alpha1.Interface{}, v1alpha1.DeviceRefIndexKey, func(obj client.Object) []string {
 		o := obj.(*v1alpha1.Interface)
 		return []string{o.Spec.DeviceRef.Name}
@@ -300,6 +336,7 @@ func (r *InterfaceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Man
 			interfaceUpdatePredicate{},
 		))).
 		Named("interface").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.InterfaceDependencies {
@@ -342,12 +379,13 @@ func (r *InterfaceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Man
 			}),
 		).
 		// Watches enqueues Aggregate Interfaces for updates in referenced member resources.
+		// Updates only trigger when the MTU of the member changes, as it must match the MTU of the aggregate.
 		Watches(
 			&v1alpha1.Interface{},
 			handler.EnqueueRequestsFromMapFunc(r.interfaceToAggregate),
 			builder.WithPredicates(predicate.Funcs{
 				UpdateFunc: func(e event.UpdateEvent) bool {
-					return false
+					return e.ObjectOld.(*v1alpha1.Interface).Spec.MTU != e.ObjectNew.(*v1alpha1.Interface).Spec.MTU
 				},
 				GenericFunc: func(e event.GenericEvent) bool {
 					return false
@@ -369,6 +407,7 @@ func (r *InterfaceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Man
 					// Only trigger when fields that affect member Physical interface
 					// reconciliation change (e.g. layer, VRF membership, MTU).
 					return !equality.Semantic.DeepEqual(oldIntf.Spec.IPv4, newIntf.Spec.IPv4) ||
+						!equality.Semantic.DeepEqual(oldIntf.Spec.IPv6, newIntf.Spec.IPv6) ||
 						!equality.Semantic.DeepEqual(oldIntf.Spec.Switchport, newIntf.Spec.Switchport) ||
 						!equality.Semantic.DeepEqual(oldIntf.Spec.VrfRef, newIntf.Spec.VrfRef) ||
 						oldIntf.Spec.MTU != newIntf.Spec.MTU
@@ -381,6 +420,29 @@ func (r *InterfaceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Man
 				},
 			}),
 		).
+		// Watches enqueues RoutedVLAN Interfaces when the MTU or switchport configuration of an Interface
+		// on the same device changes, as the MTU of a RoutedVLAN must not exceed the MTU of its switchports.
+		Watches(
+			&v1alpha1.Interface{},
+			handler.EnqueueRequestsFromMapFunc(r.switchportToRoutedVLANs),
+			builder.WithPredicates(predicate.Funcs{
+				CreateFunc: func(e event.CreateEvent) bool {
+					return false
+				},
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					oldIntf := e.ObjectOld.(*v1alpha1.Interface)
+					newIntf := e.ObjectNew.(*v1alpha1.Interface)
+					return oldIntf.Spec.MTU != newIntf.Spec.MTU ||
+						!equality.Semantic.DeepEqual(oldIntf.Spec.Switchport, newIntf.Spec.Switchport)
+				},
+				DeleteFunc: func(e event.DeleteEvent) bool {
+					return false
+				},
+				GenericFunc: func(e event.GenericEvent) bool {
+					return false
+				},
+			}),
+		).
 		// Watches enqueues RoutedVLAN Interfaces for updates in referenced VLAN resources.
 		// Only triggers on create and delete events since VLAN IDs are immutable.
 		Watches(
@@ -409,20 +471,42 @@ func (r *InterfaceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Man
 				},
 			}),
 		).
-		// Watches enqueues Interfaces for updates in referenced Device resources.
-		// Triggers on create, delete, and update events when the device's effective pause state changes.
+		// Watches enqueues Interfaces for updates in referenced AccessControlList resources.
+		// Only triggers on create and delete events since AccessControlList names are immutable.
 		Watches(
-			&v1alpha1.Device{},
-			handler.EnqueueRequestsFromMapFunc(r.deviceToInterfaces),
+			&v1alpha1.AccessControlList{},
+			handler.EnqueueRequestsFromMapFunc(r.aclToInterfaces),
+			builder.WithPredicates(predicate.Funcs{
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					return false
+				},
+				GenericFunc: func(e event.GenericEvent) bool {
+					return false
+				},
+			}),
+		).
+		// Watches enqueues Interfaces for updates in referenced QoSPolicy resources.
+		// Only triggers on create and delete events since QoSPolicy names are immutable.
+		Watches(
+			&v1alpha1.QoSPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.qosPolicyToInterfaces),
 			builder.WithPredicates(predicate.Funcs{
 				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
+					return false
 				},
 				GenericFunc: func(e event.GenericEvent) bool {
 					return false
 				},
 			}),
 		).
+		// Watches enqueues Interfaces for updates in referenced Device resources.
+		// Triggers on create, delete, and update events when the device's effective pause state
+		// or its ownership ranges change.
+		Watches(
+			&v1alpha1.Device{},
+			handler.EnqueueRequestsFromMapFunc(r.deviceToInterfaces),
+			builder.WithPredicates(paused.DevicePredicate(ownershipChanged)),
+		).
 		// Watches enqueues Interfaces that have neighbor labels pointing to interfaces
 		// on a device when the DNS resource associated with that device changes. This ensures LLDP
 		// validation is re-evaluated when DNS domain changes affect FQDN matching.
@@ -549,6 +633,9 @@ func (r *InterfaceReconciler) reconcile(ctx context.Context, s *scope) (reterr e
 		if err != nil {
 			return err
 		}
+		if err := r.validateRoutedVLANMTU(ctx, s, vlan); err != nil {
+			return err
+		}
 	}
 
 	var vrf *v1alpha1.VRF
@@ -560,6 +647,37 @@ func (r *InterfaceReconciler) reconcile(ctx context.Context, s *scope) (reterr e
 		}
 	}
 
+	// Refuse to touch VLANs and VRFs not assigned to the operator on shared devices.
+	var vlans []int16
+	if vlan != nil {
+		vlans = append(vlans, vlan.Spec.ID)
+	}
+	var vrfs []string
+	if vrf != nil {
+		vrfs = append(vrfs, vrf.Spec.Name)
+	}
+	if err := ensureOwned(s.Device, s.Interface, v1alpha1.ConfiguredCondition, vlans, vrfs); err != nil {
+		return err
+	}
+
+	var accessGroups []provider.AccessGroup
+	if len(s.Interface.Spec.AccessGroups) > 0 {
+		var err error
+		accessGroups, err = r.reconcileAccessGroups(ctx, s)
+		if err != nil {
+			return err
+		}
+	}
+
+	var servicePolicies []provider.ServicePolicy
+	if len(s.Interface.Spec.ServicePolicies) > 0 {
+		var err error
+		servicePolicies, err = r.reconcileServicePolicies(ctx, s)
+		if err != nil {
+			return err
+		}
+	}
+
 	var ip provider.IPv4
 	if s.Interface.Spec.IPv4 != nil && (len(s.Interface.Spec.IPv4.Addresses) > 0 || s.Interface.Spec.IPv4.Unnumbered != nil) {
 		var err error
@@ -578,23 +696,31 @@ func (r *InterfaceReconciler) reconcile(ctx context.Context, s *scope) (reterr e
 		}
 	}()
 
-	// Ensure the Interface is realized on the provider.
-	err := s.Provider.EnsureInterface(ctx, &provider.EnsureInterfaceRequest{
-		Interface:       s.Interface,
-		ProviderConfig:  s.ProviderConfig,
-		IPv4:            ip,
-		Members:         members,
-		MultiChassisID:  multiChassisID,
-		AggregateParent: aggregateParent,
-		VLAN:            vlan,
-		VRF:             vrf,
-	})
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if !maintenance.DeferChanges(s.Device, s.Interface) {
+		// Ensure the Interface is realized on the provider, in a single transaction if supported,
+		// so that a failure doesn't leave a partially configured interface on the device.
+		err := inTransaction(ctx, s.Provider, func(ctx context.Context, p provider.InterfaceProvider) error {
+			return p.EnsureInterface(ctx, &provider.EnsureInterfaceRequest{
+				Interface:       s.Interface,
+				ProviderConfig:  s.ProviderConfig,
+				IPv4:            ip,
+				Members:         members,
+				MultiChassisID:  multiChassisID,
+				AggregateParent: aggregateParent,
+				VLAN:            vlan,
+				VRF:             vrf,
+				AccessGroups:    accessGroups,
+				ServicePolicies: servicePolicies,
+			})
+		})
 
-	cond := conditions.FromError(err)
-	conditions.Set(s.Interface, cond)
+		cond := conditions.FromError(err)
+		conditions.Set(s.Interface, cond)
 
-	if err != nil {
-		return err
+		if err != nil {
+			return err
+		}
 	}
 
 	status, err := s.Provider.GetInterfaceStatus(ctx, &provider.InterfaceRequest{
@@ -635,6 +761,30 @@ func (r *InterfaceReconciler) reconcileInterfaceStatus(ctx context.Context, s *s
 		cond.Message = fmt.Sprintf("Device returned %q", status.OperMessage)
 	}
 	conditions.Set(s.Interface, cond)
+
+	updateFECStatus(s.Interface, status.FEC)
+
+	s.Interface.Status.OperMTU = status.OperMTU
+
+	s.Interface.Status.LACP = nil
+	if lacp := status.LACP; lacp != nil {
+		s.Interface.Status.LACP = &v1alpha1.InterfaceLACPStatus{
+			SystemID:       lacp.SystemID,
+			SystemPriority: int32(lacp.SystemPriority),
+		}
+	}
+
+	// A switchport configuration that was accepted by the device may still not take effect,
+	// e.g. due to a conflicting feature. Report the divergence instead of a Ready interface.
+	s.Interface.Status.SwitchportMode = status.SwitchportMode
+	if sw := s.Interface.Spec.Switchport; sw != nil && status.SwitchportMode != "" && status.SwitchportMode != sw.Mode {
+		conditions.Set(s.Interface, metav1.Condition{
+			Type:    v1alpha1.ConfiguredCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1alpha1.SwitchportModeMismatchReason,
+			Message: fmt.Sprintf("Switchport mode %s is configured, but the device applied mode %s", sw.Mode, status.SwitchportMode),
+		})
+	}
 }
 
 // updateNeighborAdjacenciesStatus updates the Interface status with the LLDP neighbor adjacencies returned by the provider.
@@ -895,6 +1045,50 @@ func (r *InterfaceReconciler) reconcileVLAN(ctx context.Context, s *scope) (*v1a
 	return vlan, nil
 }
 
+// validateRoutedVLANMTU ensures that the MTU of a RoutedVLAN Interface does not exceed the MTU of any switchport
+// Interface on the same device that carries its VLAN. Interfaces without an explicit MTU are not considered,
+// as their MTU depends on the platform default.
+func (r *InterfaceReconciler) validateRoutedVLANMTU(ctx context.Context, s *scope, vlan *v1alpha1.VLAN) error {
+	if s.Interface.Spec.MTU == 0 {
+		return nil
+	}
+
+	interfaces := new(v1alpha1.InterfaceList)
+	if err := r.List(ctx, interfaces, client.InNamespace(s.Interface.Namespace), client.MatchingFields{v1alpha1.DeviceRefIndexKey: s.Device.Name}); err != nil {
+		return fmt.Errorf("failed to list interfaces: %w", err)
+	}
+
+	for _, intf := range interfaces.Items {
+		if intf.Spec.MTU == 0 || intf.Spec.MTU >= s.Interface.Spec.MTU || !carriesVLAN(intf.Spec.Switchport, vlan.Spec.ID) {
+			continue
+		}
+		conditions.Set(s.Interface, metav1.Condition{
+			Type:    v1alpha1.ConfiguredCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1alpha1.MTUMismatchReason,
+			Message: fmt.Sprintf("MTU %d exceeds the MTU %d of switchport interface %q carrying VLAN %d", s.Interface.Spec.MTU, intf.Spec.MTU, intf.Name, vlan.Spec.ID),
+		})
+		return reconcile.TerminalError(fmt.Errorf("MTU %d exceeds the MTU %d of switchport interface %q carrying VLAN %d", s.Interface.Spec.MTU, intf.Spec.MTU, intf.Name, vlan.Spec.ID))
+	}
+
+	return nil
+}
+
+// carriesVLAN reports whether the switchport configuration sp carries traffic of the VLAN with the given id.
+func carriesVLAN(sp *v1alpha1.Switchport, id int16) bool {
+	if sp == nil {
+		return false
+	}
+	switch sp.Mode {
+	case v1alpha1.SwitchportModeAccess:
+		return sp.AccessVlan == int32(id)
+	case v1alpha1.SwitchportModeTrunk:
+		return len(sp.AllowedVlans) == 0 || slices.Contains(sp.AllowedVlans, int32(id))
+	default:
+		return false
+	}
+}
+
 // reconcileVRF ensures that the referenced VRF exists and belongs to the same device as the Interface.
 // It also adds a label to the Interface indicating which VRF it belongs to. This can be used for lookup purposes.
 func (r *InterfaceReconciler) reconcileVRF(ctx context.Context, s *scope) (*v1alpha1.VRF, error) {
@@ -939,6 +1133,82 @@ func (r *InterfaceReconciler) reconcileVRF(ctx context.Context, s *scope) (*v1al
 	return vrf, nil
 }
 
+// reconcileAccessGroups ensures that the referenced AccessControlLists exist and belong to the same device as the Interface.
+func (r *InterfaceReconciler) reconcileAccessGroups(ctx context.Context, s *scope) ([]provider.AccessGroup, error) {
+	groups := make([]provider.AccessGroup, 0, len(s.Interface.Spec.AccessGroups))
+	for _, ag := range s.Interface.Spec.AccessGroups {
+		key := client.ObjectKey{
+			Name:      ag.AccessControlListRef.Name,
+			Namespace: s.Interface.Namespace,
+		}
+
+		acl := new(v1alpha1.AccessControlList)
+		if err := r.Get(ctx, key, acl); err != nil {
+			if apierrors.IsNotFound(err) {
+				conditions.Set(s.Interface, metav1.Condition{
+					Type:    v1alpha1.ConfiguredCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  v1alpha1.AccessControlListNotFoundReason,
+					Message: fmt.Sprintf("referenced AccessControlList %q not found", key),
+				})
+				return nil, reconcile.TerminalError(fmt.Errorf("referenced AccessControlList %q not found", key))
+			}
+			return nil, fmt.Errorf("failed to get referenced AccessControlList %q: %w", key, err)
+		}
+
+		if acl.Spec.DeviceRef.Name != s.Device.Name {
+			conditions.Set(s.Interface, metav1.Condition{
+				Type:    v1alpha1.ConfiguredCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  v1alpha1.CrossDeviceReferenceReason,
+				Message: fmt.Sprintf("referenced AccessControlList %q does not belong to device %q", acl.Name, s.Device.Name),
+			})
+			return nil, reconcile.TerminalError(fmt.Errorf("referenced AccessControlList %q does not belong to device %q", acl.Name, s.Device.Name))
+		}
+
+		groups = append(groups, provider.AccessGroup{Direction: ag.Direction, ACL: acl})
+	}
+	return groups, nil
+}
+
+// reconcileServicePolicies ensures that the referenced QoSPolicies exist and belong to the same device as the Interface.
+func (r *InterfaceReconciler) reconcileServicePolicies(ctx context.Context, s *scope) ([]provider.ServicePolicy, error) {
+	policies := make([]provider.ServicePolicy, 0, len(s.Interface.Spec.ServicePolicies))
+	for _, sp := range s.Interface.Spec.ServicePolicies {
+		key := client.ObjectKey{
+			Name:      sp.QoSPolicyRef.Name,
+			Namespace: s.Interface.Namespace,
+		}
+
+		qos := new(v1alpha1.QoSPolicy)
+		if err := r.Get(ctx, key, qos); err != nil {
+			if apierrors.IsNotFound(err) {
+				conditions.Set(s.Interface, metav1.Condition{
+					Type:    v1alpha1.ConfiguredCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  v1alpha1.QoSPolicyNotFoundReason,
+					Message: fmt.Sprintf("referenced QoSPolicy %q not found", key),
+				})
+				return nil, reconcile.TerminalError(fmt.Errorf("referenced QoSPolicy %q not found", key))
+			}
+			return nil, fmt.Errorf("failed to get referenced QoSPolicy %q: %w", key, err)
+		}
+
+		if qos.Spec.DeviceRef.Name != s.Device.Name {
+			conditions.Set(s.Interface, metav1.Condition{
+				Type:    v1alpha1.ConfiguredCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  v1alpha1.CrossDeviceReferenceReason,
+				Message: fmt.Sprintf("referenced QoSPolicy %q does not belong to device %q", qos.Name, s.Device.Name),
+			})
+			return nil, reconcile.TerminalError(fmt.Errorf("referenced QoSPolicy %q does not belong to device %q", qos.Name, s.Device.Name))
+		}
+
+		policies = append(policies, provider.ServicePolicy{Direction: sp.Direction, QoSPolicy: qos})
+	}
+	return policies, nil
+}
+
 // reconcileMemberInterfaces ensures that all member interfaces exist and belong to the same device as the aggregate interface.
 // It also updates the member interfaces to reference the aggregate interface by setting their MemberOf status field and [v1alpha1.AggregateLabel] label.
 func (r *InterfaceReconciler) reconcileMemberInterfaces(ctx context.Context, s *scope) ([]*v1alpha1.Interface, error) {
@@ -988,6 +1258,17 @@ func (r *InterfaceReconciler) reconcileMemberInterfaces(ctx context.Context, s *
 			return nil, reconcile.TerminalError(fmt.Errorf("member interface %q is not of type Physical", intf.Name))
 		}
 
+		// Member interfaces inherit the MTU of the aggregate interface, so an explicit MTU must match it.
+		if intf.Spec.MTU != 0 && intf.Spec.MTU != s.Interface.Spec.MTU {
+			conditions.Set(s.Interface, metav1.Condition{
+				Type:    v1alpha1.ConfiguredCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  v1alpha1.MTUMismatchReason,
+				Message: fmt.Sprintf("MTU %d of member interface %q does not match the MTU of the aggregate interface", intf.Spec.MTU, intf.Name),
+			})
+			return nil, reconcile.TerminalError(fmt.Errorf("MTU %d of member interface %q does not match the MTU of the aggregate interface", intf.Spec.MTU, intf.Name))
+		}
+
 		if intf.Status.MemberOf == nil {
 			intf.Status.MemberOf = &v1alpha1.LocalObjectReference{Name: s.Interface.Name}
 			if err := r.Status().Update(ctx, intf); err != nil {
@@ -1096,6 +1377,11 @@ func (r *InterfaceReconciler) finalize(ctx context.Context, s *scope) (reterr er
 		return err
 	}
 
+	if refusedAsNotOwned(s.Interface, v1alpha1.ConfiguredCondition) {
+		ctrl.LoggerFrom(ctx).Info("Interface uses a VLAN or VRF not owned by the operator, skipping deletion on the device")
+		return nil
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
@@ -1302,6 +1588,42 @@ func (r *InterfaceReconciler) parentToSubinterfaces(ctx context.Context, obj cli
 	return requests
 }
 
+// switchportToRoutedVLANs is a [handler.MapFunc] to be used to enqueue requests for reconciliation
+// for all RoutedVLAN Interfaces on the same device as an Interface whose MTU or switchport configuration changes.
+func (r *InterfaceReconciler) switchportToRoutedVLANs(ctx context.Context, obj client.Object) []ctrl.Request {
+	intf, ok := obj.(*v1alpha1.Interface)
+	if !ok {
+		panic(fmt.Sprintf("Expected a Interface but got a %T", obj))
+	}
+
+	if intf.Spec.Type != v1alpha1.InterfaceTypePhysical && intf.Spec.Type != v1alpha1.InterfaceTypeAggregate {
+		return nil
+	}
+
+	log := ctrl.LoggerFrom(ctx, "Interface", klog.KObj(intf))
+
+	interfaces := new(v1alpha1.InterfaceList)
+	if err := r.List(ctx, interfaces, client.InNamespace(intf.Namespace), client.MatchingFields{interfaceTypeKey: string(v1alpha1.InterfaceTypeRoutedVLAN)}); err != nil {
+		log.Error(err, "Failed to list Interfaces")
+		return nil
+	}
+
+	requests := []ctrl.Request{}
+	for _, i := range interfaces.Items {
+		if i.Spec.DeviceRef.Name == intf.Spec.DeviceRef.Name {
+			log.V(2).Info("Enqueuing RoutedVLAN Interface for reconciliation", "Interface", klog.KObj(&i))
+			requests = append(requests, ctrl.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      i.Name,
+					Namespace: i.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
 // vlanToRoutedVLAN is a [handler.MapFunc] to be used to enqueue requests for reconciliation
 // for a RoutedVLAN Interface when its referenced VLAN changes.
 func (r *InterfaceReconciler) vlanToRoutedVLAN(ctx context.Context, obj client.Object) []ctrl.Request {
@@ -1335,6 +1657,66 @@ func (r *InterfaceReconciler) vlanToRoutedVLAN(ctx context.Context, obj client.O
 	return requests
 }
 
+// aclToInterfaces is a [handler.MapFunc] to be used to enqueue requests for reconciliation
+// for Interfaces when their referenced AccessControlList changes.
+func (r *InterfaceReconciler) aclToInterfaces(ctx context.Context, obj client.Object) []ctrl.Request {
+	acl, ok := obj.(*v1alpha1.AccessControlList)
+	if !ok {
+		panic(fmt.Sprintf("Expected an AccessControlList but got a %T", obj))
+	}
+
+	log := ctrl.LoggerFrom(ctx, "AccessControlList", klog.KObj(acl))
+
+	interfaces := new(v1alpha1.InterfaceList)
+	if err := r.List(ctx, interfaces, client.InNamespace(acl.Namespace), client.MatchingFields{interfaceACLRefKey: acl.Name}); err != nil {
+		log.Error(err, "Failed to list Interfaces")
+		return nil
+	}
+
+	requests := []ctrl.Request{}
+	for _, i := range interfaces.Items {
+		log.V(2).Info("Enqueuing Interface for reconciliation", "Interface", klog.KObj(&i))
+		requests = append(requests, ctrl.Request{
+			NamespacedName: client.ObjectKey{
+				Name:      i.Name,
+				Namespace: i.Namespace,
+			},
+		})
+	}
+
+	return requests
+}
+
+// qosPolicyToInterfaces is a [handler.MapFunc] to be used to enqueue requests for reconciliation
+// for Interfaces when their referenced QoSPolicy changes.
+func (r *InterfaceReconciler) qosPolicyToInterfaces(ctx context.Context, obj client.Object) []ctrl.Request {
+	qos, ok := obj.(*v1alpha1.QoSPolicy)
+	if !ok {
+		panic(fmt.Sprintf("Expected a QoSPolicy but got a %T", obj))
+	}
+
+	log := ctrl.LoggerFrom(ctx, "QoSPolicy", klog.KObj(qos))
+
+	interfaces := new(v1alpha1.InterfaceList)
+	if err := r.List(ctx, interfaces, client.InNamespace(qos.Namespace), client.MatchingFields{interfaceQoSPolicyRefKey: qos.Name}); err != nil {
+		log.Error(err, "Failed to list Interfaces")
+		return nil
+	}
+
+	requests := []ctrl.Request{}
+	for _, i := range interfaces.Items {
+		log.V(2).Info("Enqueuing Interface for reconciliation", "Interface", klog.KObj(&i))
+		requests = append(requests, ctrl.Request{
+			NamespacedName: client.ObjectKey{
+				Name:      i.Name,
+				Namespace: i.Namespace,
+			},
+		})
+	}
+
+	return requests
+}
+
 // vrfToInterface is a [handler.MapFunc] to be used to enqueue requests for reconciliation
 // for Interfaces when their referenced VRF changes.
 func (r *InterfaceReconciler) vrfToInterface(ctx context.Context, obj client.Object) []ctrl.Request {
@@ -1495,3 +1877,35 @@ func (r *InterfaceReconciler) dnsToNeighborInterfaces(ctx context.Context, obj c
 
 	return requests
 }
+
+// fecStatusInterval is the minimum time between two updates of the FEC statistics of an interface.
+// The counters change continuously, so updating them on every reconciliation would update the status
+// of the interface each time.
+const fecStatusInterval = 5 * time.Minute
+
+// updateFECStatus updates the FEC statistics in the status of the interface, if they were not updated
+// within the [fecStatusInterval] or FEC was enabled or disabled on the interface since.
+func updateFECStatus(intf *v1alpha1.Interface, fec *provider.FECStatistics) {
+	if fec == nil {
+		intf.Status.FEC = nil
+		return
+	}
+	if cur := intf.Status.FEC; cur != nil && time.Since(cur.LastUpdateTime.Time) < fecStatusInterval {
+		return
+	}
+	intf.Status.FEC = &v1alpha1.InterfaceFECStatus{
+		CorrectedCodewords:   int64(min(fec.CorrectedCodewords, math.MaxInt64)),   // #nosec G115
+		UncorrectedCodewords: int64(min(fec.UncorrectedCodewords, math.MaxInt64)), // #nosec G115
+		PreFECBER:            formatBER(fec.PreFECBER),
+		PostFECBER:           formatBER(fec.PostFECBER),
+		LastUpdateTime:       metav1.Now(),
+	}
+}
+
+// formatBER formats a bit error rate in scientific notation, or returns an empty string if it is nil.
+func formatBER(ber *float64) string {
+	if ber == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*ber, 'e', 2, 64)
+}
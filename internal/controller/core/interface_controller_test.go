@@ -143,6 +143,72 @@
 			}).Should(Succeed())
 		})
 
+		It("Should report a switchport mode mismatch", func() {
+			By("Simulating a device that does not apply the trunk mode")
+			testProvider.Lock()
+			testProvider.SwitchportModes[name] = v1alpha1.SwitchportModeAccess
+			testProvider.Unlock()
+			DeferCleanup(func() {
+				testProvider.Lock()
+				delete(testProvider.SwitchportModes, name)
+				testProvider.Unlock()
+			})
+
+			By("Creating an Interface with trunk switchport configuration")
+			intf := &v1alpha1.Interface{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.InterfaceSpec{
+					DeviceRef:  v1alpha1.LocalObjectReference{Name: name},
+					Name:       name,
+					AdminState: v1alpha1.AdminStateUp,
+					Type:       v1alpha1.InterfaceTypePhysical,
+					Switchport: &v1alpha1.Switchport{
+						Mode:       v1alpha1.SwitchportModeTrunk,
+						NativeVlan: 1,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, intf)).To(Succeed())
+
+			By("Verifying the controller reports the applied switchport mode")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Interface{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.SwitchportMode).To(Equal(v1alpha1.SwitchportModeAccess))
+				g.Expect(resource.Status.Conditions).To(HaveLen(4))
+				g.Expect(resource.Status.Conditions[0].Type).To(Equal(v1alpha1.ReadyCondition))
+				g.Expect(resource.Status.Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(resource.Status.Conditions[1].Type).To(Equal(v1alpha1.ConfiguredCondition))
+				g.Expect(resource.Status.Conditions[1].Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(resource.Status.Conditions[1].Reason).To(Equal(v1alpha1.SwitchportModeMismatchReason))
+			}).Should(Succeed())
+
+			By("Simulating the device applying the trunk mode")
+			testProvider.Lock()
+			delete(testProvider.SwitchportModes, name)
+			testProvider.Unlock()
+
+			By("Triggering a reconciliation")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Interface{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				resource.Spec.Description = "Trunk"
+				g.Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			}).Should(Succeed())
+
+			By("Verifying the mismatch is resolved")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Interface{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.SwitchportMode).To(Equal(v1alpha1.SwitchportModeTrunk))
+				g.Expect(resource.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(resource.Status.Conditions[1].Status).To(Equal(metav1.ConditionTrue))
+			}).Should(Succeed())
+		})
+
 		It("Should successfully reconcile a Physical Interface with unnumbered IPv4", func() {
 			By("Creating a Loopback Interface with IPv4 addresses")
 			lb := &v1alpha1.Interface{
@@ -597,6 +663,60 @@
 			}).Should(Succeed())
 		})
 
+		It("Should handle member interface with mismatching MTU", func() {
+			By("Creating a Physical interface with a different MTU than the aggregate")
+			member := &v1alpha1.Interface{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      memberName1,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.InterfaceSpec{
+					DeviceRef:  v1alpha1.LocalObjectReference{Name: name},
+					Name:       memberName1,
+					AdminState: v1alpha1.AdminStateUp,
+					Type:       v1alpha1.InterfaceTypePhysical,
+					MTU:        1500,
+				},
+			}
+			Expect(k8sClient.Create(ctx, member)).To(Succeed())
+
+			By("Creating an Aggregate Interface referencing the member")
+			aggregate := &v1alpha1.Interface{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.InterfaceSpec{
+					DeviceRef:  v1alpha1.LocalObjectReference{Name: name},
+					Name:       name,
+					AdminState: v1alpha1.AdminStateUp,
+					Type:       v1alpha1.InterfaceTypeAggregate,
+					MTU:        9216,
+					Aggregation: &v1alpha1.Aggregation{
+						MemberInterfaceRefs: []v1alpha1.LocalObjectReference{
+							{Name: memberName1},
+						},
+						ControlProtocol: v1alpha1.ControlProtocol{
+							Mode: v1alpha1.LACPModeActive,
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, aggregate)).To(Succeed())
+
+			By("Verifying the controller sets MTU mismatch status")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Interface{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.Conditions).To(HaveLen(4))
+				g.Expect(resource.Status.Conditions[0].Type).To(Equal(v1alpha1.ReadyCondition))
+				g.Expect(resource.Status.Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(resource.Status.Conditions[1].Type).To(Equal(v1alpha1.ConfiguredCondition))
+				g.Expect(resource.Status.Conditions[1].Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(resource.Status.Conditions[1].Reason).To(Equal(v1alpha1.MTUMismatchReason))
+			}).Should(Succeed())
+		})
+
 		It("Should successfully reconcile an Aggregate Interface with IPv4 addresses and VRF", func() {
 			By("Creating a VRF resource")
 			vrf := &v1alpha1.VRF{
@@ -1254,6 +1374,62 @@
 				g.Expect(resource.Status.Conditions[3].Status).To(Equal(metav1.ConditionFalse))
 			}).Should(Succeed())
 		})
+
+		It("Should refuse an Interface in a VRF not owned by the operator", func() {
+			By("Restricting the VRFs owned by the operator on the device")
+			device := &v1alpha1.Device{}
+			Expect(k8sClient.Get(ctx, key, device)).To(Succeed())
+			device.Spec.Ownership = &v1alpha1.DeviceOwnership{VRFs: []string{"OTHER"}}
+			Expect(k8sClient.Update(ctx, device)).To(Succeed())
+
+			By("Creating a VRF resource")
+			vrf := &v1alpha1.VRF{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.VRFSpec{
+					DeviceRef: v1alpha1.LocalObjectReference{Name: name},
+					Name:      "test-vrf",
+					VNI:       1000,
+				},
+			}
+			Expect(k8sClient.Create(ctx, vrf)).To(Succeed())
+
+			By("Creating a Loopback Interface with VRF reference")
+			intf := &v1alpha1.Interface{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.InterfaceSpec{
+					DeviceRef:  v1alpha1.LocalObjectReference{Name: name},
+					Name:       name,
+					AdminState: v1alpha1.AdminStateUp,
+					Type:       v1alpha1.InterfaceTypeLoopback,
+					VrfRef:     &v1alpha1.LocalObjectReference{Name: vrf.Name},
+					IPv4: &v1alpha1.InterfaceIPv4{
+						Addresses: []v1alpha1.IPPrefix{{Prefix: netip.MustParsePrefix("10.1.1.1/32")}},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, intf)).To(Succeed())
+
+			By("Verifying the controller sets not owned status")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.Interface{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.Conditions).To(HaveLen(4))
+				g.Expect(resource.Status.Conditions[1].Type).To(Equal(v1alpha1.ConfiguredCondition))
+				g.Expect(resource.Status.Conditions[1].Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(resource.Status.Conditions[1].Reason).To(Equal(v1alpha1.NotOwnedReason))
+			}).Should(Succeed())
+
+			By("Verifying the Interface is not configured in the provider")
+			Consistently(func(g Gomega) {
+				g.Expect(testProvider.Ports.Has(name)).To(BeFalse(), "Provider shouldn't have Interface configured")
+			}).Should(Succeed())
+		})
 	})
 
 	Context("When DNS domain changes on a neighboring device", func() {
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
+func TestUpdateFECStatus(t *testing.T) {
+	fec := &provider.FECStatistics{CorrectedCodewords: 10, PreFECBER: new(1.5e-08)}
+
+	tests := []struct {
+		name    string
+		cur     *v1alpha1.InterfaceFECStatus
+		fec     *provider.FECStatistics
+		updated bool
+		want    *v1alpha1.InterfaceFECStatus
+	}{
+		{
+			name:    "first update",
+			fec:     fec,
+			updated: true,
+			want:    &v1alpha1.InterfaceFECStatus{CorrectedCodewords: 10, PreFECBER: "1.50e-08"},
+		},
+		{
+			name: "recently updated",
+			cur:  &v1alpha1.InterfaceFECStatus{CorrectedCodewords: 5, LastUpdateTime: metav1.NewTime(time.Now().Add(-time.Minute))},
+			fec:  fec,
+			want: &v1alpha1.InterfaceFECStatus{CorrectedCodewords: 5},
+		},
+		{
+			name:    "outdated",
+			cur:     &v1alpha1.InterfaceFECStatus{CorrectedCodewords: 5, LastUpdateTime: metav1.NewTime(time.Now().Add(-fecStatusInterval))},
+			fec:     fec,
+			updated: true,
+			want:    &v1alpha1.InterfaceFECStatus{CorrectedCodewords: 10, PreFECBER: "1.50e-08"},
+		},
+		{
+			name: "fec disabled",
+			cur:  &v1alpha1.InterfaceFECStatus{CorrectedCodewords: 5, LastUpdateTime: metav1.Now()},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			intf := &v1alpha1.Interface{}
+			intf.Status.FEC = test.cur.DeepCopy()
+			before := time.Now().Add(-time.Second)
+
+			updateFECStatus(intf, test.fec)
+
+			got := intf.Status.FEC
+			if (got == nil) != (test.want == nil) {
+				t.Fatalf("updateFECStatus() = %+v, want %+v", got, test.want)
+			}
+			if got == nil {
+				return
+			}
+			if updated := got.LastUpdateTime.After(before); updated != test.updated {
+				t.Errorf("updateFECStatus() updated = %v, want %v", updated, test.updated)
+			}
+			if got.CorrectedCodewords != test.want.CorrectedCodewords || got.PreFECBER != test.want.PreFECBER || got.PostFECBER != test.want.PostFECBER {
+				t.Errorf("updateFECStatus() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
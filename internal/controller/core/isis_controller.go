@@ -4,10 +4,12 @@
 package core
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -23,6 +25,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -33,6 +36,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -55,6 +59,13 @@ type ISISReconciler struct {
 
 	// Locker is used to synchronize operations on resources targeting the same device.
 	Locker *resourcelock.ResourceLocker
+
+	// RequeueInterval is the duration after which the controller should requeue the reconciliation,
+	// regardless of changes.
+	RequeueInterval time.Duration
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=isis,verbs=get;list;watch;create;update;patch;delete
@@ -147,6 +158,9 @@ func (r *ISISReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctr
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -173,7 +187,7 @@ func (r *ISISReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctr
 	}
 
 	orig := obj.DeepCopy()
-	if conditions.InitializeConditions(obj, v1alpha1.ReadyCondition) {
+	if conditions.InitializeConditions(obj, v1alpha1.ReadyCondition, v1alpha1.ConfiguredCondition, v1alpha1.OperationalCondition) {
 		log.V(1).Info("Initializing status conditions")
 		return ctrl.Result{}, r.Status().Update(ctx, obj)
 	}
@@ -200,11 +214,15 @@ func (r *ISISReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctr
 		return ctrl.Result{}, apistatus.WrapTerminalError(err)
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: Jitter(r.RequeueInterval)}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ISISReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	if r.RequeueInterval == 0 {
+		return errors.New("requeue interval must not be 0")
+	}
+
 	labelSelector := metav1.LabelSelector{}
 	if r.WatchFilterValue != "" {
 		labelSelector.MatchLabels = map[string]string{v1alpha1.WatchLabel: r.WatchFilterValue}
@@ -225,6 +243,7 @@ func (r *ISISReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.ISIS{}).
 		Named("isis").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.ISISDependencies {
@@ -244,14 +263,7 @@ func (r *ISISReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToISISs),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		// Watches enqueues ISISs for updates in referenced Interface resources.
 		// Only triggers on create, delete and update events when the Configured condition changes.
@@ -339,19 +351,115 @@ func (r *ISISReconciler) reconcile(ctx context.Context, s *isisScope) (reterr er
 		}
 	}()
 
-	// Ensure the ISIS is realized on the provider.
-	err := s.Provider.EnsureISIS(ctx, &provider.EnsureISISRequest{
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if !maintenance.DeferChanges(s.Device, s.ISIS) {
+		// Ensure the ISIS is realized on the provider.
+		err := s.Provider.EnsureISIS(ctx, &provider.EnsureISISRequest{
+			ISIS:           s.ISIS,
+			Interfaces:     interfaces,
+			ProviderConfig: s.ProviderConfig,
+		})
+
+		cond := conditions.FromError(err)
+		conditions.Set(s.ISIS, cond)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	status, err := s.Provider.GetISISStatus(ctx, &provider.ISISStatusRequest{
 		ISIS:           s.ISIS,
 		Interfaces:     interfaces,
 		ProviderConfig: s.ProviderConfig,
 	})
+	if err != nil {
+		return fmt.Errorf("failed to get isis status: %w", err)
+	}
 
-	cond := conditions.FromError(err)
-	// As this resource is configuration only, we use the Configured condition as top-level Ready condition.
-	cond.Type = v1alpha1.ReadyCondition
+	s.ISIS.Status.Adjacencies = make([]v1alpha1.ISISAdjacency, 0, len(status.Adjacencies))
+	for _, adj := range status.Adjacencies {
+		a := v1alpha1.ISISAdjacency{
+			SystemID:     adj.SystemID,
+			InterfaceRef: v1alpha1.LocalObjectReference{Name: adj.Interface.Name},
+			Level:        adj.Level,
+			State:        adj.State,
+		}
+		if !adj.LastTransitionTime.IsZero() {
+			a.LastTransitionTime = new(metav1.NewTime(adj.LastTransitionTime))
+		}
+		s.ISIS.Status.Adjacencies = append(s.ISIS.Status.Adjacencies, a)
+	}
+
+	slices.SortFunc(s.ISIS.Status.Adjacencies, func(i, j v1alpha1.ISISAdjacency) int {
+		return cmp.Or(
+			cmp.Compare(i.InterfaceRef.Name, j.InterfaceRef.Name),
+			cmp.Compare(i.Level, j.Level),
+			cmp.Compare(i.SystemID, j.SystemID),
+		)
+	})
+
+	s.ISIS.Status.LSPDatabase = make([]v1alpha1.ISISLSPDatabaseSummary, 0, len(status.LSPCounts))
+	for level, count := range status.LSPCounts {
+		s.ISIS.Status.LSPDatabase = append(s.ISIS.Status.LSPDatabase, v1alpha1.ISISLSPDatabaseSummary{
+			Level:    level,
+			LSPCount: count,
+		})
+	}
+
+	slices.SortFunc(s.ISIS.Status.LSPDatabase, func(i, j v1alpha1.ISISLSPDatabaseSummary) int {
+		return cmp.Compare(i.Level, j.Level)
+	})
+
+	states := make(map[v1alpha1.ISISAdjacencyState]int)
+	for _, adj := range s.ISIS.Status.Adjacencies {
+		states[adj.State]++
+	}
+
+	var summaries []string
+	for _, state := range []v1alpha1.ISISAdjacencyState{
+		v1alpha1.ISISAdjacencyStateUp,
+		v1alpha1.ISISAdjacencyStateInitializing,
+		v1alpha1.ISISAdjacencyStateDown,
+		v1alpha1.ISISAdjacencyStateUnknown,
+	} {
+		if count := states[state]; count > 0 {
+			summaries = append(summaries, fmt.Sprintf("%d %s", count, state))
+		}
+	}
+
+	s.ISIS.Status.AdjacencySummary = strings.Join(summaries, ", ")
+	s.ISIS.Status.ObservedGeneration = s.ISIS.Generation
+
+	// Every referenced interface is expected to have at least one adjacency in the Up state.
+	var missing []string
+	for _, intf := range interfaces {
+		if !slices.ContainsFunc(s.ISIS.Status.Adjacencies, func(adj v1alpha1.ISISAdjacency) bool {
+			return adj.InterfaceRef.Name == intf.Name && adj.State == v1alpha1.ISISAdjacencyStateUp
+		}) {
+			missing = append(missing, intf.Name)
+		}
+	}
+
+	cond := metav1.Condition{
+		Type:    v1alpha1.OperationalCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  v1alpha1.OperationalReason,
+		Message: "ISIS is operational",
+	}
+	switch {
+	case !status.OperStatus:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = v1alpha1.DegradedReason
+		cond.Message = "ISIS is not operational"
+	case len(missing) > 0:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = v1alpha1.DegradedReason
+		cond.Message = fmt.Sprintf("No ISIS adjacency is up on interfaces: %s", strings.Join(missing, ", "))
+	}
 	conditions.Set(s.ISIS, cond)
 
-	return err
+	return nil
 }
 
 func (r *ISISReconciler) finalize(ctx context.Context, s *isisScope) (reterr error) {
@@ -24,6 +24,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -34,6 +35,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -60,6 +62,9 @@ type LLDPReconciler struct {
 	// RequeueInterval is the duration after which the controller should requeue the reconciliation,
 	// regardless of changes.
 	RequeueInterval time.Duration
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=lldps,verbs=get;list;watch;create;update;patch;delete
@@ -139,6 +144,9 @@ func (r *LLDPReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctr
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -244,18 +252,21 @@ func (r *LLDPReconciler) reconcile(ctx context.Context, s *lldpScope) (reterr er
 		}
 	}()
 
-	// Ensure the LLDP is realized on the remote device.
-	err = s.Provider.EnsureLLDP(ctx, &provider.LLDPRequest{
-		LLDP:           s.LLDP,
-		ProviderConfig: s.ProviderConfig,
-		Interfaces:     interfaces,
-	})
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if !maintenance.DeferChanges(s.Device, s.LLDP) {
+		// Ensure the LLDP is realized on the remote device.
+		err = s.Provider.EnsureLLDP(ctx, &provider.LLDPRequest{
+			LLDP:           s.LLDP,
+			ProviderConfig: s.ProviderConfig,
+			Interfaces:     interfaces,
+		})
 
-	cond := conditions.FromError(err)
-	conditions.Set(s.LLDP, cond)
+		cond := conditions.FromError(err)
+		conditions.Set(s.LLDP, cond)
 
-	if err != nil {
-		return err
+		if err != nil {
+			return err
+		}
 	}
 
 	status, err := s.Provider.GetLLDPStatus(ctx, &provider.LLDPRequest{
@@ -266,7 +277,7 @@ func (r *LLDPReconciler) reconcile(ctx context.Context, s *lldpScope) (reterr er
 		return fmt.Errorf("failed to get LLDP status: %w", err)
 	}
 
-	cond = metav1.Condition{
+	cond := metav1.Condition{
 		Type:    v1alpha1.OperationalCondition,
 		Status:  metav1.ConditionTrue,
 		Reason:  v1alpha1.OperationalReason,
@@ -430,6 +441,7 @@ func (r *LLDPReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 	c := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.LLDP{}).
 		Named("lldp").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.LLDPDependencies {
@@ -449,14 +461,7 @@ func (r *LLDPReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToLLDPs),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		// Watches enqueues LLDPs for updates in referenced Interface resources.
 		// This ensures LLDP reconciles when a referenced Interface is created or updated.
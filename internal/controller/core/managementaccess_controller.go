@@ -7,6 +7,8 @@
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"strconv"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -22,8 +24,8 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
-	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -32,6 +34,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -54,11 +57,15 @@ type ManagementAccessReconciler struct {
 
 	// Locker is used to synchronize operations on resources targeting the same device.
 	Locker *resourcelock.ResourceLocker
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=managementaccesses,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=managementaccesses/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=managementaccesses/finalizers,verbs=update
+// +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=devices,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -146,6 +153,9 @@ func (r *ManagementAccessReconciler) Reconcile(ctx context.Context, req ctrl.Req
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -224,6 +234,7 @@ func (r *ManagementAccessReconciler) SetupWithManager(ctx context.Context, mgr c
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.ManagementAccess{}).
 		Named("managementaccess").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.ManagementAccessDependencies {
@@ -243,14 +254,7 @@ func (r *ManagementAccessReconciler) SetupWithManager(ctx context.Context, mgr c
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToManagementAccesses),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		Complete(r)
 }
@@ -278,6 +282,11 @@ func (r *ManagementAccessReconciler) reconcile(ctx context.Context, s *managemen
 		}
 	}
 
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if maintenance.DeferChanges(s.Device, s.ManagementAccess) {
+		return nil
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
@@ -298,7 +307,33 @@ func (r *ManagementAccessReconciler) reconcile(ctx context.Context, s *managemen
 	cond.Type = v1alpha1.ReadyCondition
 	conditions.Set(s.ManagementAccess, cond)
 
-	return err
+	if err != nil {
+		return err
+	}
+
+	return r.updateDeviceEndpoint(ctx, s.Device, s.ManagementAccess.Spec.GRPC)
+}
+
+// updateDeviceEndpoint updates the port of the endpoint of the device to the port of the gRPC server,
+// once a change of the port has been applied, so that the device stays reachable by the operator.
+func (r *ManagementAccessReconciler) updateDeviceEndpoint(ctx context.Context, device *v1alpha1.Device, grpc v1alpha1.GRPC) error {
+	if !grpc.Enabled || grpc.Port == 0 {
+		return nil
+	}
+	host, port, err := net.SplitHostPort(device.Spec.Endpoint.Address)
+	if err != nil {
+		return fmt.Errorf("failed to parse device address: %w", err)
+	}
+	if port == strconv.Itoa(int(grpc.Port)) {
+		return nil
+	}
+	ctrl.LoggerFrom(ctx).Info("Updating the device endpoint to the new gRPC port", "old", port, "new", grpc.Port)
+	orig := device.DeepCopy()
+	device.Spec.Endpoint.Address = net.JoinHostPort(host, strconv.Itoa(int(grpc.Port)))
+	if err := r.Patch(ctx, device, client.MergeFrom(orig)); err != nil {
+		return fmt.Errorf("failed to update device endpoint: %w", err)
+	}
+	return nil
 }
 
 func (r *ManagementAccessReconciler) finalize(ctx context.Context, s *managementAccessScope) (reterr error) {
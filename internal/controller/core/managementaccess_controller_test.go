@@ -116,5 +116,22 @@
 				g.Expect(testProvider.Access).ToNot(BeNil(), "Provider should have ManagementAccess configured")
 			}).Should(Succeed())
 		})
+
+		It("Should update the device endpoint after changing the gRPC port", func() {
+			By("Changing the gRPC port")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.ManagementAccess{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				resource.Spec.GRPC.Port = 50051
+				g.Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			}).Should(Succeed())
+
+			By("Verifying the device endpoint uses the new port")
+			Eventually(func(g Gomega) {
+				device := &v1alpha1.Device{}
+				g.Expect(k8sClient.Get(ctx, key, device)).To(Succeed())
+				g.Expect(device.Spec.Endpoint.Address).To(Equal("192.168.10.2:50051"))
+			}).Should(Succeed())
+		})
 	})
 })
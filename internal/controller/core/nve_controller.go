@@ -4,10 +4,13 @@
 package core
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -24,6 +27,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -34,6 +38,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -60,6 +65,9 @@ type NetworkVirtualizationEdgeReconciler struct {
 	// RequeueInterval is the duration after which the controller should requeue the reconciliation,
 	// regardless of changes.
 	RequeueInterval time.Duration
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=networkvirtualizationedges,verbs=get;list;watch;create;update;patch;delete
@@ -147,6 +155,9 @@ func (r *NetworkVirtualizationEdgeReconciler) Reconcile(ctx context.Context, req
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -258,17 +269,20 @@ func (r *NetworkVirtualizationEdgeReconciler) reconcile(ctx context.Context, s *
 		}
 	}()
 
-	err = s.Provider.EnsureNVE(ctx, &provider.NVERequest{
-		NVE:                    s.NVE,
-		ProviderConfig:         s.ProviderConfig,
-		SourceInterface:        sourceIf,
-		AnycastSourceInterface: anycastIf,
-	})
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if !maintenance.DeferChanges(s.Device, s.NVE) {
+		err = s.Provider.EnsureNVE(ctx, &provider.NVERequest{
+			NVE:                    s.NVE,
+			ProviderConfig:         s.ProviderConfig,
+			SourceInterface:        sourceIf,
+			AnycastSourceInterface: anycastIf,
+		})
 
-	cond := conditions.FromError(err)
-	conditions.Set(s.NVE, cond)
-	if err != nil {
-		return err
+		cond := conditions.FromError(err)
+		conditions.Set(s.NVE, cond)
+		if err != nil {
+			return err
+		}
 	}
 
 	status, err := s.Provider.GetNVEStatus(ctx, &provider.NVERequest{
@@ -283,16 +297,84 @@ func (r *NetworkVirtualizationEdgeReconciler) reconcile(ctx context.Context, s *
 	s.NVE.Status.AnycastSourceInterfaceName = status.AnycastSourceInterfaceName
 	s.NVE.Status.HostReachability = status.HostReachabilityType
 
-	cond = metav1.Condition{
+	s.NVE.Status.Peers = make([]v1alpha1.NVEPeer, 0, len(status.Peers))
+	for _, peer := range status.Peers {
+		s.NVE.Status.Peers = append(s.NVE.Status.Peers, v1alpha1.NVEPeer{
+			Address: peer.Address,
+			State:   peer.State,
+		})
+	}
+
+	slices.SortFunc(s.NVE.Status.Peers, func(i, j v1alpha1.NVEPeer) int {
+		return cmp.Compare(i.Address, j.Address)
+	})
+
+	states := make(map[v1alpha1.NVEPeerState]int)
+	for _, peer := range s.NVE.Status.Peers {
+		states[peer.State]++
+	}
+
+	var summaries []string
+	for _, state := range []v1alpha1.NVEPeerState{
+		v1alpha1.NVEPeerStateUp,
+		v1alpha1.NVEPeerStateDown,
+		v1alpha1.NVEPeerStateUnknown,
+	} {
+		if count := states[state]; count > 0 {
+			summaries = append(summaries, fmt.Sprintf("%d %s", count, state))
+		}
+	}
+	s.NVE.Status.PeerSummary = strings.Join(summaries, ", ")
+
+	slices.SortFunc(status.VNIs, func(i, j provider.NVEVNIStatus) int {
+		return cmp.Compare(i.VNI, j.VNI)
+	})
+
+	var up int
+	var down []string
+	for _, vni := range status.VNIs {
+		if !vni.OperStatus {
+			down = append(down, strconv.FormatInt(int64(vni.VNI), 10))
+			continue
+		}
+		up++
+	}
+
+	s.NVE.Status.VNISummary = ""
+	if len(status.VNIs) > 0 {
+		s.NVE.Status.VNISummary = fmt.Sprintf("%d Up, %d Down", up, len(down))
+	}
+
+	// The expected peers must be discovered and up, as must every VNI configured on the NVE.
+	expected, err := r.expectedPeers(ctx, s, sourceIf, anycastIf)
+	if err != nil {
+		return err
+	}
+	missing, unreachable := checkPeers(expected, s.NVE.Status.Peers)
+
+	cond := metav1.Condition{
 		Type:    v1alpha1.OperationalCondition,
 		Status:  metav1.ConditionTrue,
 		Reason:  v1alpha1.OperationalReason,
 		Message: "NVE is operationally up",
 	}
-	if !status.OperStatus {
+	switch {
+	case !status.OperStatus:
 		cond.Status = metav1.ConditionFalse
 		cond.Reason = v1alpha1.DegradedReason
 		cond.Message = "NVE is operationally down"
+	case len(missing) > 0:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = v1alpha1.DegradedReason
+		cond.Message = fmt.Sprintf("VTEP peers are not discovered: %s", strings.Join(missing, ", "))
+	case len(unreachable) > 0:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = v1alpha1.DegradedReason
+		cond.Message = fmt.Sprintf("VTEP peers are not up: %s", strings.Join(unreachable, ", "))
+	case len(down) > 0:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = v1alpha1.DegradedReason
+		cond.Message = fmt.Sprintf("VNIs are not operational: %s", strings.Join(down, ", "))
 	}
 	conditions.Set(s.NVE, cond)
 
@@ -322,6 +404,69 @@ func (r *NetworkVirtualizationEdgeReconciler) validateUniqueNVEPerDevice(ctx con
 	return nil
 }
 
+// expectedPeers returns the addresses of the remote VTEPs the NVE is expected to discover, i.e. those of
+// the NVEs of the other devices in its namespace. An NVE with an anycast source interface, e.g. one of a
+// vPC pair, is reached through its anycast address. The addresses of the NVE itself are never expected.
+func (r *NetworkVirtualizationEdgeReconciler) expectedPeers(ctx context.Context, s *nveScope, own ...*v1alpha1.Interface) ([]string, error) {
+	var list v1alpha1.NetworkVirtualizationEdgeList
+	if err := r.List(ctx, &list, client.InNamespace(s.NVE.Namespace)); err != nil {
+		return nil, err
+	}
+
+	var self []string
+	for _, intf := range own {
+		if addr := vtepAddress(intf); addr != "" {
+			self = append(self, addr)
+		}
+	}
+
+	var peers []string
+	for _, nve := range list.Items {
+		if nve.Spec.DeviceRef.Name == s.NVE.Spec.DeviceRef.Name {
+			continue
+		}
+		ref := nve.Spec.SourceInterfaceRef
+		if nve.Spec.AnycastSourceInterfaceRef != nil {
+			ref = *nve.Spec.AnycastSourceInterfaceRef
+		}
+		intf := new(v1alpha1.Interface)
+		if err := r.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: nve.Namespace}, intf); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get interface %q: %w", ref.Name, err)
+		}
+		if addr := vtepAddress(intf); addr != "" && !slices.Contains(self, addr) && !slices.Contains(peers, addr) {
+			peers = append(peers, addr)
+		}
+	}
+	slices.Sort(peers)
+	return peers, nil
+}
+
+// vtepAddress returns the primary IPv4 address of the source interface of an NVE, or an empty string if it has none.
+func vtepAddress(intf *v1alpha1.Interface) string {
+	if intf == nil || intf.Spec.IPv4 == nil || len(intf.Spec.IPv4.Addresses) == 0 {
+		return ""
+	}
+	return intf.Spec.IPv4.Addresses[0].Addr().String()
+}
+
+// checkPeers returns the expected peers that have not been discovered and those that are not up.
+// Discovered peers that are not expected, e.g. VTEPs not managed by the operator, are ignored.
+func checkPeers(expected []string, peers []v1alpha1.NVEPeer) (missing, unreachable []string) {
+	for _, addr := range expected {
+		idx := slices.IndexFunc(peers, func(p v1alpha1.NVEPeer) bool { return p.Address == addr })
+		switch {
+		case idx < 0:
+			missing = append(missing, addr)
+		case peers[idx].State != v1alpha1.NVEPeerStateUp:
+			unreachable = append(unreachable, addr)
+		}
+	}
+	return missing, unreachable
+}
+
 // reconcileInterfaceRef checks that the referenced interface exists, is of type Loopback, and belongs to the same device as the NVE.
 func (r *NetworkVirtualizationEdgeReconciler) reconcileInterfaceRef(ctx context.Context, interfaceRef *v1alpha1.LocalObjectReference, s *nveScope) (*v1alpha1.Interface, error) {
 	intf := new(v1alpha1.Interface)
@@ -422,6 +567,7 @@ func (r *NetworkVirtualizationEdgeReconciler) SetupWithManager(ctx context.Conte
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.NetworkVirtualizationEdge{}).
 		Named("nve").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.NetworkVirtualizationEdgeDependencies {
@@ -454,14 +600,7 @@ func (r *NetworkVirtualizationEdgeReconciler) SetupWithManager(ctx context.Conte
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToNVEs),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		Complete(r)
 }
@@ -144,6 +144,9 @@
 				g.Expect(nve.Status.Conditions[2].Status).To(Equal(metav1.ConditionTrue))
 				g.Expect(nve.Status.Conditions[3].Type).To(Equal(v1alpha1.PausedCondition))
 				g.Expect(nve.Status.Conditions[3].Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(nve.Status.Peers).To(ConsistOf(v1alpha1.NVEPeer{Address: "10.0.0.2", State: v1alpha1.NVEPeerStateUp}))
+				g.Expect(nve.Status.PeerSummary).To(Equal("1 Up"))
+				g.Expect(nve.Status.VNISummary).To(Equal("1 Up, 0 Down"))
 			}).Should(Succeed())
 
 			By("Ensuring the NVE is created in the provider")
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+)
+
+func TestCheckPeers(t *testing.T) {
+	tests := []struct {
+		name            string
+		expected        []string
+		peers           []v1alpha1.NVEPeer
+		wantMissing     []string
+		wantUnreachable []string
+	}{
+		{
+			name:     "all expected peers up",
+			expected: []string{"10.0.0.2", "10.0.0.3"},
+			peers: []v1alpha1.NVEPeer{
+				{Address: "10.0.0.2", State: v1alpha1.NVEPeerStateUp},
+				{Address: "10.0.0.3", State: v1alpha1.NVEPeerStateUp},
+			},
+		},
+		{
+			name:        "expected peer not discovered",
+			expected:    []string{"10.0.0.2", "10.0.0.3"},
+			peers:       []v1alpha1.NVEPeer{{Address: "10.0.0.2", State: v1alpha1.NVEPeerStateUp}},
+			wantMissing: []string{"10.0.0.3"},
+		},
+		{
+			name:     "expected peer down",
+			expected: []string{"10.0.0.2"},
+			peers: []v1alpha1.NVEPeer{
+				{Address: "10.0.0.2", State: v1alpha1.NVEPeerStateDown},
+			},
+			wantUnreachable: []string{"10.0.0.2"},
+		},
+		{
+			name:     "unexpected peer down",
+			expected: []string{"10.0.0.2"},
+			peers: []v1alpha1.NVEPeer{
+				{Address: "10.0.0.2", State: v1alpha1.NVEPeerStateUp},
+				{Address: "192.168.0.1", State: v1alpha1.NVEPeerStateDown},
+			},
+		},
+		{
+			name:        "no peers discovered",
+			expected:    []string{"10.0.0.2"},
+			wantMissing: []string{"10.0.0.2"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			missing, unreachable := checkPeers(test.expected, test.peers)
+			if !slices.Equal(missing, test.wantMissing) {
+				t.Errorf("checkPeers() missing = %v, want %v", missing, test.wantMissing)
+			}
+			if !slices.Equal(unreachable, test.wantUnreachable) {
+				t.Errorf("checkPeers() unreachable = %v, want %v", unreachable, test.wantUnreachable)
+			}
+		})
+	}
+}
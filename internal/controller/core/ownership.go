@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/conditions"
+)
+
+// ensureOwned ensures that the given VLANs and VRFs, which are modified on behalf of obj, are owned by the
+// operator on the device, see [v1alpha1.DeviceOwnership]. Otherwise, it sets the condition of the given type
+// on obj to False with the [v1alpha1.NotOwnedReason] and returns a terminal error.
+func ensureOwned(device *v1alpha1.Device, obj conditions.Setter, conditionType string, vlans []int16, vrfs []string) error {
+	var what string
+	for _, id := range vlans {
+		if !device.Spec.Ownership.OwnsVLAN(id) {
+			what = fmt.Sprintf("VLAN %d", id)
+			break
+		}
+	}
+	for _, name := range vrfs {
+		if what != "" {
+			break
+		}
+		if !device.Spec.Ownership.OwnsVRF(name) {
+			what = "VRF " + name
+		}
+	}
+	if what == "" {
+		return nil
+	}
+	conditions.Set(obj, metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  v1alpha1.NotOwnedReason,
+		Message: fmt.Sprintf("%s is not owned by the operator on device %s", what, device.Name),
+	})
+	return reconcile.TerminalError(fmt.Errorf("%s is not owned by the operator", what))
+}
+
+// refusedAsNotOwned reports whether the reconciliation of obj was refused by [ensureOwned], in which case
+// the configuration on the device must not be deleted on its behalf either.
+func refusedAsNotOwned(obj conditions.Getter, conditionType string) bool {
+	cond := conditions.Get(obj, conditionType)
+	return cond != nil && cond.Reason == v1alpha1.NotOwnedReason
+}
+
+// ownershipChanged reports whether the VLANs and VRFs managed by the operator on the device have changed.
+func ownershipChanged(oldObj, newObj client.Object) bool {
+	oldDevice, ok := oldObj.(*v1alpha1.Device)
+	if !ok {
+		return false
+	}
+	newDevice, ok := newObj.(*v1alpha1.Device)
+	if !ok {
+		return false
+	}
+	return !equality.Semantic.DeepEqual(oldDevice.Spec.Ownership, newDevice.Spec.Ownership)
+}
@@ -23,6 +23,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -33,6 +34,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -55,6 +57,9 @@ type PIMReconciler struct {
 
 	// Locker is used to synchronize operations on resources targeting the same device.
 	Locker *resourcelock.ResourceLocker
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=pim,verbs=get;list;watch;create;update;patch;delete
@@ -147,6 +152,9 @@ func (r *PIMReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -225,6 +233,7 @@ func (r *PIMReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.PIM{}).
 		Named("pim").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.PIMDependencies {
@@ -244,14 +253,7 @@ func (r *PIMReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToPIMs),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		// Watches enqueues PIMs for updates in referenced Interface resources.
 		// Only triggers on create, delete and update events when the Configured condition changes.
@@ -297,18 +299,22 @@ func (r *PIMReconciler) reconcile(ctx context.Context, s *pimScope) (reterr erro
 		}
 	}
 
-	var interfaces []provider.PIMInterface
-	for _, intf := range s.PIM.Spec.InterfaceRefs {
+	// Resolve all referenced interfaces upfront, so that the PIM is only realized once all of them are configured.
+	resolved := make(map[string]*v1alpha1.Interface)
+	for _, name := range pimInterfaceNames(s.PIM) {
+		if _, ok := resolved[name]; ok {
+			continue
+		}
 		res := new(v1alpha1.Interface)
-		if err := r.Get(ctx, client.ObjectKey{Name: intf.Name, Namespace: s.PIM.Namespace}, res); err != nil {
+		if err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: s.PIM.Namespace}, res); err != nil {
 			if apierrors.IsNotFound(err) {
 				conditions.Set(s.PIM, metav1.Condition{
 					Type:    v1alpha1.ReadyCondition,
 					Status:  metav1.ConditionFalse,
 					Reason:  v1alpha1.InterfaceNotFoundReason,
-					Message: fmt.Sprintf("interface %q not found", intf.Name),
+					Message: fmt.Sprintf("interface %q not found", name),
 				})
-				return reconcile.TerminalError(fmt.Errorf("interface %q not found", intf.Name))
+				return reconcile.TerminalError(fmt.Errorf("interface %q not found", name))
 			}
 			return err
 		}
@@ -322,11 +328,48 @@ func (r *PIMReconciler) reconcile(ctx context.Context, s *pimScope) (reterr erro
 			})
 			return nil
 		}
+		resolved[name] = res
+	}
 
-		interfaces = append(interfaces, provider.PIMInterface{
-			Interface: res,
-			Mode:      intf.Mode,
-		})
+	var interfaces []provider.PIMInterface
+	for _, intf := range s.PIM.Spec.InterfaceRefs {
+		pi := provider.PIMInterface{
+			Interface:  resolved[intf.Name],
+			Mode:       intf.Mode,
+			DRPriority: intf.DRPriority,
+			IGMP:       intf.IGMP,
+		}
+		if intf.HelloInterval != nil {
+			pi.HelloInterval = intf.HelloInterval.Duration
+		}
+		interfaces = append(interfaces, pi)
+	}
+
+	var bsr *provider.PIMBSR
+	if b := s.PIM.Spec.BSR; b != nil {
+		bsr = &provider.PIMBSR{PIMBSR: b}
+		if b.CandidateBSR != nil {
+			bsr.CandidateBSRInterface = resolved[b.CandidateBSR.InterfaceRef.Name]
+		}
+		if b.CandidateRP != nil {
+			bsr.CandidateRPInterface = resolved[b.CandidateRP.InterfaceRef.Name]
+		}
+	}
+
+	var autoRP *provider.PIMAutoRP
+	if a := s.PIM.Spec.AutoRP; a != nil {
+		autoRP = &provider.PIMAutoRP{PIMAutoRP: a}
+		if a.CandidateRP != nil {
+			autoRP.CandidateRPInterface = resolved[a.CandidateRP.InterfaceRef.Name]
+		}
+		if a.MappingAgent != nil {
+			autoRP.MappingAgentInterface = resolved[a.MappingAgent.InterfaceRef.Name]
+		}
+	}
+
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if maintenance.DeferChanges(s.Device, s.PIM) {
+		return nil
 	}
 
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
@@ -343,6 +386,8 @@ func (r *PIMReconciler) reconcile(ctx context.Context, s *pimScope) (reterr erro
 		PIM:            s.PIM,
 		Interfaces:     interfaces,
 		ProviderConfig: s.ProviderConfig,
+		BSR:            bsr,
+		AutoRP:         autoRP,
 	})
 
 	cond := conditions.FromError(err)
@@ -424,9 +469,7 @@ func (r *PIMReconciler) interfaceToPIM(ctx context.Context, obj client.Object) [
 
 	requests := make([]reconcile.Request, 0, len(list.Items))
 	for _, i := range list.Items {
-		if slices.ContainsFunc(i.Spec.InterfaceRefs, func(ref v1alpha1.PIMInterface) bool {
-			return ref.Name == iface.Name
-		}) {
+		if slices.Contains(pimInterfaceNames(&i), iface.Name) {
 			log.V(2).Info("Enqueuing PIM for reconciliation", "PIM", klog.KObj(&i))
 			requests = append(requests, reconcile.Request{
 				NamespacedName: client.ObjectKey{
@@ -440,6 +483,32 @@ func (r *PIMReconciler) interfaceToPIM(ctx context.Context, obj client.Object) [
 	return requests
 }
 
+// pimInterfaceNames returns the names of all interfaces referenced by the PIM,
+// including the interfaces of the BSR and Auto-RP candidates.
+func pimInterfaceNames(pim *v1alpha1.PIM) []string {
+	names := make([]string, 0, len(pim.Spec.InterfaceRefs))
+	for _, ref := range pim.Spec.InterfaceRefs {
+		names = append(names, ref.Name)
+	}
+	if b := pim.Spec.BSR; b != nil {
+		if b.CandidateBSR != nil {
+			names = append(names, b.CandidateBSR.InterfaceRef.Name)
+		}
+		if b.CandidateRP != nil {
+			names = append(names, b.CandidateRP.InterfaceRef.Name)
+		}
+	}
+	if a := pim.Spec.AutoRP; a != nil {
+		if a.CandidateRP != nil {
+			names = append(names, a.CandidateRP.InterfaceRef.Name)
+		}
+		if a.MappingAgent != nil {
+			names = append(names, a.MappingAgent.InterfaceRef.Name)
+		}
+	}
+	return names
+}
+
 // pimForProviderConfig is a [handler.MapFunc] to be used to enqueue requests for reconciliation
 // for a PIM to update when one of its referenced provider configurations gets updated.
 func (r *PIMReconciler) pimForProviderConfig(ctx context.Context, obj client.Object) []reconcile.Request {
@@ -0,0 +1,439 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/conditions"
+	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
+	"github.com/ironcore-dev/network-operator/internal/paused"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+	"github.com/ironcore-dev/network-operator/internal/resourcelock"
+)
+
+// QoSPolicyReconciler reconciles a QoSPolicy object
+type QoSPolicyReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+
+	// Recorder is used to record events for the controller.
+	// More info: https://book.kubebuilder.io/reference/raising-events
+	Recorder events.EventRecorder
+
+	// Provider is the driver that will be used to create & delete the QoS policy.
+	Provider provider.ProviderFunc
+
+	// Locker is used to synchronize operations on resources targeting the same device.
+	Locker *resourcelock.ResourceLocker
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
+}
+
+// +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=qospolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=qospolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=qospolicies/finalizers,verbs=update
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.20.2/pkg/reconcile
+//
+// For more details about the method shape, read up here:
+// - https://ahmet.im/blog/controller-pitfalls/#reconcile-method-shape
+func (r *QoSPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(3).Info("Reconciling resource")
+
+	obj := new(v1alpha1.QoSPolicy)
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			// If the custom resource is not found then it usually means that it was deleted or not created
+			// In this way, we will stop the reconciliation
+			log.V(3).Info("Resource not found. Ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		log.Error(err, "Failed to get resource")
+		return ctrl.Result{}, err
+	}
+
+	prov, ok := r.Provider().(provider.QoSPolicyProvider)
+	if !ok {
+		if meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:    v1alpha1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1alpha1.NotImplementedReason,
+			Message: "Provider does not implement provider.QoSPolicyProvider",
+		}) {
+			return ctrl.Result{}, r.Status().Update(ctx, obj)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	device, err := deviceutil.GetDeviceByName(ctx, r, obj.Namespace, obj.Spec.DeviceRef.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if isPaused, requeue, err := paused.EnsureCondition(ctx, r.Client, device, obj); isPaused || requeue || err != nil {
+		return ctrl.Result{Requeue: requeue}, err
+	}
+
+	if err := r.Locker.AcquireLock(ctx, device.Name, "qospolicy-controller"); err != nil {
+		if errors.Is(err, resourcelock.ErrLockAlreadyHeld) {
+			log.V(3).Info("Device is already locked, requeuing reconciliation")
+			return ctrl.Result{RequeueAfter: Jitter(time.Second), Priority: new(LockWaitPriorityDefault)}, nil
+		}
+		log.Error(err, "Failed to acquire device lock")
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		if err := r.Locker.ReleaseLock(ctx, device.Name, "qospolicy-controller"); err != nil {
+			log.Error(err, "Failed to release device lock")
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	conn, err := deviceutil.GetDeviceConnection(ctx, r, device)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var cfg *provider.ProviderConfig
+	if obj.Spec.ProviderConfigRef != nil {
+		cfg, err = provider.GetProviderConfig(ctx, r, obj.Namespace, obj.Spec.ProviderConfigRef)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	s := &qosPolicyScope{
+		Device:         device,
+		QoSPolicy:      obj,
+		Connection:     conn,
+		ProviderConfig: cfg,
+		Provider:       prov,
+	}
+
+	if !obj.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
+			if err := r.finalize(ctx, s); err != nil {
+				log.Error(err, "Failed to finalize resource")
+				return ctrl.Result{}, err
+			}
+			controllerutil.RemoveFinalizer(obj, v1alpha1.FinalizerName)
+			if err := r.Update(ctx, obj); err != nil {
+				log.Error(err, "Failed to remove finalizer from resource")
+				return ctrl.Result{}, err
+			}
+		}
+		log.V(3).Info("Resource is being deleted, skipping reconciliation")
+		return ctrl.Result{}, nil
+	}
+
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers
+	if !controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+		controllerutil.AddFinalizer(obj, v1alpha1.FinalizerName)
+		if err := r.Update(ctx, obj); err != nil {
+			log.Error(err, "Failed to add finalizer to resource")
+			return ctrl.Result{}, err
+		}
+		log.V(1).Info("Added finalizer to resource")
+		return ctrl.Result{}, nil
+	}
+
+	orig := obj.DeepCopy()
+	if conditions.InitializeConditions(obj, v1alpha1.ReadyCondition) {
+		log.V(1).Info("Initializing status conditions")
+		return ctrl.Result{}, r.Status().Update(ctx, obj)
+	}
+
+	// Always attempt to update the metadata/status after reconciliation
+	defer func() {
+		if !equality.Semantic.DeepEqual(orig.ObjectMeta, obj.ObjectMeta) {
+			// Pass obj.DeepCopy() to avoid Patch() modifying obj and interfering with status update below
+			if err := r.Patch(ctx, obj.DeepCopy(), client.MergeFrom(orig)); err != nil {
+				log.Error(err, "Failed to update resource metadata")
+				reterr = kerrors.NewAggregate([]error{reterr, err})
+			}
+		}
+		if !equality.Semantic.DeepEqual(orig.Status, obj.Status) {
+			if err := r.Status().Patch(ctx, obj, client.MergeFrom(orig)); err != nil {
+				log.Error(err, "Failed to update status")
+				reterr = kerrors.NewAggregate([]error{reterr, err})
+			}
+		}
+	}()
+
+	if err := r.reconcile(ctx, s); err != nil {
+		log.Error(err, "Failed to reconcile resource")
+		return ctrl.Result{}, apistatus.WrapTerminalError(err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *QoSPolicyReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	labelSelector := metav1.LabelSelector{}
+	if r.WatchFilterValue != "" {
+		labelSelector.MatchLabels = map[string]string{v1alpha1.WatchLabel: r.WatchFilterValue}
+	}
+
+	filter, err := predicate.LabelSelectorPredicate(labelSelector)
+	if err != nil {
+		return fmt.Errorf("failed to create label selector predicate: %w", err)
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &v1alpha1.QoSPolicy{}, v1alpha1.DeviceRefIndexKey, func(obj client.Object) []string {
+		o := obj.(*v1alpha1.QoSPolicy)
+		return []string{o.Spec.DeviceRef.Name}
+	}); err != nil {
+		return err
+	}
+
+	bldr := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.QoSPolicy{}).
+		Named("qospolicy").
+		WithOptions(r.Options).
+		WithEventFilter(filter)
+
+	for _, gvk := range v1alpha1.QoSPolicyDependencies {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+
+		bldr = bldr.Watches(
+			obj,
+			handler.EnqueueRequestsFromMapFunc(r.qosPoliciesForProviderConfig),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		)
+	}
+
+	return bldr.
+		// Watches enqueues QoSPolicies for updates in referenced Device resources.
+		// Triggers on create, delete, and update events when the device's effective pause state changes.
+		Watches(
+			&v1alpha1.Device{},
+			handler.EnqueueRequestsFromMapFunc(r.deviceToQoSPolicies),
+			builder.WithPredicates(paused.DevicePredicate()),
+		).
+		Complete(r)
+}
+
+// scope holds the different objects that are read and used during the reconcile.
+type qosPolicyScope struct {
+	Device         *v1alpha1.Device
+	QoSPolicy      *v1alpha1.QoSPolicy
+	Connection     *deviceutil.Connection
+	ProviderConfig *provider.ProviderConfig
+	Provider       provider.QoSPolicyProvider
+}
+
+func (r *QoSPolicyReconciler) reconcile(ctx context.Context, s *qosPolicyScope) (reterr error) {
+	if s.QoSPolicy.Labels == nil {
+		s.QoSPolicy.Labels = make(map[string]string)
+	}
+
+	s.QoSPolicy.Labels[v1alpha1.DeviceLabel] = s.Device.Name
+
+	// Ensure the QoSPolicy is owned by the Device.
+	if !controllerutil.HasControllerReference(s.QoSPolicy) {
+		if err := controllerutil.SetOwnerReference(s.Device, s.QoSPolicy, r.Scheme, controllerutil.WithBlockOwnerDeletion(true)); err != nil {
+			return err
+		}
+	}
+
+	if err := r.validateUniqueClasses(ctx, s); err != nil {
+		return err
+	}
+
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if maintenance.DeferChanges(s.Device, s.QoSPolicy) {
+		return nil
+	}
+
+	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
+		return fmt.Errorf("failed to connect to provider: %w", err)
+	}
+	defer func() {
+		if err := s.Provider.Disconnect(ctx, s.Connection); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	// Ensure the QoSPolicy is realized on the provider.
+	err := s.Provider.EnsureQoSPolicy(ctx, &provider.QoSPolicyRequest{
+		QoSPolicy:      s.QoSPolicy,
+		ProviderConfig: s.ProviderConfig,
+	})
+
+	cond := conditions.FromError(err)
+	// As this resource is configuration only, we use the Configured condition as top-level Ready condition.
+	cond.Type = v1alpha1.ReadyCondition
+	conditions.Set(s.QoSPolicy, cond)
+
+	return err
+}
+
+func (r *QoSPolicyReconciler) finalize(ctx context.Context, s *qosPolicyScope) (reterr error) {
+	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
+		return fmt.Errorf("failed to connect to provider: %w", err)
+	}
+	defer func() {
+		if err := s.Provider.Disconnect(ctx, s.Connection); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	return s.Provider.DeleteQoSPolicy(ctx, &provider.QoSPolicyRequest{
+		QoSPolicy:      s.QoSPolicy,
+		ProviderConfig: s.ProviderConfig,
+	})
+}
+
+// validateUniqueClasses ensures that the classes of the QoSPolicy are not used by another QoSPolicy of the same device.
+// Classes are realized as class-maps, which are global to the device, so a QoSPolicy must neither modify nor delete
+// the class-maps of another one. A class belongs to the oldest QoSPolicy that uses it.
+func (r *QoSPolicyReconciler) validateUniqueClasses(ctx context.Context, s *qosPolicyScope) error {
+	list := new(v1alpha1.QoSPolicyList)
+	if err := r.List(
+		ctx, list,
+		client.InNamespace(s.QoSPolicy.Namespace),
+		client.MatchingFields{v1alpha1.DeviceRefIndexKey: s.Device.Name},
+	); err != nil {
+		return err
+	}
+
+	for _, other := range list.Items {
+		if other.Name == s.QoSPolicy.Name || !olderThan(&other, s.QoSPolicy) {
+			continue
+		}
+		for _, c := range s.QoSPolicy.Spec.Classes {
+			if !slices.ContainsFunc(other.Spec.Classes, func(o v1alpha1.QoSClass) bool { return o.Name == c.Name }) {
+				continue
+			}
+			conditions.Set(s.QoSPolicy, metav1.Condition{
+				Type:    v1alpha1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  v1alpha1.DuplicateQoSClassReason,
+				Message: fmt.Sprintf("Class %q is already used by QoSPolicy %q", c.Name, other.Name),
+			})
+			return reconcile.TerminalError(fmt.Errorf("class %q is already used by qospolicy %s", c.Name, other.Name))
+		}
+	}
+
+	return nil
+}
+
+// olderThan reports whether a was created before b, using the name as a tie-breaker for objects
+// created within the same second.
+func olderThan(a, b client.Object) bool {
+	ta, tb := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !ta.Equal(&tb) {
+		return ta.Before(&tb)
+	}
+	return a.GetName() < b.GetName()
+}
+
+// deviceToQoSPolicies is a [handler.MapFunc] to be used to enqueue requests for reconciliation
+// for QoSPolicies when their referenced Device's effective pause state changes.
+func (r *QoSPolicyReconciler) deviceToQoSPolicies(ctx context.Context, obj client.Object) []ctrl.Request {
+	device, ok := obj.(*v1alpha1.Device)
+	if !ok {
+		panic(fmt.Sprintf("Expected a Device but got a %T", obj))
+	}
+
+	log := ctrl.LoggerFrom(ctx, "Device", klog.KObj(device))
+
+	list := new(v1alpha1.QoSPolicyList)
+	if err := r.List(
+		ctx, list,
+		client.InNamespace(device.Namespace),
+		client.MatchingFields{v1alpha1.DeviceRefIndexKey: device.Name},
+	); err != nil {
+		log.Error(err, "Failed to list QoSPolicies")
+		return nil
+	}
+
+	requests := make([]ctrl.Request, 0, len(list.Items))
+	for _, i := range list.Items {
+		log.V(2).Info("Enqueuing QoSPolicy for reconciliation", "QoSPolicy", klog.KObj(&i))
+		requests = append(requests, ctrl.Request{
+			NamespacedName: client.ObjectKey{
+				Name:      i.Name,
+				Namespace: i.Namespace,
+			},
+		})
+	}
+
+	return requests
+}
+
+// qosPoliciesForProviderConfig is a [handler.MapFunc] to be used to enqueue requests for reconciliation
+// for a QoSPolicy to update when one of its referenced provider configurations gets updated.
+func (r *QoSPolicyReconciler) qosPoliciesForProviderConfig(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := ctrl.LoggerFrom(ctx, "Object", klog.KObj(obj))
+
+	list := &v1alpha1.QoSPolicyList{}
+	if err := r.List(ctx, list, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.Error(err, "Failed to list QoSPolicies")
+		return nil
+	}
+
+	gkv := obj.GetObjectKind().GroupVersionKind()
+
+	var requests []reconcile.Request
+	for _, m := range list.Items {
+		if m.Spec.ProviderConfigRef != nil &&
+			m.Spec.ProviderConfigRef.Name == obj.GetName() &&
+			m.Spec.ProviderConfigRef.Kind == gkv.Kind &&
+			m.Spec.ProviderConfigRef.APIVersion == gkv.GroupVersion().Identifier() {
+			log.V(2).Info("Enqueuing QoSPolicy for reconciliation", "QoSPolicy", klog.KObj(&m))
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      m.Name,
+					Namespace: m.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
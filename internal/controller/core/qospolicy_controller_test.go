@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/conditions"
+)
+
+var _ = Describe("QoSPolicy Controller", func() {
+	Context("When reconciling a resource", func() {
+		const policy = "UPLINK-EGRESS"
+		var (
+			name string
+			key  client.ObjectKey
+		)
+
+		BeforeEach(func() {
+			By("Creating the custom resource for the Kind Device")
+			device := &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-qospolicy-",
+					Namespace:    metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+			name = device.Name
+			key = client.ObjectKey{Name: name, Namespace: metav1.NamespaceDefault}
+
+			By("Creating the custom resource for the Kind QoSPolicy")
+			resource := &v1alpha1.QoSPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.QoSPolicySpec{
+					DeviceRef: v1alpha1.LocalObjectReference{Name: name},
+					Name:      policy,
+					Classes: []v1alpha1.QoSClass{
+						{
+							Name:  "VOICE",
+							Match: v1alpha1.QoSClassMatch{DSCP: []int32{46}},
+							Set:   &v1alpha1.QoSClassSet{QoSGroup: new(int32(5))},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			var resource client.Object = &v1alpha1.QoSPolicy{}
+			err := k8sClient.Get(ctx, key, resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Cleanup the specific resource instance QoSPolicy")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+
+			resource = &v1alpha1.Device{}
+			err = k8sClient.Get(ctx, key, resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Cleanup the specific resource instance Device")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+
+			By("Ensuring the resource is deleted from the provider")
+			Eventually(func(g Gomega) {
+				g.Expect(testProvider.QoSPolicies.Has(policy)).To(BeFalse(), "Provider should not have QoSPolicy configured")
+			}).Should(Succeed())
+		})
+
+		It("Should successfully reconcile the resource", func() {
+			By("Adding a finalizer to the resource")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.QoSPolicy{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(controllerutil.ContainsFinalizer(resource, v1alpha1.FinalizerName)).To(BeTrue())
+			}).Should(Succeed())
+
+			By("Adding the device label to the resource")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.QoSPolicy{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Labels).To(HaveKeyWithValue(v1alpha1.DeviceLabel, name))
+			}).Should(Succeed())
+
+			By("Adding the device as a owner reference")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.QoSPolicy{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.OwnerReferences).To(HaveLen(1))
+				g.Expect(resource.OwnerReferences[0].Kind).To(Equal("Device"))
+				g.Expect(resource.OwnerReferences[0].Name).To(Equal(name))
+			}).Should(Succeed())
+
+			By("Updating the resource status")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.QoSPolicy{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.Conditions).To(HaveLen(2))
+				g.Expect(resource.Status.Conditions[0].Type).To(Equal(v1alpha1.ReadyCondition))
+				g.Expect(resource.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(resource.Status.Conditions[1].Type).To(Equal(v1alpha1.PausedCondition))
+				g.Expect(resource.Status.Conditions[1].Status).To(Equal(metav1.ConditionFalse))
+			}).Should(Succeed())
+
+			By("Ensuring the resource is created in the provider")
+			Eventually(func(g Gomega) {
+				g.Expect(testProvider.QoSPolicies.Has(policy)).To(BeTrue(), "Provider should have QoSPolicy configured")
+			}).Should(Succeed())
+		})
+
+		It("Should reject a class used by another QoSPolicy of the same device", func() {
+			By("Creating another QoSPolicy with the same class")
+			duplicate := &v1alpha1.QoSPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-qospolicy-",
+					Namespace:    metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.QoSPolicySpec{
+					DeviceRef: v1alpha1.LocalObjectReference{Name: name},
+					Name:      "UPLINK-INGRESS",
+					Classes: []v1alpha1.QoSClass{
+						{
+							Name:  "VOICE",
+							Match: v1alpha1.QoSClassMatch{DSCP: []int32{46}},
+							Set:   &v1alpha1.QoSClassSet{QoSGroup: new(int32(6))},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, duplicate)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, duplicate)).To(Succeed())
+			})
+
+			By("Ensuring the duplicate is not configured")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.QoSPolicy{}
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(duplicate), resource)).To(Succeed())
+				cond := conditions.Get(resource, v1alpha1.ReadyCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(cond.Reason).To(Equal(v1alpha1.DuplicateQoSClassReason))
+			}).Should(Succeed())
+			Consistently(func(g Gomega) {
+				g.Expect(testProvider.QoSPolicies.Has("UPLINK-INGRESS")).To(BeFalse(), "Provider should not have the duplicate configured")
+			}).Should(Succeed())
+		})
+	})
+})
@@ -22,6 +22,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -32,6 +33,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -54,6 +56,9 @@ type RoutingPolicyReconciler struct {
 
 	// Locker is used to synchronize operations on resources targeting the same device.
 	Locker *resourcelock.ResourceLocker
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=routingpolicies,verbs=get;list;watch;create;update;patch;delete
@@ -146,6 +151,9 @@ func (r *RoutingPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -239,6 +247,7 @@ func (r *RoutingPolicyReconciler) SetupWithManager(ctx context.Context, mgr ctrl
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.RoutingPolicy{}).
 		Named("routingpolicy").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.RoutingPolicyDependencies {
@@ -272,14 +281,7 @@ func (r *RoutingPolicyReconciler) SetupWithManager(ctx context.Context, mgr ctrl
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToRoutingPolicies),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		Complete(r)
 }
@@ -318,6 +320,11 @@ func (r *RoutingPolicyReconciler) reconcile(ctx context.Context, s *routingPolic
 		return err
 	}
 
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if maintenance.DeferChanges(s.Device, s.RoutingPolicy) {
+		return nil
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
@@ -356,6 +363,20 @@ func (r *RoutingPolicyReconciler) reconcileStatements(ctx context.Context, s *ro
 				PrefixSet: prefixSet,
 			})
 		}
+		if stmt.Conditions != nil && len(stmt.Conditions.MatchCommunityLists) > 0 {
+			cond = append(cond, provider.MatchCommunityListCondition{
+				Names: stmt.Conditions.MatchCommunityLists,
+			})
+		}
+		if stmt.Conditions != nil && len(stmt.Conditions.MatchTags) > 0 {
+			tags := make([]uint32, 0, len(stmt.Conditions.MatchTags))
+			for _, tag := range stmt.Conditions.MatchTags {
+				tags = append(tags, uint32(tag)) // #nosec G115
+			}
+			cond = append(cond, provider.MatchTagCondition{
+				Tags: tags,
+			})
+		}
 
 		statements = append(statements, provider.PolicyStatement{
 			Sequence:   stmt.Sequence,
@@ -7,6 +7,8 @@
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -22,8 +24,8 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
-	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -32,6 +34,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -54,6 +57,13 @@ type SNMPReconciler struct {
 
 	// Locker is used to synchronize operations on resources targeting the same device.
 	Locker *resourcelock.ResourceLocker
+
+	// RequeueInterval is the duration after which the controller should requeue the reconciliation,
+	// regardless of changes.
+	RequeueInterval time.Duration
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=snmp,verbs=get;list;watch;create;update;patch;delete
@@ -146,6 +156,9 @@ func (r *SNMPReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctr
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -199,11 +212,15 @@ func (r *SNMPReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctr
 		return ctrl.Result{}, apistatus.WrapTerminalError(err)
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: Jitter(r.RequeueInterval)}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *SNMPReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	if r.RequeueInterval == 0 {
+		return errors.New("requeue interval must not be 0")
+	}
+
 	labelSelector := metav1.LabelSelector{}
 	if r.WatchFilterValue != "" {
 		labelSelector.MatchLabels = map[string]string{v1alpha1.WatchLabel: r.WatchFilterValue}
@@ -224,6 +241,7 @@ func (r *SNMPReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.SNMP{}).
 		Named("snmp").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.SNMPDependencies {
@@ -243,14 +261,7 @@ func (r *SNMPReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToSNMPs),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate()),
 		).
 		Complete(r)
 }
@@ -287,18 +298,101 @@ func (r *SNMPReconciler) reconcile(ctx context.Context, s *snmpScope) (reterr er
 		}
 	}()
 
-	// Ensure the SNMP is realized on the provider.
-	err := s.Provider.EnsureSNMP(ctx, &provider.EnsureSNMPRequest{
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if !maintenance.DeferChanges(s.Device, s.SNMP) {
+		// Ensure the SNMP is realized on the provider.
+		err := s.Provider.EnsureSNMP(ctx, &provider.EnsureSNMPRequest{
+			SNMP:           s.SNMP,
+			ProviderConfig: s.ProviderConfig,
+		})
+
+		cond := conditions.FromError(err)
+		// As this resource is configuration only, we use the Configured condition as top-level Ready condition.
+		cond.Type = v1alpha1.ReadyCondition
+		conditions.Set(s.SNMP, cond)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	// Read back the SNMP configuration to verify it matches the desired state.
+	status, err := s.Provider.GetSNMPStatus(ctx, &provider.SNMPStatusRequest{
 		SNMP:           s.SNMP,
 		ProviderConfig: s.ProviderConfig,
 	})
+	if err != nil {
+		return fmt.Errorf("failed to get SNMP status: %w", err)
+	}
 
-	cond := conditions.FromError(err)
-	// As this resource is configuration only, we use the Configured condition as top-level Ready condition.
-	cond.Type = v1alpha1.ReadyCondition
+	// Community names are secrets, so only those of the spec are reported, never ones configured out of band.
+	s.SNMP.Status.Communities = nil
+	for _, c := range s.SNMP.Spec.Communities {
+		if slices.Contains(status.Communities, c.Name) {
+			s.SNMP.Status.Communities = append(s.SNMP.Status.Communities, c.Name)
+		}
+	}
+	s.SNMP.Status.Hosts = status.Hosts
+
+	cond := metav1.Condition{
+		Type:    v1alpha1.ConfigurationDriftCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  v1alpha1.NoConfigurationDriftReason,
+		Message: "SNMP configuration on the device matches the desired state",
+	}
+	if drift := snmpDrift(s.SNMP, status); len(drift) > 0 {
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = v1alpha1.ConfigurationDriftReason
+		cond.Message = "SNMP configuration on the device differs from the desired state: " + strings.Join(drift, "; ")
+	}
 	conditions.Set(s.SNMP, cond)
 
-	return err
+	return nil
+}
+
+// snmpDrift compares the desired SNMP configuration with the configuration read back
+// from the device and returns a description of each difference.
+func snmpDrift(snmp *v1alpha1.SNMP, status provider.SNMPStatus) []string {
+	communities := make([]string, 0, len(snmp.Spec.Communities))
+	for _, c := range snmp.Spec.Communities {
+		communities = append(communities, c.Name)
+	}
+	hosts := make([]string, 0, len(snmp.Spec.Hosts))
+	for _, h := range snmp.Spec.Hosts {
+		hosts = append(hosts, h.Address)
+	}
+
+	var drift []string
+	if missing := difference(communities, status.Communities); len(missing) > 0 {
+		drift = append(drift, fmt.Sprintf("missing communities %v", missing))
+	}
+	// Communities configured out of band are only counted, as their names are secrets.
+	if unexpected := difference(status.Communities, communities); len(unexpected) > 0 {
+		drift = append(drift, fmt.Sprintf("unexpected communities: %d", len(unexpected)))
+	}
+	if missing := difference(hosts, status.Hosts); len(missing) > 0 {
+		drift = append(drift, fmt.Sprintf("missing hosts %v", missing))
+	}
+	if unexpected := difference(status.Hosts, hosts); len(unexpected) > 0 {
+		drift = append(drift, fmt.Sprintf("unexpected hosts %v", unexpected))
+	}
+	// Traps that are not listed in the spec may be enabled on the device by default,
+	// so only traps missing on the device are considered drift.
+	if missing := difference(snmp.Spec.Traps, status.Traps); len(missing) > 0 {
+		drift = append(drift, fmt.Sprintf("missing traps %v", missing))
+	}
+	return drift
+}
+
+// difference returns the elements of a that are not contained in b.
+func difference(a, b []string) []string {
+	var diff []string
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			diff = append(diff, v)
+		}
+	}
+	return diff
 }
 
 func (r *SNMPReconciler) finalize(ctx context.Context, s *snmpScope) (reterr error) {
@@ -118,11 +118,21 @@
 			Eventually(func(g Gomega) {
 				resource := &v1alpha1.SNMP{}
 				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
-				g.Expect(resource.Status.Conditions).To(HaveLen(2))
+				g.Expect(resource.Status.Conditions).To(HaveLen(3))
 				g.Expect(resource.Status.Conditions[0].Type).To(Equal(v1alpha1.ReadyCondition))
 				g.Expect(resource.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
 				g.Expect(resource.Status.Conditions[1].Type).To(Equal(v1alpha1.PausedCondition))
 				g.Expect(resource.Status.Conditions[1].Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(resource.Status.Conditions[2].Type).To(Equal(v1alpha1.ConfigurationDriftCondition))
+				g.Expect(resource.Status.Conditions[2].Status).To(Equal(metav1.ConditionFalse))
+			}).Should(Succeed())
+
+			By("Reporting the SNMP configuration read back from the device")
+			Eventually(func(g Gomega) {
+				resource := &v1alpha1.SNMP{}
+				g.Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+				g.Expect(resource.Status.Communities).To(Equal([]string{"snmp-community"}))
+				g.Expect(resource.Status.Hosts).To(Equal([]string{"10.0.0.1"}))
 			}).Should(Succeed())
 
 			By("Ensuring the resource is created in the provider")
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
+func TestSNMPDrift(t *testing.T) {
+	snmp := &v1alpha1.SNMP{
+		Spec: v1alpha1.SNMPSpec{
+			Communities: []v1alpha1.SNMPCommunity{{Name: "public"}},
+			Hosts:       []v1alpha1.SNMPHosts{{Address: "10.0.0.1"}},
+			Traps:       []string{"link linkUp"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		status provider.SNMPStatus
+		want   []string
+	}{
+		{
+			name: "in sync",
+			status: provider.SNMPStatus{
+				Communities: []string{"public"},
+				Hosts:       []string{"10.0.0.1"},
+				Traps:       []string{"link linkDown", "link linkUp"},
+			},
+		},
+		{
+			name: "missing and unexpected configuration",
+			status: provider.SNMPStatus{
+				Communities: []string{"private"},
+				Hosts:       []string{"10.0.0.1", "10.0.0.2"},
+			},
+			want: []string{
+				"missing communities [public]",
+				"unexpected communities: 1",
+				"unexpected hosts [10.0.0.2]",
+				"missing traps [link linkUp]",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := snmpDrift(snmp, test.status); !slices.Equal(got, test.want) {
+				t.Errorf("snmpDrift() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
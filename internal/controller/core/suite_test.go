@@ -9,6 +9,7 @@
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -122,6 +123,7 @@ func TestControllers(t *testing.T) {
 		Scheme:            k8sManager.GetScheme(),
 		Recorder:          recorder,
 		Provider:          prov,
+		Locker:            testLocker,
 		HeartbeatInterval: time.Second,
 	}).SetupWithManager(k8sManager)
 	Expect(err).NotTo(HaveOccurred())
@@ -182,20 +184,22 @@ func TestControllers(t *testing.T) {
 	Expect(err).NotTo(HaveOccurred())
 
 	err = (&CertificateReconciler{
-		Client:   k8sManager.GetClient(),
-		Scheme:   k8sManager.GetScheme(),
-		Recorder: recorder,
-		Provider: prov,
-		Locker:   testLocker,
+		Client:          k8sManager.GetClient(),
+		Scheme:          k8sManager.GetScheme(),
+		Recorder:        recorder,
+		Provider:        prov,
+		Locker:          testLocker,
+		RequeueInterval: time.Second,
 	}).SetupWithManager(ctx, k8sManager)
 	Expect(err).NotTo(HaveOccurred())
 
 	err = (&SNMPReconciler{
-		Client:   k8sManager.GetClient(),
-		Scheme:   k8sManager.GetScheme(),
-		Recorder: recorder,
-		Provider: prov,
-		Locker:   testLocker,
+		Client:          k8sManager.GetClient(),
+		Scheme:          k8sManager.GetScheme(),
+		Recorder:        recorder,
+		Provider:        prov,
+		Locker:          testLocker,
+		RequeueInterval: time.Second,
 	}).SetupWithManager(ctx, k8sManager)
 	Expect(err).NotTo(HaveOccurred())
 
@@ -218,11 +222,12 @@ func TestControllers(t *testing.T) {
 	Expect(err).NotTo(HaveOccurred())
 
 	err = (&ISISReconciler{
-		Client:   k8sManager.GetClient(),
-		Scheme:   k8sManager.GetScheme(),
-		Recorder: recorder,
-		Provider: prov,
-		Locker:   testLocker,
+		Client:          k8sManager.GetClient(),
+		Scheme:          k8sManager.GetScheme(),
+		Recorder:        recorder,
+		Provider:        prov,
+		Locker:          testLocker,
+		RequeueInterval: time.Second,
 	}).SetupWithManager(ctx, k8sManager)
 	Expect(err).NotTo(HaveOccurred())
 
@@ -312,6 +317,15 @@ func TestControllers(t *testing.T) {
 	}).SetupWithManager(ctx, k8sManager)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = (&QoSPolicyReconciler{
+		Client:   k8sManager.GetClient(),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: recorder,
+		Provider: prov,
+		Locker:   testLocker,
+	}).SetupWithManager(ctx, k8sManager)
+	Expect(err).NotTo(HaveOccurred())
+
 	err = (&RoutingPolicyReconciler{
 		Client:   k8sManager.GetClient(),
 		Scheme:   k8sManager.GetScheme(),
@@ -350,6 +364,15 @@ func TestControllers(t *testing.T) {
 	}).SetupWithManager(ctx, k8sManager)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = (&DeviceQueryReconciler{
+		Client:   k8sManager.GetClient(),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: recorder,
+		Provider: prov,
+		Locker:   testLocker,
+	}).SetupWithManager(ctx, k8sManager)
+	Expect(err).NotTo(HaveOccurred())
+
 	go func() {
 		defer GinkgoRecover()
 		err = k8sManager.Start(ctx)
@@ -396,6 +419,12 @@ func detectTestBinaryDir() string {
 	_ provider.Provider                 = (*Provider)(nil)
 	_ provider.DeviceProvider           = (*Provider)(nil)
 	_ provider.MaintenanceProvider      = (*Provider)(nil)
+	_ provider.ECMPProvider             = (*Provider)(nil)
+	_ provider.LACPProvider             = (*Provider)(nil)
+	_ provider.HostnameProvider         = (*Provider)(nil)
+	_ provider.ConfigSaveProvider       = (*Provider)(nil)
+	_ provider.ConfigRevisionProvider   = (*Provider)(nil)
+	_ provider.TransactionProvider      = (*Provider)(nil)
 	_ provider.ProvisioningProvider     = (*Provider)(nil)
 	_ provider.InterfaceProvider        = (*Provider)(nil)
 	_ provider.BannerProvider           = (*Provider)(nil)
@@ -416,11 +445,14 @@ func detectTestBinaryDir() string {
 	_ provider.VLANProvider             = (*Provider)(nil)
 	_ provider.EVPNInstanceProvider     = (*Provider)(nil)
 	_ provider.PrefixSetProvider        = (*Provider)(nil)
+	_ provider.QoSPolicyProvider        = (*Provider)(nil)
 	_ provider.RoutingPolicyProvider    = (*Provider)(nil)
 	_ provider.NVEProvider              = (*Provider)(nil)
 	_ provider.LLDPProvider             = (*Provider)(nil)
 	_ provider.DHCPRelayProvider        = (*Provider)(nil)
 	_ provider.EthernetSegmentProvider  = (*Provider)(nil)
+	_ provider.DeviceQueryProvider      = (*Provider)(nil)
+	_ provider.RunningConfigCLIProvider = (*Provider)(nil)
 )
 
 // Provider is a simple in-memory provider for testing purposes only.
@@ -429,6 +461,13 @@ type Provider struct {
 
 	ConnectError   error // if non-nil, Connect returns this error
 	LastRebootTime time.Time
+	ECMP           int32  // configured maximum ECMP paths
+	ECMPOper       int32  // effective maximum ECMP paths, only updated on reload
+	LACPPriority   int32  // configured LACP system priority, zero for the device default
+	ConfigSaves    int    // number of times the running configuration was saved
+	ConfigRevision string // revision of the running configuration
+	Reboots        []provider.RebootOptions
+	Hostname       string
 
 	Ports            sets.Set[string]
 	User             sets.Set[string]
@@ -437,7 +476,9 @@ type Provider struct {
 	DNS              *v1alpha1.DNS
 	NTP              *v1alpha1.NTP
 	ACLs             sets.Set[string]
+	ObjectGroups     sets.Set[string]
 	Certs            sets.Set[string]
+	CertInfos        map[string]provider.CertificateInfo
 	SNMP             *v1alpha1.SNMP
 	Syslog           *v1alpha1.Syslog
 	Access           *v1alpha1.ManagementAccess
@@ -446,16 +487,19 @@ type Provider struct {
 	PIM              *v1alpha1.PIM
 	BGP              *v1alpha1.BGP
 	BGPVRF           *v1alpha1.VRF
+	BGPPeerGroups    sets.Set[string]
 	BGPPeers         sets.Set[string]
 	OSPF             sets.Set[string]
 	VLANs            sets.Set[int16]
 	EVIs             sets.Set[int32]
 	PrefixSets       sets.Set[string]
+	QoSPolicies      sets.Set[string]
 	RoutingPolicies  sets.Set[string]
 	NVE              *v1alpha1.NetworkVirtualizationEdge
 	LLDP             *v1alpha1.LLDP
 	LLDPOperStatus   bool
 	LLDPNeighbors    map[string]*provider.LLDPAdjacency
+	SwitchportModes  map[string]v1alpha1.SwitchportMode
 	DHCPRelay        *v1alpha1.DHCPRelay
 	EthernetSegments map[string]string
 }
@@ -466,17 +510,22 @@ func NewProvider() *Provider {
 		Ports:            sets.New[string](),
 		User:             sets.New[string](),
 		ACLs:             sets.New[string](),
+		ObjectGroups:     sets.New[string](),
 		Certs:            sets.New[string](),
+		CertInfos:        make(map[string]provider.CertificateInfo),
 		ISIS:             sets.New[string](),
 		VRF:              sets.New[string](),
+		BGPPeerGroups:    sets.New[string](),
 		BGPPeers:         sets.New[string](),
 		OSPF:             sets.New[string](),
 		VLANs:            sets.New[int16](),
 		EVIs:             sets.New[int32](),
 		PrefixSets:       sets.New[string](),
+		QoSPolicies:      sets.New[string](),
 		RoutingPolicies:  sets.New[string](),
 		LLDPOperStatus:   true,
 		LLDPNeighbors:    make(map[string]*provider.LLDPAdjacency),
+		SwitchportModes:  make(map[string]v1alpha1.SwitchportMode),
 		EthernetSegments: make(map[string]string),
 	}
 }
@@ -521,6 +570,51 @@ func (p *Provider) GetLastRebootTime(_ context.Context) (time.Time, error) {
 	return p.LastRebootTime, nil
 }
 
+func (p *Provider) EnsureECMP(_ context.Context, req *provider.EnsureECMPRequest) (bool, error) {
+	p.Lock()
+	defer p.Unlock()
+	p.ECMP = req.MaximumPaths
+	return p.ECMP != p.ECMPOper, nil
+}
+
+func (p *Provider) EnsureLACP(_ context.Context, req *provider.EnsureLACPRequest) error {
+	p.Lock()
+	defer p.Unlock()
+	p.LACPPriority = req.SystemPriority
+	return nil
+}
+
+func (p *Provider) EnsureHostname(_ context.Context, name string) error {
+	p.Lock()
+	defer p.Unlock()
+	p.Hostname = name
+	return nil
+}
+
+func (p *Provider) GetHostname(context.Context) (string, error) {
+	p.Lock()
+	defer p.Unlock()
+	return p.Hostname, nil
+}
+
+func (p *Provider) SaveConfig(context.Context) error {
+	p.Lock()
+	defer p.Unlock()
+	p.ConfigSaves++
+	return nil
+}
+
+func (p *Provider) GetConfigRevision(context.Context) (string, error) {
+	p.Lock()
+	defer p.Unlock()
+	return p.ConfigRevision, nil
+}
+
+// Transaction applies the operations of fn right away, as the in-memory provider can't fail partially.
+func (p *Provider) Transaction(ctx context.Context, fn func(context.Context, provider.Provider) error) error {
+	return fn(ctx, p)
+}
+
 func (p *Provider) GetDeviceInfo(context.Context) (*provider.DeviceInfo, error) {
 	return &provider.DeviceInfo{
 		Manufacturer:    "Manufacturer",
@@ -538,7 +632,10 @@ func (p *Provider) VerifyProvisioned(context.Context, *deviceutil.Connection, *v
 	return true
 }
 
-func (p *Provider) Reboot(ctx context.Context, conn *deviceutil.Connection) error {
+func (p *Provider) Reboot(ctx context.Context, conn *deviceutil.Connection, opts *provider.RebootOptions) error {
+	p.Lock()
+	defer p.Unlock()
+	p.Reboots = append(p.Reboots, *opts)
 	return nil
 }
 
@@ -576,6 +673,13 @@ func (p *Provider) GetInterfaceStatus(_ context.Context, req *provider.Interface
 		status.LLDPAdjacencies = []provider.LLDPAdjacency{*neighbor}
 	}
 
+	if req.Interface.Spec.Switchport != nil {
+		status.SwitchportMode = req.Interface.Spec.Switchport.Mode
+		if mode, ok := p.SwitchportModes[req.Interface.Spec.Name]; ok {
+			status.SwitchportMode = mode
+		}
+	}
+
 	return status, nil
 }
 
@@ -667,10 +771,30 @@ func (p *Provider) DeleteACL(_ context.Context, req *provider.DeleteACLRequest)
 	return nil
 }
 
+func (p *Provider) EnsureObjectGroup(_ context.Context, req *provider.EnsureObjectGroupRequest) error {
+	p.Lock()
+	defer p.Unlock()
+	p.ObjectGroups.Insert(req.ObjectGroup.Name)
+	return nil
+}
+
+func (p *Provider) DeleteObjectGroup(_ context.Context, req *provider.DeleteObjectGroupRequest) error {
+	p.Lock()
+	defer p.Unlock()
+	p.ObjectGroups.Delete(req.Name)
+	return nil
+}
+
 func (p *Provider) EnsureCertificate(_ context.Context, req *provider.EnsureCertificateRequest) error {
 	p.Lock()
 	defer p.Unlock()
 	p.Certs.Insert(req.ID)
+	p.CertInfos[req.ID] = provider.CertificateInfo{
+		ID:           req.ID,
+		SerialNumber: strings.ToUpper(req.Certificate.Leaf.SerialNumber.Text(16)),
+		NotBefore:    req.Certificate.Leaf.NotBefore,
+		NotAfter:     req.Certificate.Leaf.NotAfter,
+	}
 	return nil
 }
 
@@ -678,9 +802,20 @@ func (p *Provider) DeleteCertificate(_ context.Context, req *provider.DeleteCert
 	p.Lock()
 	defer p.Unlock()
 	p.Certs.Delete(req.ID)
+	delete(p.CertInfos, req.ID)
 	return nil
 }
 
+func (p *Provider) GetCertificate(_ context.Context, req *provider.GetCertificateRequest) (*provider.CertificateInfo, error) {
+	p.Lock()
+	defer p.Unlock()
+	c, ok := p.CertInfos[req.ID]
+	if !ok {
+		return nil, nil
+	}
+	return &c, nil
+}
+
 func (p *Provider) EnsureSNMP(_ context.Context, req *provider.EnsureSNMPRequest) error {
 	p.Lock()
 	defer p.Unlock()
@@ -695,6 +830,23 @@ func (p *Provider) DeleteSNMP(_ context.Context, req *provider.DeleteSNMPRequest
 	return nil
 }
 
+func (p *Provider) GetSNMPStatus(_ context.Context, req *provider.SNMPStatusRequest) (provider.SNMPStatus, error) {
+	p.Lock()
+	defer p.Unlock()
+	var s provider.SNMPStatus
+	if p.SNMP == nil {
+		return s, nil
+	}
+	for _, c := range p.SNMP.Spec.Communities {
+		s.Communities = append(s.Communities, c.Name)
+	}
+	for _, h := range p.SNMP.Spec.Hosts {
+		s.Hosts = append(s.Hosts, h.Address)
+	}
+	s.Traps = p.SNMP.Spec.Traps
+	return s, nil
+}
+
 func (p *Provider) EnsureSyslog(_ context.Context, req *provider.EnsureSyslogRequest) error {
 	p.Lock()
 	defer p.Unlock()
@@ -737,6 +889,12 @@ func (p *Provider) DeleteISIS(_ context.Context, req *provider.DeleteISISRequest
 	return nil
 }
 
+func (p *Provider) GetISISStatus(context.Context, *provider.ISISStatusRequest) (provider.ISISStatus, error) {
+	return provider.ISISStatus{
+		OperStatus: true,
+	}, nil
+}
+
 func (p *Provider) EnsureVRF(_ context.Context, req *provider.VRFRequest) error {
 	p.Lock()
 	defer p.Unlock()
@@ -781,6 +939,20 @@ func (p *Provider) DeleteBGP(context.Context, *provider.DeleteBGPRequest) error
 	return nil
 }
 
+func (p *Provider) EnsureBGPPeerGroup(_ context.Context, req *provider.EnsureBGPPeerGroupRequest) error {
+	p.Lock()
+	defer p.Unlock()
+	p.BGPPeerGroups.Insert(req.PeerGroup.Name)
+	return nil
+}
+
+func (p *Provider) DeleteBGPPeerGroup(_ context.Context, req *provider.DeleteBGPPeerGroupRequest) error {
+	p.Lock()
+	defer p.Unlock()
+	p.BGPPeerGroups.Delete(req.Name)
+	return nil
+}
+
 func (p *Provider) EnsureBGPPeer(_ context.Context, req *provider.EnsureBGPPeerRequest) error {
 	p.Lock()
 	defer p.Unlock()
@@ -877,6 +1049,21 @@ func (p *Provider) DeletePrefixSet(_ context.Context, req *provider.PrefixSetReq
 	return nil
 }
 
+// EnsureQoSPolicy implements provider.QoSPolicyProvider.
+func (p *Provider) EnsureQoSPolicy(_ context.Context, req *provider.QoSPolicyRequest) error {
+	p.Lock()
+	defer p.Unlock()
+	p.QoSPolicies.Insert(req.QoSPolicy.Spec.Name)
+	return nil
+}
+
+func (p *Provider) DeleteQoSPolicy(_ context.Context, req *provider.QoSPolicyRequest) error {
+	p.Lock()
+	defer p.Unlock()
+	p.QoSPolicies.Delete(req.QoSPolicy.Spec.Name)
+	return nil
+}
+
 func (p *Provider) EnsureRoutingPolicy(_ context.Context, req *provider.EnsureRoutingPolicyRequest) error {
 	p.Lock()
 	defer p.Unlock()
@@ -908,6 +1095,8 @@ func (p *Provider) DeleteNVE(_ context.Context, req *provider.NVERequest) error
 func (p *Provider) GetNVEStatus(_ context.Context, _ *provider.NVERequest) (provider.NVEStatus, error) {
 	status := provider.NVEStatus{
 		OperStatus: true,
+		Peers:      []provider.NVEPeer{{Address: "10.0.0.2", State: v1alpha1.NVEPeerStateUp}},
+		VNIs:       []provider.NVEVNIStatus{{VNI: 100010, OperStatus: true}},
 	}
 	if p.NVE != nil {
 		if p.NVE.Spec.SourceInterfaceRef.Name != "" {
@@ -1013,3 +1202,17 @@ func (p *Provider) SetLLDPNeighbor(interfaceName, sysName, chassisID, portID str
 		TTL:           time.Duration(ttl) * time.Second,
 	}
 }
+
+func (p *Provider) QueryDevice(_ context.Context, req *provider.DeviceQueryRequest) ([]byte, error) {
+	if req.DeviceQuery.Spec.Path == "invalid" {
+		return nil, errors.New("invalid path")
+	}
+	if strings.HasSuffix(req.DeviceQuery.Spec.Path, "/pwd") {
+		return []byte(`"$5$secret"`), nil
+	}
+	return []byte(`{"name":"admin","pwdHash":"$5$secret","role":"network-admin"}`), nil
+}
+
+func (p *Provider) GetRunningConfigCLI(context.Context) (string, error) {
+	return "hostname leaf1\nusername admin password 5 $5$secret role network-admin\n", nil
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
+// inTransaction calls fn with a provider that applies all configuration changes made through it in a
+// single transaction, if p implements [provider.TransactionProvider], so that a failure doesn't leave
+// tightly-coupled objects partially configured on the device. Otherwise, fn is called with p itself.
+func inTransaction[P provider.Provider](ctx context.Context, p P, fn func(ctx context.Context, p P) error) error {
+	tp, ok := any(p).(provider.TransactionProvider)
+	if !ok {
+		return fn(ctx, p)
+	}
+	return tp.Transaction(ctx, func(ctx context.Context, tx provider.Provider) error {
+		txp, ok := tx.(P)
+		if !ok {
+			return fmt.Errorf("transaction provider does not implement %T", *new(P))
+		}
+		return fn(ctx, txp)
+	})
+}
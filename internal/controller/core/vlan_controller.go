@@ -22,8 +22,8 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
-	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -32,6 +32,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -58,6 +59,9 @@ type VLANReconciler struct {
 	// RequeueInterval is the duration after which the controller should requeue the reconciliation,
 	// regardless of changes.
 	RequeueInterval time.Duration
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=vlans,verbs=get;list;watch;create;update;patch;delete
@@ -150,6 +154,9 @@ func (r *VLANReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctr
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
 				return ctrl.Result{}, err
@@ -232,6 +239,7 @@ func (r *VLANReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.VLAN{}).
 		Named("vlan").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.VLANDependencies {
@@ -247,18 +255,12 @@ func (r *VLANReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 
 	return bldr.
 		// Watches enqueues VLANs for updates in referenced Device resources.
-		// Triggers on create, delete, and update events when the device's effective pause state changes.
+		// Triggers on create, delete, and update events when the device's effective pause state
+		// or its ownership ranges change.
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToVLANs),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate(ownershipChanged)),
 		).
 		Complete(r)
 }
@@ -290,6 +292,11 @@ func (r *VLANReconciler) reconcile(ctx context.Context, s *vlanScope) (reterr er
 		conditions.RecomputeReady(s.VLAN)
 	}()
 
+	// Refuse to touch VLANs outside of the ranges assigned to the operator on shared devices.
+	if err := ensureOwned(s.Device, s.VLAN, v1alpha1.ConfiguredCondition, []int16{s.VLAN.Spec.ID}, nil); err != nil {
+		return err
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
@@ -299,14 +306,17 @@ func (r *VLANReconciler) reconcile(ctx context.Context, s *vlanScope) (reterr er
 		}
 	}()
 
-	// Ensure the VLAN is realized on the provider.
-	err := s.Provider.EnsureVLAN(ctx, &provider.VLANRequest{
-		VLAN:           s.VLAN,
-		ProviderConfig: s.ProviderConfig,
-	})
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if !maintenance.DeferChanges(s.Device, s.VLAN) {
+		// Ensure the VLAN is realized on the provider.
+		err := s.Provider.EnsureVLAN(ctx, &provider.VLANRequest{
+			VLAN:           s.VLAN,
+			ProviderConfig: s.ProviderConfig,
+		})
 
-	cond := conditions.FromError(err)
-	conditions.Set(s.VLAN, cond)
+		cond := conditions.FromError(err)
+		conditions.Set(s.VLAN, cond)
+	}
 
 	status, err := s.Provider.GetVLANStatus(ctx, &provider.VLANRequest{
 		VLAN:           s.VLAN,
@@ -316,7 +326,7 @@ func (r *VLANReconciler) reconcile(ctx context.Context, s *vlanScope) (reterr er
 		return fmt.Errorf("failed to get vlan status: %w", err)
 	}
 
-	cond = metav1.Condition{
+	cond := metav1.Condition{
 		Type:    v1alpha1.OperationalCondition,
 		Status:  metav1.ConditionTrue,
 		Reason:  v1alpha1.OperationalReason,
@@ -333,6 +343,11 @@ func (r *VLANReconciler) reconcile(ctx context.Context, s *vlanScope) (reterr er
 }
 
 func (r *VLANReconciler) finalize(ctx context.Context, s *vlanScope) (reterr error) {
+	if !s.Device.Spec.Ownership.OwnsVLAN(s.VLAN.Spec.ID) {
+		ctrl.LoggerFrom(ctx).Info("VLAN is not owned by the operator, skipping deletion on the device", "ID", s.VLAN.Spec.ID)
+		return nil
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
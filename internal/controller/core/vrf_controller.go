@@ -7,6 +7,10 @@
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"net/netip"
+	"strconv"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -17,13 +21,14 @@
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
-	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -32,6 +37,7 @@
 	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/maintenance"
 	"github.com/ironcore-dev/network-operator/internal/paused"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/resourcelock"
@@ -54,11 +60,16 @@ type VRFReconciler struct {
 
 	// Locker is used to synchronize operations on resources targeting the same device.
 	Locker *resourcelock.ResourceLocker
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=vrfs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=vrfs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=vrfs/finalizers,verbs=update
+// +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=bgp,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.metal.ironcore.dev,resources=evpninstances,verbs=get;list;watch
 // +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -148,8 +159,14 @@ func (r *VRFReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl
 
 	if !obj.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(obj, v1alpha1.FinalizerName) {
+			if deferred, err := maintenance.DeferDeletion(ctx, r.Client, device, obj); deferred || err != nil {
+				return ctrl.Result{}, err
+			}
 			if err := r.finalize(ctx, s); err != nil {
 				log.Error(err, "Failed to finalize resource")
+				if st, ok := apistatus.FromError(err); ok && st.Code == apistatus.CodeFailedPrecondition {
+					r.Recorder.Eventf(obj, nil, "Warning", "DeletionBlocked", "Finalize", "%s", st.Error())
+				}
 				return ctrl.Result{}, err
 			}
 			controllerutil.RemoveFinalizer(obj, v1alpha1.FinalizerName)
@@ -226,7 +243,17 @@ func (r *VRFReconciler) reconcile(ctx context.Context, s *vrfScope) (reterr erro
 		}
 	}
 
+	// Refuse to touch VRFs not assigned to the operator on shared devices.
+	if err := ensureOwned(s.Device, s.VRF, v1alpha1.ReadyCondition, nil, []string{s.VRF.Spec.Name}); err != nil {
+		return err
+	}
+
 	// Connect to remote device using the provider.
+	// Changes to the device are deferred until one of its maintenance windows opens.
+	if maintenance.DeferChanges(s.Device, s.VRF) {
+		return nil
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
@@ -236,10 +263,18 @@ func (r *VRFReconciler) reconcile(ctx context.Context, s *vrfScope) (reterr erro
 		}
 	}()
 
-	// Realize the VRF on the remote device using the provider.
-	err := s.Provider.EnsureVRF(ctx, &provider.VRFRequest{
-		VRF:            s.VRF,
-		ProviderConfig: s.ProviderConfig,
+	vrf, err := r.reconcileRouteDerivation(ctx, s)
+	if err != nil {
+		return err
+	}
+
+	// Realize the VRF on the remote device using the provider, in a single transaction if supported,
+	// so that a failure doesn't leave a partially configured VRF on the device.
+	err = inTransaction(ctx, s.Provider, func(ctx context.Context, p provider.VRFProvider) error {
+		return p.EnsureVRF(ctx, &provider.VRFRequest{
+			VRF:            vrf,
+			ProviderConfig: s.ProviderConfig,
+		})
 	})
 
 	cond := conditions.FromError(err)
@@ -250,6 +285,193 @@ func (r *VRFReconciler) reconcile(ctx context.Context, s *vrfScope) (reterr erro
 	return err
 }
 
+// reconcileRouteDerivation returns the VRF to be realized on the device, with the route distinguisher
+// and route targets derived from the VRFDerivation of the Device if the VRF does not specify them.
+// Sets ReadyCondition and returns a terminal error when the derivation fails.
+func (r *VRFReconciler) reconcileRouteDerivation(ctx context.Context, s *vrfScope) (*v1alpha1.VRF, error) {
+	vrf := s.VRF.DeepCopy()
+	if d := s.Device.Spec.VRFDerivation; d != nil {
+		err := r.deriveRoutes(ctx, s.Device, vrf, d)
+		if err != nil {
+			conditions.Set(s.VRF, metav1.Condition{
+				Type:    v1alpha1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  v1alpha1.RouteDerivationFailedReason,
+				Message: err.Error(),
+			})
+			return nil, reconcile.TerminalError(err)
+		}
+	}
+
+	s.VRF.Status.RouteDistinguisher = vrf.Spec.RouteDistinguisher
+	s.VRF.Status.RouteTargets = vrf.Spec.RouteTargets
+	return vrf, nil
+}
+
+// deriveRoutes sets the route distinguisher and route targets of the VRF according to the given derivation
+// scheme, leaving values that are explicitly specified on the VRF untouched.
+func (r *VRFReconciler) deriveRoutes(ctx context.Context, device *v1alpha1.Device, vrf *v1alpha1.VRF, d *v1alpha1.VRFDerivation) error {
+	var formats []string
+	if vrf.Spec.RouteDistinguisher == "" && d.RouteDistinguisher != "" {
+		formats = append(formats, d.RouteDistinguisher)
+	}
+	if len(vrf.Spec.RouteTargets) == 0 && d.RouteTarget != "" {
+		formats = append(formats, d.RouteTarget)
+	}
+	if len(formats) == 0 {
+		return nil
+	}
+
+	vars := make(map[string]string, 3)
+	for _, f := range formats {
+		if _, ok := vars["{asn}"]; !ok && (strings.Contains(f, "{asn}") || strings.Contains(f, "{routerId}")) {
+			bgp, err := r.defaultBGP(ctx, device)
+			if err != nil {
+				return err
+			}
+			asn, err := asplain(bgp.Spec.ASNumber)
+			if err != nil {
+				return fmt.Errorf("invalid AS number of BGP %s: %w", bgp.Name, err)
+			}
+			vars["{asn}"] = strconv.FormatUint(uint64(asn), 10)
+			vars["{routerId}"] = bgp.Spec.RouterID
+		}
+		if _, ok := vars["{vni}"]; !ok && strings.Contains(f, "{vni}") {
+			vni, err := r.vrfVNI(ctx, vrf)
+			if err != nil {
+				return err
+			}
+			vars["{vni}"] = strconv.FormatUint(uint64(vni), 10)
+		}
+	}
+
+	expand := func(format string) (string, error) {
+		v := format
+		for k, val := range vars {
+			v = strings.ReplaceAll(v, k, val)
+		}
+		if err := validateDerivedRoute(v); err != nil {
+			return "", fmt.Errorf("format %q yields invalid value %q: %w", format, v, err)
+		}
+		return v, nil
+	}
+
+	if vrf.Spec.RouteDistinguisher == "" && d.RouteDistinguisher != "" {
+		rd, err := expand(d.RouteDistinguisher)
+		if err != nil {
+			return fmt.Errorf("failed to derive route distinguisher: %w", err)
+		}
+		vrf.Spec.RouteDistinguisher = rd
+	}
+
+	if len(vrf.Spec.RouteTargets) == 0 && d.RouteTarget != "" {
+		rt, err := expand(d.RouteTarget)
+		if err != nil {
+			return fmt.Errorf("failed to derive route target: %w", err)
+		}
+		afs := d.RouteTargetAddressFamilies
+		if len(afs) == 0 {
+			afs = []v1alpha1.RouteTargetAF{v1alpha1.IPv4EVPN, v1alpha1.IPv6EVPN}
+		}
+		vrf.Spec.RouteTargets = []v1alpha1.RouteTarget{{
+			Value:           rt,
+			AddressFamilies: afs,
+			Action:          v1alpha1.RouteTargetActionBoth,
+		}}
+	}
+
+	return nil
+}
+
+// defaultBGP returns the BGP instance in the default VRF of the device.
+func (r *VRFReconciler) defaultBGP(ctx context.Context, device *v1alpha1.Device) (*v1alpha1.BGP, error) {
+	list := new(v1alpha1.BGPList)
+	if err := r.List(ctx, list, client.InNamespace(device.Namespace), client.MatchingFields{v1alpha1.DeviceRefIndexKey: device.Name}); err != nil {
+		return nil, fmt.Errorf("failed to list BGPs: %w", err)
+	}
+	for i := range list.Items {
+		if list.Items[i].Spec.VrfRef == nil {
+			return &list.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no BGP in the default VRF found for device %s", device.Name)
+}
+
+// vrfVNI returns the L3 VNI of the VRF, which is taken from the Routed EVPNInstance referencing the VRF
+// or, if there is none, from the deprecated VNI field of the VRF.
+func (r *VRFReconciler) vrfVNI(ctx context.Context, vrf *v1alpha1.VRF) (uint32, error) {
+	list := new(v1alpha1.EVPNInstanceList)
+	if err := r.List(ctx, list, client.InNamespace(vrf.Namespace), client.MatchingFields{eviVrfRefKey: vrf.Name}); err != nil {
+		return 0, fmt.Errorf("failed to list EVPNInstances: %w", err)
+	}
+	for _, evi := range list.Items {
+		if evi.Spec.Type == v1alpha1.EVPNInstanceTypeRouted && evi.Spec.DeviceRef.Name == vrf.Spec.DeviceRef.Name {
+			return uint32(evi.Spec.VNI), nil // #nosec G115
+		}
+	}
+	if vrf.Spec.VNI != 0 {
+		return vrf.Spec.VNI, nil
+	}
+	return 0, fmt.Errorf("no VNI found for VRF %s", vrf.Spec.Name)
+}
+
+// asplain converts an AS number in plain or dotted notation (RFC 5396) to its plain representation.
+func asplain(asn intstr.IntOrString) (uint32, error) {
+	if asn.Type == intstr.Int {
+		if asn.IntVal < 1 {
+			return 0, fmt.Errorf("AS number %d out of range", asn.IntVal)
+		}
+		return uint32(asn.IntVal), nil // #nosec G115
+	}
+	high, low, dotted := strings.Cut(asn.StrVal, ".")
+	if !dotted {
+		v, err := strconv.ParseUint(asn.StrVal, 10, 32)
+		if err != nil {
+			return 0, err
+		}
+		return uint32(v), nil
+	}
+	h, err := strconv.ParseUint(high, 10, 16)
+	if err != nil {
+		return 0, err
+	}
+	l, err := strconv.ParseUint(low, 10, 16)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(h<<16 | l), nil
+}
+
+// validateDerivedRoute validates that a derived value is a valid type-0, type-1 or type-2 route distinguisher.
+// Route targets share the same textual format.
+func validateDerivedRoute(v string) error {
+	admin, assigned, ok := strings.Cut(v, ":")
+	if !ok {
+		return errors.New("format must be <admin>:<assigned>")
+	}
+	num, err := strconv.ParseUint(assigned, 10, 32)
+	if err != nil {
+		return errors.New("'Assigned Number' must be a 32-bit unsigned decimal")
+	}
+	if ip, err := netip.ParseAddr(admin); err == nil && ip.Is4() {
+		if num > math.MaxUint16 {
+			return errors.New("type-1 'Assigned Number' is out of range (0-65535)")
+		}
+		return nil
+	}
+	asn, err := strconv.ParseUint(admin, 10, 32)
+	if err != nil {
+		return errors.New("'Administrator' must be an IPv4 address or an AS number")
+	}
+	switch {
+	case asn == 0 || asn == math.MaxUint16 || asn == math.MaxUint32:
+		return fmt.Errorf("ASN %d is reserved and cannot be used", asn)
+	case asn > math.MaxUint16 && num > math.MaxUint16:
+		return errors.New("type-2 'Assigned Number' is out of range (0-65535)")
+	}
+	return nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *VRFReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	labelSelector := metav1.LabelSelector{}
@@ -272,6 +494,7 @@ func (r *VRFReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.VRF{}).
 		Named("vrf").
+		WithOptions(r.Options).
 		WithEventFilter(filter)
 
 	for _, gvk := range v1alpha1.VRFDependencies {
@@ -287,23 +510,49 @@ func (r *VRFReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 
 	return bldr.
 		// Watches enqueues VRFs for updates in referenced Device resources.
-		// Triggers on create, delete, and update events when the device's effective pause state changes.
+		// Triggers on create, delete, and update events when the device's effective pause state
+		// its VRF derivation scheme or its ownership ranges change.
 		Watches(
 			&v1alpha1.Device{},
 			handler.EnqueueRequestsFromMapFunc(r.deviceToVRFs),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					return paused.DevicePausedChanged(e.ObjectOld, e.ObjectNew)
-				},
-				GenericFunc: func(e event.GenericEvent) bool {
-					return false
-				},
-			}),
+			builder.WithPredicates(paused.DevicePredicate(vrfDerivationChanged, ownershipChanged)),
+		).
+		// Watches enqueues VRFs of the device when a BGP instance changes, as its AS number
+		// and router identifier are used to derive route distinguishers and route targets.
+		Watches(
+			&v1alpha1.BGP{},
+			handler.EnqueueRequestsFromMapFunc(r.bgpToVRFs),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		// Watches enqueues VRFs referenced by an EVPNInstance, as its VNI is used to derive
+		// route distinguishers and route targets.
+		Watches(
+			&v1alpha1.EVPNInstance{},
+			handler.EnqueueRequestsFromMapFunc(r.evpnInstanceToVRF),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		).
 		Complete(r)
 }
 
+// vrfDerivationChanged reports whether the VRF derivation scheme of a Device has changed.
+func vrfDerivationChanged(oldObj, newObj client.Object) bool {
+	oldDevice, ok := oldObj.(*v1alpha1.Device)
+	if !ok {
+		return false
+	}
+	newDevice, ok := newObj.(*v1alpha1.Device)
+	if !ok {
+		return false
+	}
+	return !equality.Semantic.DeepEqual(oldDevice.Spec.VRFDerivation, newDevice.Spec.VRFDerivation)
+}
+
 func (r *VRFReconciler) finalize(ctx context.Context, s *vrfScope) (reterr error) {
+	if !s.Device.Spec.Ownership.OwnsVRF(s.VRF.Spec.Name) {
+		ctrl.LoggerFrom(ctx).Info("VRF is not owned by the operator, skipping deletion on the device", "Name", s.VRF.Spec.Name)
+		return nil
+	}
+
 	if err := s.Provider.Connect(ctx, s.Connection); err != nil {
 		return fmt.Errorf("failed to connect to provider: %w", err)
 	}
@@ -353,6 +602,54 @@ func (r *VRFReconciler) deviceToVRFs(ctx context.Context, obj client.Object) []c
 	return requests
 }
 
+// bgpToVRFs is a [handler.MapFunc] to be used to enqueue requests for reconciliation
+// for VRFs on the same device as a BGP instance when the device uses a VRF derivation scheme.
+func (r *VRFReconciler) bgpToVRFs(ctx context.Context, obj client.Object) []ctrl.Request {
+	bgp, ok := obj.(*v1alpha1.BGP)
+	if !ok {
+		panic(fmt.Sprintf("Expected a BGP but got a %T", obj))
+	}
+
+	log := ctrl.LoggerFrom(ctx, "BGP", klog.KObj(bgp))
+
+	device := new(v1alpha1.Device)
+	if err := r.Get(ctx, client.ObjectKey{Name: bgp.Spec.DeviceRef.Name, Namespace: bgp.Namespace}, device); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "Failed to get Device")
+		}
+		return nil
+	}
+
+	if device.Spec.VRFDerivation == nil {
+		return nil
+	}
+
+	return r.deviceToVRFs(ctx, device)
+}
+
+// evpnInstanceToVRF is a [handler.MapFunc] to be used to enqueue requests for reconciliation
+// for the VRF referenced by an EVPNInstance.
+func (r *VRFReconciler) evpnInstanceToVRF(ctx context.Context, obj client.Object) []ctrl.Request {
+	evi, ok := obj.(*v1alpha1.EVPNInstance)
+	if !ok {
+		panic(fmt.Sprintf("Expected an EVPNInstance but got a %T", obj))
+	}
+
+	if evi.Spec.VRFRef == nil {
+		return nil
+	}
+
+	log := ctrl.LoggerFrom(ctx, "EVPNInstance", klog.KObj(evi))
+	log.V(2).Info("Enqueuing VRF for reconciliation", "VRF", evi.Spec.VRFRef.Name)
+
+	return []ctrl.Request{{
+		NamespacedName: client.ObjectKey{
+			Name:      evi.Spec.VRFRef.Name,
+			Namespace: evi.Namespace,
+		},
+	}}
+}
+
 // vrfsForProviderConfig is a [handler.MapFunc] to be used to enqueue requests for reconciliation
 // for a VRF to update when one of its referenced provider configurations gets updated.
 func (r *VRFReconciler) vrfsForProviderConfig(ctx context.Context, obj client.Object) []reconcile.Request {
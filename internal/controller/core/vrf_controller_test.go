@@ -9,7 +9,9 @@
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
 )
@@ -131,4 +133,106 @@
 			}).Should(Succeed())
 		})
 	})
+
+	Context("When deriving route distinguisher and route targets", func() {
+		var (
+			key    client.ObjectKey
+			device *v1alpha1.Device
+		)
+
+		BeforeEach(func() {
+			By("Creating a Device with a VRF derivation scheme")
+			device = &v1alpha1.Device{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-vrf-derive-",
+					Namespace:    metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.DeviceSpec{
+					Endpoint: v1alpha1.Endpoint{
+						Address: "192.168.10.2:9339",
+					},
+					VRFDerivation: &v1alpha1.VRFDerivation{
+						RouteDistinguisher: "{routerId}:{vni}",
+						RouteTarget:        "{asn}:{vni}",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, device)).To(Succeed())
+			key = client.ObjectKey{Name: device.Name, Namespace: metav1.NamespaceDefault}
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, device)).To(Succeed())
+			})
+		})
+
+		createBGP := func(asn intstr.IntOrString) {
+			bgp := &v1alpha1.BGP{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      device.Name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.BGPSpec{
+					DeviceRef: v1alpha1.LocalObjectReference{Name: device.Name},
+					ASNumber:  asn,
+					RouterID:  "10.0.0.10",
+				},
+			}
+			Expect(k8sClient.Create(ctx, bgp)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, bgp)).To(Succeed())
+			})
+		}
+
+		createVRF := func(vni uint32) *v1alpha1.VRF {
+			vrf := &v1alpha1.VRF{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      device.Name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1alpha1.VRFSpec{
+					DeviceRef: v1alpha1.LocalObjectReference{Name: device.Name},
+					Name:      "CC-DERIVED",
+					VNI:       vni,
+				},
+			}
+			Expect(k8sClient.Create(ctx, vrf)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, vrf)).To(Succeed())
+				Eventually(func(g Gomega) {
+					g.Expect(k8sClient.Get(ctx, key, vrf)).ToNot(Succeed())
+				}).Should(Succeed())
+			})
+			return vrf
+		}
+
+		It("Should derive the values from the ASN, router ID and VNI", func() {
+			createBGP(intstr.FromString("1.10"))
+			vrf := createVRF(100)
+
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, key, vrf)).To(Succeed())
+				g.Expect(vrf.Status.RouteDistinguisher).To(Equal("10.0.0.10:100"))
+				g.Expect(vrf.Status.RouteTargets).To(Equal([]v1alpha1.RouteTarget{{
+					Value:           "65546:100",
+					AddressFamilies: []v1alpha1.RouteTargetAF{v1alpha1.IPv4EVPN, v1alpha1.IPv6EVPN},
+					Action:          v1alpha1.RouteTargetActionBoth,
+				}}))
+				cond := meta.FindStatusCondition(vrf.Status.Conditions, v1alpha1.ReadyCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			}).Should(Succeed())
+		})
+
+		It("Should reject derived values that are out of range", func() {
+			createBGP(intstr.FromString("4200000000"))
+			vrf := createVRF(100000)
+
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, key, vrf)).To(Succeed())
+				cond := meta.FindStatusCondition(vrf.Status.Conditions, v1alpha1.ReadyCondition)
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(cond.Reason).To(Equal(v1alpha1.RouteDerivationFailedReason))
+			}).Should(Succeed())
+		})
+	})
 })
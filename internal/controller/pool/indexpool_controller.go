@@ -16,6 +16,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -29,6 +30,9 @@
 type IndexPoolReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=pool.networking.metal.ironcore.dev,resources=indexpools,verbs=get;list;watch;create;update;patch;delete
@@ -106,6 +110,7 @@ func (r *IndexPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&poolv1alpha1.IndexPool{}).
 		Named("pool-indexpool").
+		WithOptions(r.Options).
 		// Watches enqueues IndexPools based on updates of contained Index resources.
 		// Only triggers on create and delete events since poolRefs are immutable.
 		Watches(
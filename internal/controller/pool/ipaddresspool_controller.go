@@ -15,6 +15,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -28,6 +29,9 @@
 type IPAddressPoolReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=pool.networking.metal.ironcore.dev,resources=ipaddresspools,verbs=get;list;watch;create;update;patch;delete
@@ -105,6 +109,7 @@ func (r *IPAddressPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&poolv1alpha1.IPAddressPool{}).
 		Named("pool-ipaddresspool").
+		WithOptions(r.Options).
 		// Watches enqueues IPAddressPools based on updates of contained IPAddress resources.
 		// Only triggers on create and delete events since poolRefs are immutable.
 		Watches(
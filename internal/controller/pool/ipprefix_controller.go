@@ -17,6 +17,7 @@
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -30,6 +31,9 @@
 type IPPrefixReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Options are the options of the controller, e.g. its rate limiter and the number of concurrent reconciles.
+	Options controller.Options
 }
 
 // +kubebuilder:rbac:groups=pool.networking.metal.ironcore.dev,resources=ipprefixes,verbs=get;list;watch;create;update;patch;delete
@@ -139,6 +143,7 @@ func (r *IPPrefixReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Mana
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&poolv1alpha1.IPPrefix{}).
 		Named("pool-ipprefix").
+		WithOptions(r.Options).
 		// Watches enqueues IPPrefix objects based on changes to their referenced IPPrefixPool.
 		// Triggers on create, spec update, and delete events since the pool's prefixes determine validity.
 		Watches(
@@ -115,6 +115,9 @@ type Connection struct {
 	Password string `json:"-"`
 	// TLS configuration for the connection.
 	TLS *tls.Config
+	// CompatibleVersion is the operating system release the device should be treated as, if its
+	// version cannot be determined from the device itself. Might be empty.
+	CompatibleVersion string
 }
 
 // GetDeviceConnection retrieves the connection details for accessing the Device.
@@ -160,5 +163,7 @@ func GetDeviceConnection(ctx context.Context, r client.Reader, obj *v1alpha1.Dev
 		Username: string(user),
 		Password: string(pass),
 		TLS:      conf,
+
+		CompatibleVersion: obj.Annotations[v1alpha1.DeviceCompatibleVersionAnnotation],
 	}, nil
 }
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/conditions"
+)
+
+// Object combines [client.Object] with [conditions.Setter].
+type Object interface {
+	client.Object
+	conditions.Setter
+}
+
+// DeferChanges reports whether changes to the configuration of the device on behalf of obj must be
+// deferred, which is the case if the device has maintenance windows of which none is open. Callers
+// skip the calls to the provider that change the configuration of the device, i.e. its Ensure and
+// Delete methods, while the status of obj is still observed.
+//
+// It sets the "PendingChange" condition on obj if the changes are deferred and removes it otherwise.
+func DeferChanges(device *v1alpha1.Device, obj Object) bool {
+	cond, deferred := pendingChange(device, obj, time.Now())
+	if !deferred {
+		conditions.Del(obj, v1alpha1.PendingChangeCondition)
+		return false
+	}
+	conditions.Set(obj, cond)
+	return true
+}
+
+// DeferDeletion is like [DeferChanges] for objects that are being deleted. As the status of
+// such objects is not updated otherwise, it patches the status of obj if the condition changed.
+func DeferDeletion(ctx context.Context, c client.Client, device *v1alpha1.Device, obj Object) (bool, error) {
+	orig := obj.DeepCopyObject().(client.Object)
+	cond, deferred := pendingChange(device, obj, time.Now())
+	if !deferred {
+		conditions.Del(obj, v1alpha1.PendingChangeCondition)
+		return false, nil
+	}
+	ctrl.LoggerFrom(ctx).V(1).Info("Deferring the deletion until a maintenance window opens")
+	if !conditions.Set(obj, cond) {
+		return true, nil
+	}
+	return true, c.Status().Patch(ctx, obj, client.MergeFrom(orig))
+}
+
+// pendingChange builds the PendingChange condition of obj and reports whether changes on its behalf
+// must be deferred at the given time. Changes are pending if obj is being deleted or if its current
+// generation has not been applied to the device yet, as recorded by its "Configured" condition, or
+// by its "Ready" condition for configuration-only resources without a "Configured" condition.
+func pendingChange(device *v1alpha1.Device, obj Object, now time.Time) (metav1.Condition, bool) {
+	if len(device.Spec.MaintenanceWindows) == 0 || IsOpen(device.Spec.MaintenanceWindows, now) {
+		return metav1.Condition{}, false
+	}
+	condition := metav1.Condition{
+		Type:               v1alpha1.PendingChangeCondition,
+		Status:             metav1.ConditionFalse,
+		Reason:             v1alpha1.NoChangePendingReason,
+		Message:            "No changes are pending",
+		ObservedGeneration: obj.GetGeneration(),
+	}
+	configured := conditions.Get(obj, v1alpha1.ConfiguredCondition)
+	if configured == nil {
+		configured = conditions.Get(obj, v1alpha1.ReadyCondition)
+	}
+	if !obj.GetDeletionTimestamp().IsZero() || configured == nil || configured.Status == metav1.ConditionUnknown || configured.ObservedGeneration != obj.GetGeneration() {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = v1alpha1.ChangePendingReason
+		condition.Message = "Changes are deferred until a maintenance window of the device opens"
+		if next := NextTransition(device.Spec.MaintenanceWindows, now); !next.IsZero() {
+			condition.Message += " at " + next.Format(time.RFC3339)
+		}
+	}
+	return condition, true
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+)
+
+func TestPendingChange(t *testing.T) {
+	// 2026-01-05 is a Monday, the window is closed at noon.
+	now := time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+	windows := []v1alpha1.MaintenanceWindow{
+		{Days: []v1alpha1.Weekday{v1alpha1.Monday}, Start: "22:00", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+	}
+
+	configured := func(status metav1.ConditionStatus, generation int64) []metav1.Condition {
+		return []metav1.Condition{{Type: v1alpha1.ConfiguredCondition, Status: status, ObservedGeneration: generation}}
+	}
+
+	tests := []struct {
+		name         string
+		windows      []v1alpha1.MaintenanceWindow
+		now          time.Time
+		conditions   []metav1.Condition
+		deleting     bool
+		wantDeferred bool
+		wantStatus   metav1.ConditionStatus
+	}{
+		{name: "no windows", conditions: configured(metav1.ConditionUnknown, 2)},
+		{name: "window open", windows: windows, now: now.Add(11 * time.Hour), conditions: configured(metav1.ConditionUnknown, 2)},
+		{name: "applied", windows: windows, now: now, conditions: configured(metav1.ConditionTrue, 2), wantDeferred: true, wantStatus: metav1.ConditionFalse},
+		{name: "failed", windows: windows, now: now, conditions: configured(metav1.ConditionFalse, 2), wantDeferred: true, wantStatus: metav1.ConditionFalse},
+		{name: "spec changed", windows: windows, now: now, conditions: configured(metav1.ConditionTrue, 1), wantDeferred: true, wantStatus: metav1.ConditionTrue},
+		{name: "never applied", windows: windows, now: now, conditions: configured(metav1.ConditionUnknown, 2), wantDeferred: true, wantStatus: metav1.ConditionTrue},
+		{name: "deleting", windows: windows, now: now, conditions: configured(metav1.ConditionTrue, 2), deleting: true, wantDeferred: true, wantStatus: metav1.ConditionTrue},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			device := &v1alpha1.Device{}
+			device.Spec.MaintenanceWindows = test.windows
+
+			obj := &v1alpha1.VLAN{}
+			obj.Generation = 2
+			obj.Status.Conditions = test.conditions
+			if test.deleting {
+				obj.DeletionTimestamp = &metav1.Time{Time: test.now}
+			}
+
+			cond, deferred := pendingChange(device, obj, test.now)
+			if deferred != test.wantDeferred {
+				t.Fatalf("pendingChange() deferred = %v, want %v", deferred, test.wantDeferred)
+			}
+			if deferred && cond.Status != test.wantStatus {
+				t.Errorf("pendingChange() status = %s, want %s", cond.Status, test.wantStatus)
+			}
+		})
+	}
+}
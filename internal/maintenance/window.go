@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package maintenance implements helper functions for evaluating the maintenance windows of devices.
+package maintenance
+
+import (
+	"slices"
+	"time"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+)
+
+// weekdays maps the days of the API to their [time.Weekday].
+var weekdays = map[v1alpha1.Weekday]time.Weekday{
+	v1alpha1.Sunday:    time.Sunday,
+	v1alpha1.Monday:    time.Monday,
+	v1alpha1.Tuesday:   time.Tuesday,
+	v1alpha1.Wednesday: time.Wednesday,
+	v1alpha1.Thursday:  time.Thursday,
+	v1alpha1.Friday:    time.Friday,
+	v1alpha1.Saturday:  time.Saturday,
+}
+
+// IsOpen reports whether any of the windows is open at the given time.
+func IsOpen(windows []v1alpha1.MaintenanceWindow, now time.Time) bool {
+	now = now.UTC()
+	for _, w := range windows {
+		// Windows last at most a day, so only those opened today or yesterday can be open.
+		for _, days := range []int{-1, 0} {
+			start, ok := opens(w, now.AddDate(0, 0, days))
+			if ok && !now.Before(start) && now.Before(start.Add(w.Duration.Duration)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NextTransition returns the time after now at which the windows next open or close,
+// i.e. when the result of [IsOpen] changes. It returns the zero time if there are no windows.
+func NextTransition(windows []v1alpha1.MaintenanceWindow, now time.Time) time.Time {
+	now = now.UTC()
+	var boundaries []time.Time
+	for _, w := range windows {
+		for days := -1; days <= 7; days++ {
+			start, ok := opens(w, now.AddDate(0, 0, days))
+			if !ok {
+				continue
+			}
+			for _, t := range []time.Time{start, start.Add(w.Duration.Duration)} {
+				if t.After(now) {
+					boundaries = append(boundaries, t)
+				}
+			}
+		}
+	}
+	slices.SortFunc(boundaries, time.Time.Compare)
+
+	open := IsOpen(windows, now)
+	for _, t := range boundaries {
+		if IsOpen(windows, t) != open {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// opens returns the time at which the window opens on the day of t, and whether it opens on that day at all.
+func opens(w v1alpha1.MaintenanceWindow, t time.Time) (time.Time, bool) {
+	if len(w.Days) > 0 && !slices.ContainsFunc(w.Days, func(d v1alpha1.Weekday) bool { return weekdays[d] == t.Weekday() }) {
+		return time.Time{}, false
+	}
+	start, err := time.Parse("15:04", w.Start)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), start.Hour(), start.Minute(), 0, 0, time.UTC), true
+}
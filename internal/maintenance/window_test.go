@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+)
+
+func TestIsOpen(t *testing.T) {
+	// 2026-01-05 is a Monday.
+	windows := []v1alpha1.MaintenanceWindow{
+		{
+			Days:     []v1alpha1.Weekday{v1alpha1.Monday},
+			Start:    "22:00",
+			Duration: metav1.Duration{Duration: 4 * time.Hour},
+		},
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{"before window", time.Date(2026, 1, 5, 21, 59, 0, 0, time.UTC), false},
+		{"window opens", time.Date(2026, 1, 5, 22, 0, 0, 0, time.UTC), true},
+		{"window spans midnight", time.Date(2026, 1, 6, 1, 0, 0, 0, time.UTC), true},
+		{"window closes", time.Date(2026, 1, 6, 2, 0, 0, 0, time.UTC), false},
+		{"other day", time.Date(2026, 1, 6, 22, 30, 0, 0, time.UTC), false},
+		{"other time zone", time.Date(2026, 1, 6, 0, 30, 0, 0, time.FixedZone("CET", 3600)), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := IsOpen(windows, test.now); got != test.want {
+				t.Errorf("IsOpen() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNextTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		windows []v1alpha1.MaintenanceWindow
+		now     time.Time
+		want    time.Time
+	}{
+		{
+			name: "next week",
+			windows: []v1alpha1.MaintenanceWindow{
+				{Days: []v1alpha1.Weekday{v1alpha1.Monday}, Start: "22:00", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			now:  time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 1, 12, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "window closes",
+			windows: []v1alpha1.MaintenanceWindow{
+				{Start: "22:00", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+			},
+			now:  time.Date(2026, 1, 6, 1, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 1, 6, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "overlapping windows",
+			windows: []v1alpha1.MaintenanceWindow{
+				{Start: "01:00", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+				{Start: "02:00", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+			},
+			now:  time.Date(2026, 1, 6, 1, 30, 0, 0, time.UTC),
+			want: time.Date(2026, 1, 6, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "always open",
+			windows: []v1alpha1.MaintenanceWindow{
+				{Start: "00:00", Duration: metav1.Duration{Duration: 24 * time.Hour}},
+			},
+			now:  time.Date(2026, 1, 6, 1, 30, 0, 0, time.UTC),
+			want: time.Time{},
+		},
+		{
+			name: "no windows",
+			now:  time.Date(2026, 1, 6, 1, 30, 0, 0, time.UTC),
+			want: time.Time{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := NextTransition(test.windows, test.now); !got.Equal(test.want) {
+				t.Errorf("NextTransition() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
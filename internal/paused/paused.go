@@ -11,6 +11,8 @@
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
 	"github.com/ironcore-dev/network-operator/internal/conditions"
@@ -121,7 +123,8 @@ func computeCondition(device *v1alpha1.Device, obj Object) metav1.Condition {
 
 // DevicePausedChanged reports whether the device's effective pause state changed
 // between the old and new object versions. The effective pause state is
-// determined by [computeCondition].
+// determined by [computeCondition]. It also reports whether a maintenance window
+// of the device opened or closed, such that deferred changes are applied.
 func DevicePausedChanged(oldObj, newObj client.Object) bool {
 	oldDevice := oldObj.(*v1alpha1.Device)
 	newDevice := newObj.(*v1alpha1.Device)
@@ -137,5 +140,43 @@ func DevicePausedChanged(oldObj, newObj client.Object) bool {
 	newReachable := conditions.Get(newDevice, v1alpha1.ReachableCondition)
 	oldIsReachable := oldReachable == nil || oldReachable.Status == metav1.ConditionTrue
 	newIsReachable := newReachable == nil || newReachable.Status == metav1.ConditionTrue
-	return oldIsReachable != newIsReachable
+	if oldIsReachable != newIsReachable {
+		return true
+	}
+	oldWindow := conditions.Get(oldDevice, v1alpha1.MaintenanceWindowCondition)
+	newWindow := conditions.Get(newDevice, v1alpha1.MaintenanceWindowCondition)
+	oldIsOpen := oldWindow == nil || oldWindow.Status == metav1.ConditionTrue
+	newIsOpen := newWindow == nil || newWindow.Status == metav1.ConditionTrue
+	return oldIsOpen != newIsOpen
+}
+
+// DevicePredicate returns the predicate for watches of the Device of the resources of a controller.
+// Besides create and delete events, it admits update events if the effective pause state or the data
+// model of the device changed, or if any of the given functions reports a change of the device.
+func DevicePredicate(changed ...func(oldObj, newObj client.Object) bool) predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if DevicePausedChanged(e.ObjectOld, e.ObjectNew) || dataModelChanged(e.ObjectOld, e.ObjectNew) {
+				return true
+			}
+			for _, fn := range changed {
+				if fn(e.ObjectOld, e.ObjectNew) {
+					return true
+				}
+			}
+			return false
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// dataModelChanged reports whether the data model version of the device changed
+// between the old and new object versions, e.g. after a firmware upgrade. Resources of
+// the device must then be reconciled again against the new data model.
+func dataModelChanged(oldObj, newObj client.Object) bool {
+	oldDevice := oldObj.(*v1alpha1.Device)
+	newDevice := newObj.(*v1alpha1.Device)
+	return oldDevice.Status.DataModelVersion != "" && oldDevice.Status.DataModelVersion != newDevice.Status.DataModelVersion
 }
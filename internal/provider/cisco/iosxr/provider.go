@@ -11,6 +11,7 @@
 	"time"
 
 	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
 	"github.com/ironcore-dev/network-operator/internal/deviceutil"
 	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
@@ -21,6 +22,7 @@
 
 var (
 	_ provider.Provider          = &Provider{}
+	_ provider.PingProvider      = &Provider{}
 	_ provider.DeviceProvider    = &Provider{}
 	_ provider.InterfaceProvider = &Provider{}
 	_ provider.VRFProvider       = &Provider{}
@@ -38,11 +40,15 @@ func NewProvider() provider.Provider {
 }
 
 func (p *Provider) Connect(ctx context.Context, conn *deviceutil.Connection) (err error) {
-	p.conn, err = grpcext.NewClient(conn)
+	p.conn, err = grpcext.NewClient(conn, grpcext.WithUserAgent(provider.UserAgent()))
 	if err != nil {
 		return fmt.Errorf("failed to create grpc connection: %w", err)
 	}
-	p.client, err = gnmiext.New(ctx, p.conn)
+	p.client, err = gnmiext.New(ctx, p.conn,
+		gnmiext.WithMetadata(provider.Metadata()...),
+		gnmiext.WithSemaphore(provider.DeviceSemaphore(conn.Address)),
+		gnmiext.WithSetLimiter(provider.DeviceChangeLimiter(conn.Address)),
+	)
 	if err != nil {
 		return err
 	}
@@ -53,6 +59,10 @@ func (p *Provider) Disconnect(ctx context.Context, conn *deviceutil.Connection)
 	return p.conn.Close()
 }
 
+func (p *Provider) Ping(ctx context.Context) error {
+	return p.client.Ping(ctx)
+}
+
 func (p *Provider) ListPorts(ctx context.Context) ([]provider.DevicePort, error) {
 	iFaces := new(Ifaces)
 	err := p.client.GetConfig(ctx, iFaces)
@@ -111,7 +121,7 @@ func (p *Provider) GetLastRebootTime(ctx context.Context) (time.Time, error) {
 	return t.CurrTime.ConvertToTime().Add(uptimeDuration), nil
 }
 
-func (p *Provider) Reboot(_ context.Context, conn *deviceutil.Connection) error {
+func (p *Provider) Reboot(_ context.Context, conn *deviceutil.Connection, _ *provider.RebootOptions) error {
 	return errors.New("IOS XR Provider does not support rebooting the device")
 }
 
@@ -442,6 +452,20 @@ func (p *Provider) DeleteBGP(context.Context, *provider.DeleteBGPRequest) error
 	return nil
 }
 
+func (p *Provider) EnsureBGPPeerGroup(context.Context, *provider.EnsureBGPPeerGroupRequest) error {
+	return apistatus.NewUnsupportedFieldError(apistatus.FieldViolation{
+		Field:       "spec.peerGroups",
+		Description: "BGP peer groups are not supported by the IOS XR provider",
+	})
+}
+
+func (p *Provider) DeleteBGPPeerGroup(context.Context, *provider.DeleteBGPPeerGroupRequest) error {
+	return apistatus.NewUnsupportedFieldError(apistatus.FieldViolation{
+		Field:       "spec.peerGroups",
+		Description: "BGP peer groups are not supported by the IOS XR provider",
+	})
+}
+
 func (p *Provider) EnsureBGPPeer(ctx context.Context, req *provider.EnsureBGPPeerRequest) error {
 	// Ensure that the BGP instance exists and is configured on the "default" domain
 	bgp := new(BGP)
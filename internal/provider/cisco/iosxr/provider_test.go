@@ -92,11 +92,14 @@ func Test_Payload(t *testing.T) {
 type MockClient struct {
 	// Function fields for mocking different methods
 	CapabilitiesFunc func() *gnmiext.Capabilities
+	PingFunc         func(ctx context.Context) error
 	GetConfigFunc    func(ctx context.Context, configs ...gnmiext.DataElement) error
 	PatchFunc        func(ctx context.Context, patches ...gnmiext.DataElement) error
 	UpdateFunc       func(ctx context.Context, updates ...gnmiext.DataElement) error
 	DeleteFunc       func(ctx context.Context, deletes ...gnmiext.DataElement) error
 	GetStateFunc     func(ctx context.Context, states ...gnmiext.DataElement) error
+	SubscribeFunc    func(ctx context.Context, xpaths []string, mode gnmiext.SubscribeMode) (<-chan gnmiext.Notification, error)
+	TransactionFunc  func(ctx context.Context, fn func(gnmiext.Client) error) error
 }
 
 var _ gnmiext.Client = (*MockClient)(nil)
@@ -109,6 +112,13 @@ func (m *MockClient) Capabilities() *gnmiext.Capabilities {
 	return nil
 }
 
+func (m *MockClient) Ping(ctx context.Context) error {
+	if m.PingFunc != nil {
+		return m.PingFunc(ctx)
+	}
+	return nil
+}
+
 func (m *MockClient) GetConfig(ctx context.Context, configs ...gnmiext.DataElement) error {
 	if m.GetConfigFunc != nil {
 		return m.GetConfigFunc(ctx, configs...)
@@ -144,6 +154,20 @@ func (m *MockClient) Delete(ctx context.Context, deletes ...gnmiext.DataElement)
 	return nil
 }
 
+func (m *MockClient) Subscribe(ctx context.Context, xpaths []string, mode gnmiext.SubscribeMode) (<-chan gnmiext.Notification, error) {
+	if m.SubscribeFunc != nil {
+		return m.SubscribeFunc(ctx, xpaths, mode)
+	}
+	return nil, nil
+}
+
+func (m *MockClient) Transaction(ctx context.Context, fn func(gnmiext.Client) error) error {
+	if m.TransactionFunc != nil {
+		return m.TransactionFunc(ctx, fn)
+	}
+	return fn(m)
+}
+
 func Test_EnsureInterface(t *testing.T) {
 	m := &MockClient{}
 	p := &Provider{client: m}
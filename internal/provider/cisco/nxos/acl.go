@@ -10,7 +10,12 @@
 	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
 )
 
-var _ gnmiext.DataElement = (*ACL)(nil)
+var (
+	_ gnmiext.DataElement = (*ACL)(nil)
+	_ gnmiext.DataElement = (*AddrGroup)(nil)
+	_ gnmiext.DataElement = (*PortGroup)(nil)
+	_ gnmiext.DataElement = (*ACLInterfacePolicy)(nil)
+)
 
 // ACL represents an IPv4 or IPv6 access control list, depending on the rules it contains.
 // It can only contain either IPv4 or IPv6 rules, never both. It's name must be unique
@@ -41,14 +46,118 @@ type ACLEntry struct {
 	Action          Action   `json:"action"`
 	Protocol        Protocol `json:"protocol"`
 	Remark          string   `json:"remark,omitempty"`
-	SrcPrefix       string   `json:"srcPrefix"`
+	SrcPrefix       string   `json:"srcPrefix,omitempty"`
 	SrcPrefixLength int      `json:"srcPrefixLength,omitempty"`
-	DstPrefix       string   `json:"dstPrefix"`
+	SrcAddrGroup    string   `json:"srcAddrGroup,omitempty"`
+	DstPrefix       string   `json:"dstPrefix,omitempty"`
 	DstPrefixLength int      `json:"dstPrefixLength,omitempty"`
+	DstAddrGroup    string   `json:"dstAddrGroup,omitempty"`
+	SrcPortOp       PortOp   `json:"srcPortOp,omitempty"`
+	SrcPort1        int32    `json:"srcPort1,omitempty"`
+	SrcPort2        int32    `json:"srcPort2,omitempty"`
+	DstPortOp       PortOp   `json:"dstPortOp,omitempty"`
+	DstPort1        int32    `json:"dstPort1,omitempty"`
+	DstPort2        int32    `json:"dstPort2,omitempty"`
+	SrcPortGroup    string   `json:"srcPortGroup,omitempty"`
+	DstPortGroup    string   `json:"dstPortGroup,omitempty"`
+	Established     bool     `json:"established,omitempty"`
+	Logging         bool     `json:"logging,omitempty"`
 }
 
 func (e *ACLEntry) Key() int32 { return e.SeqNum }
 
+// AddrGroup represents an IPv4 or IPv6 address object group, depending on the prefixes it contains.
+type AddrGroup struct {
+	// Name is the name of the object group. This name must be unique across all object groups.
+	Name string `json:"name"`
+	// SeqItems contains the list of prefixes in the object group.
+	SeqItems struct {
+		AddrMemberList gnmiext.List[int32, *AddrMember] `json:"AddrMember-list,omitzero"`
+	} `json:"seq-items,omitzero"`
+	// Is6 indicates whether this is an IPv6 object group. This field is not serialized to JSON
+	// and is only used internally to determine the correct XPath for the object group.
+	Is6 bool `json:"-"`
+}
+
+func (*AddrGroup) IsListItem() {}
+
+func (g *AddrGroup) XPath() string {
+	if g.Is6 {
+		return "System/acl-items/ipv6-items/oGroup-items/AddrGroup-list[name=" + g.Name + "]"
+	}
+	return "System/acl-items/ipv4-items/oGroup-items/AddrGroup-list[name=" + g.Name + "]"
+}
+
+type AddrMember struct {
+	SeqNum       int32  `json:"seqNum"`
+	Prefix       string `json:"prefix"`
+	PrefixLength int    `json:"prefixLength"`
+}
+
+func (m *AddrMember) Key() int32 { return m.SeqNum }
+
+// PortGroup represents a layer 4 port object group.
+type PortGroup struct {
+	// Name is the name of the object group. This name must be unique across all object groups.
+	Name string `json:"name"`
+	// SeqItems contains the list of port matches in the object group.
+	SeqItems struct {
+		PortMemberList gnmiext.List[int32, *PortMember] `json:"PortMember-list,omitzero"`
+	} `json:"seq-items,omitzero"`
+}
+
+func (*PortGroup) IsListItem() {}
+
+func (g *PortGroup) XPath() string {
+	return "System/acl-items/oGroup-items/PortGroup-list[name=" + g.Name + "]"
+}
+
+type PortMember struct {
+	SeqNum int32  `json:"seqNum"`
+	PortOp PortOp `json:"portOp"`
+	Port1  int32  `json:"port1"`
+	Port2  int32  `json:"port2,omitempty"`
+}
+
+func (m *PortMember) Key() int32 { return m.SeqNum }
+
+// ACLInterfacePolicy represents the attachment of an IPv4 or IPv6 access control list
+// to the ingress or egress of an interface, i.e. an access-group.
+type ACLInterfacePolicy struct {
+	IfName   string `json:"name"`
+	ACLItems struct {
+		Name string `json:"name"`
+	} `json:"acl-items"`
+	// Is6 indicates whether an IPv6 access control list is attached.
+	Is6 bool `json:"-"`
+	// Egress indicates whether the access control list is attached to the egress of the interface.
+	Egress bool `json:"-"`
+}
+
+func (*ACLInterfacePolicy) IsListItem() {}
+
+func (p *ACLInterfacePolicy) XPath() string {
+	af, dir := "ipv4-items", "ingress-items"
+	if p.Is6 {
+		af = "ipv6-items"
+	}
+	if p.Egress {
+		dir = "egress-items"
+	}
+	return "System/acl-items/" + af + "/policy-items/" + dir + "/intf-items/If-list[name=" + p.IfName + "]"
+}
+
+// aclInterfacePolicies returns the access-groups of all address families and
+// directions that can be attached to the interface.
+func aclInterfacePolicies(name string) []*ACLInterfacePolicy {
+	return []*ACLInterfacePolicy{
+		{IfName: name},
+		{IfName: name, Egress: true},
+		{IfName: name, Is6: true},
+		{IfName: name, Is6: true, Egress: true},
+	}
+}
+
 type Action string
 
 const (
@@ -97,3 +206,42 @@ func ProtocolFrom(proto v1alpha1.Protocol) Protocol {
 		return 0 // unknown protocol - default to 0 == "ip"
 	}
 }
+
+// PortOp is the operator of a layer 4 port match of an [ACLEntry].
+type PortOp uint8
+
+const (
+	PortOpNone  PortOp = 0
+	PortOpLT    PortOp = 1
+	PortOpGT    PortOp = 2
+	PortOpEQ    PortOp = 3
+	PortOpRange PortOp = 5
+)
+
+// PortMatchFrom returns the operator and the ports of an [ACLEntry] port match.
+// The second port is only set for the range operator.
+func PortMatchFrom(m *v1alpha1.ACLPortMatch) (op PortOp, port1, port2 int32, err error) {
+	if m == nil {
+		return PortOpNone, 0, 0, nil
+	}
+	for _, p := range []int32{m.Port, m.EndPort} {
+		if p < 0 || p > 65535 {
+			return PortOpNone, 0, 0, fmt.Errorf("port %d is out of range [0, 65535]", p)
+		}
+	}
+	switch m.Operator {
+	case v1alpha1.PortOperatorEqual:
+		return PortOpEQ, m.Port, 0, nil
+	case v1alpha1.PortOperatorLessThan:
+		return PortOpLT, m.Port, 0, nil
+	case v1alpha1.PortOperatorGreaterThan:
+		return PortOpGT, m.Port, 0, nil
+	case v1alpha1.PortOperatorRange:
+		if m.EndPort < m.Port {
+			return PortOpNone, 0, 0, fmt.Errorf("port range %d-%d is empty", m.Port, m.EndPort)
+		}
+		return PortOpRange, m.Port, m.EndPort, nil
+	default:
+		return PortOpNone, 0, 0, fmt.Errorf("unsupported port operator %q", m.Operator)
+	}
+}
@@ -3,6 +3,16 @@
 
 package nxos
 
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
 func init() {
 	acl := &ACL{Name: "TEST-ACL"}
 	acl.SeqItems.ACEList.Set(&ACLEntry{
@@ -15,4 +25,321 @@ func init() {
 		DstPrefixLength: 0,
 	})
 	Register("acl", acl)
+
+	eq := &ACL{Name: "TEST-ACL"}
+	eq.SeqItems.ACEList.Set(&ACLEntry{
+		SeqNum:    10,
+		Action:    ActionPermit,
+		Protocol:  ProtocolTCP,
+		SrcPrefix: "0.0.0.0",
+		DstPrefix: "0.0.0.0",
+		DstPortOp: PortOpEQ,
+		DstPort1:  443,
+	})
+	Register("acl_port_eq", eq)
+
+	rng := &ACL{Name: "TEST-ACL"}
+	rng.SeqItems.ACEList.Set(&ACLEntry{
+		SeqNum:          10,
+		Action:          ActionPermit,
+		Protocol:        ProtocolUDP,
+		SrcPrefix:       "10.0.0.0",
+		SrcPrefixLength: 8,
+		SrcPortOp:       PortOpRange,
+		SrcPort1:        1024,
+		SrcPort2:        65535,
+		DstPrefix:       "0.0.0.0",
+	})
+	Register("acl_port_range", rng)
+
+	log := &ACL{Name: "TEST-ACL"}
+	log.SeqItems.ACEList.Set(&ACLEntry{
+		SeqNum:    10,
+		Action:    ActionDeny,
+		Protocol:  ProtocolIP,
+		SrcPrefix: "0.0.0.0",
+		DstPrefix: "0.0.0.0",
+		Logging:   true,
+	})
+	Register("acl_log", log)
+
+	est := &ACL{Name: "TEST-ACL"}
+	est.SeqItems.ACEList.Set(&ACLEntry{
+		SeqNum:          10,
+		Action:          ActionPermit,
+		Protocol:        ProtocolTCP,
+		SrcPrefix:       "0.0.0.0",
+		DstPrefix:       "10.0.0.0",
+		DstPrefixLength: 8,
+		Established:     true,
+	})
+	Register("acl_established", est)
+
+	ag := &AddrGroup{Name: "TEST-NETS"}
+	ag.SeqItems.AddrMemberList.Set(&AddrMember{SeqNum: 10, Prefix: "10.0.0.0", PrefixLength: 8})
+	ag.SeqItems.AddrMemberList.Set(&AddrMember{SeqNum: 20, Prefix: "192.168.0.0", PrefixLength: 16})
+	Register("acl_addr_group", ag)
+
+	pg := &PortGroup{Name: "TEST-PORTS"}
+	pg.SeqItems.PortMemberList.Set(&PortMember{SeqNum: 10, PortOp: PortOpEQ, Port1: 443})
+	pg.SeqItems.PortMemberList.Set(&PortMember{SeqNum: 20, PortOp: PortOpRange, Port1: 8000, Port2: 8080})
+	Register("acl_port_group", pg)
+
+	grp := &ACL{Name: "TEST-ACL"}
+	grp.SeqItems.ACEList.Set(&ACLEntry{
+		SeqNum:       10,
+		Action:       ActionPermit,
+		Protocol:     ProtocolTCP,
+		SrcAddrGroup: "TEST-NETS",
+		DstPrefix:    "0.0.0.0",
+		DstPortGroup: "TEST-PORTS",
+	})
+	Register("acl_object_group", grp)
+
+	pol := &ACLInterfacePolicy{IfName: "eth1/1", Is6: true, Egress: true}
+	pol.ACLItems.Name = "TEST-ACL"
+	Register("acl_interface_policy", pol)
+}
+
+func TestProvider_EnsureACL(t *testing.T) {
+	const xpath = "System/acl-items/ipv4-items/name-items/ACL-list[name=TEST-ACL]"
+
+	any4 := v1alpha1.IPPrefix{Prefix: netip.MustParsePrefix("0.0.0.0/0")}
+	tests := []struct {
+		name    string
+		entry   v1alpha1.ACLEntry
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "destination port equal",
+			entry: v1alpha1.ACLEntry{
+				Protocol:        v1alpha1.ProtocolTCP,
+				DestinationPort: &v1alpha1.ACLPortMatch{Operator: v1alpha1.PortOperatorEqual, Port: 443},
+			},
+			want: `{"name":"TEST-ACL","seq-items":{"ACE-list":[{"seqNum":10,"action":"permit","protocol":6,"srcPrefix":"0.0.0.0","dstPrefix":"0.0.0.0","dstPortOp":3,"dstPort1":443}]}}`,
+		},
+		{
+			name: "source port range",
+			entry: v1alpha1.ACLEntry{
+				Protocol:   v1alpha1.ProtocolUDP,
+				SourcePort: &v1alpha1.ACLPortMatch{Operator: v1alpha1.PortOperatorRange, Port: 1024, EndPort: 65535},
+			},
+			want: `{"name":"TEST-ACL","seq-items":{"ACE-list":[{"seqNum":10,"action":"permit","protocol":17,"srcPrefix":"0.0.0.0","dstPrefix":"0.0.0.0","srcPortOp":5,"srcPort1":1024,"srcPort2":65535}]}}`,
+		},
+		{
+			name: "logged deny",
+			entry: v1alpha1.ACLEntry{
+				Action:   v1alpha1.ActionDeny,
+				Protocol: v1alpha1.ProtocolIP,
+				Log:      true,
+			},
+			want: `{"name":"TEST-ACL","seq-items":{"ACE-list":[{"seqNum":10,"action":"deny","protocol":0,"srcPrefix":"0.0.0.0","dstPrefix":"0.0.0.0","logging":true}]}}`,
+		},
+		{
+			name: "established tcp",
+			entry: v1alpha1.ACLEntry{
+				Protocol:    v1alpha1.ProtocolTCP,
+				Established: true,
+			},
+			want: `{"name":"TEST-ACL","seq-items":{"ACE-list":[{"seqNum":10,"action":"permit","protocol":6,"srcPrefix":"0.0.0.0","dstPrefix":"0.0.0.0","established":true}]}}`,
+		},
+		{
+			name: "established on udp",
+			entry: v1alpha1.ACLEntry{
+				Protocol:    v1alpha1.ProtocolUDP,
+				Established: true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "port on non-tcp/udp protocol",
+			entry: v1alpha1.ACLEntry{
+				Protocol:        v1alpha1.ProtocolICMP,
+				DestinationPort: &v1alpha1.ACLPortMatch{Operator: v1alpha1.PortOperatorEqual, Port: 443},
+			},
+			wantErr: true,
+		},
+		{
+			name: "port out of range",
+			entry: v1alpha1.ACLEntry{
+				Protocol:        v1alpha1.ProtocolTCP,
+				DestinationPort: &v1alpha1.ACLPortMatch{Operator: v1alpha1.PortOperatorGreaterThan, Port: 65536},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty port range",
+			entry: v1alpha1.ACLEntry{
+				Protocol:   v1alpha1.ProtocolTCP,
+				SourcePort: &v1alpha1.ACLPortMatch{Operator: v1alpha1.PortOperatorRange, Port: 2000, EndPort: 1000},
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			entry := test.entry
+			entry.Sequence = 10
+			if entry.Action == "" {
+				entry.Action = v1alpha1.ActionPermit
+			}
+			entry.SourceAddress = any4
+			entry.DestinationAddress = any4
+
+			acl := &v1alpha1.AccessControlList{}
+			acl.Spec.Name = "TEST-ACL"
+			acl.Spec.Entries = []v1alpha1.ACLEntry{entry}
+
+			err := p.EnsureACL(context.Background(), &provider.EnsureACLRequest{ACL: acl})
+			if test.wantErr {
+				if _, ok := apistatus.FromError(err); !ok {
+					t.Fatalf("EnsureACL() error = %v, want status error", err)
+				}
+				if _, ok := c.config[xpath]; ok {
+					t.Errorf("EnsureACL() configured invalid entry")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureACL() error = %v", err)
+			}
+			if got := c.config[xpath]; got != test.want {
+				t.Errorf("EnsureACL() config = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestIsIPv6ACL(t *testing.T) {
+	v4 := v1alpha1.IPPrefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")}
+	v6 := v1alpha1.IPPrefix{Prefix: netip.MustParsePrefix("2001:db8::/32")}
+	groups := []v1alpha1.ACLObjectGroup{{Name: "V6-HOSTS", Addresses: []v1alpha1.IPPrefix{v6}}}
+	tests := []struct {
+		name    string
+		entries []v1alpha1.ACLEntry
+		want    bool
+	}{
+		{
+			name:    "ipv4 source",
+			entries: []v1alpha1.ACLEntry{{SourceAddress: v4, DestinationAddress: v4}},
+		},
+		{
+			name:    "ipv6 source group",
+			entries: []v1alpha1.ACLEntry{{SourceAddressGroup: "V6-HOSTS", DestinationAddress: v6}},
+			want:    true,
+		},
+		{
+			name:    "unset source with ipv6 destination",
+			entries: []v1alpha1.ACLEntry{{DestinationAddress: v6}},
+			want:    true,
+		},
+		{
+			name: "unknown group in first entry",
+			entries: []v1alpha1.ACLEntry{
+				{SourceAddressGroup: "MISSING", DestinationAddressGroup: "MISSING"},
+				{SourceAddress: v6, DestinationAddress: v6},
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			acl := &v1alpha1.AccessControlList{}
+			acl.Spec.ObjectGroups = groups
+			acl.Spec.Entries = test.entries
+			if got := isIPv6ACL(acl); got != test.want {
+				t.Errorf("isIPv6ACL() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureObjectGroup(t *testing.T) {
+	const (
+		v4 = "System/acl-items/ipv4-items/oGroup-items/AddrGroup-list[name=TEST-GROUP]"
+		v6 = "System/acl-items/ipv6-items/oGroup-items/AddrGroup-list[name=TEST-GROUP]"
+		pg = "System/acl-items/oGroup-items/PortGroup-list[name=TEST-GROUP]"
+	)
+
+	tests := []struct {
+		name    string
+		config  map[string]string
+		group   v1alpha1.ACLObjectGroup
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "ipv4 address group",
+			config: map[string]string{},
+			group: v1alpha1.ACLObjectGroup{
+				Addresses: []v1alpha1.IPPrefix{{Prefix: netip.MustParsePrefix("10.0.0.0/8")}},
+			},
+			want: map[string]string{
+				v4: `{"name":"TEST-GROUP","seq-items":{"AddrMember-list":[{"seqNum":10,"prefix":"10.0.0.0","prefixLength":8}]}}`,
+			},
+		},
+		{
+			name:   "ipv4 replaced by ipv6 address group",
+			config: map[string]string{v4: `{"name":"TEST-GROUP"}`},
+			group: v1alpha1.ACLObjectGroup{
+				Addresses: []v1alpha1.IPPrefix{{Prefix: netip.MustParsePrefix("2001:db8::/32")}},
+			},
+			want: map[string]string{
+				v6: `{"name":"TEST-GROUP","seq-items":{"AddrMember-list":[{"seqNum":10,"prefix":"2001:db8::","prefixLength":32}]}}`,
+			},
+		},
+		{
+			name:   "address group replaced by port group",
+			config: map[string]string{v4: `{"name":"TEST-GROUP"}`},
+			group: v1alpha1.ACLObjectGroup{
+				Ports: []v1alpha1.ACLPortMatch{{Operator: v1alpha1.PortOperatorLessThan, Port: 1024}},
+			},
+			want: map[string]string{
+				pg: `{"name":"TEST-GROUP","seq-items":{"PortMember-list":[{"seqNum":10,"portOp":1,"port1":1024}]}}`,
+			},
+		},
+		{
+			name:   "mixed address families",
+			config: map[string]string{},
+			group: v1alpha1.ACLObjectGroup{
+				Addresses: []v1alpha1.IPPrefix{
+					{Prefix: netip.MustParsePrefix("10.0.0.0/8")},
+					{Prefix: netip.MustParsePrefix("2001:db8::/32")},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: test.config}
+			p := &Provider{client: c}
+
+			group := test.group
+			group.Name = "TEST-GROUP"
+
+			err := p.EnsureObjectGroup(context.Background(), &provider.EnsureObjectGroupRequest{ObjectGroup: &group})
+			if test.wantErr {
+				if _, ok := apistatus.FromError(err); !ok {
+					t.Fatalf("EnsureObjectGroup() error = %v, want status error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureObjectGroup() error = %v", err)
+			}
+			if len(c.config) != len(test.want) {
+				t.Errorf("EnsureObjectGroup() config = %v, want %v", c.config, test.want)
+			}
+			for k, v := range test.want {
+				if got := c.config[k]; got != v {
+					t.Errorf("EnsureObjectGroup() config[%s] = %s, want %s", k, got, v)
+				}
+			}
+		})
+	}
 }
@@ -2,7 +2,11 @@
 // SPDX-License-Identifier: Apache-2.0
 package nxos
 
-import "github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+import (
+	"fmt"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+)
 
 // AddressFamily represents the identifier of an address family.
 type AddressFamily string
@@ -25,3 +29,17 @@ func (af AddressFamily) ToAddressFamilyType() v1alpha1.BGPAddressFamilyType {
 		return v1alpha1.BGPAddressFamilyType("")
 	}
 }
+
+// AddressFamilyFromType returns the identifier of the given BGP address family type.
+func AddressFamilyFromType(t v1alpha1.BGPAddressFamilyType) (AddressFamily, error) {
+	switch t {
+	case v1alpha1.BGPAddressFamilyL2vpnEvpn:
+		return AddressFamilyL2EVPN, nil
+	case v1alpha1.BGPAddressFamilyIpv4Unicast:
+		return AddressFamilyIPv4Unicast, nil
+	case v1alpha1.BGPAddressFamilyIpv6Unicast:
+		return AddressFamilyIPv6Unicast, nil
+	default:
+		return "", fmt.Errorf("unsupported address family %q", t)
+	}
+}
@@ -6,11 +6,15 @@
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
+	"unicode"
 
 	nxv1alpha1 "github.com/ironcore-dev/network-operator/api/cisco/nx/v1alpha1"
 	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
 )
 
@@ -45,10 +49,14 @@ func (*BGP) XPath() string {
 }
 
 type BGPDom struct {
-	Name      string  `json:"name"`
-	RtrID     string  `json:"rtrId"`
-	RtrIDAuto AdminSt `json:"rtrIdAuto"`
-	AfItems   struct {
+	Name          string  `json:"name"`
+	RtrID         string  `json:"rtrId"`
+	RtrIDAuto     AdminSt `json:"rtrIdAuto"`
+	LogNbrChanges AdminSt `json:"logNeighborChanges,omitempty"`
+	BestPathItems struct {
+		AsPathMultipathRelax AdminSt `json:"asPathMultipathRelax,omitempty"`
+	} `json:"bestpath-items,omitzero"`
+	AfItems struct {
 		DomAfList gnmiext.List[AddressFamily, *BGPDomAfItem] `json:"DomAf-list,omitzero"`
 	} `json:"af-items,omitzero"`
 	PeerContItems struct {
@@ -73,10 +81,45 @@ func (*BGPDomItems) XPath() string {
 	return "System/bgp-items/inst-items/dom-items"
 }
 
+// BGPDomAfItems is the container for the address families of a BGP domain.
+type BGPDomAfItems struct {
+	VRFName string `json:"-"`
+}
+
+func (a *BGPDomAfItems) XPath() string {
+	return "System/bgp-items/inst-items/dom-items/Dom-list[name=" + a.VRFName + "]/af-items"
+}
+
+// BGPDomRtrID is the router identifier of a BGP domain.
+type BGPDomRtrID struct {
+	VRFName string `json:"-"`
+}
+
+func (r *BGPDomRtrID) XPath() string {
+	return "System/bgp-items/inst-items/dom-items/Dom-list[name=" + r.VRFName + "]/rtrId"
+}
+
+// BGPDomPeerItems is the container for the peers of a BGP domain.
+// It is only used to determine whether a domain still has peers configured.
+type BGPDomPeerItems struct {
+	VRFName  string `json:"-"`
+	PeerList []struct {
+		Addr string `json:"addr"`
+	} `json:"Peer-list,omitzero"`
+}
+
+func (p *BGPDomPeerItems) XPath() string {
+	return "System/bgp-items/inst-items/dom-items/Dom-list[name=" + p.VRFName + "]/peer-items"
+}
+
 // BGPPeerGroup is a template peer group under a BGP domain.
 type BGPPeerGroup struct {
 	VRFName string `json:"-"`
 	Name    string `json:"name"`
+	SrcIf   string `json:"srcIf,omitempty"`
+	AfItems struct {
+		PeerAfList gnmiext.List[AddressFamily, *BGPPeerGroupAfItem] `json:"PeerAf-list,omitzero"`
+	} `json:"af-items,omitzero"`
 }
 
 func (*BGPPeerGroup) IsListItem() {}
@@ -87,13 +130,22 @@ func (g *BGPPeerGroup) XPath() string {
 	return "System/bgp-items/inst-items/dom-items/Dom-list[name=" + g.VRFName + "]/peercont-items/PeerCont-list[name=" + g.Name + "]"
 }
 
+// BGPPeerGroupAfItem activates an address family for all peers inheriting from a peer group.
+type BGPPeerGroupAfItem struct {
+	Type AddressFamily `json:"type"`
+}
+
+func (af *BGPPeerGroupAfItem) Key() AddressFamily { return af.Type }
+
 type BGPDomAfItem struct {
 	// Maximum number of equal-cost paths for iBGP
 	MaxEcmp int8 `json:"maxEcmp,omitempty"`
 	// Maximum number of equal-cost paths for eBGP
-	MaxExtEcmp int8          `json:"maxExtEcmp,omitempty"`
-	ExportGwIP AdminSt       `json:"exportGwIp"`
-	Type       AddressFamily `json:"type"`
+	MaxExtEcmp int8 `json:"maxExtEcmp,omitempty"`
+	// Weighted load balancing across the multipaths based on their link-bandwidth extended community
+	WeightedEcmp AdminSt       `json:"weightedEcmp,omitempty"`
+	ExportGwIP   AdminSt       `json:"exportGwIp"`
+	Type         AddressFamily `json:"type"`
 
 	// The fields below are only valid for the l2vpn-evpn address family.
 	// For other address families, these fields will be omitted in the JSON
@@ -120,6 +172,7 @@ func (af BGPDomAfItem) MarshalJSON() ([]byte, error) {
 		return json.Marshal(struct {
 			MaxEcmp         int8          `json:"maxEcmp,omitempty"`
 			MaxExtEcmp      int8          `json:"maxExtEcmp,omitempty"`
+			WeightedEcmp    AdminSt       `json:"weightedEcmp,omitempty"`
 			ExportGwIP      AdminSt       `json:"exportGwIp"`
 			Type            AddressFamily `json:"type"`
 			InterLeakPItems struct {
@@ -128,6 +181,7 @@ func (af BGPDomAfItem) MarshalJSON() ([]byte, error) {
 		}{
 			MaxEcmp:         af.MaxEcmp,
 			MaxExtEcmp:      af.MaxExtEcmp,
+			WeightedEcmp:    af.WeightedEcmp,
 			ExportGwIP:      af.ExportGwIP,
 			Type:            af.Type,
 			InterLeakPItems: af.InterLeakPItems,
@@ -169,6 +223,49 @@ func NewInterLeakPDirect(rtMap string) *InterLeakP {
 	}
 }
 
+// NewInterLeakP creates an InterLeakP entry for redistributing the routes of
+// the given protocol into a BGP address family. The instance is only used for OSPF.
+func NewInterLeakP(proto v1alpha1.BGPRedistributionProtocol, inst, rtMap string) (*InterLeakP, error) {
+	if rtMap == "" || strings.ContainsFunc(rtMap, unicode.IsSpace) {
+		return nil, fmt.Errorf("invalid route map name %q", rtMap)
+	}
+	il := &InterLeakP{
+		InterLeakPKey: InterLeakPKey{
+			Asn:  "none",
+			Inst: "none",
+		},
+		RtMap: rtMap,
+	}
+	switch proto {
+	case v1alpha1.BGPRedistributionProtocolStatic:
+		il.Proto = RtLeakProtoStatic
+	case v1alpha1.BGPRedistributionProtocolOSPF:
+		if inst == "" {
+			return nil, errors.New("ospf instance name cannot be empty")
+		}
+		il.Proto = RtLeakProtoOSPF
+		il.Inst = inst
+	default:
+		return nil, fmt.Errorf("unsupported redistribution protocol %q", proto)
+	}
+	return il, nil
+}
+
+// SetRedistributions configures the redistribution of the routes of other protocols into the address family.
+func (af *BGPDomAfItem) SetRedistributions(redists []provider.BGPRedistribution) error {
+	for _, rd := range redists {
+		il, err := NewInterLeakP(rd.Protocol, rd.Instance, rd.RoutingPolicy.Spec.Name)
+		if err != nil {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.addressFamilies[*].redistribute",
+				Description: err.Error(),
+			})
+		}
+		af.InterLeakPItems.InterLeakPList.Set(il)
+	}
+	return nil
+}
+
 func (af *BGPDomAfItem) SetMultipath(m *v1alpha1.BGPMultipath) error {
 	// Default from YANG model
 	af.MaxEcmp = 1
@@ -177,26 +274,48 @@ func (af *BGPDomAfItem) SetMultipath(m *v1alpha1.BGPMultipath) error {
 		return nil
 	}
 	if m.Ebgp != nil {
-		af.MaxExtEcmp = m.Ebgp.MaximumPaths
 		if m.Ebgp.AllowMultipleAs {
 			return errors.New("allowing multiple AS numbers for eBGP multipath is not supported on Cisco NX-OS")
 		}
+		if m.Ebgp.MaximumPaths != 0 {
+			if m.Ebgp.MaximumPaths < 1 || m.Ebgp.MaximumPaths > 64 {
+				return fmt.Errorf("ebgp multipath: maximum paths %d is out of range, must be between 1 and 64", m.Ebgp.MaximumPaths)
+			}
+			af.MaxExtEcmp = m.Ebgp.MaximumPaths
+		}
 	}
-	if m.Ibgp != nil {
+	if m.Ibgp != nil && m.Ibgp.MaximumPaths != 0 {
+		if m.Ibgp.MaximumPaths < 1 || m.Ibgp.MaximumPaths > 64 {
+			return fmt.Errorf("ibgp multipath: maximum paths %d is out of range, must be between 1 and 64", m.Ibgp.MaximumPaths)
+		}
 		af.MaxEcmp = m.Ibgp.MaximumPaths
 	}
+	if m.Weighted {
+		// Weighting a single path has no effect and most likely indicates a misconfiguration.
+		if af.MaxEcmp < 2 && af.MaxExtEcmp < 2 {
+			return errors.New("weighted multipath requires maximum paths greater than 1 for eBGP or iBGP")
+		}
+		af.WeightedEcmp = AdminStEnabled
+	}
 	return nil
 }
 
 type BGPPeer struct {
-	VRFName       string      `json:"-"`
-	Addr          string      `json:"addr"`
-	AdminSt       AdminSt     `json:"adminSt"`
-	Asn           string      `json:"asn"`
-	AsnType       PeerAsnType `json:"asnType"`
-	Name          string      `json:"name,omitempty"`
-	SrcIf         string      `json:"srcIf,omitempty"`
-	LocalAsnItems struct {
+	VRFName          string      `json:"-"`
+	Addr             string      `json:"addr"`
+	AdminSt          AdminSt     `json:"adminSt"`
+	Asn              string      `json:"asn"`
+	AsnType          PeerAsnType `json:"asnType"`
+	Name             string      `json:"name,omitempty"`
+	Password         string      `json:"password,omitempty"`
+	PasswdType       PasswdType  `json:"passwdType,omitempty"`
+	SrcIf            string      `json:"srcIf,omitempty"`
+	PeerImp          string      `json:"peerImp,omitempty"`
+	Ctrl             string      `json:"ctrl,omitempty"`
+	CapSuppr4ByteAsn AdminSt     `json:"capSuppr4ByteAsn,omitempty"`
+	ConnRetryIntvl   uint16      `json:"connRetryIntvl,omitempty"`
+	AdvIntvl         uint16      `json:"advIntvl,omitempty"`
+	LocalAsnItems    struct {
 		AsnPropagate AsnPropagate `json:"asnPropagate"`
 		LocalAsn     string       `json:"localAsn"`
 	} `json:"localasn-items,omitzero"`
@@ -221,8 +340,20 @@ type BGPPeer struct {
 	AsnPropagateDualAs AsnPropagate = "dual-as"
 )
 
+// PasswdType is the encryption type of a BGP neighbor password.
+type PasswdType string
+
+const (
+	PasswdTypeClear PasswdType = "0"
+	PasswdType3DES  PasswdType = "3"
+	PasswdTypeCisco PasswdType = "7"
+)
+
 func (*BGPPeer) IsListItem() {}
 
+// IsSensitive implements [gnmiext.Sensitive], as the peer may hold an authentication password.
+func (*BGPPeer) IsSensitive() {}
+
 func (p *BGPPeer) XPath() string {
 	return "System/bgp-items/inst-items/dom-items/Dom-list[name=" + p.VRFName + "]/peer-items/Peer-list[addr=" + p.Addr + "]"
 }
@@ -232,6 +363,14 @@ type BGPPeerAfItem struct {
 	SendComExt AdminSt        `json:"sendComExt"`
 	SendComStd AdminSt        `json:"sendComStd"`
 	Type       AddressFamily  `json:"type"`
+	// Link bandwidth in Mbps advertised to the peer with the link-bandwidth extended community
+	LnkBw uint32 `json:"lnkBw,omitempty"`
+	// Advertise the aggregated link bandwidth of all multipaths instead of a fixed link bandwidth
+	LnkBwAggr AdminSt `json:"lnkBwAggr,omitempty"`
+	// Number of occurrences of the local AS number allowed in the AS path of received routes
+	AllowedSelfAsCnt uint8 `json:"allowedSelfAsCnt,omitempty"`
+	// Maximum number of prefixes accepted from the peer
+	MaxPfxPItems *BGPPeerAfMaxPfxP `json:"maxpfxp-items,omitempty"`
 
 	RtCtrlPItems struct {
 		RtCtrlPList gnmiext.List[RtCtrlDirection, *BGPPeerAfRtCtrlP] `json:"RtCtrlP-list,omitzero"`
@@ -240,6 +379,89 @@ type BGPPeerAfItem struct {
 
 func (af *BGPPeerAfItem) Key() AddressFamily { return af.Type }
 
+// MaxPfxAction is the action taken when a peer exceeds the maximum number of prefixes.
+type MaxPfxAction string
+
+const (
+	// MaxPfxActionShut tears down the session until it is cleared manually.
+	MaxPfxActionShut MaxPfxAction = "shut"
+	// MaxPfxActionRestart tears down the session and restarts it after the restart time.
+	MaxPfxActionRestart MaxPfxAction = "restart"
+)
+
+type BGPPeerAfMaxPfxP struct {
+	Action MaxPfxAction `json:"action"`
+	MaxPfx uint32       `json:"maxPfx"`
+	// Restart time in minutes, only used with the restart action
+	RestartTime uint16 `json:"restartTime,omitempty"`
+	// Threshold in percent of the maximum number of prefixes at which a warning is logged
+	Thresh uint8 `json:"thresh,omitempty"`
+}
+
+// NewMaxPfxP returns the maximum prefix configuration of a peer address family,
+// or nil if the number of prefixes accepted from the peer is not limited.
+func NewMaxPfxP(af *v1alpha1.BGPPeerAddressFamily) (*BGPPeerAfMaxPfxP, error) {
+	if af.MaxPrefixes == 0 {
+		if af.MaxPrefixWarningPercent != 0 || af.MaxPrefixRestartMinutes != 0 {
+			return nil, errors.New("warning threshold and restart time require a maximum number of prefixes")
+		}
+		return nil, nil
+	}
+	m := &BGPPeerAfMaxPfxP{
+		Action: MaxPfxActionShut,
+		MaxPfx: af.MaxPrefixes,
+		Thresh: af.MaxPrefixWarningPercent,
+	}
+	if af.MaxPrefixRestartMinutes != 0 {
+		m.Action = MaxPfxActionRestart
+		m.RestartTime = af.MaxPrefixRestartMinutes
+	}
+	return m, nil
+}
+
+// maxLinkBandwidthMbps is the maximum link bandwidth that can be advertised to a peer.
+const maxLinkBandwidthMbps = 25600000
+
+// allowASInOccurrences returns the number of occurrences of the local AS number that are allowed in the
+// AS path of routes received from a peer, falling back to the device default if none are specified.
+func allowASInOccurrences(a *v1alpha1.BGPAllowASIn) (uint8, error) {
+	const (
+		defaultOccurrences = 3
+		maxOccurrences     = 10
+	)
+	switch {
+	case a.Occurrences == 0:
+		return defaultOccurrences, nil
+	case a.Occurrences < 1 || a.Occurrences > maxOccurrences:
+		return 0, fmt.Errorf("allowas-in occurrences %d is out of range, must be between 1 and %d", a.Occurrences, maxOccurrences)
+	default:
+		return uint8(a.Occurrences), nil
+	}
+}
+
+// multipathEnabled reports whether multipath is enabled for the address family of the BGP instance.
+func multipathEnabled(b *v1alpha1.BGP, t AddressFamily) bool {
+	if b == nil || b.Spec.AddressFamilies == nil {
+		return false
+	}
+	var m *v1alpha1.BGPMultipath
+	switch afs := b.Spec.AddressFamilies; t {
+	case AddressFamilyIPv4Unicast:
+		if afs.Ipv4Unicast != nil && afs.Ipv4Unicast.Enabled {
+			m = afs.Ipv4Unicast.Multipath
+		}
+	case AddressFamilyIPv6Unicast:
+		if afs.Ipv6Unicast != nil && afs.Ipv6Unicast.Enabled {
+			m = afs.Ipv6Unicast.Multipath
+		}
+	case AddressFamilyL2EVPN:
+		if afs.L2vpnEvpn != nil && afs.L2vpnEvpn.Enabled {
+			m = afs.L2vpnEvpn.Multipath
+		}
+	}
+	return m != nil && m.Enabled
+}
+
 type RtCtrlDirection string
 
 const (
@@ -254,6 +476,39 @@ type BGPPeerAfRtCtrlP struct {
 
 func (r *BGPPeerAfRtCtrlP) Key() RtCtrlDirection { return r.Direction }
 
+// BGPPeerClear is the action that resets the session with a BGP peer.
+// It is triggered by setting its administrative state to start.
+type BGPPeerClear struct {
+	VRFName string           `json:"-"`
+	Addr    string           `json:"-"`
+	AdminSt ActionAdminSt    `json:"adminSt"`
+	Type    BGPPeerClearType `json:"type"`
+	Dir     BGPPeerClearDir  `json:"dir,omitempty"`
+}
+
+func (c *BGPPeerClear) XPath() string {
+	return `System/action-items/lsubj-items/LSubj-list[oDn=sys/bgp/inst/dom-` + c.VRFName + `/peer-\[` + c.Addr + `\]]/peerclearltask-items`
+}
+
+type ActionAdminSt string
+
+const ActionAdminStStart ActionAdminSt = "start"
+
+type BGPPeerClearType string
+
+const (
+	BGPPeerClearTypeSoft BGPPeerClearType = "soft"
+	BGPPeerClearTypeHard BGPPeerClearType = "hard"
+)
+
+type BGPPeerClearDir string
+
+const (
+	BGPPeerClearDirIn   BGPPeerClearDir = "in"
+	BGPPeerClearDirOut  BGPPeerClearDir = "out"
+	BGPPeerClearDirBoth BGPPeerClearDir = "both"
+)
+
 type BGPPeerOperItems struct {
 	VRFName      string        `json:"-"`
 	Addr         string        `json:"addr"`
@@ -362,7 +617,17 @@ func (AsFormat) XPath() string {
 	PeerAsnTypeInternal PeerAsnType = "internal"
 )
 
-const RouteReflectorClient = "rr-client"
+const (
+	RouteReflectorClient = "rr-client"
+	ASOverride           = "as-override"
+)
+
+const (
+	// PeerCtrlDisConnCheck disables the connected check for single-hop eBGP peers.
+	PeerCtrlDisConnCheck = "dis-conn-check"
+	// PeerCtrlCapNegOff disables the capability negotiation with the peer.
+	PeerCtrlCapNegOff = "cap-neg-off"
+)
 
 type BorderGatewayPeerType string
 
@@ -3,6 +3,28 @@
 
 package nxos
 
+import (
+	"context"
+	"encoding/json"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nxv1alpha1 "github.com/ironcore-dev/network-operator/api/cisco/nx/v1alpha1"
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+)
+
 func init() {
 	bgpDom := &BGPDom{Name: DefaultVRFName, RtrID: "1.1.1.1", RtrIDAuto: AdminStDisabled}
 	bgpDom.AfItems.DomAfList.Set(&BGPDomAfItem{
@@ -22,6 +44,10 @@ func init() {
 	})
 	Register("bgp_dom_advpip", bgpDomAdvPip)
 
+	bgpDomBestpath := &BGPDom{Name: DefaultVRFName, RtrID: "1.1.1.1", RtrIDAuto: AdminStDisabled, LogNbrChanges: AdminStEnabled}
+	bgpDomBestpath.BestPathItems.AsPathMultipathRelax = AdminStEnabled
+	Register("bgp_dom_bestpath", bgpDomBestpath)
+
 	bgp := &BGP{AdminSt: AdminStEnabled, Asn: "65000"}
 	Register("bgp", bgp)
 
@@ -68,6 +94,13 @@ func init() {
 	bgpDomRdst.AfItems.DomAfList.Set(rdstItem)
 	Register("bgp_dom_rdst", bgpDomRdst)
 
+	bgpDomRedist := &BGPDom{Name: "CC-CLOUD01", RtrID: "1.1.1.1", RtrIDAuto: AdminStDisabled}
+	redistItem := &BGPDomAfItem{Type: AddressFamilyIPv4Unicast, ExportGwIP: AdminStDisabled}
+	redistItem.InterLeakPItems.InterLeakPList.Set(&InterLeakP{InterLeakPKey: InterLeakPKey{Asn: "none", Inst: "none", Proto: RtLeakProtoStatic}, RtMap: "REDIST_STATIC"})
+	redistItem.InterLeakPItems.InterLeakPList.Set(&InterLeakP{InterLeakPKey: InterLeakPKey{Asn: "none", Inst: "UNDERLAY", Proto: RtLeakProtoOSPF}, RtMap: "REDIST_OSPF"})
+	bgpDomRedist.AfItems.DomAfList.Set(redistItem)
+	Register("bgp_dom_redist", bgpDomRedist)
+
 	bgpDomExp := &BGPDom{Name: "CC-CLOUD01", RtrID: "1.1.1.1", RtrIDAuto: AdminStDisabled}
 	bgpDomExp.AfItems.DomAfList.Set(&BGPDomAfItem{
 		Type:       AddressFamilyIPv4Unicast,
@@ -75,6 +108,15 @@ func init() {
 	})
 	Register("bgp_dom_exp", bgpDomExp)
 
+	bgpDomEcmp := &BGPDom{Name: DefaultVRFName, RtrID: "1.1.1.1", RtrIDAuto: AdminStDisabled}
+	bgpDomEcmp.AfItems.DomAfList.Set(&BGPDomAfItem{
+		Type:       AddressFamilyIPv4Unicast,
+		MaxEcmp:    8,
+		MaxExtEcmp: 32,
+		ExportGwIP: AdminStDisabled,
+	})
+	Register("bgp_dom_max_paths", bgpDomEcmp)
+
 	bgpPeerLocalAs := &BGPPeer{
 		VRFName: DefaultVRFName,
 		Addr:    "1.1.1.1",
@@ -85,4 +127,1255 @@ func init() {
 	bgpPeerLocalAs.LocalAsnItems.AsnPropagate = AsnPropagateNone
 	bgpPeerLocalAs.LocalAsnItems.LocalAsn = "65002"
 	Register("bgp_peer_local_as", bgpPeerLocalAs)
+
+	bgpPeerPwd := &BGPPeer{
+		VRFName:    DefaultVRFName,
+		Addr:       "1.1.1.1",
+		AdminSt:    AdminStEnabled,
+		Asn:        "65001",
+		AsnType:    PeerAsnTypeNone,
+		Password:   Type7{Seed: 2}.Encode("cisco"),
+		PasswdType: PasswdTypeCisco,
+	}
+	Register("bgp_peer_password", bgpPeerPwd)
+
+	bgpDomWeighted := &BGPDom{Name: DefaultVRFName, RtrID: "1.1.1.1", RtrIDAuto: AdminStDisabled}
+	bgpDomWeighted.AfItems.DomAfList.Set(&BGPDomAfItem{
+		Type:         AddressFamilyIPv4Unicast,
+		MaxEcmp:      1,
+		MaxExtEcmp:   8,
+		WeightedEcmp: AdminStEnabled,
+		ExportGwIP:   AdminStDisabled,
+	})
+	Register("bgp_dom_weighted_ecmp", bgpDomWeighted)
+
+	bgpPeerLinkBw := &BGPPeer{
+		VRFName: DefaultVRFName,
+		Addr:    "10.0.0.1",
+		AdminSt: AdminStEnabled,
+		Asn:     "65001",
+		AsnType: PeerAsnTypeNone,
+	}
+	bgpPeerLinkBw.AfItems.PeerAfList.Set(&BGPPeerAfItem{
+		SendComExt: AdminStEnabled,
+		SendComStd: AdminStDisabled,
+		Type:       AddressFamilyIPv4Unicast,
+		LnkBw:      10000,
+	})
+	Register("bgp_peer_link_bw", bgpPeerLinkBw)
+
+	bgpPeerAllowASIn := &BGPPeer{
+		VRFName: DefaultVRFName,
+		Addr:    "10.0.0.2",
+		AdminSt: AdminStEnabled,
+		Asn:     "65001",
+		AsnType: PeerAsnTypeNone,
+	}
+	bgpPeerAllowASIn.AfItems.PeerAfList.Set(&BGPPeerAfItem{
+		SendComExt:       AdminStDisabled,
+		SendComStd:       AdminStDisabled,
+		Type:             AddressFamilyIPv4Unicast,
+		AllowedSelfAsCnt: 3,
+	})
+	Register("bgp_peer_allowas_in", bgpPeerAllowASIn)
+
+	bgpPeerMaxPfx := &BGPPeer{
+		VRFName: DefaultVRFName,
+		Addr:    "10.0.0.2",
+		AdminSt: AdminStEnabled,
+		Asn:     "65001",
+		AsnType: PeerAsnTypeNone,
+	}
+	bgpPeerMaxPfx.AfItems.PeerAfList.Set(&BGPPeerAfItem{
+		SendComExt: AdminStDisabled,
+		SendComStd: AdminStDisabled,
+		Type:       AddressFamilyIPv4Unicast,
+		MaxPfxPItems: &BGPPeerAfMaxPfxP{
+			Action:      MaxPfxActionRestart,
+			MaxPfx:      1000,
+			RestartTime: 5,
+			Thresh:      80,
+		},
+	})
+	Register("bgp_peer_max_pfx", bgpPeerMaxPfx)
+
+	bgpPeerASOverride := &BGPPeer{
+		VRFName: DefaultVRFName,
+		Addr:    "10.0.0.3",
+		AdminSt: AdminStEnabled,
+		Asn:     "65001",
+		AsnType: PeerAsnTypeNone,
+	}
+	bgpPeerASOverride.AfItems.PeerAfList.Set(&BGPPeerAfItem{
+		Ctrl:       NewOption(ASOverride),
+		SendComExt: AdminStDisabled,
+		SendComStd: AdminStDisabled,
+		Type:       AddressFamilyIPv4Unicast,
+	})
+	Register("bgp_peer_as_override", bgpPeerASOverride)
+
+	Register("bgp_peer_capabilities", &BGPPeer{
+		VRFName:          DefaultVRFName,
+		Addr:             "10.0.0.4",
+		AdminSt:          AdminStEnabled,
+		Asn:              "65001",
+		AsnType:          PeerAsnTypeNone,
+		Ctrl:             PeerCtrlDisConnCheck,
+		CapSuppr4ByteAsn: AdminStEnabled,
+	})
+
+	Register("bgp_peer_timers", &BGPPeer{
+		VRFName:        DefaultVRFName,
+		Addr:           "10.0.0.6",
+		AdminSt:        AdminStEnabled,
+		Asn:            "65001",
+		AsnType:        PeerAsnTypeNone,
+		ConnRetryIntvl: 10,
+		AdvIntvl:       5,
+	})
+
+	bgpPeerGroup := &BGPPeerGroup{VRFName: DefaultVRFName, Name: "SPINES", SrcIf: "lo0"}
+	bgpPeerGroup.AfItems.PeerAfList.Set(&BGPPeerGroupAfItem{Type: AddressFamilyL2EVPN})
+	Register("bgp_peer_group", bgpPeerGroup)
+
+	Register("bgp_peer_inherit", &BGPPeer{
+		VRFName: DefaultVRFName,
+		Addr:    "10.0.0.5",
+		AdminSt: AdminStEnabled,
+		Asn:     "65000",
+		AsnType: PeerAsnTypeNone,
+		PeerImp: "SPINES",
+	})
+}
+
+func TestProvider_DeleteBGPPeer(t *testing.T) {
+	const xpath = "System/bgp-items/inst-items/dom-items/Dom-list[name=default]/peer-items/Peer-list[addr=10.0.0.1]"
+
+	tests := []struct {
+		name        string
+		config      map[string]string
+		wantDeleted []string
+	}{
+		{
+			name:        "existing peer",
+			config:      map[string]string{xpath: `{"addr":"10.0.0.1"}`},
+			wantDeleted: []string{xpath},
+		},
+		{
+			name:   "missing peer",
+			config: map[string]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: test.config}
+			p := &Provider{client: c}
+
+			err := p.DeleteBGPPeer(context.Background(), &provider.DeleteBGPPeerRequest{
+				BGPPeer: &v1alpha1.BGPPeer{
+					ObjectMeta: metav1.ObjectMeta{Name: "peer"},
+					Spec:       v1alpha1.BGPPeerSpec{Address: "10.0.0.1"},
+				},
+			})
+			if err != nil {
+				t.Fatalf("DeleteBGPPeer() error = %v", err)
+			}
+			if !slices.Equal(c.deleted, test.wantDeleted) {
+				t.Errorf("DeleteBGPPeer() deleted = %v, want %v", c.deleted, test.wantDeleted)
+			}
+			if _, ok := c.config[xpath]; ok {
+				t.Errorf("DeleteBGPPeer() peer still configured")
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureBGPPeerPassword(t *testing.T) {
+	const (
+		dom   = "System/bgp-items/inst-items/dom-items/Dom-list[name=default]"
+		xpath = dom + "/peer-items/Peer-list[addr=10.0.0.1]"
+	)
+
+	existing := Type7{Seed: 9}.Encode("s3cr3t")
+
+	tests := []struct {
+		name     string
+		config   map[string]string
+		password string
+		// wantSeed is the expected seed of the configured password, -1 for any seed.
+		wantSeed int
+		// wantUnchanged expects the configured password to remain as is.
+		wantUnchanged bool
+	}{
+		{
+			name:     "new peer",
+			config:   map[string]string{dom: `{"name":"default"}`},
+			password: "s3cr3t",
+			wantSeed: -1,
+		},
+		{
+			name: "unchanged password",
+			config: map[string]string{
+				dom:   `{"name":"default"}`,
+				xpath: `{"addr":"10.0.0.1","password":"` + existing + `","passwdType":"7"}`,
+			},
+			password:      "s3cr3t",
+			wantSeed:      9,
+			wantUnchanged: true,
+		},
+		{
+			name: "changed password",
+			config: map[string]string{
+				dom:   `{"name":"default"}`,
+				xpath: `{"addr":"10.0.0.1","password":"` + existing + `","passwdType":"7"}`,
+			},
+			password: "n3w-s3cr3t",
+			wantSeed: 9,
+		},
+		{
+			name: "existing 3DES password",
+			config: map[string]string{
+				dom:   `{"name":"default"}`,
+				xpath: `{"addr":"10.0.0.1","password":"abcdef0123456789","passwdType":"3"}`,
+			},
+			password: "s3cr3t",
+			wantSeed: -1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: test.config}
+			p := &Provider{client: c}
+
+			err := p.EnsureBGPPeer(context.Background(), &provider.EnsureBGPPeerRequest{
+				BGPPeer: &v1alpha1.BGPPeer{
+					ObjectMeta: metav1.ObjectMeta{Name: "peer"},
+					Spec: v1alpha1.BGPPeerSpec{
+						Address:  "10.0.0.1",
+						ASNumber: intstr.FromInt32(65001),
+					},
+				},
+				BGP:      &v1alpha1.BGP{Spec: v1alpha1.BGPSpec{ASNumber: intstr.FromInt32(65000)}},
+				Password: test.password,
+			})
+			if err != nil {
+				t.Fatalf("EnsureBGPPeer() error = %v", err)
+			}
+
+			if strings.Contains(c.config[xpath], test.password) {
+				t.Errorf("EnsureBGPPeer() configured plain text password: %s", c.config[xpath])
+			}
+
+			got := new(BGPPeer)
+			if err := json.Unmarshal([]byte(c.config[xpath]), got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if got.PasswdType != PasswdTypeCisco {
+				t.Errorf("EnsureBGPPeer() passwdType = %q, want %q", got.PasswdType, PasswdTypeCisco)
+			}
+			seed, pwd, err := ParseType7(got.Password)
+			if err != nil {
+				t.Fatalf("ParseType7() error = %v", err)
+			}
+			if pwd != test.password {
+				t.Errorf("EnsureBGPPeer() configured a different password")
+			}
+			if test.wantSeed >= 0 && seed != test.wantSeed {
+				t.Errorf("EnsureBGPPeer() seed = %d, want %d", seed, test.wantSeed)
+			}
+			if test.wantUnchanged && got.Password != existing {
+				t.Errorf("EnsureBGPPeer() rewrote unchanged password: got %q, want %q", got.Password, existing)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureBGP_ProviderConfig(t *testing.T) {
+	const dom = "System/bgp-items/inst-items/dom-items/Dom-list[name=default]"
+
+	scheme := runtime.NewScheme()
+	if err := nxv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme() error = %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		spec          *nxv1alpha1.BGPConfigSpec
+		wantLogNbr    AdminSt
+		wantMultipath AdminSt
+	}{
+		{
+			name:          "no provider config",
+			wantLogNbr:    AdminStDisabled,
+			wantMultipath: AdminStDisabled,
+		},
+		{
+			name:          "empty provider config",
+			spec:          &nxv1alpha1.BGPConfigSpec{},
+			wantLogNbr:    AdminStDisabled,
+			wantMultipath: AdminStDisabled,
+		},
+		{
+			name:          "log neighbor changes",
+			spec:          &nxv1alpha1.BGPConfigSpec{LogNeighborChanges: true},
+			wantLogNbr:    AdminStEnabled,
+			wantMultipath: AdminStDisabled,
+		},
+		{
+			name: "as-path multipath-relax",
+			spec: &nxv1alpha1.BGPConfigSpec{
+				LogNeighborChanges: true,
+				Bestpath:           &nxv1alpha1.BGPConfigBestpath{AsPathMultipathRelax: true},
+			},
+			wantLogNbr:    AdminStEnabled,
+			wantMultipath: AdminStEnabled,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := &provider.EnsureBGPRequest{
+				BGP: &v1alpha1.BGP{
+					Spec: v1alpha1.BGPSpec{
+						ASNumber: intstr.FromInt32(65000),
+						RouterID: "1.1.1.1",
+					},
+				},
+			}
+			if test.spec != nil {
+				cfg := &nxv1alpha1.BGPConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "bgp", Namespace: metav1.NamespaceDefault},
+					Spec:       *test.spec,
+				}
+				r := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cfg).Build()
+				ref := &v1alpha1.TypedLocalObjectReference{
+					APIVersion: nxv1alpha1.GroupVersion.String(),
+					Kind:       "BGPConfig",
+					Name:       cfg.Name,
+				}
+				pc, err := provider.GetProviderConfig(context.Background(), r, cfg.Namespace, ref)
+				if err != nil {
+					t.Fatalf("GetProviderConfig() error = %v", err)
+				}
+				req.ProviderConfig = pc
+			}
+
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+			if err := p.EnsureBGP(context.Background(), req); err != nil {
+				t.Fatalf("EnsureBGP() error = %v", err)
+			}
+
+			got := new(BGPDom)
+			if err := json.Unmarshal([]byte(c.config[dom]), got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if got.LogNbrChanges != test.wantLogNbr {
+				t.Errorf("EnsureBGP() logNeighborChanges = %q, want %q", got.LogNbrChanges, test.wantLogNbr)
+			}
+			if got.BestPathItems.AsPathMultipathRelax != test.wantMultipath {
+				t.Errorf("EnsureBGP() asPathMultipathRelax = %q, want %q", got.BestPathItems.AsPathMultipathRelax, test.wantMultipath)
+			}
+		})
+	}
+}
+
+func TestProvider_DeleteBGP(t *testing.T) {
+	const (
+		feature = "System/fm-items/bgp-items"
+		inst    = "System/bgp-items/inst-items"
+		doms    = "System/bgp-items/inst-items/dom-items"
+		dflt    = "System/bgp-items/inst-items/dom-items/Dom-list[name=default]"
+		vrf     = "System/bgp-items/inst-items/dom-items/Dom-list[name=VRF1]"
+	)
+	marker := func(vrf string) string {
+		return dflt + "/peercont-items/PeerCont-list[name=" + ownershipMarkerName(vrf) + "]"
+	}
+
+	tests := []struct {
+		name        string
+		vrf         *v1alpha1.VRF
+		config      map[string]string
+		wantDeleted []string
+		wantKept    []string
+		wantFeature string
+	}{
+		{
+			name: "last instance without peers",
+			config: map[string]string{
+				feature:            `{"adminSt":"enabled"}`,
+				inst:               `{"adminSt":"enabled","asn":"65000"}`,
+				doms:               `{"Dom-list":[{"name":"default"}]}`,
+				dflt + "/af-items": `{}`,
+				marker("default"):  `{"name":"` + ownershipMarkerName("default") + `"}`,
+			},
+			wantDeleted: []string{marker("default"), dflt + "/af-items", dflt + "/rtrId", inst},
+			wantFeature: `{"adminSt":"disabled"}`,
+		},
+		{
+			name: "default domain with peers",
+			config: map[string]string{
+				feature:              `{"adminSt":"enabled"}`,
+				inst:                 `{"adminSt":"enabled","asn":"65000"}`,
+				doms:                 `{"Dom-list":[{"name":"default"}]}`,
+				dflt + "/peer-items": `{"Peer-list":[{"addr":"10.0.0.1"}]}`,
+				marker("default"):    `{"name":"` + ownershipMarkerName("default") + `"}`,
+			},
+			wantDeleted: []string{marker("default"), dflt + "/af-items", dflt + "/rtrId"},
+			wantKept:    []string{inst, dflt + "/peer-items"},
+			wantFeature: `{"adminSt":"enabled"}`,
+		},
+		{
+			name: "vrf domain with remaining default instance",
+			vrf:  &v1alpha1.VRF{Spec: v1alpha1.VRFSpec{Name: "VRF1"}},
+			config: map[string]string{
+				feature:           `{"adminSt":"enabled"}`,
+				inst:              `{"adminSt":"enabled","asn":"65000"}`,
+				doms:              `{"Dom-list":[{"name":"default","peercont-items":{"PeerCont-list":[{"name":"` + ownershipMarkerName("default") + `"}]}},{"name":"VRF1"}]}`,
+				vrf:               `{"name":"VRF1"}`,
+				marker("VRF1"):    `{"name":"` + ownershipMarkerName("VRF1") + `"}`,
+				marker("default"): `{"name":"` + ownershipMarkerName("default") + `"}`,
+			},
+			wantDeleted: []string{marker("VRF1"), vrf},
+			wantKept:    []string{inst, marker("default")},
+			wantFeature: `{"adminSt":"enabled"}`,
+		},
+		{
+			name: "vrf domain with peers",
+			vrf:  &v1alpha1.VRF{Spec: v1alpha1.VRFSpec{Name: "VRF1"}},
+			config: map[string]string{
+				feature:             `{"adminSt":"enabled"}`,
+				inst:                `{"adminSt":"enabled","asn":"65000"}`,
+				doms:                `{"Dom-list":[{"name":"default"},{"name":"VRF1"}]}`,
+				vrf + "/peer-items": `{"Peer-list":[{"addr":"10.0.0.1"}]}`,
+				marker("VRF1"):      `{"name":"` + ownershipMarkerName("VRF1") + `"}`,
+			},
+			wantDeleted: []string{marker("VRF1"), vrf + "/af-items", vrf + "/rtrId"},
+			wantKept:    []string{inst, vrf + "/peer-items"},
+			wantFeature: `{"adminSt":"enabled"}`,
+		},
+		{
+			name:   "feature disabled",
+			config: map[string]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: test.config}
+			p := &Provider{client: c}
+
+			err := p.DeleteBGP(context.Background(), &provider.DeleteBGPRequest{
+				BGP: &v1alpha1.BGP{ObjectMeta: metav1.ObjectMeta{Name: "bgp"}},
+				VRF: test.vrf,
+			})
+			if err != nil {
+				t.Fatalf("DeleteBGP() error = %v", err)
+			}
+			if !slices.Equal(c.deleted, test.wantDeleted) {
+				t.Errorf("DeleteBGP() deleted = %v, want %v", c.deleted, test.wantDeleted)
+			}
+			for _, xpath := range test.wantKept {
+				if _, ok := c.config[xpath]; !ok {
+					t.Errorf("DeleteBGP() removed %s", xpath)
+				}
+			}
+			if got := c.config[feature]; got != test.wantFeature {
+				t.Errorf("DeleteBGP() feature = %s, want %s", got, test.wantFeature)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureBGPPeerLinkBandwidth(t *testing.T) {
+	const (
+		dom   = "System/bgp-items/inst-items/dom-items/Dom-list[name=default]"
+		xpath = dom + "/peer-items/Peer-list[addr=10.0.0.1]"
+	)
+
+	multipath := &v1alpha1.BGPAddressFamilies{
+		Ipv4Unicast: &v1alpha1.BGPUnicastAddressFamily{
+			BGPAddressFamily: v1alpha1.BGPAddressFamily{
+				Enabled:   true,
+				Multipath: &v1alpha1.BGPMultipath{Enabled: true, Ebgp: &v1alpha1.BGPMultipathEbgp{MaximumPaths: 8}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		af            v1alpha1.BGPPeerAddressFamily
+		bgpAFs        *v1alpha1.BGPAddressFamilies
+		wantLnkBw     uint32
+		wantLnkBwAggr AdminSt
+		wantField     string
+	}{
+		{
+			name: "fixed bandwidth",
+			af: v1alpha1.BGPPeerAddressFamily{
+				SendCommunity: v1alpha1.BGPCommunityTypeExtended,
+				LinkBandwidth: &v1alpha1.BGPLinkBandwidth{BandwidthMbps: 10000},
+			},
+			wantLnkBw: 10000,
+		},
+		{
+			name: "aggregate",
+			af: v1alpha1.BGPPeerAddressFamily{
+				SendCommunity: v1alpha1.BGPCommunityTypeBoth,
+				LinkBandwidth: &v1alpha1.BGPLinkBandwidth{Aggregate: true},
+			},
+			bgpAFs:        multipath,
+			wantLnkBwAggr: AdminStEnabled,
+		},
+		{
+			name: "aggregate without multipath",
+			af: v1alpha1.BGPPeerAddressFamily{
+				SendCommunity: v1alpha1.BGPCommunityTypeExtended,
+				LinkBandwidth: &v1alpha1.BGPLinkBandwidth{Aggregate: true},
+			},
+			wantField: "spec.addressFamilies[*].linkBandwidth.aggregate",
+		},
+		{
+			name: "standard communities only",
+			af: v1alpha1.BGPPeerAddressFamily{
+				SendCommunity: v1alpha1.BGPCommunityTypeStandard,
+				LinkBandwidth: &v1alpha1.BGPLinkBandwidth{BandwidthMbps: 10000},
+			},
+			wantField: "spec.addressFamilies[*].linkBandwidth",
+		},
+		{
+			name: "bandwidth out of range",
+			af: v1alpha1.BGPPeerAddressFamily{
+				SendCommunity: v1alpha1.BGPCommunityTypeExtended,
+				LinkBandwidth: &v1alpha1.BGPLinkBandwidth{BandwidthMbps: maxLinkBandwidthMbps + 1},
+			},
+			wantField: "spec.addressFamilies[*].linkBandwidth.bandwidthMbps",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{dom: `{"name":"default"}`}}
+			p := &Provider{client: c}
+
+			af := test.af
+			af.Enabled = true
+			err := p.EnsureBGPPeer(context.Background(), &provider.EnsureBGPPeerRequest{
+				BGPPeer: &v1alpha1.BGPPeer{
+					ObjectMeta: metav1.ObjectMeta{Name: "peer"},
+					Spec: v1alpha1.BGPPeerSpec{
+						Address:         "10.0.0.1",
+						ASNumber:        intstr.FromInt32(65001),
+						AddressFamilies: &v1alpha1.BGPPeerAddressFamilies{Ipv4Unicast: &af},
+					},
+				},
+				BGP: &v1alpha1.BGP{Spec: v1alpha1.BGPSpec{ASNumber: intstr.FromInt32(65000), AddressFamilies: test.bgpAFs}},
+			})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureBGPPeer() error = %v, want violation of %q", err, test.wantField)
+				}
+				if _, ok := c.config[xpath]; ok {
+					t.Errorf("EnsureBGPPeer() configured peer despite error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureBGPPeer() error = %v", err)
+			}
+
+			got := new(BGPPeer)
+			if err := json.Unmarshal([]byte(c.config[xpath]), got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			item, ok := got.AfItems.PeerAfList.Get(AddressFamilyIPv4Unicast)
+			if !ok {
+				t.Fatalf("EnsureBGPPeer() address family %s not configured", AddressFamilyIPv4Unicast)
+			}
+			if item.LnkBw != test.wantLnkBw {
+				t.Errorf("EnsureBGPPeer() lnkBw = %d, want %d", item.LnkBw, test.wantLnkBw)
+			}
+			if item.LnkBwAggr != test.wantLnkBwAggr {
+				t.Errorf("EnsureBGPPeer() lnkBwAggr = %q, want %q", item.LnkBwAggr, test.wantLnkBwAggr)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureBGPPeerASPathLoop(t *testing.T) {
+	const (
+		dom   = "System/bgp-items/inst-items/dom-items/Dom-list[name=default]"
+		xpath = dom + "/peer-items/Peer-list[addr=10.0.0.1]"
+	)
+
+	tests := []struct {
+		name      string
+		af        v1alpha1.BGPPeerAddressFamily
+		wantCtrl  Option[string]
+		wantCnt   uint8
+		wantField string
+	}{
+		{
+			name:     "as-override",
+			af:       v1alpha1.BGPPeerAddressFamily{ASOverride: true},
+			wantCtrl: NewOption(ASOverride),
+		},
+		{
+			name:     "as-override with route reflector client",
+			af:       v1alpha1.BGPPeerAddressFamily{ASOverride: true, RouteReflectorClient: true},
+			wantCtrl: NewOption(ASOverride + "," + RouteReflectorClient),
+		},
+		{
+			name:    "allowas-in",
+			af:      v1alpha1.BGPPeerAddressFamily{AllowASIn: &v1alpha1.BGPAllowASIn{Occurrences: 5}},
+			wantCnt: 5,
+		},
+		{
+			name:    "allowas-in default occurrences",
+			af:      v1alpha1.BGPPeerAddressFamily{AllowASIn: &v1alpha1.BGPAllowASIn{}},
+			wantCnt: 3,
+		},
+		{
+			name:      "allowas-in occurrences out of range",
+			af:        v1alpha1.BGPPeerAddressFamily{AllowASIn: &v1alpha1.BGPAllowASIn{Occurrences: 11}},
+			wantField: "spec.addressFamilies[*].allowASIn.occurrences",
+		},
+		{
+			name:      "both enabled",
+			af:        v1alpha1.BGPPeerAddressFamily{ASOverride: true, AllowASIn: &v1alpha1.BGPAllowASIn{Occurrences: 3}},
+			wantField: "spec.addressFamilies[*].allowASIn",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{dom: `{"name":"default"}`}}
+			p := &Provider{client: c}
+
+			af := test.af
+			af.Enabled = true
+			err := p.EnsureBGPPeer(context.Background(), &provider.EnsureBGPPeerRequest{
+				BGPPeer: &v1alpha1.BGPPeer{
+					ObjectMeta: metav1.ObjectMeta{Name: "peer"},
+					Spec: v1alpha1.BGPPeerSpec{
+						Address:         "10.0.0.1",
+						ASNumber:        intstr.FromInt32(65001),
+						AddressFamilies: &v1alpha1.BGPPeerAddressFamilies{Ipv4Unicast: &af},
+					},
+				},
+				BGP: &v1alpha1.BGP{Spec: v1alpha1.BGPSpec{ASNumber: intstr.FromInt32(65000)}},
+			})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureBGPPeer() error = %v, want violation of %q", err, test.wantField)
+				}
+				if _, ok := c.config[xpath]; ok {
+					t.Errorf("EnsureBGPPeer() configured peer despite error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureBGPPeer() error = %v", err)
+			}
+
+			got := new(BGPPeer)
+			if err := json.Unmarshal([]byte(c.config[xpath]), got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			item, ok := got.AfItems.PeerAfList.Get(AddressFamilyIPv4Unicast)
+			if !ok {
+				t.Fatalf("EnsureBGPPeer() address family %s not configured", AddressFamilyIPv4Unicast)
+			}
+			gotCtrl, _ := json.Marshal(item.Ctrl)
+			wantCtrl, _ := json.Marshal(test.wantCtrl)
+			if string(gotCtrl) != string(wantCtrl) {
+				t.Errorf("EnsureBGPPeer() ctrl = %s, want %s", gotCtrl, wantCtrl)
+			}
+			if item.AllowedSelfAsCnt != test.wantCnt {
+				t.Errorf("EnsureBGPPeer() allowedSelfAsCnt = %d, want %d", item.AllowedSelfAsCnt, test.wantCnt)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureBGPPeerMaxPrefix(t *testing.T) {
+	const (
+		dom   = "System/bgp-items/inst-items/dom-items/Dom-list[name=default]"
+		xpath = dom + "/peer-items/Peer-list[addr=10.0.0.1]"
+	)
+
+	tests := []struct {
+		name      string
+		af        v1alpha1.BGPPeerAddressFamily
+		want      *BGPPeerAfMaxPfxP
+		wantField string
+	}{
+		{
+			name: "defaults",
+		},
+		{
+			name: "maximum prefixes",
+			af:   v1alpha1.BGPPeerAddressFamily{MaxPrefixes: 1000},
+			want: &BGPPeerAfMaxPfxP{Action: MaxPfxActionShut, MaxPfx: 1000},
+		},
+		{
+			name: "warning threshold and restart",
+			af:   v1alpha1.BGPPeerAddressFamily{MaxPrefixes: 1000, MaxPrefixWarningPercent: 80, MaxPrefixRestartMinutes: 5},
+			want: &BGPPeerAfMaxPfxP{Action: MaxPfxActionRestart, MaxPfx: 1000, RestartTime: 5, Thresh: 80},
+		},
+		{
+			name:      "warning threshold out of range",
+			af:        v1alpha1.BGPPeerAddressFamily{MaxPrefixes: 1000, MaxPrefixWarningPercent: 101},
+			wantField: "spec.addressFamilies[*].maxPrefixWarningPercent",
+		},
+		{
+			name:      "restart without maximum prefixes",
+			af:        v1alpha1.BGPPeerAddressFamily{MaxPrefixRestartMinutes: 5},
+			wantField: "spec.addressFamilies[*].maxPrefixes",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{dom: `{"name":"default"}`}}
+			p := &Provider{client: c}
+
+			af := test.af
+			af.Enabled = true
+			err := p.EnsureBGPPeer(context.Background(), &provider.EnsureBGPPeerRequest{
+				BGPPeer: &v1alpha1.BGPPeer{
+					ObjectMeta: metav1.ObjectMeta{Name: "peer"},
+					Spec: v1alpha1.BGPPeerSpec{
+						Address:         "10.0.0.1",
+						ASNumber:        intstr.FromInt32(65001),
+						AddressFamilies: &v1alpha1.BGPPeerAddressFamilies{Ipv4Unicast: &af},
+					},
+				},
+				BGP: &v1alpha1.BGP{Spec: v1alpha1.BGPSpec{ASNumber: intstr.FromInt32(65000)}},
+			})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureBGPPeer() error = %v, want violation of %q", err, test.wantField)
+				}
+				if _, ok := c.config[xpath]; ok {
+					t.Errorf("EnsureBGPPeer() configured peer despite error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureBGPPeer() error = %v", err)
+			}
+
+			got := new(BGPPeer)
+			if err := json.Unmarshal([]byte(c.config[xpath]), got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			item, ok := got.AfItems.PeerAfList.Get(AddressFamilyIPv4Unicast)
+			if !ok {
+				t.Fatalf("EnsureBGPPeer() address family %s not configured", AddressFamilyIPv4Unicast)
+			}
+			if !reflect.DeepEqual(item.MaxPfxPItems, test.want) {
+				t.Errorf("EnsureBGPPeer() maxpfxp-items = %+v, want %+v", item.MaxPfxPItems, test.want)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureBGPPeerTimers(t *testing.T) {
+	const (
+		dom   = "System/bgp-items/inst-items/dom-items/Dom-list[name=default]"
+		xpath = dom + "/peer-items/Peer-list[addr=10.0.0.1]"
+	)
+
+	tests := []struct {
+		name          string
+		timers        *v1alpha1.BGPPeerTimers
+		wantConnRetry uint16
+		wantAdv       uint16
+		wantField     string
+	}{
+		{
+			name: "device defaults",
+		},
+		{
+			name: "connect retry and minimum advertisement interval",
+			timers: &v1alpha1.BGPPeerTimers{
+				ConnectRetry:                 &metav1.Duration{Duration: 10 * time.Second},
+				MinimumAdvertisementInterval: &metav1.Duration{Duration: 5 * time.Second},
+			},
+			wantConnRetry: 10,
+			wantAdv:       5,
+		},
+		{
+			name:      "connect retry not positive",
+			timers:    &v1alpha1.BGPPeerTimers{ConnectRetry: &metav1.Duration{}},
+			wantField: "spec.timers.connectRetry",
+		},
+		{
+			name:      "connect retry fractional seconds",
+			timers:    &v1alpha1.BGPPeerTimers{ConnectRetry: &metav1.Duration{Duration: 1500 * time.Millisecond}},
+			wantField: "spec.timers.connectRetry",
+		},
+		{
+			name:      "minimum advertisement interval too large",
+			timers:    &v1alpha1.BGPPeerTimers{MinimumAdvertisementInterval: &metav1.Duration{Duration: 601 * time.Second}},
+			wantField: "spec.timers.minimumAdvertisementInterval",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{dom: `{"name":"default"}`}}
+			p := &Provider{client: c}
+
+			err := p.EnsureBGPPeer(context.Background(), &provider.EnsureBGPPeerRequest{
+				BGPPeer: &v1alpha1.BGPPeer{
+					ObjectMeta: metav1.ObjectMeta{Name: "peer"},
+					Spec: v1alpha1.BGPPeerSpec{
+						Address:  "10.0.0.1",
+						ASNumber: intstr.FromInt32(65001),
+						Timers:   test.timers,
+					},
+				},
+				BGP: &v1alpha1.BGP{Spec: v1alpha1.BGPSpec{ASNumber: intstr.FromInt32(65000)}},
+			})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureBGPPeer() error = %v, want violation of %q", err, test.wantField)
+				}
+				if _, ok := c.config[xpath]; ok {
+					t.Errorf("EnsureBGPPeer() configured peer despite error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureBGPPeer() error = %v", err)
+			}
+
+			got := new(BGPPeer)
+			if err := json.Unmarshal([]byte(c.config[xpath]), got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if got.ConnRetryIntvl != test.wantConnRetry {
+				t.Errorf("EnsureBGPPeer() connRetryIntvl = %d, want %d", got.ConnRetryIntvl, test.wantConnRetry)
+			}
+			if got.AdvIntvl != test.wantAdv {
+				t.Errorf("EnsureBGPPeer() advIntvl = %d, want %d", got.AdvIntvl, test.wantAdv)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureBGPPeerCapabilities(t *testing.T) {
+	const (
+		dom   = "System/bgp-items/inst-items/dom-items/Dom-list[name=default]"
+		xpath = dom + "/peer-items/Peer-list[addr=10.0.0.1]"
+	)
+
+	tests := []struct {
+		name                  string
+		asn                   int32
+		disableConnectedCheck bool
+		capabilities          *v1alpha1.BGPPeerCapabilities
+		wantCtrl              string
+		wantCapSuppr          AdminSt
+		wantField             string
+	}{
+		{
+			name: "defaults",
+			asn:  65001,
+		},
+		{
+			name:                  "disable connected check",
+			asn:                   65001,
+			disableConnectedCheck: true,
+			wantCtrl:              PeerCtrlDisConnCheck,
+		},
+		{
+			name:                  "dont negotiate capabilities",
+			asn:                   65001,
+			disableConnectedCheck: true,
+			capabilities:          &v1alpha1.BGPPeerCapabilities{DontNegotiate: true},
+			wantCtrl:              PeerCtrlDisConnCheck + "," + PeerCtrlCapNegOff,
+		},
+		{
+			name:         "suppress 4-byte as",
+			asn:          65001,
+			capabilities: &v1alpha1.BGPPeerCapabilities{SuppressFourByteAS: true},
+			wantCapSuppr: AdminStEnabled,
+		},
+		{
+			name:                  "disable connected check on ibgp peer",
+			asn:                   65000,
+			disableConnectedCheck: true,
+			wantField:             "spec.disableConnectedCheck",
+		},
+		{
+			name:         "suppress 4-byte as without negotiation",
+			asn:          65001,
+			capabilities: &v1alpha1.BGPPeerCapabilities{DontNegotiate: true, SuppressFourByteAS: true},
+			wantField:    "spec.capabilities.suppressFourByteAS",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{dom: `{"name":"default"}`}}
+			p := &Provider{client: c}
+
+			err := p.EnsureBGPPeer(context.Background(), &provider.EnsureBGPPeerRequest{
+				BGPPeer: &v1alpha1.BGPPeer{
+					ObjectMeta: metav1.ObjectMeta{Name: "peer"},
+					Spec: v1alpha1.BGPPeerSpec{
+						Address:               "10.0.0.1",
+						ASNumber:              intstr.FromInt32(test.asn),
+						DisableConnectedCheck: test.disableConnectedCheck,
+						Capabilities:          test.capabilities,
+					},
+				},
+				BGP: &v1alpha1.BGP{Spec: v1alpha1.BGPSpec{ASNumber: intstr.FromInt32(65000)}},
+			})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureBGPPeer() error = %v, want violation of %q", err, test.wantField)
+				}
+				if _, ok := c.config[xpath]; ok {
+					t.Errorf("EnsureBGPPeer() configured peer despite error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureBGPPeer() error = %v", err)
+			}
+
+			got := new(BGPPeer)
+			if err := json.Unmarshal([]byte(c.config[xpath]), got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if got.Ctrl != test.wantCtrl {
+				t.Errorf("EnsureBGPPeer() ctrl = %q, want %q", got.Ctrl, test.wantCtrl)
+			}
+			if got.CapSuppr4ByteAsn != test.wantCapSuppr {
+				t.Errorf("EnsureBGPPeer() capSuppr4ByteAsn = %q, want %q", got.CapSuppr4ByteAsn, test.wantCapSuppr)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureBGPPeerGroup(t *testing.T) {
+	const xpath = "System/bgp-items/inst-items/dom-items/Dom-list[name=default]/peercont-items/PeerCont-list[name=SPINES]"
+
+	tests := []struct {
+		name      string
+		group     v1alpha1.BGPPeerGroup
+		srcIf     string
+		vrf       *v1alpha1.VRF
+		want      string
+		wantField string
+	}{
+		{
+			name: "update source",
+			group: v1alpha1.BGPPeerGroup{
+				Name:            "SPINES",
+				AddressFamilies: []v1alpha1.BGPAddressFamilyType{v1alpha1.BGPAddressFamilyL2vpnEvpn},
+			},
+			srcIf: "loopback0",
+			want:  `{"name":"SPINES","srcIf":"lo0","af-items":{"PeerAf-list":[{"type":"l2vpn-evpn"}]}}`,
+		},
+		{
+			name:  "vrf",
+			group: v1alpha1.BGPPeerGroup{Name: "SPINES"},
+			vrf:   &v1alpha1.VRF{Spec: v1alpha1.VRFSpec{Name: "CC-MGMT"}},
+			want:  `{"name":"SPINES"}`,
+		},
+		{
+			name:      "reserved name",
+			group:     v1alpha1.BGPPeerGroup{Name: ownershipMarkerName("default")},
+			wantField: "spec.peerGroups[*].name",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			err := p.EnsureBGPPeerGroup(context.Background(), &provider.EnsureBGPPeerGroupRequest{
+				PeerGroup:       &test.group,
+				BGP:             &v1alpha1.BGP{Spec: v1alpha1.BGPSpec{ASNumber: intstr.FromInt32(65000)}},
+				VRF:             test.vrf,
+				SourceInterface: test.srcIf,
+			})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureBGPPeerGroup() error = %v, want violation of %q", err, test.wantField)
+				}
+				if len(c.config) > 0 {
+					t.Errorf("EnsureBGPPeerGroup() configured %v despite error", c.config)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureBGPPeerGroup() error = %v", err)
+			}
+			if got := c.config[xpath]; got != test.want {
+				t.Errorf("EnsureBGPPeerGroup() config = %s, want %s", got, test.want)
+			}
+
+			if err := p.DeleteBGPPeerGroup(context.Background(), &provider.DeleteBGPPeerGroupRequest{Name: test.group.Name, VRF: test.vrf}); err != nil {
+				t.Fatalf("DeleteBGPPeerGroup() error = %v", err)
+			}
+			if _, ok := c.config[xpath]; ok {
+				t.Errorf("DeleteBGPPeerGroup() peer group still configured")
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureBGPPeer_PeerGroup(t *testing.T) {
+	const (
+		dom   = "System/bgp-items/inst-items/dom-items/Dom-list[name=default]"
+		xpath = dom + "/peer-items/Peer-list[addr=10.0.0.5]"
+	)
+
+	c := &fakeClient{config: map[string]string{dom: `{"name":"default"}`}}
+	p := &Provider{client: c}
+
+	err := p.EnsureBGPPeer(context.Background(), &provider.EnsureBGPPeerRequest{
+		BGPPeer: &v1alpha1.BGPPeer{
+			ObjectMeta: metav1.ObjectMeta{Name: "peer"},
+			Spec: v1alpha1.BGPPeerSpec{
+				Address:   "10.0.0.5",
+				ASNumber:  intstr.FromInt32(65000),
+				PeerGroup: "SPINES",
+			},
+		},
+		BGP: &v1alpha1.BGP{Spec: v1alpha1.BGPSpec{ASNumber: intstr.FromInt32(65000)}},
+	})
+	if err != nil {
+		t.Fatalf("EnsureBGPPeer() error = %v", err)
+	}
+
+	got := new(BGPPeer)
+	if err := json.Unmarshal([]byte(c.config[xpath]), got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got.PeerImp != "SPINES" {
+		t.Errorf("EnsureBGPPeer() peerImp = %q, want %q", got.PeerImp, "SPINES")
+	}
+	if got.SrcIf != "" {
+		t.Errorf("EnsureBGPPeer() srcIf = %q, want it to be inherited from the peer group", got.SrcIf)
+	}
+}
+
+func TestBGPDomAfItem_SetMultipath(t *testing.T) {
+	tests := []struct {
+		name             string
+		multipath        *v1alpha1.BGPMultipath
+		wantMaxEcmp      int8
+		wantMaxExtEcmp   int8
+		wantWeightedEcmp AdminSt
+		wantErr          bool
+	}{
+		{
+			name:           "unset",
+			wantMaxEcmp:    1,
+			wantMaxExtEcmp: 1,
+		},
+		{
+			name:           "enabled without maximum paths",
+			multipath:      &v1alpha1.BGPMultipath{Enabled: true, Ebgp: &v1alpha1.BGPMultipathEbgp{}, Ibgp: &v1alpha1.BGPMultipathIbgp{}},
+			wantMaxEcmp:    1,
+			wantMaxExtEcmp: 1,
+		},
+		{
+			name: "maximum paths",
+			multipath: &v1alpha1.BGPMultipath{
+				Enabled: true,
+				Ebgp:    &v1alpha1.BGPMultipathEbgp{MaximumPaths: 32},
+				Ibgp:    &v1alpha1.BGPMultipathIbgp{MaximumPaths: 8},
+			},
+			wantMaxEcmp:    8,
+			wantMaxExtEcmp: 32,
+		},
+		{
+			name:      "maximum paths out of range",
+			multipath: &v1alpha1.BGPMultipath{Enabled: true, Ibgp: &v1alpha1.BGPMultipathIbgp{MaximumPaths: 65}},
+			wantErr:   true,
+		},
+		{
+			name: "weighted",
+			multipath: &v1alpha1.BGPMultipath{
+				Enabled:  true,
+				Weighted: true,
+				Ebgp:     &v1alpha1.BGPMultipathEbgp{MaximumPaths: 8},
+			},
+			wantMaxEcmp:      1,
+			wantMaxExtEcmp:   8,
+			wantWeightedEcmp: AdminStEnabled,
+		},
+		{
+			name:      "weighted with single path",
+			multipath: &v1alpha1.BGPMultipath{Enabled: true, Weighted: true},
+			wantErr:   true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			af := &BGPDomAfItem{Type: AddressFamilyIPv4Unicast}
+			err := af.SetMultipath(test.multipath)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("SetMultipath() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+			if af.MaxEcmp != test.wantMaxEcmp {
+				t.Errorf("SetMultipath() MaxEcmp = %d, want %d", af.MaxEcmp, test.wantMaxEcmp)
+			}
+			if af.MaxExtEcmp != test.wantMaxExtEcmp {
+				t.Errorf("SetMultipath() MaxExtEcmp = %d, want %d", af.MaxExtEcmp, test.wantMaxExtEcmp)
+			}
+			if af.WeightedEcmp != test.wantWeightedEcmp {
+				t.Errorf("SetMultipath() WeightedEcmp = %q, want %q", af.WeightedEcmp, test.wantWeightedEcmp)
+			}
+		})
+	}
+}
+
+func TestNewInterLeakP(t *testing.T) {
+	tests := []struct {
+		name    string
+		proto   v1alpha1.BGPRedistributionProtocol
+		inst    string
+		rtMap   string
+		want    *InterLeakP
+		wantErr bool
+	}{
+		{
+			name:  "static",
+			proto: v1alpha1.BGPRedistributionProtocolStatic,
+			rtMap: "REDIST_STATIC",
+			want:  &InterLeakP{InterLeakPKey: InterLeakPKey{Asn: "none", Inst: "none", Proto: RtLeakProtoStatic}, RtMap: "REDIST_STATIC"},
+		},
+		{
+			name:  "ospf",
+			proto: v1alpha1.BGPRedistributionProtocolOSPF,
+			inst:  "UNDERLAY",
+			rtMap: "REDIST_OSPF",
+			want:  &InterLeakP{InterLeakPKey: InterLeakPKey{Asn: "none", Inst: "UNDERLAY", Proto: RtLeakProtoOSPF}, RtMap: "REDIST_OSPF"},
+		},
+		{
+			name:    "ospf without instance",
+			proto:   v1alpha1.BGPRedistributionProtocolOSPF,
+			rtMap:   "REDIST_OSPF",
+			wantErr: true,
+		},
+		{
+			name:    "empty route map",
+			proto:   v1alpha1.BGPRedistributionProtocolStatic,
+			wantErr: true,
+		},
+		{
+			name:    "route map with whitespace",
+			proto:   v1alpha1.BGPRedistributionProtocolStatic,
+			rtMap:   "REDIST STATIC",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported protocol",
+			proto:   "RIP",
+			rtMap:   "REDIST_RIP",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NewInterLeakP(test.proto, test.inst, test.rtMap)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("NewInterLeakP() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+			if *got != *test.want {
+				t.Errorf("NewInterLeakP() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestProvider_ClearBGPSession(t *testing.T) {
+	const xpath = `System/action-items/lsubj-items/LSubj-list[oDn=sys/bgp/inst/dom-default/peer-\[10.0.0.1\]]/peerclearltask-items`
+
+	tests := []struct {
+		name string
+		mode provider.BGPSessionClearMode
+		want string
+	}{
+		{
+			name: "hard",
+			mode: provider.BGPSessionClearHard,
+			want: `{"adminSt":"start","type":"hard"}`,
+		},
+		{
+			name: "soft",
+			mode: provider.BGPSessionClearSoft,
+			want: `{"adminSt":"start","type":"soft","dir":"both"}`,
+		},
+		{
+			name: "soft inbound",
+			mode: provider.BGPSessionClearSoftInbound,
+			want: `{"adminSt":"start","type":"soft","dir":"in"}`,
+		},
+		{
+			name: "soft outbound",
+			mode: provider.BGPSessionClearSoftOutbound,
+			want: `{"adminSt":"start","type":"soft","dir":"out"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			req := &provider.ClearBGPSessionRequest{Address: netip.MustParseAddr("10.0.0.1"), Mode: test.mode}
+			if err := p.ClearBGPSession(context.Background(), req); err != nil {
+				t.Fatalf("ClearBGPSession() error = %v", err)
+			}
+			got, ok := c.config[xpath]
+			if !ok {
+				t.Fatalf("ClearBGPSession() did not trigger action at %s", xpath)
+			}
+			if got != test.want {
+				t.Errorf("ClearBGPSession() = %s, want %s", got, test.want)
+			}
+
+			path, err := gnmiext.StringToStructuredPath(xpath)
+			if err != nil {
+				t.Fatalf("StringToStructuredPath() error = %v", err)
+			}
+			if key := path.GetElem()[3].GetKey()["oDn"]; key != "sys/bgp/inst/dom-default/peer-[10.0.0.1]" {
+				t.Errorf("StringToStructuredPath() oDn = %q, want %q", key, "sys/bgp/inst/dom-default/peer-[10.0.0.1]")
+			}
+		})
+	}
+}
+
+func TestProvider_ClearBGPSession_VRF(t *testing.T) {
+	const xpath = `System/action-items/lsubj-items/LSubj-list[oDn=sys/bgp/inst/dom-CUSTOMER/peer-\[2001:db8::1\]]/peerclearltask-items`
+
+	c := &fakeClient{config: map[string]string{}}
+	p := &Provider{client: c}
+
+	req := &provider.ClearBGPSessionRequest{VRF: "CUSTOMER", Address: netip.MustParseAddr("2001:db8::1"), Mode: provider.BGPSessionClearHard}
+	if err := p.ClearBGPSession(context.Background(), req); err != nil {
+		t.Fatalf("ClearBGPSession() error = %v", err)
+	}
+	if _, ok := c.config[xpath]; !ok {
+		t.Errorf("ClearBGPSession() did not trigger action at %s", xpath)
+	}
+
+	req.Mode = "Unknown"
+	if err := p.ClearBGPSession(context.Background(), req); err == nil {
+		t.Error("ClearBGPSession() expected error for unsupported mode")
+	}
 }
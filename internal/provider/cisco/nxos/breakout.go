@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ironcore-dev/network-operator/internal/provider"
+	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+)
+
+var _ gnmiext.DataElement = (*BreakoutPort)(nil)
+
+// BreakoutPort represents the breakout configuration of a front panel port of a module.
+type BreakoutPort struct {
+	Module      int         `json:"-"`
+	ID          int         `json:"id"`
+	BreakoutMap BreakoutMap `json:"breakoutMap"`
+}
+
+func (*BreakoutPort) IsListItem() {}
+
+func (b *BreakoutPort) XPath() string {
+	return "System/breakout-items/module-items/Module-list[id=" + strconv.Itoa(b.Module) + "]/fport-items/FrontPort-list[id=" + strconv.Itoa(b.ID) + "]"
+}
+
+// NewBreakoutPort returns the breakout configuration of the physical interface with the given short name, e.g. "eth1/49".
+func NewBreakoutPort(name string) (*BreakoutPort, error) {
+	mod, port, ok := strings.Cut(strings.TrimPrefix(name, "eth"), "/")
+	if !ok {
+		return nil, fmt.Errorf("breakout: invalid port name %q", name)
+	}
+	m, err := strconv.Atoi(mod)
+	if err != nil {
+		return nil, fmt.Errorf("breakout: invalid module of port %q: %w", name, err)
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, fmt.Errorf("breakout: invalid port number of port %q: %w", name, err)
+	}
+	return &BreakoutPort{Module: m, ID: p}, nil
+}
+
+// BreakoutMap is the speed and the number of the ports a front panel port is broken out into.
+type BreakoutMap string
+
+const (
+	BreakoutMapNone   BreakoutMap = "none"
+	BreakoutMap10g4x  BreakoutMap = "10g-4x"
+	BreakoutMap25g4x  BreakoutMap = "25g-4x"
+	BreakoutMap50g2x  BreakoutMap = "50g-2x"
+	BreakoutMap100g4x BreakoutMap = "100g-4x"
+)
+
+var breakoutMaps = map[provider.BreakoutMode]BreakoutMap{
+	provider.BreakoutMode4x10G:  BreakoutMap10g4x,
+	provider.BreakoutMode4x25G:  BreakoutMap25g4x,
+	provider.BreakoutMode2x50G:  BreakoutMap50g2x,
+	provider.BreakoutMode4x100G: BreakoutMap100g4x,
+}
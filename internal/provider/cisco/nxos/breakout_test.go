@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
+func init() {
+	Register("breakout", &BreakoutPort{Module: 1, ID: 49, BreakoutMap: BreakoutMap25g4x})
+}
+
+func TestProvider_EnsureBreakout(t *testing.T) {
+	tests := []struct {
+		name    string
+		port    string
+		mode    provider.BreakoutMode
+		want    provider.BreakoutMode
+		wantErr bool
+	}{
+		{
+			name: "4x25G on 100G port",
+			port: "Ethernet1/49",
+			mode: provider.BreakoutMode4x25G,
+			want: provider.BreakoutMode4x25G,
+		},
+		{
+			name: "4x10G on 100G port",
+			port: "eth1/49",
+			mode: provider.BreakoutMode4x10G,
+			want: provider.BreakoutMode4x10G,
+		},
+		{
+			name: "unchanged",
+			port: "eth1/49",
+			mode: provider.BreakoutMode2x50G,
+			want: provider.BreakoutMode2x50G,
+		},
+		{
+			name:    "4x100G on 100G port",
+			port:    "eth1/49",
+			mode:    provider.BreakoutMode4x100G,
+			want:    provider.BreakoutMode2x50G,
+			wantErr: true,
+		},
+		{
+			name:    "4x25G on 25G port",
+			port:    "eth1/1",
+			mode:    provider.BreakoutMode4x25G,
+			wantErr: true,
+		},
+		{
+			name:    "unknown port",
+			port:    "eth1/50",
+			mode:    provider.BreakoutMode4x25G,
+			wantErr: true,
+		},
+		{
+			name: "none",
+			port: "eth1/49",
+			mode: provider.BreakoutModeNone,
+			want: provider.BreakoutModeNone,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{
+				// The port is broken out into a different mode before, such that the device
+				// only reports its breakout ports instead of the port itself.
+				new(Ports).XPath(): `{"PhysIf-list":[` +
+					`{"id":"eth1/1","phys-items":{"portcap-items":{"speed":"1000,10000,25000"}}},` +
+					`{"id":"eth1/49/1","phys-items":{"portcap-items":{"speed":"10000,25000,50000"}}},` +
+					`{"id":"eth1/49/2","phys-items":{"portcap-items":{"speed":"10000,25000,50000"}}}]}`,
+				(&BreakoutPort{Module: 1, ID: 49}).XPath(): `{"id":49,"breakoutMap":"50g-2x"}`,
+			}}
+			p := &Provider{client: c}
+
+			err := p.EnsureBreakout(context.Background(), test.port, test.mode)
+			if test.wantErr {
+				if _, ok := apistatus.FromError(err); !ok {
+					t.Fatalf("EnsureBreakout() error = %v, want status error", err)
+				}
+			} else if err != nil {
+				t.Fatalf("EnsureBreakout() error = %v", err)
+			}
+
+			got, err := p.GetBreakout(context.Background(), test.port)
+			if err != nil {
+				t.Fatalf("GetBreakout() error = %v", err)
+			}
+			if got != test.want {
+				t.Errorf("GetBreakout() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureBreakout_Unchanged(t *testing.T) {
+	// The ports are not listed, as the breakout mode is only validated when it changes.
+	c := &fakeClient{config: map[string]string{
+		(&BreakoutPort{Module: 1, ID: 49}).XPath(): `{"id":49,"breakoutMap":"25g-4x"}`,
+	}}
+	p := &Provider{client: c}
+
+	for range 2 {
+		if err := p.EnsureBreakout(context.Background(), "eth1/49", provider.BreakoutMode4x25G); err != nil {
+			t.Fatalf("EnsureBreakout() error = %v", err)
+		}
+	}
+}
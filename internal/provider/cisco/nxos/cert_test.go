@@ -3,6 +3,76 @@
 
 package nxos
 
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
 func init() {
 	Register("trustpoint", &Trustpoint{Name: "mytrustpoint"})
 }
+
+func TestProvider_GetCertificate(t *testing.T) {
+	bodies := map[string]string{
+		"show crypto ca certificates tp1":     `{"Certificate":{"certificate":"Trustpoint: tp1\nsubject=CN = switch.example.com\nissuer=CN = Example CA\nserial=0A1B2C\nnotBefore=Jan  2 03:04:05 2025 GMT\nnotAfter=Jan  2 03:04:05 2026 GMT\nSHA1 Fingerprint=00:11:22\n"}}`,
+		"show crypto ca certificates ca-only": `{"Certificate":{"certificate":""}}`,
+	}
+
+	tests := []struct {
+		name     string
+		id       string
+		want     *provider.CertificateInfo
+		wantCmds []string
+	}{
+		{
+			name: "identity certificate",
+			id:   "tp1",
+			want: &provider.CertificateInfo{
+				ID:           "tp1",
+				SerialNumber: "0A1B2C",
+				NotBefore:    time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC),
+				NotAfter:     time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC),
+			},
+			wantCmds: []string{"show crypto ca certificates tp1"},
+		},
+		{
+			name:     "ca certificates only",
+			id:       "ca-only",
+			wantCmds: []string{"show crypto ca certificates ca-only"},
+		},
+		{
+			name: "unknown trustpoint",
+			id:   "unknown",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api, client := newFakeNXAPI(t, func(cmd string) (string, error) {
+				return `{"body":` + bodies[cmd] + `}`, nil
+			})
+
+			c := &fakeClient{config: map[string]string{}}
+			for _, name := range []string{"tp1", "ca-only"} {
+				c.config[(&Trustpoint{Name: name}).XPath()] = `{"name":"` + name + `"}`
+			}
+			p := &Provider{client: c, nxapi: client}
+
+			got, err := p.GetCertificate(t.Context(), &provider.GetCertificateRequest{ID: test.id})
+			if err != nil {
+				t.Fatalf("GetCertificate() error = %v", err)
+			}
+			if !slices.Equal(api.Commands(), test.wantCmds) {
+				t.Errorf("GetCertificate() nxapi commands = %v, want %v", api.Commands(), test.wantCmds)
+			}
+			if (got == nil) != (test.want == nil) {
+				t.Fatalf("GetCertificate() = %v, want %v", got, test.want)
+			}
+			if got != nil && (got.ID != test.want.ID || got.SerialNumber != test.want.SerialNumber || !got.NotBefore.Equal(test.want.NotBefore) || !got.NotAfter.Equal(test.want.NotAfter)) {
+				t.Errorf("GetCertificate() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// canonicalJSON writes the JSON value in data to w in canonical form, i.e. compact, with the
+// members of all objects sorted by key and numbers written as-is. The output is written to w
+// while data is being read, so that only the keys of the objects that are currently being
+// sorted are held in memory in addition to data, at the cost of reading nested values once
+// per level of nesting.
+func canonicalJSON(w io.Writer, data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := writeCanonical(w, dec, data); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("unexpected data after top-level value")
+	}
+	return nil
+}
+
+func writeCanonical(w io.Writer, dec *json.Decoder, data []byte) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	// next returns the raw bytes of the next value of dec.
+	next := func() ([]byte, error) {
+		start := dec.InputOffset()
+		if err := skipValue(dec); err != nil {
+			return nil, err
+		}
+		return bytes.TrimLeft(data[start:dec.InputOffset()], " \t\r\n:,"), nil
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			type member struct {
+				key   string
+				value []byte
+			}
+			var members []member
+			for dec.More() {
+				tok, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				key, ok := tok.(string)
+				if !ok {
+					return fmt.Errorf("unexpected object key %v", tok)
+				}
+				value, err := next()
+				if err != nil {
+					return err
+				}
+				members = append(members, member{key, value})
+			}
+			if _, err := dec.Token(); err != nil { // consume '}'
+				return err
+			}
+			slices.SortFunc(members, func(a, b member) int { return strings.Compare(a.key, b.key) })
+
+			if _, err := io.WriteString(w, "{"); err != nil {
+				return err
+			}
+			for i, m := range members {
+				if i > 0 {
+					if _, err := io.WriteString(w, ","); err != nil {
+						return err
+					}
+				}
+				if err := writeScalar(w, m.key); err != nil {
+					return err
+				}
+				if _, err := io.WriteString(w, ":"); err != nil {
+					return err
+				}
+				if err := canonicalJSON(w, m.value); err != nil {
+					return err
+				}
+			}
+			_, err := io.WriteString(w, "}")
+			return err
+
+		case '[':
+			if _, err := io.WriteString(w, "["); err != nil {
+				return err
+			}
+			for i := 0; dec.More(); i++ {
+				if i > 0 {
+					if _, err := io.WriteString(w, ","); err != nil {
+						return err
+					}
+				}
+				value, err := next()
+				if err != nil {
+					return err
+				}
+				if err := canonicalJSON(w, value); err != nil {
+					return err
+				}
+			}
+			if _, err := dec.Token(); err != nil { // consume ']'
+				return err
+			}
+			_, err := io.WriteString(w, "]")
+			return err
+		}
+		return fmt.Errorf("unexpected delimiter %v", t)
+
+	default:
+		return writeScalar(w, t)
+	}
+}
+
+// skipValue reads the next value of dec, including all nested values, without decoding it.
+func skipValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// writeScalar writes a string, number, boolean or null value without escaping HTML characters.
+func writeScalar(w io.Writer, v any) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := w.Write(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+	return err
+}
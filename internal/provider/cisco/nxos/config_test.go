@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"slices"
+	"testing"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "sorted keys",
+			in:   `{"b": 1, "a": {"d": true, "c": null}}`,
+			want: `{"a":{"c":null,"d":true},"b":1}`,
+		},
+		{
+			name: "list order preserved",
+			in:   `[{"id": "eth1/2"}, {"id": "eth1/1"}]`,
+			want: `[{"id":"eth1/2"},{"id":"eth1/1"}]`,
+		},
+		{
+			name: "numbers and strings unchanged",
+			in:   `{"asn": 4294967295, "descr": "a<b & \"c\""}`,
+			want: `{"asn":4294967295,"descr":"a<b & \"c\""}`,
+		},
+		{
+			name: "nested with whitespace",
+			in:   "{ \"z\" : [ { \"b\" : 2 , \"a\" : [ ] } , 1 ] ,\n\t\"y\" : { } }",
+			want: `{"y":{},"z":[{"a":[],"b":2},1]}`,
+		},
+		{
+			name:    "trailing data",
+			in:      `{} {}`,
+			wantErr: true,
+		},
+		{
+			name:    "truncated",
+			in:      `{"a": [1, 2`,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := canonicalJSON(&buf, []byte(test.in))
+			if (err != nil) != test.wantErr {
+				t.Fatalf("canonicalJSON() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+			if got := buf.String(); got != test.want {
+				t.Errorf("canonicalJSON() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestProvider_GetRunningConfig(t *testing.T) {
+	c := &fakeClient{config: map[string]string{
+		"System": `{
+			"intf-items": {"phys-items": {"PhysIf-list": [{"id": "eth1/1", "descr": "uplink", "adminSt": "up"}]}},
+			"bgp-items": {"inst-items": {"asn": "65000", "adminSt": "enabled"}}
+		}`,
+	}}
+	p := &Provider{client: c}
+
+	const want = `{"bgp-items":{"inst-items":{"adminSt":"enabled","asn":"65000"}},"intf-items":{"phys-items":{"PhysIf-list":[{"adminSt":"up","descr":"uplink","id":"eth1/1"}]}}}`
+
+	var first bytes.Buffer
+	if err := p.GetRunningConfig(t.Context(), &first); err != nil {
+		t.Fatalf("GetRunningConfig() error = %v", err)
+	}
+	if first.String() != want {
+		t.Errorf("GetRunningConfig() = %s, want %s", first.String(), want)
+	}
+
+	var second bytes.Buffer
+	if err := p.GetRunningConfig(t.Context(), &second); err != nil {
+		t.Fatalf("GetRunningConfig() error = %v", err)
+	}
+	if !bytes.Equal(first.Bytes(), second.Bytes()) {
+		t.Errorf("GetRunningConfig() not stable across calls: %s != %s", first.String(), second.String())
+	}
+
+	p.client = &fakeClient{config: map[string]string{}}
+	if err := p.GetRunningConfig(t.Context(), io.Discard); err == nil {
+		t.Errorf("GetRunningConfig() expected error for missing config")
+	}
+}
+
+func TestProvider_GetRunningConfigCLI(t *testing.T) {
+	const want = "!Command: show running-config\nhostname leaf1\nfeature bgp\n"
+
+	api, client := newFakeNXAPI(t, func(string) (string, error) {
+		b, err := json.Marshal(map[string]string{"msg": want})
+		return string(b), err
+	})
+
+	p := &Provider{nxapi: client}
+	got, err := p.GetRunningConfigCLI(t.Context())
+	if err != nil {
+		t.Fatalf("GetRunningConfigCLI() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("GetRunningConfigCLI() = %q, want %q", got, want)
+	}
+	if want := []string{"show running-config"}; !slices.Equal(api.Commands(), want) {
+		t.Errorf("GetRunningConfigCLI() nxapi commands = %v, want %v", api.Commands(), want)
+	}
+}
+
+func TestProvider_GetConfigRevision(t *testing.T) {
+	tests := []struct {
+		name    string
+		out     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "last change",
+			out:  "!Running configuration last done at: Fri Oct 16 09:12:44 2026\n",
+			want: "Fri Oct 16 09:12:44 2026",
+		},
+		{
+			name:    "missing",
+			out:     "",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api, client := newFakeNXAPI(t, func(string) (string, error) {
+				b, err := json.Marshal(map[string]string{"msg": test.out})
+				return string(b), err
+			})
+
+			p := &Provider{nxapi: client}
+			got, err := p.GetConfigRevision(t.Context())
+			if (err != nil) != test.wantErr {
+				t.Fatalf("GetConfigRevision() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("GetConfigRevision() = %q, want %q", got, test.want)
+			}
+			if want := []string{`show running-config | include "!Running configuration last done at:"`}; !slices.Equal(api.Commands(), want) {
+				t.Errorf("GetConfigRevision() nxapi commands = %v, want %v", api.Commands(), want)
+			}
+		})
+	}
+}
@@ -9,7 +9,11 @@
 	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
 )
 
-var _ gnmiext.DataElement = (*DHCPRelayConfig)(nil)
+var (
+	_ gnmiext.DataElement = (*DHCPRelayConfig)(nil)
+	_ gnmiext.DataElement = (*DHCPRelayInfoOption)(nil)
+	_ gnmiext.Defaultable = (*DHCPRelayInfoOption)(nil)
+)
 
 // DHCPRelayConfig represents the complete DHCP relay configuration tree.
 type DHCPRelayConfig struct {
@@ -22,7 +26,9 @@ func (*DHCPRelayConfig) XPath() string {
 
 // DHCPRelay represents the DHCP Relay configuration for a single interface.
 type DHCPRelay struct {
-	ID        string `json:"id"`
+	ID string `json:"id"`
+	// Trusted forwards DHCP messages received on the interface that already contain option 82 unchanged.
+	Trusted   bool `json:"trusted,omitempty"`
 	AddrItems struct {
 		AddrList gnmiext.List[netip.Addr, *DHCPRelayServer] `json:"RelayAddr-list,omitzero"`
 	} `json:"addr-items"`
@@ -42,3 +48,15 @@ type DHCPRelayServer struct {
 func (d *DHCPRelayServer) Key() netip.Addr {
 	return d.Address
 }
+
+// DHCPRelayInfoOption represents whether the relay agent information option (option 82)
+// is inserted into DHCP messages relayed by the device.
+type DHCPRelayInfoOption bool
+
+func (*DHCPRelayInfoOption) XPath() string {
+	return "System/dhcp-items/inst-items/relayInfoOptEnabled"
+}
+
+func (o *DHCPRelayInfoOption) Default() {
+	*o = false
+}
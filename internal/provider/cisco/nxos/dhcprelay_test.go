@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
+func init() {
+	single := &DHCPRelay{ID: "vlan2"}
+	single.AddrItems.AddrList.Set(&DHCPRelayServer{Address: netip.MustParseAddr("1.1.1.1"), Vrf: "!unspecified"})
+	relay := new(DHCPRelayConfig)
+	relay.RelayIfList.Set(single)
+	Register("dhcprelay", relay)
+
+	multi := new(DHCPRelayConfig)
+	for _, id := range []string{"vlan2", "eth1/1"} {
+		intf := &DHCPRelay{ID: id}
+		intf.AddrItems.AddrList.Set(&DHCPRelayServer{Address: netip.MustParseAddr("1.1.1.1"), Vrf: "!unspecified"})
+		intf.AddrItems.AddrList.Set(&DHCPRelayServer{Address: netip.MustParseAddr("2.2.2.2"), Vrf: "!unspecified"})
+		multi.RelayIfList.Set(intf)
+	}
+	Register("dhcprelay_multi", multi)
+
+	vrf := &DHCPRelay{ID: "vlan2"}
+	vrf.AddrItems.AddrList.Set(&DHCPRelayServer{Address: netip.MustParseAddr("1.1.1.1"), Vrf: "CC-MGMT"})
+	vrf.AddrItems.AddrList.Set(&DHCPRelayServer{Address: netip.MustParseAddr("2.2.2.2"), Vrf: "CC-MGMT"})
+	relayVRF := new(DHCPRelayConfig)
+	relayVRF.RelayIfList.Set(vrf)
+	Register("dhcprelay_vrf", relayVRF)
+
+	trusted := &DHCPRelay{ID: "vlan2", Trusted: true}
+	trusted.AddrItems.AddrList.Set(&DHCPRelayServer{Address: netip.MustParseAddr("1.1.1.1"), Vrf: "!unspecified"})
+	relayTrusted := new(DHCPRelayConfig)
+	relayTrusted.RelayIfList.Set(trusted)
+	Register("dhcprelay_trusted", relayTrusted)
+
+	opt := DHCPRelayInfoOption(true)
+	Register("dhcprelay_info_option", &opt)
+}
+
+func TestProvider_EnsureDHCPRelay_Option82(t *testing.T) {
+	const (
+		relayIf = "System/dhcp-items/inst-items/relayif-items"
+		infoOpt = "System/dhcp-items/inst-items/relayInfoOptEnabled"
+	)
+
+	tests := []struct {
+		name        string
+		option82    *v1alpha1.DHCPRelayOption82
+		wantInfoOpt string
+		wantRelayIf string
+	}{
+		{
+			name:        "disabled",
+			wantInfoOpt: "false",
+			wantRelayIf: `{"RelayIf-list":[{"id":"vlan2","addr-items":{"RelayAddr-list":[{"address":"1.1.1.1","vrf":"!unspecified"}]}}]}`,
+		},
+		{
+			name:        "enabled with platform defaults",
+			option82:    &v1alpha1.DHCPRelayOption82{},
+			wantInfoOpt: "true",
+			wantRelayIf: `{"RelayIf-list":[{"id":"vlan2","addr-items":{"RelayAddr-list":[{"address":"1.1.1.1","vrf":"!unspecified"}]}}]}`,
+		},
+		{
+			name:        "keep existing option",
+			option82:    &v1alpha1.DHCPRelayOption82{Policy: v1alpha1.DHCPOption82PolicyKeep},
+			wantInfoOpt: "true",
+			wantRelayIf: `{"RelayIf-list":[{"id":"vlan2","trusted":true,"addr-items":{"RelayAddr-list":[{"address":"1.1.1.1","vrf":"!unspecified"}]}}]}`,
+		},
+		{
+			name:        "drop existing option",
+			option82:    &v1alpha1.DHCPRelayOption82{Policy: v1alpha1.DHCPOption82PolicyDrop},
+			wantInfoOpt: "true",
+			wantRelayIf: `{"RelayIf-list":[{"id":"vlan2","addr-items":{"RelayAddr-list":[{"address":"1.1.1.1","vrf":"!unspecified"}]}}]}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			relay := &v1alpha1.DHCPRelay{}
+			relay.Spec.Servers = []string{"1.1.1.1"}
+			relay.Spec.Option82 = test.option82
+
+			intf := &v1alpha1.Interface{}
+			intf.Spec.Name = "vlan2"
+
+			err := p.EnsureDHCPRelay(context.Background(), &provider.DHCPRelayRequest{
+				DHCPRelay:  relay,
+				Interfaces: []*v1alpha1.Interface{intf},
+			})
+			if err != nil {
+				t.Fatalf("EnsureDHCPRelay() error = %v", err)
+			}
+			if got := c.config[infoOpt]; got != test.wantInfoOpt {
+				t.Errorf("EnsureDHCPRelay() info option = %q, want %q", got, test.wantInfoOpt)
+			}
+			if got := c.config[relayIf]; got != test.wantRelayIf {
+				t.Errorf("EnsureDHCPRelay() relay interfaces = %q, want %q", got, test.wantRelayIf)
+			}
+		})
+	}
+}
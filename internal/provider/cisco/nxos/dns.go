@@ -30,12 +30,18 @@ type DNSProf struct {
 	DomItems struct {
 		Name string `json:"name,omitempty"`
 	} `json:"dom-items,omitzero"`
+	DlistItems struct {
+		DomExtList gnmiext.List[string, *DNSDomExt] `json:"DomExt-list,omitzero"`
+	} `json:"dlist-items,omitzero"`
 }
 
 func (p *DNSProf) Key() string { return p.Name }
 
 type DNSVrf struct {
-	Name      string `json:"name"`
+	Name string `json:"name"`
+	// Order is the position of the VRF in the resolution order, starting at 1.
+	// The device queries the name servers of the VRFs by ascending order.
+	Order     int32 `json:"order,omitempty"`
 	ProvItems struct {
 		ProviderList gnmiext.List[string, *DNSProv] `json:"Provider-list,omitzero"`
 	} `json:"prov-items,omitzero"`
@@ -49,3 +55,13 @@ type DNSProv struct {
 }
 
 func (p *DNSProv) Key() string { return p.Addr }
+
+// DNSDomExt represents a search domain of a DNS profile.
+type DNSDomExt struct {
+	Name string `json:"name"`
+	// Order is the position of the domain in the search list, starting at 1.
+	// The device appends the search domains to unqualified hostnames by ascending order.
+	Order int32 `json:"order,omitempty"`
+}
+
+func (d *DNSDomExt) Key() string { return d.Name }
@@ -3,8 +3,19 @@
 
 package nxos
 
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"reflect"
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
 func init() {
-	vrf := &DNSVrf{Name: ManagementVRFName}
+	vrf := &DNSVrf{Name: ManagementVRFName, Order: 1}
 	vrf.ProvItems.ProviderList.Set(&DNSProv{Addr: "10.10.10.10"})
 
 	prof := &DNSProf{Name: DefaultVRFName}
@@ -15,4 +26,140 @@ func init() {
 	dns := &DNS{AdminSt: AdminStEnabled}
 	dns.ProfItems.ProfList.Set(prof)
 	Register("dns", dns)
+
+	prof = &DNSProf{Name: DefaultVRFName}
+	prof.DomItems.Name = "example.com"
+	prof.DlistItems.DomExtList.Set(&DNSDomExt{Name: "us.example.com", Order: 1})
+	prof.DlistItems.DomExtList.Set(&DNSDomExt{Name: "eu.example.com", Order: 2})
+
+	dns = &DNS{AdminSt: AdminStEnabled}
+	dns.ProfItems.ProfList.Set(prof)
+	Register("dns_search_domains", dns)
+}
+
+func TestProvider_EnsureDNS_ResolutionOrder(t *testing.T) {
+	servers := []v1alpha1.NameServer{
+		{Address: "10.0.0.1", VrfName: "default"},
+		{Address: "10.0.0.2", VrfName: "blue"},
+		{Address: "10.0.0.3", VrfName: ManagementVRFName},
+	}
+	vrfs := map[string]string{
+		(&VRF{Name: ManagementVRFName}).XPath(): `{"name":"management"}`,
+		(&VRF{Name: "default"}).XPath():         `{"name":"default"}`,
+	}
+
+	tests := []struct {
+		name    string
+		order   []string
+		want    map[string]int32
+		wantErr bool
+	}{
+		{name: "no order", want: map[string]int32{"blue": 0, "default": 0, ManagementVRFName: 0}},
+		{name: "management first", order: []string{ManagementVRFName, "default"}, want: map[string]int32{ManagementVRFName: 1, "default": 2, "blue": 0}},
+		{name: "vrf not on device", order: []string{"blue"}, wantErr: true},
+		{name: "vrf without servers", order: []string{"red"}, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: maps.Clone(vrfs)}
+			p := &Provider{client: c}
+
+			dns := &v1alpha1.DNS{}
+			dns.Spec.Domain = "example.com"
+			dns.Spec.Servers = servers
+			dns.Spec.ResolutionOrder = test.order
+
+			err := p.EnsureDNS(context.Background(), &provider.EnsureDNSRequest{DNS: dns})
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("EnsureDNS() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureDNS() error = %v", err)
+			}
+
+			d := new(DNS)
+			if err := json.Unmarshal([]byte(c.config[d.XPath()]), d); err != nil {
+				t.Fatal(err)
+			}
+			prof, ok := d.ProfItems.ProfList.Get(DefaultVRFName)
+			if !ok {
+				t.Fatal("EnsureDNS() did not configure the default profile")
+			}
+			got := make(map[string]int32)
+			for _, v := range prof.VrfItems.VrfList {
+				got[v.Name] = v.Order
+			}
+			if !maps.Equal(got, test.want) {
+				t.Errorf("EnsureDNS() vrf order = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureDNS_SearchDomains(t *testing.T) {
+	tests := []struct {
+		name    string
+		domains []string
+		want    map[string]int32
+	}{
+		{name: "two domains", domains: []string{"us.example.com", "eu.example.com"}, want: map[string]int32{"us.example.com": 1, "eu.example.com": 2}},
+		{name: "none", want: map[string]int32{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			dns := &v1alpha1.DNS{}
+			dns.Spec.Domain = "example.com"
+			dns.Spec.SearchDomains = test.domains
+
+			if err := p.EnsureDNS(context.Background(), &provider.EnsureDNSRequest{DNS: dns}); err != nil {
+				t.Fatalf("EnsureDNS() error = %v", err)
+			}
+
+			d := new(DNS)
+			if err := json.Unmarshal([]byte(c.config[d.XPath()]), d); err != nil {
+				t.Fatal(err)
+			}
+			prof, ok := d.ProfItems.ProfList.Get(DefaultVRFName)
+			if !ok {
+				t.Fatal("EnsureDNS() did not configure the default profile")
+			}
+			if prof.DomItems.Name != "example.com" {
+				t.Errorf("EnsureDNS() domain = %q, want %q", prof.DomItems.Name, "example.com")
+			}
+			got := make(map[string]int32)
+			for _, dom := range prof.DlistItems.DomExtList {
+				got[dom.Name] = dom.Order
+			}
+			if !maps.Equal(got, test.want) {
+				t.Errorf("EnsureDNS() search domains = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+// TestDNS_ListOrder verifies that the configuration read back from the device equals the desired
+// configuration regardless of the order in which the device returns the list entries, such that
+// the configuration is not rewritten on every reconciliation.
+func TestDNS_ListOrder(t *testing.T) {
+	const (
+		want = `{"adminSt":"enabled","prof-items":{"Prof-list":[{"name":"default","vrf-items":{"Vrf-list":[{"name":"management","order":1},{"name":"blue","order":2}]},"dlist-items":{"DomExt-list":[{"name":"us.example.com","order":1},{"name":"eu.example.com","order":2}]}}]}}`
+		got  = `{"adminSt":"enabled","prof-items":{"Prof-list":[{"name":"default","vrf-items":{"Vrf-list":[{"name":"blue","order":2},{"name":"management","order":1}]},"dlist-items":{"DomExt-list":[{"name":"eu.example.com","order":2},{"name":"us.example.com","order":1}]}}]}}`
+	)
+
+	a, b := new(DNS), new(DNS)
+	if err := json.Unmarshal([]byte(want), a); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(got), b); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("DNS configuration depends on the order of the list entries:\nwant: %+v\ngot:  %+v", a, b)
+	}
 }
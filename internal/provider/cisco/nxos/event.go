@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ironcore-dev/network-operator/internal/provider"
+	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+)
+
+// eventPaths are the operational state leafs subscribed to for device events.
+var eventPaths = []string{
+	"System/intf-items/phys-items/PhysIf-list/phys-items/operSt",
+	"System/bgp-items/inst-items/dom-items/Dom-list/peer-items/Peer-list/ent-items/PeerEntry-list/operSt",
+}
+
+// DeviceEvents translates a gNMI notification on one of the [eventPaths] into device events.
+func DeviceEvents(n gnmiext.Notification) []provider.DeviceEvent {
+	var events []provider.DeviceEvent
+	for _, u := range n.Updates {
+		path, err := gnmiext.StringToStructuredPath(u.Path)
+		if err != nil {
+			continue
+		}
+		var val string
+		if err := json.Unmarshal(u.Value, &val); err != nil {
+			continue
+		}
+		for _, e := range path.GetElem() {
+			switch e.GetName() {
+			case "PhysIf-list":
+				id := e.GetKey()["id"]
+				if id == "" {
+					continue
+				}
+				events = append(events, provider.DeviceEvent{
+					Kind:      provider.DeviceEventKindInterface,
+					Name:      id,
+					Up:        OperSt(val) == OperStUp,
+					Message:   fmt.Sprintf("Interface %s changed operational state to %s", id, val),
+					Timestamp: n.Timestamp,
+				})
+			case "PeerEntry-list":
+				addr := e.GetKey()["addr"]
+				if addr == "" {
+					continue
+				}
+				events = append(events, provider.DeviceEvent{
+					Kind:      provider.DeviceEventKindBGPPeer,
+					Name:      addr,
+					Up:        BGPPeerOperSt(val) == BGPPeerOperStEstablished,
+					Message:   fmt.Sprintf("BGP session to %s changed state to %s", addr, val),
+					Timestamp: n.Timestamp,
+				})
+			}
+		}
+	}
+	return events
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ironcore-dev/network-operator/internal/provider"
+	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+)
+
+func TestDeviceEvents(t *testing.T) {
+	ts := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		n    gnmiext.Notification
+		want []provider.DeviceEvent
+	}{
+		{
+			name: "interface down",
+			n: gnmiext.Notification{
+				Timestamp: ts,
+				Updates: []gnmiext.Update{{
+					Path:  "System/intf-items/phys-items/PhysIf-list[id=eth1/1]/phys-items/operSt",
+					Value: json.RawMessage(`"down"`),
+				}},
+			},
+			want: []provider.DeviceEvent{{
+				Kind:      provider.DeviceEventKindInterface,
+				Name:      "eth1/1",
+				Message:   "Interface eth1/1 changed operational state to down",
+				Timestamp: ts,
+			}},
+		},
+		{
+			name: "bgp peer established",
+			n: gnmiext.Notification{
+				Timestamp: ts,
+				Updates: []gnmiext.Update{{
+					Path:  "System/bgp-items/inst-items/dom-items/Dom-list[name=default]/peer-items/Peer-list[addr=10.0.0.1]/ent-items/PeerEntry-list[addr=10.0.0.1]/operSt",
+					Value: json.RawMessage(`"established"`),
+				}},
+			},
+			want: []provider.DeviceEvent{{
+				Kind:      provider.DeviceEventKindBGPPeer,
+				Name:      "10.0.0.1",
+				Up:        true,
+				Message:   "BGP session to 10.0.0.1 changed state to established",
+				Timestamp: ts,
+			}},
+		},
+		{
+			name: "unrelated path",
+			n: gnmiext.Notification{
+				Updates: []gnmiext.Update{{
+					Path:  "System/name",
+					Value: json.RawMessage(`"leaf1"`),
+				}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := DeviceEvents(test.n)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("DeviceEvents() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
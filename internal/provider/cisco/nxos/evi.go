@@ -17,6 +17,12 @@
 
 var _ gnmiext.DataElement = (*BDEVI)(nil)
 
+// The range of valid VXLAN Network Identifiers. The 24-bit value 16777215 is reserved.
+const (
+	minVNI = 1
+	maxVNI = 16777214
+)
+
 // BDEVI represents a Bridge Domain Ethernet VPN Instance (MAC-VRF).
 type BDEVI struct {
 	Encap     string         `json:"encap"`
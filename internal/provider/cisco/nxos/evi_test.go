@@ -3,6 +3,19 @@
 
 package nxos
 
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
 func init() {
 	rtt := &Rtt{}
 	rtt.Rtt, _ = RouteTarget("65000:100010") //nolint:errcheck
@@ -17,3 +30,217 @@ func init() {
 	evi.RttpItems.RttPList.Set(rt)
 	Register("evi", evi)
 }
+
+func TestProvider_EnsureEVPNInstance(t *testing.T) {
+	vlan := &VLAN{FabEncap: "vlan-10"}
+	evi := &BDEVI{Encap: "vxlan-100010"}
+	vni := &VNI{Vni: 100010}
+	vxlan := &VXLAN{FabEncap: "vlan-10"}
+
+	tests := []struct {
+		name      string
+		spec      v1alpha1.EVPNInstanceSpec
+		wantField string
+	}{
+		{
+			name: "l2vni",
+			spec: v1alpha1.EVPNInstanceSpec{
+				VNI:                100010,
+				Type:               v1alpha1.EVPNInstanceTypeBridged,
+				RouteDistinguisher: "10.0.0.10:65000",
+				RouteTargets: []v1alpha1.EVPNRouteTarget{
+					{Value: "65000:100010", Action: v1alpha1.RouteTargetActionBoth},
+				},
+			},
+		},
+		{
+			name:      "vni out of range",
+			spec:      v1alpha1.EVPNInstanceSpec{VNI: maxVNI + 1, Type: v1alpha1.EVPNInstanceTypeBridged},
+			wantField: "spec.vni",
+		},
+		{
+			name: "invalid route distinguisher",
+			spec: v1alpha1.EVPNInstanceSpec{
+				VNI:                100010,
+				Type:               v1alpha1.EVPNInstanceTypeBridged,
+				RouteDistinguisher: "invalid",
+			},
+			wantField: "spec.routeDistinguisher",
+		},
+		{
+			name: "invalid route target",
+			spec: v1alpha1.EVPNInstanceSpec{
+				VNI:  100010,
+				Type: v1alpha1.EVPNInstanceTypeBridged,
+				RouteTargets: []v1alpha1.EVPNRouteTarget{
+					{Value: "65000:100010", Action: v1alpha1.RouteTargetActionImport},
+					{Value: "65000", Action: v1alpha1.RouteTargetActionExport},
+				},
+			},
+			wantField: "spec.routeTargets[1].value",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{vlan.XPath(): `{"fabEncap":"vlan-10"}`}}
+			p := &Provider{client: c}
+
+			err := p.EnsureEVPNInstance(context.Background(), &provider.EVPNInstanceRequest{
+				EVPNInstance: &v1alpha1.EVPNInstance{
+					ObjectMeta: metav1.ObjectMeta{Name: "vni-100010"},
+					Spec:       test.spec,
+				},
+				VLAN: &v1alpha1.VLAN{Spec: v1alpha1.VLANSpec{ID: 10}},
+			})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureEVPNInstance() error = %v, want violation of %q", err, test.wantField)
+				}
+				if len(c.config) != 1 {
+					t.Errorf("EnsureEVPNInstance() configured device despite error: %v", c.config)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureEVPNInstance() error = %v", err)
+			}
+
+			for _, xpath := range []string{evi.XPath(), vni.XPath(), vxlan.XPath()} {
+				if _, ok := c.config[xpath]; !ok {
+					t.Errorf("EnsureEVPNInstance() did not configure %q", xpath)
+				}
+			}
+
+			got := new(BDEVI)
+			if err := json.Unmarshal([]byte(c.config[evi.XPath()]), got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if want := "rd:ipv4-nn2:10.0.0.10:65000"; got.Rd.Value == nil || *got.Rd.Value != want {
+				t.Errorf("EnsureEVPNInstance() rd = %v, want %q", got.Rd.Value, want)
+			}
+			for _, typ := range []RttEntryType{RttEntryTypeImport, RttEntryTypeExport} {
+				e, ok := got.RttpItems.RttPList.Get(typ)
+				if !ok || e.EntItems.RttEntryList.Len() != 1 {
+					t.Errorf("EnsureEVPNInstance() %s route targets not configured", typ)
+				}
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureEVPNInstance_ReplicationMode(t *testing.T) {
+	vlan := &VLAN{FabEncap: "vlan-10"}
+	nve := &NVE{}
+
+	tests := []struct {
+		name      string
+		mode      v1alpha1.ReplicationMode
+		group     string
+		hostReach HostReachType
+		want      IngReplProto
+		wantCode  apistatus.Code
+	}{
+		{
+			name:      "ingress replication",
+			mode:      v1alpha1.ReplicationModeIngressReplication,
+			hostReach: HostReachBGP,
+			want:      IngReplProtoBGP,
+		},
+		{
+			name:      "ingress replication without bgp host reachability",
+			mode:      v1alpha1.ReplicationModeIngressReplication,
+			hostReach: HostReachFloodAndLearn,
+			wantCode:  apistatus.CodeFailedPrecondition,
+		},
+		{
+			name:      "multicast",
+			mode:      v1alpha1.ReplicationModeMulticast,
+			group:     "239.1.1.100",
+			hostReach: HostReachBGP,
+		},
+		{
+			name:      "default of the nve",
+			hostReach: HostReachBGP,
+		},
+		{
+			name:      "multicast without group",
+			mode:      v1alpha1.ReplicationModeMulticast,
+			hostReach: HostReachBGP,
+			wantCode:  apistatus.CodeInvalidArgument,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{
+				vlan.XPath(): `{"fabEncap":"vlan-10"}`,
+				nve.XPath():  `{"hostReach":"` + string(test.hostReach) + `"}`,
+			}}
+			p := &Provider{client: c}
+
+			err := p.EnsureEVPNInstance(context.Background(), &provider.EVPNInstanceRequest{
+				EVPNInstance: &v1alpha1.EVPNInstance{
+					ObjectMeta: metav1.ObjectMeta{Name: "vni-100010"},
+					Spec: v1alpha1.EVPNInstanceSpec{
+						VNI:                   100010,
+						Type:                  v1alpha1.EVPNInstanceTypeBridged,
+						MulticastGroupAddress: test.group,
+						ReplicationMode:       test.mode,
+					},
+				},
+				VLAN: &v1alpha1.VLAN{Spec: v1alpha1.VLANSpec{ID: 10}},
+			})
+			if test.wantCode != 0 {
+				if s, ok := apistatus.FromError(err); !ok || s.Code != test.wantCode {
+					t.Fatalf("EnsureEVPNInstance() error = %v, want code %v", err, test.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureEVPNInstance() error = %v", err)
+			}
+
+			got := &VNI{Vni: 100010}
+			if err := json.Unmarshal([]byte(c.config[got.XPath()]), got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if got.IngReplItems.Proto != test.want {
+				t.Errorf("EnsureEVPNInstance() ingress replication = %q, want %q", got.IngReplItems.Proto, test.want)
+			}
+			if test.mode != v1alpha1.ReplicationModeIngressReplication {
+				if ir := (&VNIIngRepl{Vni: 100010}).XPath(); !slices.Contains(c.deleted, ir) {
+					t.Errorf("EnsureEVPNInstance() did not delete %q", ir)
+				}
+			}
+		})
+	}
+}
+
+func TestProvider_DeleteEVPNInstance(t *testing.T) {
+	evi := &BDEVI{Encap: "vxlan-100010"}
+	vni := &VNI{Vni: 100010}
+	vxlan := &VXLAN{FabEncap: "vlan-10"}
+
+	c := &fakeClient{config: map[string]string{
+		evi.XPath():          `{"encap":"vxlan-100010"}`,
+		vni.XPath():          `{"vni":100010}`,
+		vxlan.XPath():        `"vxlan-100010"`,
+		(&BDItems{}).XPath(): `{"BD-list":[{"fabEncap":"vlan-10","accEncap":"vxlan-100010"}]}`,
+	}}
+	p := &Provider{client: c}
+
+	err := p.DeleteEVPNInstance(context.Background(), &provider.EVPNInstanceRequest{
+		EVPNInstance: &v1alpha1.EVPNInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "vni-100010"},
+			Spec:       v1alpha1.EVPNInstanceSpec{VNI: 100010, Type: v1alpha1.EVPNInstanceTypeBridged},
+		},
+	})
+	if err != nil {
+		t.Fatalf("DeleteEVPNInstance() error = %v", err)
+	}
+
+	want := []string{evi.XPath(), vni.XPath(), vxlan.XPath()}
+	if !slices.Equal(c.deleted, want) {
+		t.Errorf("DeleteEVPNInstance() deleted = %v, want %v", c.deleted, want)
+	}
+}
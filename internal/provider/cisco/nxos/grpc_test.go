@@ -3,7 +3,188 @@
 
 package nxos
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"testing"
+	"time"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
 func init() {
 	Register("grpc", &GRPC{Cert: NewOption("trustpoint"), CertClientRoot: "client_trustpoint", Port: 9339, UseVrf: DefaultVRFName})
 	Register("gnmi", &GNMI{KeepAliveTimeout: 1200, MaxCalls: 4})
 }
+
+// gnmiServer is a minimal gNMI server that only answers the capabilities handshake.
+type gnmiServer struct {
+	gpb.UnimplementedGNMIServer
+}
+
+func (*gnmiServer) Capabilities(context.Context, *gpb.CapabilityRequest) (*gpb.CapabilityResponse, error) {
+	return &gpb.CapabilityResponse{SupportedEncodings: []gpb.Encoding{gpb.Encoding_JSON}}, nil
+}
+
+func TestProvider_EnsureManagementAccess_PortChange(t *testing.T) {
+	const xpath = "System/grpc-items"
+
+	// reachable is a port with a gNMI server listening on it.
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	gpb.RegisterGNMIServer(srv, &gnmiServer{})
+	go srv.Serve(lis) //nolint:errcheck
+	defer srv.Stop()
+	reachable := int32(lis.Addr().(*net.TCPAddr).Port) //nolint:gosec
+
+	// unreachable is a port nothing is listening on.
+	lis, err = net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	unreachable := int32(lis.Addr().(*net.TCPAddr).Port) //nolint:gosec
+	_ = lis.Close()
+
+	tests := []struct {
+		name     string
+		port     int32
+		wantCmds []string
+		wantErr  bool
+	}{
+		{
+			name: "unchanged",
+			port: 50051,
+		},
+		{
+			name:     "changed and reachable",
+			port:     reachable,
+			wantCmds: []string{fmt.Sprintf("grpc port %d", reachable)},
+		},
+		{
+			name:     "changed and unreachable",
+			port:     unreachable,
+			wantCmds: []string{fmt.Sprintf("grpc port %d", unreachable), "grpc port 50051"},
+			wantErr:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conn := &deviceutil.Connection{Address: "127.0.0.1:50051"}
+			api, client := newFakeNXAPI(t, nil)
+
+			c := &fakeClient{config: map[string]string{xpath: `{"port":50051}`}}
+			p := &Provider{client: c, nxapi: client, connection: conn}
+
+			ma := &v1alpha1.ManagementAccess{}
+			ma.Spec.GRPC.Enabled = true
+			ma.Spec.GRPC.Port = test.port
+			ma.Spec.GRPC.GNMI.MaxConcurrentCall = 8
+			ma.Spec.GRPC.GNMI.KeepAliveTimeout = metav1.Duration{Duration: 10 * time.Minute}
+			ma.Spec.SSH.SessionLimit = 32
+
+			err = p.EnsureManagementAccess(t.Context(), &provider.EnsureManagementAccessRequest{ManagementAccess: ma})
+			if (err != nil) != test.wantErr {
+				t.Fatalf("EnsureManagementAccess() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if !slices.Equal(api.Commands(), test.wantCmds) {
+				t.Errorf("EnsureManagementAccess() nxapi commands = %v, want %v", api.Commands(), test.wantCmds)
+			}
+			g := new(GRPC)
+			if err := json.Unmarshal([]byte(c.config[xpath]), g); err != nil {
+				t.Fatalf("failed to unmarshal grpc config: %v", err)
+			}
+			if g.Port != 50051 {
+				t.Errorf("EnsureManagementAccess() gnmi port = %d, want 50051", g.Port)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureManagementAccess_Protocols(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      v1alpha1.ManagementAccessSpec
+		want      map[string]AdminSt
+		wantField string
+	}{
+		{
+			name: "grpc only",
+			spec: v1alpha1.ManagementAccessSpec{GRPC: v1alpha1.GRPC{Enabled: true}},
+			want: map[string]AdminSt{
+				"grpc":     AdminStEnabled,
+				"ssh":      AdminStDisabled,
+				"netconf":  AdminStDisabled,
+				"restconf": AdminStDisabled,
+				"nxapi":    AdminStEnabled,
+			},
+		},
+		{
+			name: "netconf and restconf",
+			spec: v1alpha1.ManagementAccessSpec{
+				GRPC:     v1alpha1.GRPC{Enabled: true},
+				SSH:      v1alpha1.SSH{Enabled: true},
+				NETCONF:  v1alpha1.NETCONF{Enabled: true},
+				RESTCONF: v1alpha1.RESTCONF{Enabled: true},
+			},
+			want: map[string]AdminSt{
+				"grpc":     AdminStEnabled,
+				"ssh":      AdminStEnabled,
+				"netconf":  AdminStEnabled,
+				"restconf": AdminStEnabled,
+				"nxapi":    AdminStEnabled,
+			},
+		},
+		{
+			name:      "grpc disabled",
+			spec:      v1alpha1.ManagementAccessSpec{NETCONF: v1alpha1.NETCONF{Enabled: true}},
+			wantField: "spec.grpc.enabled",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{"System/grpc-items": `{"port":9339}`}}
+			p := &Provider{client: c}
+
+			ma := &v1alpha1.ManagementAccess{Spec: test.spec}
+			ma.Spec.GRPC.Port = 9339
+			ma.Spec.GRPC.GNMI.MaxConcurrentCall = 8
+			ma.Spec.GRPC.GNMI.KeepAliveTimeout = metav1.Duration{Duration: 10 * time.Minute}
+			ma.Spec.SSH.SessionLimit = 32
+
+			err := p.EnsureManagementAccess(t.Context(), &provider.EnsureManagementAccessRequest{ManagementAccess: ma})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureManagementAccess() error = %v, want violation of %q", err, test.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureManagementAccess() error = %v", err)
+			}
+
+			for name, want := range test.want {
+				f := &Feature{Name: name}
+				if err := json.Unmarshal([]byte(c.config[f.XPath()]), f); err != nil {
+					t.Fatalf("feature %q not configured: %v", name, err)
+				}
+				if f.AdminSt != want {
+					t.Errorf("EnsureManagementAccess() feature %q = %q, want %q", name, f.AdminSt, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import "github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+
+var _ gnmiext.DataElement = (*IGMPIfItems)(nil)
+
+// IGMPIfItems represents the IGMP interface configuration.
+// It is used to configure the IGMP querier on Layer 3 interfaces.
+type IGMPIfItems struct {
+	IfList gnmiext.List[string, *IGMPIf] `json:"If-list,omitzero"`
+}
+
+func (*IGMPIfItems) XPath() string {
+	return "System/igmp-items/inst-items/dom-items/Dom-list[name=default]/if-items"
+}
+
+type IGMPIf struct {
+	ID            string      `json:"id"`
+	Ver           IGMPVersion `json:"ver"`
+	QuerierpItems struct {
+		// QueryIntvl is the interval between general queries in seconds.
+		QueryIntvl uint16 `json:"queryIntvl"`
+		// RobustFac is the robustness variable of the querier.
+		RobustFac uint8 `json:"robustFac"`
+	} `json:"querierp-items"`
+}
+
+func (*IGMPIf) IsListItem() {}
+
+func (i *IGMPIf) Key() string { return i.ID }
+
+func (i *IGMPIf) XPath() string {
+	return "System/igmp-items/inst-items/dom-items/Dom-list[name=default]/if-items/If-list[id=" + i.ID + "]"
+}
+
+type IGMPVersion string
+
+const (
+	IGMPVersion2 IGMPVersion = "v2"
+	IGMPVersion3 IGMPVersion = "v3"
+)
+
+const (
+	// DefaultIGMPQueryInterval is the default IGMP query interval in seconds.
+	DefaultIGMPQueryInterval = 125
+	// DefaultIGMPRobustness is the default IGMP robustness variable.
+	DefaultIGMPRobustness = 2
+)
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
+func init() {
+	igmp := &IGMPIf{ID: "vlan10", Ver: IGMPVersion3}
+	igmp.QuerierpItems.QueryIntvl = 60
+	igmp.QuerierpItems.RobustFac = 3
+	igmpItems := new(IGMPIfItems)
+	igmpItems.IfList.Set(igmp)
+	Register("igmp_intf", igmpItems)
+}
+
+func Test_igmpIf(t *testing.T) {
+	svi := &v1alpha1.Interface{}
+	svi.Spec.Name = "Vlan10"
+	svi.Spec.Type = v1alpha1.InterfaceTypeRoutedVLAN
+
+	access := &v1alpha1.Interface{}
+	access.Spec.Name = "Ethernet1/1"
+	access.Spec.Type = v1alpha1.InterfaceTypePhysical
+	access.Spec.Switchport = &v1alpha1.Switchport{}
+
+	tests := []struct {
+		name     string
+		intf     *v1alpha1.Interface
+		igmp     v1alpha1.IGMPQuerier
+		interval uint16
+		robust   uint8
+		ver      IGMPVersion
+		wantErr  bool
+	}{
+		{
+			name:     "defaults",
+			intf:     svi,
+			igmp:     v1alpha1.IGMPQuerier{},
+			interval: DefaultIGMPQueryInterval,
+			robust:   DefaultIGMPRobustness,
+			ver:      IGMPVersion2,
+		},
+		{
+			name: "custom",
+			intf: svi,
+			igmp: v1alpha1.IGMPQuerier{
+				Version:            v1alpha1.IGMPVersion3,
+				QueryInterval:      &metav1.Duration{Duration: time.Minute},
+				RobustnessVariable: 3,
+			},
+			interval: 60,
+			robust:   3,
+			ver:      IGMPVersion3,
+		},
+		{
+			name:    "fractional interval",
+			intf:    svi,
+			igmp:    v1alpha1.IGMPQuerier{QueryInterval: &metav1.Duration{Duration: 1500 * time.Millisecond}},
+			wantErr: true,
+		},
+		{
+			name:    "interval too long",
+			intf:    svi,
+			igmp:    v1alpha1.IGMPQuerier{QueryInterval: &metav1.Duration{Duration: 6 * time.Hour}},
+			wantErr: true,
+		},
+		{
+			name:    "robustness out of range",
+			intf:    svi,
+			igmp:    v1alpha1.IGMPQuerier{RobustnessVariable: 8},
+			wantErr: true,
+		},
+		{
+			name:    "switchport",
+			intf:    access,
+			igmp:    v1alpha1.IGMPQuerier{},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := igmpIf("vlan10", provider.PIMInterface{Interface: test.intf, IGMP: &test.igmp})
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("igmpIf() expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("igmpIf() error = %v", err)
+			}
+			if got.Ver != test.ver {
+				t.Errorf("igmpIf() Ver = %q, want %q", got.Ver, test.ver)
+			}
+			if got.QuerierpItems.QueryIntvl != test.interval {
+				t.Errorf("igmpIf() QueryIntvl = %d, want %d", got.QuerierpItems.QueryIntvl, test.interval)
+			}
+			if got.QuerierpItems.RobustFac != test.robust {
+				t.Errorf("igmpIf() RobustFac = %d, want %d", got.QuerierpItems.RobustFac, test.robust)
+			}
+		})
+	}
+}
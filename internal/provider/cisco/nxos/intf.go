@@ -8,11 +8,17 @@
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"slices"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
 
 	nxv1alpha1 "github.com/ironcore-dev/network-operator/api/cisco/nx/v1alpha1"
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
 )
 
@@ -24,11 +30,20 @@
 	_ gnmiext.DataElement = (*PhysIfOperItems)(nil)
 	_ gnmiext.DataElement = (*VrfMember)(nil)
 	_ gnmiext.DataElement = (*SpanningTree)(nil)
+	_ gnmiext.DataElement = (*L2ProtocolTunnel)(nil)
+	_ gnmiext.DataElement = (*PortSecurityIf)(nil)
+	_ gnmiext.DataElement = (*PortCapabilities)(nil)
 	_ gnmiext.DataElement = (*MultisiteIfTracking)(nil)
 	_ gnmiext.DataElement = (*BFD)(nil)
 	_ gnmiext.DataElement = (*ICMPIf)(nil)
+	_ gnmiext.DataElement = (*ARPIf)(nil)
+	_ gnmiext.DataElement = (*PhysIfFECItems)(nil)
 	_ gnmiext.DataElement = (*PortChannel)(nil)
 	_ gnmiext.DataElement = (*PortChannelOperItems)(nil)
+	_ gnmiext.DataElement = (*PortChannelMemberOperItems)(nil)
+	_ gnmiext.DataElement = (*LACPIf)(nil)
+	_ gnmiext.DataElement = (*LACPSysPrio)(nil)
+	_ gnmiext.DataElement = (*LACPInstOperItems)(nil)
 	_ gnmiext.DataElement = (*SwitchVirtualInterface)(nil)
 	_ gnmiext.DataElement = (*SwitchVirtualInterfaceOperItems)(nil)
 	_ gnmiext.DataElement = (*EncapRoutedInterface)(nil)
@@ -72,6 +87,7 @@ type PhysIf struct {
 	AccessVlan    string         `json:"accessVlan"`
 	AdminSt       AdminSt2       `json:"adminSt,omitempty"`
 	Descr         Option[string] `json:"descr"`
+	Duplex        Duplex         `json:"duplex"`
 	FecMode       FecMode        `json:"FECMode"`
 	ID            string         `json:"id"`
 	Layer         Layer          `json:"layer"`
@@ -79,6 +95,7 @@ type PhysIf struct {
 	Medium        Medium         `json:"medium"`
 	Mode          SwitchportMode `json:"mode"`
 	NativeVlan    string         `json:"nativeVlan"`
+	Speed         Speed          `json:"speed"`
 	TrunkVlans    string         `json:"trunkVlans"`
 	UserCfgdFlags UserFlags      `json:"userCfgdFlags"`
 	RtvrfMbrItems *VrfMember     `json:"rtvrfMbr-items,omitempty"`
@@ -86,10 +103,23 @@ type PhysIf struct {
 		BufferBoost AdminSt4 `json:"bufferBoost,omitempty"`
 	} `json:"physExtd-items,omitzero"`
 	ESICoreTrackingItems *ESICoreTracking `json:"esimhcoretracking-items,omitempty"`
+	StormCtrlItems       *StormControl    `json:"stormctrl-items,omitempty"`
 }
 
 func (*PhysIf) IsListItem() {}
 
+// StormControl represents the storm-control thresholds of a physical interface per traffic type.
+// Levels are percentages of the interface bandwidth with two decimal places, e.g. "5.00", while
+// rates are in packets per second. Only one of level or rate can be set per traffic type.
+type StormControl struct {
+	BcLevel  string `json:"bcLevel,omitempty"`
+	BcPPS    *int32 `json:"bcPPS,omitempty"`
+	McLevel  string `json:"mcLevel,omitempty"`
+	McPPS    *int32 `json:"mcPPS,omitempty"`
+	UucLevel string `json:"uucLevel,omitempty"`
+	UucPPS   *int32 `json:"uucPPS,omitempty"`
+}
+
 // ESICoreTracking represents the EVPN ESI multihoming core-tracking configuration on an interface.
 type ESICoreTracking struct {
 	CoreTracking AdminSt `json:"coretracking"`
@@ -107,7 +137,9 @@ func (p *PhysIf) Validate() error {
 }
 
 func (p *PhysIf) Default() {
+	p.Duplex = DuplexAuto
 	p.FecMode = FecModeAuto
+	p.Speed = SpeedAuto
 	p.Layer = Layer2
 	p.MTU = DefaultMTU
 	p.Medium = MediumBroadcast
@@ -119,15 +151,55 @@ func (p *PhysIf) Default() {
 }
 
 type PhysIfOperItems struct {
-	ID         string `json:"-"`
-	OperSt     OperSt `json:"operSt"`
-	OperStQual string `json:"operStQual"`
+	ID         string         `json:"-"`
+	OperSt     OperSt         `json:"operSt"`
+	OperStQual string         `json:"operStQual"`
+	OperMode   SwitchportMode `json:"operMode,omitempty"`
+	OperMtu    int32          `json:"operMtu,omitempty"`
 }
 
 func (p *PhysIfOperItems) XPath() string {
 	return "System/intf-items/phys-items/PhysIf-list[id=" + p.ID + "]/phys-items"
 }
 
+// PhysIfFECItems represents the forward error correction (FEC) statistics of a physical interface.
+// The bit error rates are reported as strings in scientific notation, e.g. "1.2E-08".
+type PhysIfFECItems struct {
+	ID            string `json:"-"`
+	CorrectedCw   uint64 `json:"correctedCw,string"`
+	UncorrectedCw uint64 `json:"uncorrectedCw,string"`
+	PreFecBer     string `json:"preFecBer"`
+	PostFecBer    string `json:"postFecBer"`
+}
+
+func (p *PhysIfFECItems) XPath() string {
+	return "System/intf-items/phys-items/PhysIf-list[id=" + p.ID + "]/phys-items/fec-items"
+}
+
+// ToFECStatistics converts the FEC statistics reported by the device into a [provider.FECStatistics].
+// Bit error rates that can't be parsed, e.g. "n/a" for links without traffic, are logged and skipped.
+func (p *PhysIfFECItems) ToFECStatistics(ctx context.Context) *provider.FECStatistics {
+	return &provider.FECStatistics{
+		CorrectedCodewords:   p.CorrectedCw,
+		UncorrectedCodewords: p.UncorrectedCw,
+		PreFECBER:            parseBER(ctx, "pre-FEC", p.PreFecBer),
+		PostFECBER:           parseBER(ctx, "post-FEC", p.PostFecBer),
+	}
+}
+
+// parseBER parses a bit error rate in scientific notation, or returns nil if it is empty or invalid.
+func parseBER(ctx context.Context, kind, s string) *float64 {
+	if s == "" {
+		return nil
+	}
+	ber, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		logr.FromContextOrDiscard(ctx).Info("Skipping invalid bit error rate", "kind", kind, "value", s)
+		return nil
+	}
+	return &ber
+}
+
 // EncapRoutedInterface represents an Encapsulated Routed Subinterface.
 type EncapRoutedInterface struct {
 	ID            string         `json:"id"`
@@ -197,6 +269,117 @@ func (s *SpanningTree) Default() {
 	s.Mode = SpanningTreeModeDefault
 }
 
+// L2ProtocolTunnel represents the Layer 2 protocol tunneling configuration for an interface.
+type L2ProtocolTunnel struct {
+	IfName string `json:"id"`
+	// Comma-separated list of the tunneled protocols, e.g. "cdp,stp"
+	Proto      string `json:"proto"`
+	DropThresh int32  `json:"dropThresh,omitempty"`
+	ShutThresh int32  `json:"shutThresh,omitempty"`
+}
+
+func (*L2ProtocolTunnel) IsListItem() {}
+
+func (l *L2ProtocolTunnel) XPath() string {
+	return "System/l2pt-items/if-items/If-list[id=" + l.IfName + "]"
+}
+
+// PortSecurityIf represents the port-security configuration of an interface.
+type PortSecurityIf struct {
+	IfName    string                `json:"id"`
+	AdminSt   AdminSt               `json:"adminSt"`
+	Maximum   int32                 `json:"maximum,omitempty"`
+	Sticky    AdminSt               `json:"sticky"`
+	Violation PortSecurityViolation `json:"violation"`
+}
+
+func (*PortSecurityIf) IsListItem() {}
+
+func (p *PortSecurityIf) XPath() string {
+	return "System/portsec-items/if-items/If-list[id=" + p.IfName + "]"
+}
+
+// PortSecurityViolation is the action taken by the device on a port-security violation.
+type PortSecurityViolation string
+
+const (
+	PortSecurityViolationShutdown PortSecurityViolation = "shutdown"
+	PortSecurityViolationRestrict PortSecurityViolation = "restrict"
+	PortSecurityViolationProtect  PortSecurityViolation = "protect"
+)
+
+// NewPortSecurityIf returns the port-security configuration for the given interface.
+func NewPortSecurityIf(ifName string, ps *v1alpha1.PortSecurity) (*PortSecurityIf, error) {
+	p := &PortSecurityIf{
+		IfName:    ifName,
+		AdminSt:   AdminStDisabled,
+		Maximum:   ps.MaxMACAddresses,
+		Sticky:    AdminStDisabled,
+		Violation: PortSecurityViolationShutdown,
+	}
+	if ps.Enabled {
+		p.AdminSt = AdminStEnabled
+	}
+	if ps.Sticky {
+		p.Sticky = AdminStEnabled
+	}
+	switch ps.ViolationAction {
+	case v1alpha1.PortSecurityViolationActionShutdown, "":
+	case v1alpha1.PortSecurityViolationActionRestrict:
+		p.Violation = PortSecurityViolationRestrict
+	case v1alpha1.PortSecurityViolationActionProtect:
+		p.Violation = PortSecurityViolationProtect
+	default:
+		return nil, fmt.Errorf("port security: unsupported violation action %q", ps.ViolationAction)
+	}
+	return p, nil
+}
+
+// maxL2ProtocolTunnelThreshold is the maximum rate in packets per second of the tunneling thresholds.
+const maxL2ProtocolTunnelThreshold = 4096
+
+// NewL2ProtocolTunnel returns the Layer 2 protocol tunneling configuration for the given interface.
+func NewL2ProtocolTunnel(ifName string, t *nxv1alpha1.L2ProtocolTunnel) (*L2ProtocolTunnel, error) {
+	if len(t.Protocols) == 0 {
+		return nil, errors.New("l2 protocol tunnel: at least one protocol must be tunneled")
+	}
+	protos := make([]string, 0, len(t.Protocols))
+	for _, p := range t.Protocols {
+		var proto string
+		switch p {
+		case nxv1alpha1.L2ProtocolTunnelProtocolCDP:
+			proto = "cdp"
+		case nxv1alpha1.L2ProtocolTunnelProtocolLLDP:
+			proto = "lldp"
+		case nxv1alpha1.L2ProtocolTunnelProtocolSTP:
+			proto = "stp"
+		case nxv1alpha1.L2ProtocolTunnelProtocolVTP:
+			proto = "vtp"
+		default:
+			return nil, fmt.Errorf("l2 protocol tunnel: unsupported protocol %q", p)
+		}
+		if slices.Contains(protos, proto) {
+			return nil, fmt.Errorf("l2 protocol tunnel: duplicate protocol %q", p)
+		}
+		protos = append(protos, proto)
+	}
+	for _, thresh := range []int32{t.DropThreshold, t.ShutdownThreshold} {
+		if thresh < 0 || thresh > maxL2ProtocolTunnelThreshold {
+			return nil, fmt.Errorf("l2 protocol tunnel: threshold %d is out of range, must be between 1 and %d", thresh, maxL2ProtocolTunnelThreshold)
+		}
+	}
+	if t.DropThreshold != 0 && t.ShutdownThreshold != 0 && t.DropThreshold >= t.ShutdownThreshold {
+		return nil, fmt.Errorf("l2 protocol tunnel: shutdown threshold %d must be greater than drop threshold %d", t.ShutdownThreshold, t.DropThreshold)
+	}
+	slices.Sort(protos)
+	return &L2ProtocolTunnel{
+		IfName:     ifName,
+		Proto:      strings.Join(protos, ","),
+		DropThresh: t.DropThreshold,
+		ShutThresh: t.ShutdownThreshold,
+	}, nil
+}
+
 type MultisiteIfTrackingItems struct {
 	PhysIfList []struct {
 		ID                  string               `json:"id"`
@@ -257,6 +440,25 @@ func (i *ICMPIf) XPath() string {
 	return "System/icmpv4-items/inst-items/dom-items/Dom-list[name=default]/if-items/If-list[id=" + i.ID + "]"
 }
 
+const (
+	// MinARPTimeout is the minimum ARP timeout of an interface.
+	MinARPTimeout = 60 * time.Second
+	// MaxARPTimeout is the maximum ARP timeout of an interface.
+	MaxARPTimeout = 28800 * time.Second
+)
+
+// ARPIf represents the ARP configuration of a routed interface.
+type ARPIf struct {
+	ID      string `json:"id"`
+	Timeout int32  `json:"timeout"`
+}
+
+func (*ARPIf) IsListItem() {}
+
+func (a *ARPIf) XPath() string {
+	return "System/arp-items/inst-items/if-items/If-list[id=" + a.ID + "]"
+}
+
 // PortChannel represents a port-channel (LAG) interface.
 type PortChannel struct {
 	AccessVlan     string          `json:"accessVlan"`
@@ -267,6 +469,7 @@ type PortChannel struct {
 	Layer          Layer           `json:"layer"`
 	MTU            int32           `json:"mtu"`
 	Medium         Medium          `json:"medium"`
+	MinLinks       uint8           `json:"minLinks"`
 	Mode           SwitchportMode  `json:"mode"`
 	PcMode         PortChannelMode `json:"pcMode"`
 	NativeVlan     string          `json:"nativeVlan"`
@@ -302,16 +505,73 @@ func (p *PortChannel) XPath() string {
 	return "System/intf-items/aggr-items/AggrIf-list[id=" + p.ID + "]"
 }
 
+// DefaultLACPPriority is the default LACP system and port priority.
+const DefaultLACPPriority = 32768
+
+// LACPSysPrio represents the global LACP system priority. It is a leaf, such that
+// patching it leaves the per-interface LACP settings of the same container untouched.
+type LACPSysPrio uint16
+
+func (*LACPSysPrio) XPath() string {
+	return "System/lacp-items/inst-items/sysPrio"
+}
+
+// LACPInstOperItems represents the LACP system parameters the device uses operationally.
+type LACPInstOperItems struct {
+	SysMac  string `json:"sysMac"`
+	SysPrio uint16 `json:"sysPrio"`
+}
+
+func (*LACPInstOperItems) XPath() string {
+	return "System/lacp-items/inst-items"
+}
+
+// SystemID returns the system MAC address in canonical notation, e.g. "00:23:04:ee:be:0a".
+func (l *LACPInstOperItems) SystemID() string {
+	if mac, err := net.ParseMAC(l.SysMac); err == nil {
+		return mac.String()
+	}
+	return l.SysMac
+}
+
+// LACPIf represents the LACP configuration of a port-channel member interface.
+type LACPIf struct {
+	ID     string   `json:"id"`
+	Prio   uint16   `json:"prio"`
+	TxRate LACPRate `json:"txRate"`
+}
+
+func (*LACPIf) IsListItem() {}
+
+func (l *LACPIf) XPath() string {
+	return "System/lacp-items/inst-items/if-items/If-list[id=" + l.ID + "]"
+}
+
 type PortChannelOperItems struct {
-	ID         string `json:"-"`
-	OperSt     OperSt `json:"operSt"`
-	OperStQual string `json:"operStQual"`
+	ID         string         `json:"-"`
+	OperSt     OperSt         `json:"operSt"`
+	OperStQual string         `json:"operStQual"`
+	OperMode   SwitchportMode `json:"operMode,omitempty"`
+	OperMtu    int32          `json:"operMtu,omitempty"`
 }
 
 func (p *PortChannelOperItems) XPath() string {
 	return "System/intf-items/aggr-items/AggrIf-list[id=" + p.ID + "]/aggrif-items"
 }
 
+// PortChannelMemberOperItems represents the operational state of the members of a port-channel.
+type PortChannelMemberOperItems struct {
+	ID           string `json:"-"`
+	RsMbrIfsList []struct {
+		TDn          string       `json:"tDn"`
+		ChannelingSt ChannelingSt `json:"channelingSt"`
+	} `json:"RsMbrIfs-list,omitzero"`
+}
+
+func (p *PortChannelMemberOperItems) XPath() string {
+	return "System/intf-items/aggr-items/AggrIf-list[id=" + p.ID + "]/rsmbrIfs-items"
+}
+
 type SwitchVirtualInterface struct {
 	AdminSt       AdminSt2   `json:"adminSt"`
 	Descr         string     `json:"descr"`
@@ -386,7 +646,17 @@ func (d *AddrDom) Key() string { return d.Name }
 type AddrItem struct {
 	ID         string `json:"id"`
 	Unnumbered string `json:"unnumbered,omitempty"`
-	AddrItems  struct {
+	// MTU is the IP MTU of the interface, configured independently of the interface MTU.
+	MTU int32 `json:"mtu,omitempty"`
+	// LLAddr is the statically configured link-local address. Only applicable for IPv6.
+	LLAddr string `json:"llAddr,omitempty"`
+	// Autoconfig enables stateless address autoconfiguration (SLAAC). Only applicable for IPv6.
+	Autoconfig AdminSt `json:"autoconfig,omitempty"`
+	// UseLinkLocalOnly enables IPv6 on the interface without a global address. Only applicable for IPv6.
+	UseLinkLocalOnly AdminSt `json:"useLinkLocalOnly,omitempty"`
+	// URPF is the unicast reverse path forwarding check of the interface.
+	URPF      URPF `json:"urpf,omitempty"`
+	AddrItems struct {
 		AddrList gnmiext.List[string, *IntfAddr] `json:"Addr-list,omitzero"`
 	} `json:"addr-items,omitzero"`
 
@@ -421,6 +691,17 @@ type IntfAddr struct {
 
 func (a *IntfAddr) Key() string { return a.Addr }
 
+// URPF is the unicast reverse path forwarding check of an interface,
+// i.e. the mode combined with its verify options.
+type URPF string
+
+const (
+	URPFDisabled          URPF = "disabled"
+	URPFStrict            URPF = "strict"
+	URPFLoose             URPF = "loose"
+	URPFLooseAllowDefault URPF = "loose-allow-default"
+)
+
 type IntfAddrType string
 
 const (
@@ -558,6 +839,56 @@ type Port struct {
 	} `json:"phys-items"`
 }
 
+var (
+	_ json.Marshaler   = PhysIfSpeed{}
+	_ json.Unmarshaler = (*PhysIfSpeed)(nil)
+)
+
+// PhysIfSpeed is the speed configured on a physical interface.
+type PhysIfSpeed struct {
+	ID    string `json:"-"`
+	Speed Speed  `json:"-"`
+}
+
+func (p *PhysIfSpeed) XPath() string {
+	return "System/intf-items/phys-items/PhysIf-list[id=" + p.ID + "]/speed"
+}
+
+func (p PhysIfSpeed) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Speed)
+}
+
+func (p *PhysIfSpeed) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.Speed = Speed(s)
+	return nil
+}
+
+// PortCapabilities represents the capabilities of a physical port.
+type PortCapabilities struct {
+	ID string `json:"-"`
+	// Speed is the comma-separated list of the speeds supported by the port in Mbps, e.g. "1000,10000".
+	Speed string `json:"speed"`
+}
+
+func (p *PortCapabilities) XPath() string {
+	return "System/intf-items/phys-items/PhysIf-list[id=" + p.ID + "]/phys-items/portcap-items"
+}
+
+// Speeds returns the speeds supported by the port in Mbps.
+func (p *PortCapabilities) Speeds() []int {
+	var speeds []int
+	for s := range strings.SplitSeq(p.Speed, ",") {
+		if n, err := strconv.Atoi(strings.TrimSpace(s)); err == nil && n > 0 && !slices.Contains(speeds, n) {
+			speeds = append(speeds, n)
+		}
+	}
+	return speeds
+}
+
 type Layer string
 
 const (
@@ -572,6 +903,55 @@ type Port struct {
 	MediumPointToPoint Medium = "p2p"
 )
 
+type Speed string
+
+const (
+	SpeedAuto Speed = "auto"
+	Speed100M Speed = "100M"
+	Speed1G   Speed = "1G"
+	Speed10G  Speed = "10G"
+	Speed25G  Speed = "25G"
+	Speed40G  Speed = "40G"
+	Speed50G  Speed = "50G"
+	Speed100G Speed = "100G"
+	Speed200G Speed = "200G"
+	Speed400G Speed = "400G"
+)
+
+// Mbps returns the speed in Mbps, or zero if the speed is negotiated.
+func (s Speed) Mbps() int {
+	switch s {
+	case Speed100M:
+		return 100
+	case Speed1G:
+		return 1000
+	case Speed10G:
+		return 10000
+	case Speed25G:
+		return 25000
+	case Speed40G:
+		return 40000
+	case Speed50G:
+		return 50000
+	case Speed100G:
+		return 100000
+	case Speed200G:
+		return 200000
+	case Speed400G:
+		return 400000
+	default:
+		return 0
+	}
+}
+
+type Duplex string
+
+const (
+	DuplexAuto Duplex = "auto"
+	DuplexFull Duplex = "full"
+	DuplexHalf Duplex = "half"
+)
+
 type FecMode string
 
 const (
@@ -595,6 +975,19 @@ type Port struct {
 	SwitchportModeTrunk  SwitchportMode = "trunk"
 )
 
+// ToSwitchportMode converts the switchport mode to its API representation.
+// Modes without an API representation, e.g. fex-fabric, are converted to an empty string.
+func (m SwitchportMode) ToSwitchportMode() v1alpha1.SwitchportMode {
+	switch m {
+	case SwitchportModeAccess:
+		return v1alpha1.SwitchportModeAccess
+	case SwitchportModeTrunk:
+		return v1alpha1.SwitchportModeTrunk
+	default:
+		return ""
+	}
+}
+
 type SpanningTreeMode string
 
 const (
@@ -620,6 +1013,38 @@ func (s SpanningTreeMode) IsValid() bool {
 	PortChannelModePassive PortChannelMode = "passive"
 )
 
+type LACPRate string
+
+const (
+	LACPRateNormal LACPRate = "normal"
+	LACPRateFast   LACPRate = "fast"
+)
+
+// ChannelingSt is the bundling state of a port-channel member.
+type ChannelingSt string
+
+const (
+	ChannelingStChanneling ChannelingSt = "channeling"
+	ChannelingStIndividual ChannelingSt = "individual"
+	ChannelingStSuspended  ChannelingSt = "suspended"
+	ChannelingStHotStandby ChannelingSt = "hot-standby"
+	ChannelingStDown       ChannelingSt = "down"
+	ChannelingStUnknown    ChannelingSt = "unknown"
+)
+
+// ToBundleStatus converts the channeling state to its provider representation.
+// Members in hot-standby are not forwarding traffic and are therefore reported as down.
+func (c ChannelingSt) ToBundleStatus() provider.BundleStatus {
+	switch c {
+	case ChannelingStChanneling:
+		return provider.BundleStatusActive
+	case ChannelingStIndividual:
+		return provider.BundleStatusIndividual
+	default:
+		return provider.BundleStatusDown
+	}
+}
+
 type MultisiteIfTrackingMode string
 
 const (
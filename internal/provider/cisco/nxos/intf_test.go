@@ -3,6 +3,28 @@
 
 package nxos
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nxv1alpha1 "github.com/ironcore-dev/network-operator/api/cisco/nx/v1alpha1"
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+)
+
 func init() {
 	Register("loopback", &Loopback{
 		ID:            "lo0",
@@ -15,6 +37,7 @@ func init() {
 		AdminSt:       AdminStUp,
 		ID:            "eth1/1",
 		Descr:         NewOption("Leaf1 to Spine1"),
+		Duplex:        DuplexAuto,
 		FecMode:       FecModeAuto,
 		Layer:         Layer3,
 		MTU:           9216,
@@ -22,6 +45,7 @@ func init() {
 		Mode:          SwitchportModeAccess,
 		AccessVlan:    DefaultVLAN,
 		NativeVlan:    DefaultVLAN,
+		Speed:         SpeedAuto,
 		TrunkVlans:    DefaultVLANRange,
 		UserCfgdFlags: UserFlagAdminState | UserFlagAdminLayer | UserFlagAdminMTU,
 	})
@@ -30,6 +54,7 @@ func init() {
 		AdminSt:       AdminStUp,
 		ID:            "eth1/10",
 		Descr:         NewOption("Leaf1 to Host1"),
+		Duplex:        DuplexAuto,
 		FecMode:       FecModeAuto,
 		Layer:         Layer2,
 		MTU:           DefaultMTU,
@@ -37,10 +62,46 @@ func init() {
 		Mode:          SwitchportModeTrunk,
 		AccessVlan:    DefaultVLAN,
 		NativeVlan:    DefaultVLAN,
+		Speed:         SpeedAuto,
 		TrunkVlans:    "10",
 		UserCfgdFlags: UserFlagAdminState,
 	})
 
+	Register("physif_storm_control", &PhysIf{
+		AdminSt:        AdminStUp,
+		ID:             "eth1/10",
+		Descr:          NewOption("Leaf1 to Host1"),
+		Duplex:         DuplexAuto,
+		FecMode:        FecModeAuto,
+		Layer:          Layer2,
+		MTU:            DefaultMTU,
+		Medium:         MediumBroadcast,
+		Mode:           SwitchportModeAccess,
+		AccessVlan:     "vlan-10",
+		NativeVlan:     DefaultVLAN,
+		Speed:          SpeedAuto,
+		TrunkVlans:     DefaultVLANRange,
+		UserCfgdFlags:  UserFlagAdminState,
+		StormCtrlItems: &StormControl{BcLevel: "5.00", McPPS: new(int32(1000))},
+	})
+
+	Register("physif_speed", &PhysIf{
+		AdminSt:       AdminStUp,
+		ID:            "eth1/10",
+		Descr:         NewOption("Leaf1 to Legacy1"),
+		Duplex:        DuplexFull,
+		FecMode:       FecModeAuto,
+		Layer:         Layer2,
+		MTU:           DefaultMTU,
+		Medium:        MediumBroadcast,
+		Mode:          SwitchportModeAccess,
+		AccessVlan:    "vlan-10",
+		NativeVlan:    DefaultVLAN,
+		Speed:         Speed1G,
+		TrunkVlans:    DefaultVLANRange,
+		UserCfgdFlags: UserFlagAdminState,
+	})
+
 	Register("subinterface", &EncapRoutedInterface{
 		ID:         "eth1/1.100",
 		MTU:        1500,
@@ -60,6 +121,33 @@ func init() {
 	})
 	Register("intf_addr4", intfAddr4)
 
+	intfAddr4MTU := &AddrItem{ID: "eth1/1", Vrf: DefaultVRFName, MTU: 9000}
+	intfAddr4MTU.AddrItems.AddrList.Set(&IntfAddr{
+		Addr: "10.0.0.1/31",
+		Type: "primary",
+	})
+	Register("intf_addr4_mtu", intfAddr4MTU)
+
+	intfAddr4URPF := &AddrItem{ID: "eth1/1", Vrf: DefaultVRFName, URPF: URPFLooseAllowDefault}
+	intfAddr4URPF.AddrItems.AddrList.Set(&IntfAddr{
+		Addr: "10.0.0.1/31",
+		Type: "primary",
+	})
+	Register("intf_addr4_urpf", intfAddr4URPF)
+
+	intfAddr6 := &AddrItem{ID: "eth1/1", Vrf: DefaultVRFName, Is6: true, LLAddr: "fe80::1"}
+	intfAddr6.AddrItems.AddrList.Set(&IntfAddr{
+		Addr: "2001:db8::1/64",
+		Type: "primary",
+	})
+	Register("intf_addr6", intfAddr6)
+
+	Register("intf_addr6_link_local", &AddrItem{ID: "eth1/1", Vrf: DefaultVRFName, Is6: true, UseLinkLocalOnly: AdminStEnabled})
+
+	Register("intf_addr6_autoconfig", &AddrItem{ID: "eth1/1", Vrf: DefaultVRFName, Is6: true, Autoconfig: AdminStEnabled})
+
+	Register("intf_addr6_urpf", &AddrItem{ID: "eth1/1", Vrf: DefaultVRFName, Is6: true, UseLinkLocalOnly: AdminStEnabled, URPF: URPFStrict})
+
 	pc := &PortChannel{
 		AccessVlan:     DefaultVLAN,
 		AdminSt:        AdminStUp,
@@ -69,6 +157,7 @@ func init() {
 		Layer:          Layer2,
 		MTU:            DefaultMTU,
 		Medium:         MediumBroadcast,
+		MinLinks:       1,
 		Mode:           SwitchportModeTrunk,
 		PcMode:         PortChannelModeActive,
 		NativeVlan:     DefaultVLAN,
@@ -88,6 +177,7 @@ func init() {
 		Layer:          Layer3,
 		MTU:            9216,
 		Medium:         MediumPointToPoint,
+		MinLinks:       1,
 		Mode:           SwitchportModeAccess,
 		NativeVlan:     "unknown",
 		PcMode:         PortChannelModeActive,
@@ -109,6 +199,7 @@ func init() {
 		Layer:          Layer2,
 		MTU:            DefaultMTU,
 		Medium:         MediumBroadcast,
+		MinLinks:       1,
 		Mode:           SwitchportModeTrunk,
 		PcMode:         PortChannelModeActive,
 		NativeVlan:     DefaultVLAN,
@@ -119,6 +210,11 @@ func init() {
 	pcLacp.RsmbrIfsItems.RsMbrIfsList.Set(NewPortChannelMember("eth1/1"))
 	Register("pc_lacp", pcLacp)
 
+	Register("lacp_rate", &LACPIf{ID: "eth1/1", Prio: DefaultLACPPriority, TxRate: LACPRateFast})
+
+	prio := LACPSysPrio(4096)
+	Register("lacp_sys_prio", &prio)
+
 	svi := &SwitchVirtualInterface{
 		AdminSt: AdminStUp,
 		Descr:   "Foo",
@@ -140,6 +236,7 @@ func init() {
 		AccessVlan:           DefaultVLAN,
 		AdminSt:              AdminStUp,
 		Descr:                NewOption("Uplink to Spine1"),
+		Duplex:               DuplexAuto,
 		FecMode:              FecModeAuto,
 		ID:                   "eth1/1",
 		Layer:                Layer3,
@@ -147,6 +244,7 @@ func init() {
 		Medium:               MediumPointToPoint,
 		Mode:                 SwitchportModeAccess,
 		NativeVlan:           DefaultVLAN,
+		Speed:                SpeedAuto,
 		TrunkVlans:           DefaultVLANRange,
 		UserCfgdFlags:        UserFlagAdminState | UserFlagAdminLayer | UserFlagAdminMTU,
 		ESICoreTrackingItems: &ESICoreTracking{CoreTracking: AdminStEnabled},
@@ -163,4 +261,1441 @@ func init() {
 
 	icmp := &ICMPIf{ID: "eth1/1", Ctrl: "port-unreachable"}
 	Register("rdr", icmp)
+
+	arp := &ARPIf{ID: "eth1/1", Timeout: 1800}
+	Register("arp", arp)
+
+	l2pt := &L2ProtocolTunnel{IfName: "eth1/1", Proto: "cdp,lldp,stp", DropThresh: 100, ShutThresh: 200}
+	Register("l2pt", l2pt)
+
+	portsec := &PortSecurityIf{IfName: "eth1/10", AdminSt: AdminStEnabled, Maximum: 2, Sticky: AdminStEnabled, Violation: PortSecurityViolationShutdown}
+	Register("port_security", portsec)
+}
+
+func TestProvider_EnsureInterface_IPv6(t *testing.T) {
+	const (
+		xpath4 = "System/ipv4-items/inst-items/dom-items/Dom-list[name=default]/if-items/If-list[id=lo0]"
+		xpath6 = "System/ipv6-items/inst-items/dom-items/Dom-list[name=default]/if-items/If-list[id=lo0]"
+	)
+
+	tests := []struct {
+		name    string
+		ipv6    *v1alpha1.InterfaceIPv6
+		config  map[string]string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "static address with link-local address",
+			ipv6: &v1alpha1.InterfaceIPv6{
+				Addresses:        []v1alpha1.IPPrefix{{Prefix: netip.MustParsePrefix("2001:db8::1/128")}},
+				LinkLocalAddress: v1alpha1.MustParseAddr("fe80::1"),
+			},
+			want: `{"id":"lo0","llAddr":"fe80::1","addr-items":{"Addr-list":[{"addr":"2001:db8::1/128","pref":0,"tag":0,"type":"primary"}]}}`,
+		},
+		{
+			name: "link-local only",
+			ipv6: &v1alpha1.InterfaceIPv6{},
+			want: `{"id":"lo0","useLinkLocalOnly":"enabled"}`,
+		},
+		{
+			name: "autoconfig",
+			ipv6: &v1alpha1.InterfaceIPv6{Autoconfig: true},
+			want: `{"id":"lo0","autoconfig":"enabled"}`,
+		},
+		{
+			name: "removed",
+			config: map[string]string{
+				"System/ipv6-items/inst-items/dom-items": `{"Dom-list":[{"name":"default","if-items":{"If-list":[{"id":"lo0","useLinkLocalOnly":"enabled"}]}}]}`,
+				xpath6:                                   `{"id":"lo0","useLinkLocalOnly":"enabled"}`,
+			},
+		},
+		{
+			name: "autoconfig with static address",
+			ipv6: &v1alpha1.InterfaceIPv6{
+				Addresses:  []v1alpha1.IPPrefix{{Prefix: netip.MustParsePrefix("2001:db8::1/128")}},
+				Autoconfig: true,
+			},
+			wantErr: true,
+		},
+		{
+			name:    "link-local address outside fe80::/10",
+			ipv6:    &v1alpha1.InterfaceIPv6{LinkLocalAddress: v1alpha1.MustParseAddr("2001:db8::1")},
+			wantErr: true,
+		},
+		{
+			name: "link-local static address",
+			ipv6: &v1alpha1.InterfaceIPv6{
+				Addresses: []v1alpha1.IPPrefix{{Prefix: netip.MustParsePrefix("fe80::1/64")}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.config == nil {
+				test.config = map[string]string{}
+			}
+			c := &fakeClient{config: test.config}
+			p := &Provider{client: c}
+
+			intf := &v1alpha1.Interface{}
+			intf.Spec.Name = "lo0"
+			intf.Spec.Type = v1alpha1.InterfaceTypeLoopback
+			intf.Spec.IPv6 = test.ipv6
+
+			err := p.EnsureInterface(context.Background(), &provider.EnsureInterfaceRequest{Interface: intf})
+			if test.wantErr {
+				if _, ok := apistatus.FromError(err); !ok {
+					t.Fatalf("EnsureInterface() error = %v, want status error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureInterface() error = %v", err)
+			}
+			if got := c.config[xpath6]; got != test.want {
+				t.Errorf("EnsureInterface() ipv6 config = %s, want %s", got, test.want)
+			}
+			if _, ok := c.config[xpath4]; ok {
+				t.Errorf("EnsureInterface() configured unexpected ipv4 addresses")
+			}
+		})
+	}
+}
+
+func TestProvider_GetInterfaceStatus_SwitchportMode(t *testing.T) {
+	const xpath = "System/intf-items/phys-items/PhysIf-list[id=eth1/1]/phys-items"
+
+	tests := []struct {
+		name       string
+		switchport *v1alpha1.Switchport
+		operMode   string
+		want       v1alpha1.SwitchportMode
+	}{
+		{
+			name:       "trunk",
+			switchport: &v1alpha1.Switchport{Mode: v1alpha1.SwitchportModeTrunk},
+			operMode:   "trunk",
+			want:       v1alpha1.SwitchportModeTrunk,
+		},
+		{
+			name:       "access",
+			switchport: &v1alpha1.Switchport{Mode: v1alpha1.SwitchportModeTrunk},
+			operMode:   "access",
+			want:       v1alpha1.SwitchportModeAccess,
+		},
+		{
+			name:       "unsupported mode",
+			switchport: &v1alpha1.Switchport{Mode: v1alpha1.SwitchportModeTrunk},
+			operMode:   "fex-fabric",
+		},
+		{
+			name:     "routed",
+			operMode: "access",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{
+				xpath: `{"operSt":"up","operStQual":"none","operMode":"` + test.operMode + `"}`,
+			}}
+			p := &Provider{client: c}
+
+			status, err := p.GetInterfaceStatus(context.Background(), &provider.InterfaceRequest{
+				Interface: &v1alpha1.Interface{
+					Spec: v1alpha1.InterfaceSpec{
+						Name:       "Ethernet1/1",
+						Type:       v1alpha1.InterfaceTypePhysical,
+						Switchport: test.switchport,
+					},
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetInterfaceStatus() error = %v", err)
+			}
+			if !status.OperStatus {
+				t.Errorf("GetInterfaceStatus() OperStatus = false, want true")
+			}
+			if status.SwitchportMode != test.want {
+				t.Errorf("GetInterfaceStatus() SwitchportMode = %q, want %q", status.SwitchportMode, test.want)
+			}
+		})
+	}
+}
+
+func TestProvider_GetInterfaceStatus_AggregateMembers(t *testing.T) {
+	c := &fakeClient{config: map[string]string{
+		"System/intf-items/aggr-items/AggrIf-list[id=po10]/aggrif-items": `{"operSt":"up","operStQual":"none","operMode":"trunk"}`,
+		"System/intf-items/aggr-items/AggrIf-list[id=po10]/rsmbrIfs-items": `{"RsMbrIfs-list":[` +
+			`{"tDn":"/System/intf-items/phys-items/PhysIf-list[id='eth1/1']","channelingSt":"channeling"},` +
+			`{"tDn":"/System/intf-items/phys-items/PhysIf-list[id='eth1/2']","channelingSt":"individual"}]}`,
+	}}
+	p := &Provider{client: c}
+
+	status, err := p.GetInterfaceStatus(context.Background(), &provider.InterfaceRequest{
+		Interface: &v1alpha1.Interface{
+			Spec: v1alpha1.InterfaceSpec{
+				Name: "port-channel10",
+				Type: v1alpha1.InterfaceTypeAggregate,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetInterfaceStatus() error = %v", err)
+	}
+	if !status.OperStatus {
+		t.Errorf("GetInterfaceStatus() OperStatus = false, want true")
+	}
+
+	want := []provider.MemberStatus{
+		{Name: "eth1/1", BundleStatus: provider.BundleStatusActive},
+		{Name: "eth1/2", BundleStatus: provider.BundleStatusIndividual},
+	}
+	if !slices.Equal(status.Members, want) {
+		t.Errorf("GetInterfaceStatus() Members = %v, want %v", status.Members, want)
+	}
+}
+
+func TestProvider_EnsureInterface_AnycastGateway(t *testing.T) {
+	fwif := &FabricFwdIf{ID: "vlan10"}
+
+	tests := []struct {
+		name    string
+		config  map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "anycast mac configured",
+			config: map[string]string{new(FabricFwdAnycastMAC).XPath(): `"00:00:5e:00:01:01"`},
+		},
+		{
+			name:    "anycast mac missing",
+			config:  map[string]string{},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: test.config}
+			p := &Provider{client: c}
+
+			intf := &v1alpha1.Interface{}
+			intf.Spec.Name = "vlan10"
+			intf.Spec.Type = v1alpha1.InterfaceTypeRoutedVLAN
+			intf.Spec.IPv4 = &v1alpha1.InterfaceIPv4{AnycastGateway: true}
+
+			err := p.EnsureInterface(context.Background(), &provider.EnsureInterfaceRequest{
+				Interface: intf,
+				VLAN:      &v1alpha1.VLAN{Spec: v1alpha1.VLANSpec{ID: 10}},
+			})
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("EnsureInterface() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureInterface() error = %v", err)
+			}
+
+			got := new(FabricFwdIf)
+			if err := json.Unmarshal([]byte(c.config[fwif.XPath()]), got); err != nil {
+				t.Fatalf("EnsureInterface() did not configure fabric forwarding: %v", err)
+			}
+			if got.AdminSt != AdminStEnabled || got.Mode != FwdModeAnycastGateway {
+				t.Errorf("EnsureInterface() fabric forwarding = %+v, want enabled in mode %s", got, FwdModeAnycastGateway)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureInterface_ARPTimeout(t *testing.T) {
+	arp := &ARPIf{ID: "eth1/1"}
+
+	tests := []struct {
+		name    string
+		typ     v1alpha1.InterfaceType
+		timeout *metav1.Duration
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "physical",
+			typ:     v1alpha1.InterfaceTypePhysical,
+			timeout: &metav1.Duration{Duration: 30 * time.Minute},
+			want:    `{"id":"eth1/1","timeout":1800}`,
+		},
+		{
+			name: "unset",
+			typ:  v1alpha1.InterfaceTypePhysical,
+		},
+		{
+			name:    "too short",
+			typ:     v1alpha1.InterfaceTypePhysical,
+			timeout: &metav1.Duration{Duration: 30 * time.Second},
+			wantErr: true,
+		},
+		{
+			name:    "fractional seconds",
+			typ:     v1alpha1.InterfaceTypePhysical,
+			timeout: &metav1.Duration{Duration: 90500 * time.Millisecond},
+			wantErr: true,
+		},
+		{
+			name:    "loopback",
+			typ:     v1alpha1.InterfaceTypeLoopback,
+			timeout: &metav1.Duration{Duration: 30 * time.Minute},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{arp.XPath(): `{"id":"eth1/1","timeout":600}`}}
+			p := &Provider{client: c}
+
+			intf := &v1alpha1.Interface{}
+			intf.Spec.Name = "eth1/1"
+			intf.Spec.Type = test.typ
+			intf.Spec.AdminState = v1alpha1.AdminStateUp
+			intf.Spec.IPv4 = &v1alpha1.InterfaceIPv4{
+				Addresses:  []v1alpha1.IPPrefix{v1alpha1.MustParsePrefix("10.0.0.0/31")},
+				ARPTimeout: test.timeout,
+			}
+			if test.typ == v1alpha1.InterfaceTypeLoopback {
+				intf.Spec.Name = "lo0"
+			}
+
+			err := p.EnsureInterface(context.Background(), &provider.EnsureInterfaceRequest{
+				Interface: intf,
+				IPv4:      provider.IPv4AddressList{netip.MustParsePrefix("10.0.0.0/31")},
+			})
+			if test.wantErr {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != "spec.ipv4.arpTimeout" {
+					t.Fatalf("EnsureInterface() error = %v, want violation of spec.ipv4.arpTimeout", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureInterface() error = %v", err)
+			}
+
+			got, ok := c.config[arp.XPath()]
+			if test.want == "" {
+				if ok || !slices.Contains(c.deleted, arp.XPath()) {
+					t.Errorf("EnsureInterface() did not remove the arp timeout, got %s", got)
+				}
+				return
+			}
+			if got != test.want {
+				t.Errorf("EnsureInterface() arp config = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+// vrfMoveClient is a fakeClient that mimics NX-OS clearing the addresses of an
+// interface once the request that changes its VRF membership has been processed.
+type vrfMoveClient struct {
+	*fakeClient
+}
+
+func (c *vrfMoveClient) Update(ctx context.Context, el ...gnmiext.DataElement) error {
+	var moved []string
+	for _, e := range el {
+		phys, ok := e.(*PhysIf)
+		if !ok || phys.RtvrfMbrItems == nil {
+			continue
+		}
+		cur := &PhysIf{ID: phys.ID}
+		if err := c.GetConfig(ctx, cur); err == nil && cur.RtvrfMbrItems != nil && cur.RtvrfMbrItems.TDn != phys.RtvrfMbrItems.TDn {
+			moved = append(moved, phys.ID)
+		}
+	}
+	if err := c.fakeClient.Update(ctx, el...); err != nil {
+		return err
+	}
+	for _, id := range moved {
+		for k := range c.config {
+			if strings.Contains(k, "/dom-items/Dom-list[") && strings.HasSuffix(k, "/if-items/If-list[id="+id+"]") {
+				delete(c.config, k)
+			}
+		}
+	}
+	return nil
+}
+
+func TestProvider_EnsureInterface_URPF(t *testing.T) {
+	const (
+		xpath4 = "System/ipv4-items/inst-items/dom-items/Dom-list[name=default]/if-items/If-list[id=eth1/1]"
+		xpath6 = "System/ipv6-items/inst-items/dom-items/Dom-list[name=default]/if-items/If-list[id=eth1/1]"
+	)
+
+	tests := []struct {
+		name      string
+		urpf4     *v1alpha1.URPF
+		urpf6     *v1alpha1.URPF
+		want4     string
+		want6     string
+		wantField string
+	}{
+		{
+			name:  "strict",
+			urpf4: &v1alpha1.URPF{Mode: v1alpha1.URPFModeStrict},
+			urpf6: &v1alpha1.URPF{Mode: v1alpha1.URPFModeStrict},
+			want4: `{"id":"eth1/1","urpf":"strict","addr-items":{"Addr-list":[{"addr":"10.0.0.0/31","pref":0,"tag":0,"type":"primary"}]}}`,
+			want6: `{"id":"eth1/1","useLinkLocalOnly":"enabled","urpf":"strict"}`,
+		},
+		{
+			name:  "loose",
+			urpf4: &v1alpha1.URPF{Mode: v1alpha1.URPFModeLoose, AllowDefault: true},
+			urpf6: &v1alpha1.URPF{Mode: v1alpha1.URPFModeLoose},
+			want4: `{"id":"eth1/1","urpf":"loose-allow-default","addr-items":{"Addr-list":[{"addr":"10.0.0.0/31","pref":0,"tag":0,"type":"primary"}]}}`,
+			want6: `{"id":"eth1/1","useLinkLocalOnly":"enabled","urpf":"loose"}`,
+		},
+		{
+			name:  "unset",
+			want4: `{"id":"eth1/1","addr-items":{"Addr-list":[{"addr":"10.0.0.0/31","pref":0,"tag":0,"type":"primary"}]}}`,
+			want6: `{"id":"eth1/1","useLinkLocalOnly":"enabled"}`,
+		},
+		{
+			name:      "allow-default in strict mode",
+			urpf4:     &v1alpha1.URPF{Mode: v1alpha1.URPFModeStrict, AllowDefault: true},
+			wantField: "spec.ipv4.urpf.allowDefault",
+		},
+		{
+			name:      "allow-self-ping",
+			urpf6:     &v1alpha1.URPF{Mode: v1alpha1.URPFModeStrict, AllowSelfPing: true},
+			wantField: "spec.ipv6.urpf.allowSelfPing",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			intf := &v1alpha1.Interface{}
+			intf.Spec.Name = "eth1/1"
+			intf.Spec.Type = v1alpha1.InterfaceTypePhysical
+			intf.Spec.AdminState = v1alpha1.AdminStateUp
+			intf.Spec.IPv4 = &v1alpha1.InterfaceIPv4{
+				Addresses: []v1alpha1.IPPrefix{v1alpha1.MustParsePrefix("10.0.0.0/31")},
+				URPF:      test.urpf4,
+			}
+			intf.Spec.IPv6 = &v1alpha1.InterfaceIPv6{URPF: test.urpf6}
+
+			err := p.EnsureInterface(context.Background(), &provider.EnsureInterfaceRequest{
+				Interface: intf,
+				IPv4:      provider.IPv4AddressList{netip.MustParsePrefix("10.0.0.0/31")},
+			})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureInterface() error = %v, want violation of %s", err, test.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureInterface() error = %v", err)
+			}
+			if got := c.config[xpath4]; got != test.want4 {
+				t.Errorf("EnsureInterface() ipv4 config = %s, want %s", got, test.want4)
+			}
+			if got := c.config[xpath6]; got != test.want6 {
+				t.Errorf("EnsureInterface() ipv6 config = %s, want %s", got, test.want6)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureInterface_VRFChange(t *testing.T) {
+	const (
+		red4  = "System/ipv4-items/inst-items/dom-items/Dom-list[name=RED]/if-items/If-list[id=eth1/1]"
+		red6  = "System/ipv6-items/inst-items/dom-items/Dom-list[name=RED]/if-items/If-list[id=eth1/1]"
+		blue4 = "System/ipv4-items/inst-items/dom-items/Dom-list[name=BLUE]/if-items/If-list[id=eth1/1]"
+		blue6 = "System/ipv6-items/inst-items/dom-items/Dom-list[name=BLUE]/if-items/If-list[id=eth1/1]"
+	)
+
+	for _, transaction := range []bool{false, true} {
+		t.Run(fmt.Sprintf("transaction=%t", transaction), func(t *testing.T) {
+			intf := &v1alpha1.Interface{}
+			intf.Spec.Name = "eth1/1"
+			intf.Spec.Type = v1alpha1.InterfaceTypePhysical
+			intf.Spec.AdminState = v1alpha1.AdminStateUp
+			intf.Spec.IPv4 = &v1alpha1.InterfaceIPv4{Addresses: []v1alpha1.IPPrefix{v1alpha1.MustParsePrefix("10.0.0.0/31")}}
+			intf.Spec.IPv6 = &v1alpha1.InterfaceIPv6{Addresses: []v1alpha1.IPPrefix{v1alpha1.MustParsePrefix("2001:db8::/127")}}
+
+			c := &vrfMoveClient{&fakeClient{config: map[string]string{}}}
+			p := &Provider{client: c}
+
+			ensure := func(name string) {
+				t.Helper()
+				vrf := &v1alpha1.VRF{}
+				vrf.Spec.Name = name
+				fn := func(ctx context.Context, p provider.Provider) error {
+					return p.(*Provider).EnsureInterface(ctx, &provider.EnsureInterfaceRequest{
+						Interface: intf,
+						IPv4:      provider.IPv4AddressList{netip.MustParsePrefix("10.0.0.0/31")},
+						VRF:       vrf,
+					})
+				}
+				var err error
+				if transaction {
+					err = p.Transaction(context.Background(), fn)
+				} else {
+					err = fn(context.Background(), p)
+				}
+				if err != nil {
+					t.Fatalf("EnsureInterface() error = %v", err)
+				}
+				// The fake client does not derive the address lists from the individual items.
+				for _, af := range []string{"ipv4", "ipv6"} {
+					c.config["System/"+af+"-items/inst-items/dom-items"] = fmt.Sprintf(`{"Dom-list":[{"name":%q,"if-items":{"If-list":[{"id":"eth1/1"}]}}]}`, name)
+				}
+			}
+
+			ensure("RED")
+			for _, xpath := range []string{red4, red6} {
+				if _, ok := c.config[xpath]; !ok {
+					t.Fatalf("EnsureInterface() did not configure addresses at %s", xpath)
+				}
+			}
+
+			c.sets = nil
+			ensure("BLUE")
+			want := map[string]string{
+				blue4: `{"id":"eth1/1","addr-items":{"Addr-list":[{"addr":"10.0.0.0/31","pref":0,"tag":0,"type":"primary"}]}}`,
+				blue6: `{"id":"eth1/1","addr-items":{"Addr-list":[{"addr":"2001:db8::/127","pref":0,"tag":0,"type":"primary"}]}}`,
+			}
+			for xpath, w := range want {
+				if got := c.config[xpath]; got != w {
+					t.Errorf("EnsureInterface() addresses at %s = %q, want %q", xpath, got, w)
+				}
+			}
+			for _, xpath := range []string{red4, red6} {
+				if _, ok := c.config[xpath]; ok || !slices.Contains(c.deleted, xpath) {
+					t.Errorf("EnsureInterface() did not remove the addresses in the previous vrf at %s", xpath)
+				}
+			}
+			phys := &PhysIf{ID: "eth1/1"}
+			if err := c.GetConfig(context.Background(), phys); err != nil {
+				t.Fatalf("GetConfig() error = %v", err)
+			}
+			if want := NewVrfMember("eth1/1", "BLUE"); phys.RtvrfMbrItems == nil || phys.RtvrfMbrItems.TDn != want.TDn {
+				t.Errorf("EnsureInterface() vrf membership = %+v, want %+v", phys.RtvrfMbrItems, want)
+			}
+
+			// The membership must be sent in a Set RPC of its own, before the one with the addresses.
+			if len(c.sets) != 2 {
+				t.Fatalf("EnsureInterface() sent %d Set RPCs, want 2: %v", len(c.sets), c.sets)
+			}
+			if !slices.Contains(c.sets[0], phys.XPath()) || slices.Contains(c.sets[0], blue4) {
+				t.Errorf("EnsureInterface() first Set RPC = %v, want the membership only", c.sets[0])
+			}
+			if !slices.Contains(c.sets[1], blue4) || !slices.Contains(c.sets[1], blue6) {
+				t.Errorf("EnsureInterface() second Set RPC = %v, want the addresses", c.sets[1])
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureInterface_StormControl(t *testing.T) {
+	tests := []struct {
+		name      string
+		storm     *v1alpha1.StormControl
+		want      *StormControl
+		wantField string
+	}{
+		{
+			name:  "broadcast percent",
+			storm: &v1alpha1.StormControl{Broadcast: &v1alpha1.StormControlThreshold{Percent: new(int32(5))}},
+			want:  &StormControl{BcLevel: "5.00"},
+		},
+		{
+			name: "all traffic types",
+			storm: &v1alpha1.StormControl{
+				Broadcast:      &v1alpha1.StormControlThreshold{Percent: new(int32(0))},
+				Multicast:      &v1alpha1.StormControlThreshold{PacketsPerSecond: new(int32(1000))},
+				UnknownUnicast: &v1alpha1.StormControlThreshold{Percent: new(int32(100))},
+			},
+			want: &StormControl{BcLevel: "0.00", McPPS: new(int32(1000)), UucLevel: "100.00"},
+		},
+		{
+			name: "unset",
+		},
+		{
+			name:      "percent above 100",
+			storm:     &v1alpha1.StormControl{Multicast: &v1alpha1.StormControlThreshold{Percent: new(int32(150))}},
+			wantField: "spec.ethernet.stormControl.multicast.percent",
+		},
+		{
+			name:      "negative percent",
+			storm:     &v1alpha1.StormControl{Broadcast: &v1alpha1.StormControlThreshold{Percent: new(int32(-1))}},
+			wantField: "spec.ethernet.stormControl.broadcast.percent",
+		},
+		{
+			name: "percent and packets per second",
+			storm: &v1alpha1.StormControl{UnknownUnicast: &v1alpha1.StormControlThreshold{
+				Percent:          new(int32(5)),
+				PacketsPerSecond: new(int32(1000)),
+			}},
+			wantField: "spec.ethernet.stormControl.unknownUnicast",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			intf := &v1alpha1.Interface{}
+			intf.Spec.Name = "eth1/10"
+			intf.Spec.Type = v1alpha1.InterfaceTypePhysical
+			intf.Spec.AdminState = v1alpha1.AdminStateUp
+			intf.Spec.Switchport = &v1alpha1.Switchport{Mode: v1alpha1.SwitchportModeAccess, AccessVlan: 10}
+			intf.Spec.Ethernet = &v1alpha1.Ethernet{StormControl: test.storm}
+
+			err := p.EnsureInterface(context.Background(), &provider.EnsureInterfaceRequest{Interface: intf})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureInterface() error = %v, want violation of %s", err, test.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureInterface() error = %v", err)
+			}
+
+			phys := &PhysIf{ID: "eth1/10"}
+			if err := c.GetConfig(context.Background(), phys); err != nil {
+				t.Fatalf("GetConfig() error = %v", err)
+			}
+			if !reflect.DeepEqual(phys.StormCtrlItems, test.want) {
+				t.Errorf("EnsureInterface() storm-control = %+v, want %+v", phys.StormCtrlItems, test.want)
+			}
+			if test.want == nil && strings.Contains(c.config[phys.XPath()], "stormctrl-items") {
+				t.Errorf("EnsureInterface() configured storm-control although it is unset: %s", c.config[phys.XPath()])
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureInterface_Speed(t *testing.T) {
+	tests := []struct {
+		name       string
+		ethernet   *v1alpha1.Ethernet
+		current    Speed
+		wantSpeed  Speed
+		wantDuplex Duplex
+		wantField  string
+	}{
+		{
+			name:       "supported speed",
+			ethernet:   &v1alpha1.Ethernet{Speed: v1alpha1.Speed1G, Duplex: v1alpha1.DuplexFull},
+			wantSpeed:  Speed1G,
+			wantDuplex: DuplexFull,
+		},
+		{
+			name:      "unsupported speed",
+			ethernet:  &v1alpha1.Ethernet{Speed: v1alpha1.Speed100G},
+			wantField: "spec.ethernet.speed",
+		},
+		{
+			name:      "unknown speed",
+			ethernet:  &v1alpha1.Ethernet{Speed: "2.5G"},
+			wantField: "spec.ethernet.speed",
+		},
+		{
+			name:      "half duplex at 10G",
+			ethernet:  &v1alpha1.Ethernet{Speed: v1alpha1.Speed10G, Duplex: v1alpha1.DuplexHalf},
+			wantField: "spec.ethernet.duplex",
+		},
+		{
+			name:      "unknown duplex",
+			ethernet:  &v1alpha1.Ethernet{Speed: v1alpha1.Speed1G, Duplex: "Simplex"},
+			wantField: "spec.ethernet.duplex",
+		},
+		{
+			// The capabilities of the port are not queried again if the speed is already configured.
+			name:       "unchanged speed",
+			ethernet:   &v1alpha1.Ethernet{Speed: v1alpha1.Speed100G},
+			current:    Speed100G,
+			wantSpeed:  Speed100G,
+			wantDuplex: DuplexAuto,
+		},
+		{
+			name:       "unset",
+			wantSpeed:  SpeedAuto,
+			wantDuplex: DuplexAuto,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			c.config[(&PortCapabilities{ID: "eth1/10"}).XPath()] = `{"speed":"1000,10000,25000"}`
+			if test.current != "" {
+				c.config[(&PhysIfSpeed{ID: "eth1/10"}).XPath()] = `"` + string(test.current) + `"`
+			}
+			p := &Provider{client: c}
+
+			intf := &v1alpha1.Interface{}
+			intf.Spec.Name = "eth1/10"
+			intf.Spec.Type = v1alpha1.InterfaceTypePhysical
+			intf.Spec.AdminState = v1alpha1.AdminStateUp
+			intf.Spec.Switchport = &v1alpha1.Switchport{Mode: v1alpha1.SwitchportModeAccess, AccessVlan: 10}
+			intf.Spec.Ethernet = test.ethernet
+
+			err := p.EnsureInterface(context.Background(), &provider.EnsureInterfaceRequest{Interface: intf})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureInterface() error = %v, want violation of %s", err, test.wantField)
+				}
+				if _, ok := c.config[(&PhysIf{ID: "eth1/10"}).XPath()]; ok {
+					t.Errorf("EnsureInterface() configured the interface with an unsupported speed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureInterface() error = %v", err)
+			}
+
+			phys := &PhysIf{ID: "eth1/10"}
+			if err := c.GetConfig(context.Background(), phys); err != nil {
+				t.Fatalf("GetConfig() error = %v", err)
+			}
+			if phys.Speed != test.wantSpeed || phys.Duplex != test.wantDuplex {
+				t.Errorf("EnsureInterface() speed/duplex = %s/%s, want %s/%s", phys.Speed, phys.Duplex, test.wantSpeed, test.wantDuplex)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureInterface_PortSecurity(t *testing.T) {
+	const xpath = "System/portsec-items/if-items/If-list[id=eth1/10]"
+
+	tests := []struct {
+		name      string
+		portsec   *v1alpha1.PortSecurity
+		ipv4      *v1alpha1.InterfaceIPv4
+		want      *PortSecurityIf
+		wantField string
+	}{
+		{
+			name:    "sticky with maximum of two",
+			portsec: &v1alpha1.PortSecurity{Enabled: true, MaxMACAddresses: 2, Sticky: true},
+			want:    &PortSecurityIf{IfName: "eth1/10", AdminSt: AdminStEnabled, Maximum: 2, Sticky: AdminStEnabled, Violation: PortSecurityViolationShutdown},
+		},
+		{
+			name:    "restrict",
+			portsec: &v1alpha1.PortSecurity{Enabled: true, ViolationAction: v1alpha1.PortSecurityViolationActionRestrict},
+			want:    &PortSecurityIf{IfName: "eth1/10", AdminSt: AdminStEnabled, Sticky: AdminStDisabled, Violation: PortSecurityViolationRestrict},
+		},
+		{
+			name: "unset",
+		},
+		{
+			name:      "routed interface",
+			portsec:   &v1alpha1.PortSecurity{Enabled: true, MaxMACAddresses: 2, Sticky: true},
+			ipv4:      &v1alpha1.InterfaceIPv4{Addresses: []v1alpha1.IPPrefix{v1alpha1.MustParsePrefix("10.0.0.1/30")}},
+			wantField: "spec.portSecurity",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			intf := &v1alpha1.Interface{}
+			intf.Spec.Name = "eth1/10"
+			intf.Spec.Type = v1alpha1.InterfaceTypePhysical
+			intf.Spec.AdminState = v1alpha1.AdminStateUp
+			intf.Spec.PortSecurity = test.portsec
+			if test.ipv4 != nil {
+				intf.Spec.IPv4 = test.ipv4
+			} else {
+				intf.Spec.Switchport = &v1alpha1.Switchport{Mode: v1alpha1.SwitchportModeAccess, AccessVlan: 10}
+			}
+
+			err := p.EnsureInterface(context.Background(), &provider.EnsureInterfaceRequest{Interface: intf})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureInterface() error = %v, want violation of %s", err, test.wantField)
+				}
+				if _, ok := c.config[xpath]; ok {
+					t.Errorf("EnsureInterface() configured port security on a routed interface")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureInterface() error = %v", err)
+			}
+
+			if test.want == nil {
+				if !slices.Contains(c.deleted, xpath) {
+					t.Errorf("EnsureInterface() did not remove port security, deleted = %v", c.deleted)
+				}
+				return
+			}
+			got := &PortSecurityIf{IfName: "eth1/10"}
+			if err := c.GetConfig(context.Background(), got); err != nil {
+				t.Fatalf("GetConfig() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("EnsureInterface() port security = %+v, want %+v", got, test.want)
+			}
+			if _, ok := c.config["System/fm-items/portsec-items"]; !ok {
+				t.Errorf("EnsureInterface() did not enable the port-security feature")
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureInterface_AccessGroups(t *testing.T) {
+	newACL := func(name, prefix string) *v1alpha1.AccessControlList {
+		acl := &v1alpha1.AccessControlList{}
+		acl.Spec.Name = name
+		acl.Spec.Entries = []v1alpha1.ACLEntry{{
+			Sequence:           10,
+			Action:             v1alpha1.ActionPermit,
+			Protocol:           v1alpha1.ProtocolIP,
+			SourceAddress:      v1alpha1.MustParsePrefix(prefix),
+			DestinationAddress: v1alpha1.MustParsePrefix(prefix),
+		}}
+		return acl
+	}
+	acl4 := newACL("ACL-IN", "10.0.0.0/8")
+	acl6 := newACL("ACL6-OUT", "2001:db8::/32")
+
+	vrf := &v1alpha1.VRF{}
+	vrf.Spec.Name = "TENANT"
+
+	const (
+		ingress4 = "System/acl-items/ipv4-items/policy-items/ingress-items/intf-items/If-list[name=eth1/1]"
+		egress4  = "System/acl-items/ipv4-items/policy-items/egress-items/intf-items/If-list[name=eth1/1]"
+		egress6  = "System/acl-items/ipv6-items/policy-items/egress-items/intf-items/If-list[name=eth1/1]"
+	)
+
+	tests := []struct {
+		name      string
+		groups    []provider.AccessGroup
+		noIPv6    bool
+		want      map[string]string
+		wantField string
+	}{
+		{
+			name: "ingress ipv4 and egress ipv6",
+			groups: []provider.AccessGroup{
+				{Direction: v1alpha1.AccessGroupDirectionIngress, ACL: acl4},
+				{Direction: v1alpha1.AccessGroupDirectionEgress, ACL: acl6},
+			},
+			want: map[string]string{
+				ingress4: `{"name":"eth1/1","acl-items":{"name":"ACL-IN"}}`,
+				egress6:  `{"name":"eth1/1","acl-items":{"name":"ACL6-OUT"}}`,
+			},
+		},
+		{
+			name: "removed",
+			want: map[string]string{},
+		},
+		{
+			name:      "ipv6 access control list without ipv6 configuration",
+			groups:    []provider.AccessGroup{{Direction: v1alpha1.AccessGroupDirectionEgress, ACL: acl6}},
+			noIPv6:    true,
+			wantField: "spec.accessGroups[0]",
+		},
+		{
+			name: "two ipv4 access control lists in the same direction",
+			groups: []provider.AccessGroup{
+				{Direction: v1alpha1.AccessGroupDirectionIngress, ACL: acl4},
+				{Direction: v1alpha1.AccessGroupDirectionIngress, ACL: newACL("ACL-OTHER", "192.168.0.0/16")},
+			},
+			wantField: "spec.accessGroups[1]",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{egress4: `{"name":"eth1/1","acl-items":{"name":"STALE"}}`}}
+			p := &Provider{client: c}
+
+			intf := &v1alpha1.Interface{}
+			intf.Spec.Name = "eth1/1"
+			intf.Spec.Type = v1alpha1.InterfaceTypePhysical
+			intf.Spec.AdminState = v1alpha1.AdminStateUp
+			intf.Spec.IPv4 = &v1alpha1.InterfaceIPv4{Addresses: []v1alpha1.IPPrefix{v1alpha1.MustParsePrefix("10.0.0.0/31")}}
+			if !test.noIPv6 {
+				intf.Spec.IPv6 = &v1alpha1.InterfaceIPv6{Addresses: []v1alpha1.IPPrefix{v1alpha1.MustParsePrefix("2001:db8::/127")}}
+			}
+
+			err := p.EnsureInterface(context.Background(), &provider.EnsureInterfaceRequest{
+				Interface:    intf,
+				IPv4:         provider.IPv4AddressList{netip.MustParsePrefix("10.0.0.0/31")},
+				VRF:          vrf,
+				AccessGroups: test.groups,
+			})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureInterface() error = %v, want violation of %s", err, test.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureInterface() error = %v", err)
+			}
+
+			for _, xpath := range []string{
+				"System/ipv4-items/inst-items/dom-items/Dom-list[name=TENANT]/if-items/If-list[id=eth1/1]",
+				"System/ipv6-items/inst-items/dom-items/Dom-list[name=TENANT]/if-items/If-list[id=eth1/1]",
+			} {
+				if _, ok := c.config[xpath]; !ok {
+					t.Errorf("EnsureInterface() did not configure addresses at %s", xpath)
+				}
+			}
+			for _, xpath := range []string{ingress4, egress4, egress6} {
+				got, ok := c.config[xpath]
+				if want, wantOK := test.want[xpath]; ok != wantOK || got != want {
+					t.Errorf("EnsureInterface() access group at %s = %q, want %q", xpath, got, want)
+				}
+			}
+			if !slices.Contains(c.deleted, egress4) {
+				t.Errorf("EnsureInterface() did not remove the stale access group")
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureInterface_ServicePolicies(t *testing.T) {
+	qos := &v1alpha1.QoSPolicy{}
+	qos.Spec.Name = "UPLINK-EGRESS"
+
+	const (
+		input  = "System/ipqos-items/dflt-items/policy-items/in-items/intf-items/If-list[name=eth1/1]"
+		output = "System/ipqos-items/dflt-items/policy-items/out-items/intf-items/If-list[name=eth1/1]"
+	)
+
+	tests := []struct {
+		name      string
+		intfType  v1alpha1.InterfaceType
+		policies  []provider.ServicePolicy
+		want      map[string]string
+		wantField string
+	}{
+		{
+			name:     "output",
+			intfType: v1alpha1.InterfaceTypePhysical,
+			policies: []provider.ServicePolicy{{Direction: v1alpha1.ServicePolicyDirectionOutput, QoSPolicy: qos}},
+			want: map[string]string{
+				output: `{"name":"eth1/1","pmap-items":{"name":"UPLINK-EGRESS"}}`,
+			},
+		},
+		{
+			name:     "removed",
+			intfType: v1alpha1.InterfaceTypePhysical,
+			want:     map[string]string{},
+		},
+		{
+			name:      "loopback",
+			intfType:  v1alpha1.InterfaceTypeLoopback,
+			policies:  []provider.ServicePolicy{{Direction: v1alpha1.ServicePolicyDirectionOutput, QoSPolicy: qos}},
+			wantField: "spec.servicePolicies[0]",
+		},
+		{
+			name:     "two policies in the same direction",
+			intfType: v1alpha1.InterfaceTypePhysical,
+			policies: []provider.ServicePolicy{
+				{Direction: v1alpha1.ServicePolicyDirectionOutput, QoSPolicy: qos},
+				{Direction: v1alpha1.ServicePolicyDirectionOutput, QoSPolicy: qos},
+			},
+			wantField: "spec.servicePolicies[1]",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{input: `{"name":"eth1/1","pmap-items":{"name":"STALE"}}`}}
+			p := &Provider{client: c}
+
+			intf := &v1alpha1.Interface{}
+			intf.Spec.Name = "eth1/1"
+			intf.Spec.Type = test.intfType
+			intf.Spec.AdminState = v1alpha1.AdminStateUp
+
+			err := p.EnsureInterface(context.Background(), &provider.EnsureInterfaceRequest{
+				Interface:       intf,
+				ServicePolicies: test.policies,
+			})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureInterface() error = %v, want violation of %s", err, test.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureInterface() error = %v", err)
+			}
+
+			for _, xpath := range []string{input, output} {
+				got, ok := c.config[xpath]
+				if want, wantOK := test.want[xpath]; ok != wantOK || got != want {
+					t.Errorf("EnsureInterface() service policy at %s = %q, want %q", xpath, got, want)
+				}
+			}
+			if !slices.Contains(c.deleted, input) {
+				t.Errorf("EnsureInterface() did not remove the stale service policy")
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureInterface_Aggregation(t *testing.T) {
+	tests := []struct {
+		name         string
+		minLinks     uint8
+		rate         v1alpha1.LACPRate
+		wantMinLinks uint8
+		wantRate     LACPRate
+		wantErr      bool
+	}{
+		{
+			name:         "defaults",
+			wantMinLinks: 1,
+			wantRate:     LACPRateNormal,
+		},
+		{
+			name:         "fast rate",
+			minLinks:     2,
+			rate:         v1alpha1.LACPRateFast,
+			wantMinLinks: 2,
+			wantRate:     LACPRateFast,
+		},
+		{
+			name:     "min-links exceeds members",
+			minLinks: 3,
+			wantErr:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			intf := &v1alpha1.Interface{}
+			intf.Spec.Name = "po10"
+			intf.Spec.Type = v1alpha1.InterfaceTypeAggregate
+			intf.Spec.AdminState = v1alpha1.AdminStateUp
+			intf.Spec.Aggregation = &v1alpha1.Aggregation{
+				MinLinks: test.minLinks,
+				ControlProtocol: v1alpha1.ControlProtocol{
+					Mode: v1alpha1.LACPModeActive,
+					Rate: test.rate,
+				},
+			}
+
+			var members []*v1alpha1.Interface
+			for _, name := range []string{"eth1/1", "eth1/2"} {
+				m := &v1alpha1.Interface{}
+				m.Spec.Name = name
+				m.Spec.Type = v1alpha1.InterfaceTypePhysical
+				members = append(members, m)
+			}
+
+			err := p.EnsureInterface(context.Background(), &provider.EnsureInterfaceRequest{
+				Interface: intf,
+				Members:   members,
+			})
+			if test.wantErr {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != "spec.aggregation.minLinks" {
+					t.Fatalf("EnsureInterface() error = %v, want violation of spec.aggregation.minLinks", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureInterface() error = %v", err)
+			}
+
+			pc := &PortChannel{ID: "po10"}
+			if err := json.Unmarshal([]byte(c.config[pc.XPath()]), pc); err != nil {
+				t.Fatalf("EnsureInterface() did not configure port-channel: %v", err)
+			}
+			if pc.MinLinks != test.wantMinLinks {
+				t.Errorf("EnsureInterface() min-links = %d, want %d", pc.MinLinks, test.wantMinLinks)
+			}
+			for _, m := range []string{"eth1/1", "eth1/2"} {
+				l := &LACPIf{ID: m}
+				if err := json.Unmarshal([]byte(c.config[l.XPath()]), l); err != nil {
+					t.Fatalf("EnsureInterface() did not configure LACP on %s: %v", m, err)
+				}
+				if l.TxRate != test.wantRate {
+					t.Errorf("EnsureInterface() LACP rate of %s = %q, want %q", m, l.TxRate, test.wantRate)
+				}
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureInterface_LACPPriority(t *testing.T) {
+	c := &fakeClient{config: map[string]string{}}
+	p := &Provider{client: c}
+
+	intf := &v1alpha1.Interface{}
+	intf.Spec.Name = "po10"
+	intf.Spec.Type = v1alpha1.InterfaceTypeAggregate
+	intf.Spec.AdminState = v1alpha1.AdminStateUp
+	intf.Spec.Aggregation = &v1alpha1.Aggregation{
+		ControlProtocol: v1alpha1.ControlProtocol{
+			Mode: v1alpha1.LACPModeActive,
+		},
+	}
+
+	want := map[string]uint16{"eth1/1": 100, "eth1/2": 200, "eth1/3": DefaultLACPPriority}
+
+	var members []*v1alpha1.Interface
+	for _, name := range []string{"eth1/1", "eth1/2", "eth1/3"} {
+		m := &v1alpha1.Interface{}
+		m.Spec.Name = name
+		m.Spec.Type = v1alpha1.InterfaceTypePhysical
+		if want[name] != DefaultLACPPriority {
+			m.Spec.Ethernet = &v1alpha1.Ethernet{LACPPortPriority: want[name]}
+		}
+		members = append(members, m)
+	}
+
+	err := p.EnsureInterface(context.Background(), &provider.EnsureInterfaceRequest{
+		Interface: intf,
+		Members:   members,
+	})
+	if err != nil {
+		t.Fatalf("EnsureInterface() error = %v", err)
+	}
+
+	// The LACP system priority is a device-wide setting and must not be touched by an aggregate.
+	if v, ok := c.config[new(LACPSysPrio).XPath()]; ok {
+		t.Errorf("EnsureInterface() configured the LACP system priority: %s", v)
+	}
+	for name, prio := range want {
+		l := &LACPIf{ID: name}
+		if err := json.Unmarshal([]byte(c.config[l.XPath()]), l); err != nil {
+			t.Fatalf("EnsureInterface() did not configure LACP on %s: %v", name, err)
+		}
+		if l.Prio != prio {
+			t.Errorf("EnsureInterface() LACP port priority of %s = %d, want %d", name, l.Prio, prio)
+		}
+	}
+}
+
+func TestProvider_GetInterfaceStatus_FEC(t *testing.T) {
+	const xpath = "System/intf-items/phys-items/PhysIf-list[id=eth1/1]/phys-items"
+
+	tests := []struct {
+		name string
+		fec  string
+		want *provider.FECStatistics
+	}{
+		{
+			name: "fec statistics",
+			fec:  `{"correctedCw":"1234","uncorrectedCw":"2","preFecBer":"1.5E-08","postFecBer":"0.0E+00"}`,
+			want: &provider.FECStatistics{CorrectedCodewords: 1234, UncorrectedCodewords: 2, PreFECBER: new(1.5e-08), PostFECBER: new(0.0)},
+		},
+		{
+			name: "fec not enabled",
+		},
+		{
+			name: "invalid bit error rate",
+			fec:  `{"correctedCw":"5","uncorrectedCw":"0","preFecBer":"n/a"}`,
+			want: &provider.FECStatistics{CorrectedCodewords: 5},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{
+				xpath: `{"operSt":"up","operStQual":"none"}`,
+				"System/lldp-items/inst-items/if-items/If-list[id=eth1/1]": `{}`,
+			}}
+			if test.fec != "" {
+				c.config[xpath+"/fec-items"] = test.fec
+			}
+			p := &Provider{client: c}
+
+			status, err := p.GetInterfaceStatus(context.Background(), &provider.InterfaceRequest{
+				Interface: &v1alpha1.Interface{
+					Spec: v1alpha1.InterfaceSpec{
+						Name: "Ethernet1/1",
+						Type: v1alpha1.InterfaceTypePhysical,
+					},
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetInterfaceStatus() error = %v", err)
+			}
+			if !reflect.DeepEqual(status.FEC, test.want) {
+				t.Errorf("GetInterfaceStatus() FEC = %+v, want %+v", status.FEC, test.want)
+			}
+		})
+	}
+}
+
+func TestProvider_GetInterfaceStatus_OperMTU(t *testing.T) {
+	tests := []struct {
+		name   string
+		typ    v1alpha1.InterfaceType
+		ifName string
+		xpath  string
+		state  string
+		want   int32
+	}{
+		{
+			name:   "physical with jumbo frames",
+			typ:    v1alpha1.InterfaceTypePhysical,
+			ifName: "Ethernet1/1",
+			xpath:  "System/intf-items/phys-items/PhysIf-list[id=eth1/1]/phys-items",
+			state:  `{"operSt":"up","operStQual":"none","operMtu":9216}`,
+			want:   9216,
+		},
+		{
+			name:   "physical without operational mtu",
+			typ:    v1alpha1.InterfaceTypePhysical,
+			ifName: "Ethernet1/1",
+			xpath:  "System/intf-items/phys-items/PhysIf-list[id=eth1/1]/phys-items",
+			state:  `{"operSt":"up","operStQual":"none"}`,
+		},
+		{
+			name:   "aggregate",
+			typ:    v1alpha1.InterfaceTypeAggregate,
+			ifName: "port-channel10",
+			xpath:  "System/intf-items/aggr-items/AggrIf-list[id=po10]/aggrif-items",
+			state:  `{"operSt":"up","operStQual":"none","operMtu":1500}`,
+			want:   1500,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{test.xpath: test.state}}
+			p := &Provider{client: c}
+
+			status, err := p.GetInterfaceStatus(context.Background(), &provider.InterfaceRequest{
+				Interface: &v1alpha1.Interface{
+					Spec: v1alpha1.InterfaceSpec{Name: test.ifName, Type: test.typ},
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetInterfaceStatus() error = %v", err)
+			}
+			if status.OperMTU != test.want {
+				t.Errorf("GetInterfaceStatus() OperMTU = %d, want %d", status.OperMTU, test.want)
+			}
+		})
+	}
+}
+
+func TestProvider_GetInterfaceStatus_LACP(t *testing.T) {
+	const (
+		lacpInst  = "System/lacp-items/inst-items"
+		vpcIfs    = "System/vpc-items/inst-items/dom-items/if-items"
+		vpcDomain = "System/vpc-items/inst-items/dom-items"
+	)
+
+	tests := []struct {
+		name   string
+		config map[string]string
+		want   *provider.LACPSystem
+	}{
+		{
+			name:   "global system parameters",
+			config: map[string]string{lacpInst: `{"sysMac":"52:54:00:AB:CD:EF","sysPrio":100}`},
+			want:   &provider.LACPSystem{SystemID: "52:54:00:ab:cd:ef", SystemPriority: 100},
+		},
+		{
+			name: "vpc system mac",
+			config: map[string]string{
+				lacpInst:  `{"sysMac":"52:54:00:ab:cd:ef","sysPrio":32768}`,
+				vpcIfs:    `{"If-list":[{"id":10,"rsvpcConf-items":{"tDn":"/System/intf-items/aggr-items/AggrIf-list[id='po10']"}}]}`,
+				vpcDomain: `{"operSysMac":"00:00:5E:00:53:01","sysPrio":4096}`,
+			},
+			want: &provider.LACPSystem{SystemID: "00:00:5e:00:53:01", SystemPriority: 4096},
+		},
+		{
+			name: "vpc system mac not reported",
+			config: map[string]string{
+				lacpInst:  `{"sysMac":"52:54:00:ab:cd:ef","sysPrio":32768}`,
+				vpcIfs:    `{"If-list":[{"id":10,"rsvpcConf-items":{"tDn":"/System/intf-items/aggr-items/AggrIf-list[id='po10']"}}]}`,
+				vpcDomain: `{"operSysMac":"00:00:00:00:00:00","sysPrio":32667}`,
+			},
+		},
+		{
+			name: "other vpc port-channel",
+			config: map[string]string{
+				lacpInst:  `{"sysMac":"52:54:00:ab:cd:ef","sysPrio":32768}`,
+				vpcIfs:    `{"If-list":[{"id":20,"rsvpcConf-items":{"tDn":"/System/intf-items/aggr-items/AggrIf-list[id='po20']"}}]}`,
+				vpcDomain: `{"operSysMac":"00:00:5e:00:53:01","sysPrio":4096}`,
+			},
+			want: &provider.LACPSystem{SystemID: "52:54:00:ab:cd:ef", SystemPriority: 32768},
+		},
+		{
+			name:   "not reported",
+			config: map[string]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: test.config}
+			p := &Provider{client: c}
+
+			status, err := p.GetInterfaceStatus(context.Background(), &provider.InterfaceRequest{
+				Interface: &v1alpha1.Interface{
+					Spec: v1alpha1.InterfaceSpec{Name: "port-channel10", Type: v1alpha1.InterfaceTypeAggregate},
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetInterfaceStatus() error = %v", err)
+			}
+			if !reflect.DeepEqual(status.LACP, test.want) {
+				t.Errorf("GetInterfaceStatus() LACP = %+v, want %+v", status.LACP, test.want)
+			}
+		})
+	}
+}
+
+func TestNewL2ProtocolTunnel(t *testing.T) {
+	tests := []struct {
+		name    string
+		tunnel  nxv1alpha1.L2ProtocolTunnel
+		want    *L2ProtocolTunnel
+		wantErr bool
+	}{
+		{
+			name:   "protocols",
+			tunnel: nxv1alpha1.L2ProtocolTunnel{Protocols: []nxv1alpha1.L2ProtocolTunnelProtocol{"STP", "CDP"}},
+			want:   &L2ProtocolTunnel{IfName: "eth1/1", Proto: "cdp,stp"},
+		},
+		{
+			name: "thresholds",
+			tunnel: nxv1alpha1.L2ProtocolTunnel{
+				Protocols:         []nxv1alpha1.L2ProtocolTunnelProtocol{"LLDP"},
+				DropThreshold:     100,
+				ShutdownThreshold: 200,
+			},
+			want: &L2ProtocolTunnel{IfName: "eth1/1", Proto: "lldp", DropThresh: 100, ShutThresh: 200},
+		},
+		{
+			name:    "no protocols",
+			tunnel:  nxv1alpha1.L2ProtocolTunnel{},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported protocol",
+			tunnel:  nxv1alpha1.L2ProtocolTunnel{Protocols: []nxv1alpha1.L2ProtocolTunnelProtocol{"LACP"}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate protocol",
+			tunnel:  nxv1alpha1.L2ProtocolTunnel{Protocols: []nxv1alpha1.L2ProtocolTunnelProtocol{"CDP", "CDP"}},
+			wantErr: true,
+		},
+		{
+			name: "threshold out of range",
+			tunnel: nxv1alpha1.L2ProtocolTunnel{
+				Protocols:     []nxv1alpha1.L2ProtocolTunnelProtocol{"CDP"},
+				DropThreshold: 4097,
+			},
+			wantErr: true,
+		},
+		{
+			name: "shutdown threshold below drop threshold",
+			tunnel: nxv1alpha1.L2ProtocolTunnel{
+				Protocols:         []nxv1alpha1.L2ProtocolTunnelProtocol{"CDP"},
+				DropThreshold:     200,
+				ShutdownThreshold: 100,
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NewL2ProtocolTunnel("eth1/1", &test.tunnel)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("NewL2ProtocolTunnel() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("NewL2ProtocolTunnel() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureInterface_L2ProtocolTunnel(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := nxv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme() error = %v", err)
+	}
+	cfg := &nxv1alpha1.InterfaceConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "l2pt", Namespace: metav1.NamespaceDefault},
+		Spec: nxv1alpha1.InterfaceConfigSpec{
+			L2ProtocolTunnel: &nxv1alpha1.L2ProtocolTunnel{Protocols: []nxv1alpha1.L2ProtocolTunnelProtocol{"CDP"}},
+		},
+	}
+	r := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cfg).Build()
+	pc, err := provider.GetProviderConfig(context.Background(), r, cfg.Namespace, &v1alpha1.TypedLocalObjectReference{
+		APIVersion: nxv1alpha1.GroupVersion.String(),
+		Kind:       "InterfaceConfig",
+		Name:       cfg.Name,
+	})
+	if err != nil {
+		t.Fatalf("GetProviderConfig() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		switchport *v1alpha1.Switchport
+		wantErr    bool
+	}{
+		{
+			name:       "access port",
+			switchport: &v1alpha1.Switchport{Mode: v1alpha1.SwitchportModeAccess, AccessVlan: 10},
+		},
+		{
+			name:       "trunk port",
+			switchport: &v1alpha1.Switchport{Mode: v1alpha1.SwitchportModeTrunk},
+			wantErr:    true,
+		},
+		{
+			name:    "routed port",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			intf := &v1alpha1.Interface{}
+			intf.Spec.Name = "eth1/1"
+			intf.Spec.Type = v1alpha1.InterfaceTypePhysical
+			intf.Spec.Switchport = test.switchport
+
+			err := p.EnsureInterface(context.Background(), &provider.EnsureInterfaceRequest{
+				Interface:      intf,
+				ProviderConfig: pc,
+			})
+			l2pt := &L2ProtocolTunnel{IfName: "eth1/1"}
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("EnsureInterface() expected error, got nil")
+				}
+				if _, ok := c.config[l2pt.XPath()]; ok {
+					t.Errorf("EnsureInterface() configured l2 protocol tunneling despite error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureInterface() error = %v", err)
+			}
+			if got := c.config[l2pt.XPath()]; got != `{"id":"eth1/1","proto":"cdp"}` {
+				t.Errorf("EnsureInterface() l2 protocol tunnel = %s, want %s", got, `{"id":"eth1/1","proto":"cdp"}`)
+			}
+		})
+	}
 }
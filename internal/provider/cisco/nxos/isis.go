@@ -4,6 +4,8 @@
 package nxos
 
 import (
+	"time"
+
 	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
 	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
 )
@@ -90,3 +92,90 @@ func ISISLevelFrom(level v1alpha1.ISISLevel) ISISLevel {
 	ISISAfIPv4Unicast ISISAddressFamily = "v4"
 	ISISAfIPv6Unicast ISISAddressFamily = "v6"
 )
+
+type ISISOperItems struct {
+	Name    string `json:"name"`
+	OperSt  OperSt `json:"operSt"`
+	IfItems struct {
+		IfList []*ISISIfOperItems `json:"If-list"`
+	} `json:"if-items"`
+	LvlItems struct {
+		LevelList []*ISISLevelOperItems `json:"Level-list"`
+	} `json:"lvl-items"`
+}
+
+func (*ISISOperItems) IsListItem() {}
+
+func (o *ISISOperItems) XPath() string {
+	return "System/isis-items/inst-items/Inst-list[name=" + o.Name + "]/dom-items/Dom-list[name=" + DefaultVRFName + "]"
+}
+
+type ISISIfOperItems struct {
+	ID       string `json:"id"`
+	AdjItems struct {
+		AdjList []*ISISAdjEp `json:"AdjEp-list,omitzero"`
+	} `json:"adj-items,omitzero"`
+}
+
+type ISISAdjEp struct {
+	SysID       string        `json:"sysId"`       // System ID of the neighbor
+	Level       ISISLevel     `json:"level"`       // Level of the adjacency
+	OperSt      ISISAdjOperSt `json:"operSt"`      // Adjacency state
+	LastStChgTs time.Time     `json:"lastStChgTs"` // Timestamp of the last state change
+}
+
+type ISISAdjOperSt string
+
+const (
+	ISISAdjOperStUnknown ISISAdjOperSt = "unknown"
+	ISISAdjOperStDown    ISISAdjOperSt = "down"
+	ISISAdjOperStInit    ISISAdjOperSt = "init"
+	ISISAdjOperStUp      ISISAdjOperSt = "up"
+)
+
+func (s ISISAdjOperSt) ToAdjacencyState() v1alpha1.ISISAdjacencyState {
+	switch s {
+	case ISISAdjOperStDown:
+		return v1alpha1.ISISAdjacencyStateDown
+	case ISISAdjOperStInit:
+		return v1alpha1.ISISAdjacencyStateInitializing
+	case ISISAdjOperStUp:
+		return v1alpha1.ISISAdjacencyStateUp
+	default:
+		return v1alpha1.ISISAdjacencyStateUnknown
+	}
+}
+
+type ISISLevelOperItems struct {
+	CktT    ISISLevel `json:"cktT"`
+	DBItems struct {
+		DBList []*struct {
+			LspItems struct {
+				LspList []*struct {
+					LspID string `json:"lspId"`
+				} `json:"LspRec-list,omitzero"`
+			} `json:"lsp-items,omitzero"`
+		} `json:"Db-list,omitzero"`
+	} `json:"db-items,omitzero"`
+}
+
+// LSPCount returns the number of LSPs in the link-state database of the level.
+func (l *ISISLevelOperItems) LSPCount() int32 {
+	var n int32
+	for _, db := range l.DBItems.DBList {
+		n += int32(len(db.LspItems.LspList)) // #nosec G115
+	}
+	return n
+}
+
+// ToISISLevel converts the NX-OS level to the corresponding API level.
+func (l ISISLevel) ToISISLevel() v1alpha1.ISISLevel {
+	switch l {
+	case ISISLevel1:
+		return v1alpha1.ISISLevel1
+	case ISISLevel2:
+		return v1alpha1.ISISLevel2
+	default:
+		return v1alpha1.ISISLevel12
+	}
+}
@@ -3,6 +3,14 @@
 
 package nxos
 
+import (
+	"testing"
+	"time"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
 func init() {
 	dom := &ISISDom{
 		Name:        DefaultVRFName,
@@ -26,3 +34,61 @@ func init() {
 	isis.DomItems.DomList.Set(dom)
 	Register("isis", isis)
 }
+
+func TestProvider_GetISISStatus(t *testing.T) {
+	st := &ISISOperItems{Name: "UNDERLAY"}
+	c := &fakeClient{config: map[string]string{
+		st.XPath(): `{"name":"default","operSt":"up","if-items":{"If-list":[` +
+			`{"id":"eth1/1","adj-items":{"AdjEp-list":[{"sysId":"0000.0000.0020","level":"l2","operSt":"up","lastStChgTs":"2025-01-01T12:00:00Z"}]}},` +
+			`{"id":"eth1/2"},` +
+			`{"id":"eth1/3","adj-items":{"AdjEp-list":[{"sysId":"0000.0000.0030","level":"l2","operSt":"up","lastStChgTs":"2025-01-01T12:00:00Z"}]}}` +
+			`]}}`,
+	}}
+	p := &Provider{client: c}
+
+	isis := &v1alpha1.ISIS{}
+	isis.Spec.Instance = "UNDERLAY"
+	eth1 := &v1alpha1.Interface{}
+	eth1.Spec.Name = "Ethernet1/1"
+	eth2 := &v1alpha1.Interface{}
+	eth2.Spec.Name = "Ethernet1/2"
+
+	status, err := p.GetISISStatus(t.Context(), &provider.ISISStatusRequest{
+		ISIS:       isis,
+		Interfaces: []*v1alpha1.Interface{eth1, eth2},
+	})
+	if err != nil {
+		t.Fatalf("GetISISStatus() error = %v", err)
+	}
+	if !status.OperStatus {
+		t.Error("GetISISStatus() OperStatus = false, want true")
+	}
+	if len(status.Adjacencies) != 1 {
+		t.Fatalf("GetISISStatus() returned %d adjacencies, want 1", len(status.Adjacencies))
+	}
+	want := provider.ISISAdjacency{
+		SystemID:           "0000.0000.0020",
+		Interface:          eth1,
+		Level:              v1alpha1.ISISLevel2,
+		LastTransitionTime: time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC),
+		State:              v1alpha1.ISISAdjacencyStateUp,
+	}
+	if got := status.Adjacencies[0]; got != want {
+		t.Errorf("GetISISStatus() adjacency = %+v, want %+v", got, want)
+	}
+}
+
+func TestProvider_GetISISStatus_NotConfigured(t *testing.T) {
+	p := &Provider{client: &fakeClient{config: map[string]string{}}}
+
+	isis := &v1alpha1.ISIS{}
+	isis.Spec.Instance = "UNDERLAY"
+
+	status, err := p.GetISISStatus(t.Context(), &provider.ISISStatusRequest{ISIS: isis})
+	if err != nil {
+		t.Fatalf("GetISISStatus() error = %v", err)
+	}
+	if status.OperStatus || len(status.Adjacencies) != 0 {
+		t.Errorf("GetISISStatus() = %+v, want no adjacencies and down", status)
+	}
+}
@@ -3,7 +3,10 @@
 
 package nxos
 
-import "github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+import (
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+)
 
 var (
 	_ gnmiext.DataElement = (*NTP)(nil)
@@ -49,3 +52,11 @@ func (p *NTPProvider) Key() string { return p.Name }
 	ProvTypePeer   ProvType = "peer"
 	ProvTypeServer ProvType = "server"
 )
+
+// ProvTypeFrom converts the NTP server mode to the corresponding provider type.
+func ProvTypeFrom(mode v1alpha1.NTPServerMode) ProvType {
+	if mode == v1alpha1.NTPServerModePeer {
+		return ProvTypePeer
+	}
+	return ProvTypeServer
+}
@@ -3,6 +3,12 @@
 
 package nxos
 
+import (
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+)
+
 func init() {
 	ntp := &NTP{AdminSt: AdminStEnabled, Logging: AdminStEnabled}
 	ntp.ProvItems.NtpProviderList.Set(&NTPProvider{
@@ -16,4 +22,54 @@ func init() {
 	})
 	ntp.SrcIfItems.SrcIf = "mgmt0"
 	Register("ntp", ntp)
+
+	peer := &NTP{AdminSt: AdminStEnabled, Logging: AdminStDisabled}
+	peer.ProvItems.NtpProviderList.Set(&NTPProvider{
+		MaxPoll: 6,
+		MinPoll: 4,
+		Name:    "10.0.0.1",
+		ProvT:   ProvTypeFrom(v1alpha1.NTPServerModeServer),
+		Vrf:     DefaultVRFName,
+	})
+	peer.ProvItems.NtpProviderList.Set(&NTPProvider{
+		MaxPoll: 6,
+		MinPoll: 4,
+		Name:    "10.0.0.2",
+		ProvT:   ProvTypeFrom(v1alpha1.NTPServerModePeer),
+		Vrf:     DefaultVRFName,
+	})
+	peer.SrcIfItems.SrcIf = "lo0"
+	Register("ntp_peer", peer)
+}
+
+func TestProvTypeFrom(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     v1alpha1.NTPServerMode
+		expected ProvType
+	}{
+		{
+			name:     "unset defaults to server",
+			mode:     "",
+			expected: ProvTypeServer,
+		},
+		{
+			name:     "server",
+			mode:     v1alpha1.NTPServerModeServer,
+			expected: ProvTypeServer,
+		},
+		{
+			name:     "peer",
+			mode:     v1alpha1.NTPServerModePeer,
+			expected: ProvTypePeer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ProvTypeFrom(tt.mode); got != tt.expected {
+				t.Errorf("ProvTypeFrom(%q) = %q, want %q", tt.mode, got, tt.expected)
+			}
+		})
+	}
 }
@@ -5,8 +5,11 @@
 
 import (
 	"encoding/json"
+	"fmt"
+	"net"
 	"strconv"
 
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
 	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
 )
 
@@ -14,20 +17,23 @@
 	_ gnmiext.DataElement = (*NVE)(nil)
 	_ gnmiext.DataElement = (*NVEInfraVLANs)(nil)
 	_ gnmiext.DataElement = (*FabricFwd)(nil)
+	_ gnmiext.DataElement = (*VNIIngRepl)(nil)
+	_ gnmiext.DataElement = (*VNIMember)(nil)
 )
 
 // NVE represents the Network Virtualization Edge interface (nve1).
 // Note: NXOS only supports a single NVE interface with epId=1.
 type NVE struct {
-	AdminSt          AdminSt        `json:"adminSt"`
-	AdvertiseVmac    bool           `json:"advertiseVmac"`
-	SourceInterface  string         `json:"sourceInterface,omitempty"`
-	AnycastInterface Option[string] `json:"anycastIntf"`
-	HoldDownTime     uint16         `json:"holdDownTime"`
-	HostReach        HostReachType  `json:"hostReach"`
-	McastGroupL2     Option[string] `json:"mcastGroupL2"`
-	McastGroupL3     Option[string] `json:"mcastGroupL3"`
-	SuppressARP      bool           `json:"suppressARP"`
+	AdminSt             AdminSt        `json:"adminSt"`
+	AdvertiseVmac       bool           `json:"advertiseVmac"`
+	SourceInterface     string         `json:"sourceInterface,omitempty"`
+	AnycastInterface    Option[string] `json:"anycastIntf"`
+	HoldDownTime        uint16         `json:"holdDownTime"`
+	HostReach           HostReachType  `json:"hostReach"`
+	IngressReplProtoBGP bool           `json:"ingressReplProtoBGP"`
+	McastGroupL2        Option[string] `json:"mcastGroupL2"`
+	McastGroupL3        Option[string] `json:"mcastGroupL3"`
+	SuppressARP         bool           `json:"suppressARP"`
 }
 
 var _ json.Marshaler = (*NVE)(nil)
@@ -68,6 +74,9 @@ type VNI struct {
 	AssociateVrfFlag bool           `json:"associateVrfFlag"`
 	McastGroup       Option[string] `json:"mcastGroup"`
 	Vni              int32          `json:"vni"`
+	IngReplItems     struct {
+		Proto IngReplProto `json:"proto,omitempty"`
+	} `json:"IngRepl-items,omitzero"`
 }
 
 func (*VNI) IsListItem() {}
@@ -76,9 +85,54 @@ func (v *VNI) XPath() string {
 	return "System/eps-items/epId-items/Ep-list[epId=1]/nws-items/vni-items/Nw-list[vni=" + strconv.FormatInt(int64(v.Vni), 10) + "]"
 }
 
+// VNIIngRepl represents the ingress replication settings of a VNI on the NVE.
+type VNIIngRepl struct {
+	Vni int32 `json:"-"`
+}
+
+func (v *VNIIngRepl) XPath() string {
+	return (&VNI{Vni: v.Vni}).XPath() + "/IngRepl-items"
+}
+
+// VNIMember represents the settings of a VNI that are configured through the members of the NVE.
+// It shares the model with [VNI] and must only be patched, so that the settings
+// managed by the EVPN instance of the VNI are preserved.
+type VNIMember struct {
+	McastGroup   string         `json:"mcastGroup,omitempty"`
+	SuppressARP  VNISuppressARP `json:"suppressARP"`
+	Vni          int32          `json:"vni"`
+	IngReplItems struct {
+		Proto IngReplProto `json:"proto,omitempty"`
+	} `json:"IngRepl-items,omitzero"`
+}
+
+func (*VNIMember) IsListItem() {}
+
+func (v *VNIMember) XPath() string {
+	return (&VNI{Vni: v.Vni}).XPath()
+}
+
+// VNISuppressARP overrides the ARP suppression setting of the NVE for a single VNI.
+type VNISuppressARP string
+
+const (
+	VNISuppressARPEnabled  VNISuppressARP = "enabled"
+	VNISuppressARPDisabled VNISuppressARP = "disabled"
+	// VNISuppressARPOff inherits the ARP suppression setting of the NVE.
+	VNISuppressARPOff VNISuppressARP = "off"
+)
+
+// IngReplProto is the protocol used for ingress replication of BUM traffic of a VNI.
+type IngReplProto string
+
+const (
+	IngReplProtoBGP    IngReplProto = "bgp"
+	IngReplProtoStatic IngReplProto = "static"
+)
+
 type VNIOperItems struct {
-	Vni   int32  `json:"vni"`
-	State OperSt `json:"state"`
+	Vni   int32    `json:"vni"`
+	State VNIState `json:"state"`
 }
 
 func (v *VNIOperItems) XPath() string {
@@ -106,11 +160,22 @@ type NVEInfraVLAN struct {
 
 func (*NVEInfraVLAN) IsListItem() {}
 
-// NVEOper represents the operational state of the NVE interface.
+// NVEOper represents the operational state of the NVE interface, including
+// the dynamically discovered VTEP peers and the operational state of the VNIs.
 // Note: NXOS also returns the Operational status of the associated interfaces,
 // but those are not included here.
 type NVEOper struct {
-	OperSt OperSt `json:"operState"`
+	OperSt     OperSt `json:"operState"`
+	PeersItems struct {
+		DyPeerItems struct {
+			PeerList []*NVEPeerOper `json:"DyPeer-list,omitzero"`
+		} `json:"dy_peer-items,omitzero"`
+	} `json:"peers-items,omitzero"`
+	NwsItems struct {
+		OperVniItems struct {
+			OperNwList []*VNIOperItems `json:"OperNw-list,omitzero"`
+		} `json:"opervni-items,omitzero"`
+	} `json:"nws-items,omitzero"`
 }
 
 func (n *NVEOper) XPath() string {
@@ -119,6 +184,31 @@ func (n *NVEOper) XPath() string {
 
 func (*NVEOper) IsListItem() {}
 
+// NVEPeerOper represents a VTEP peer that was discovered dynamically, e.g. via BGP EVPN.
+type NVEPeerOper struct {
+	IP    string       `json:"ip"`
+	State NVEPeerState `json:"state"`
+}
+
+// NVEPeerState is the state of a dynamically discovered VTEP peer.
+type NVEPeerState string
+
+const (
+	NVEPeerStateUp   NVEPeerState = "Up"
+	NVEPeerStateDown NVEPeerState = "Down"
+)
+
+func (s NVEPeerState) ToNVEPeerState() v1alpha1.NVEPeerState {
+	switch s {
+	case NVEPeerStateDown:
+		return v1alpha1.NVEPeerStateDown
+	case NVEPeerStateUp:
+		return v1alpha1.NVEPeerStateUp
+	default:
+		return v1alpha1.NVEPeerStateUnknown
+	}
+}
+
 // FabricFwd represents the fabric forwarding settings required for NVE operation.
 // Should use only PATCH operations: `FabricFwdIf` also modifies this model.
 type FabricFwd struct {
@@ -133,3 +223,22 @@ func (*FabricFwd) XPath() string {
 }
 
 func (*FabricFwd) IsListItem() {}
+
+// validateAnycastGatewayMAC checks that the given address is a well-formed MAC-48 unicast address,
+// as required for the anycast gateway MAC shared by all SVIs in the fabric.
+func validateAnycastGatewayMAC(s string) error {
+	mac, err := net.ParseMAC(s)
+	if err != nil {
+		return err
+	}
+	if len(mac) != 6 {
+		return fmt.Errorf("address %q is not a MAC-48 address", s)
+	}
+	if mac[0]&0x01 != 0 {
+		return fmt.Errorf("address %q is a multicast address", s)
+	}
+	if [6]byte(mac) == [6]byte{} {
+		return fmt.Errorf("address %q is the zero address", s)
+	}
+	return nil
+}
@@ -3,6 +3,17 @@
 
 package nxos
 
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
 func init() {
 	nve := &NVE{
 		AdminSt:          AdminStEnabled,
@@ -22,6 +33,14 @@ func init() {
 		McastGroup: NewOption("239.1.1.100"),
 	}
 	Register("vni", vni)
+
+	member := &VNIMember{
+		Vni:         100010,
+		SuppressARP: VNISuppressARPEnabled,
+	}
+	member.IngReplItems.Proto = IngReplProtoBGP
+	Register("vni_member", member)
+
 	nveInfraVLANs := &NVEInfraVLANs{
 		InfraVLANList: []*NVEInfraVLAN{
 			{ID: 4052},
@@ -36,3 +55,213 @@ func init() {
 	}
 	Register("fabric_forward", ffw)
 }
+
+func TestProvider_EnsureNVE_AnycastGateway(t *testing.T) {
+	tests := []struct {
+		name    string
+		mac     string
+		wantErr bool
+	}{
+		{name: "unicast", mac: "00:00:5E:00:01:01"},
+		{name: "multicast", mac: "01:00:5E:00:01:01", wantErr: true},
+		{name: "zero", mac: "00:00:00:00:00:00", wantErr: true},
+		{name: "malformed", mac: "00:00:5E:00:01", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			nve := &v1alpha1.NetworkVirtualizationEdge{}
+			nve.Spec.HostReachability = v1alpha1.HostReachabilityTypeBGP
+			nve.Spec.AnycastGateway = &v1alpha1.AnycastGateway{VirtualMAC: test.mac}
+			lo := &v1alpha1.Interface{}
+			lo.Spec.Name = "lo0"
+
+			err := p.EnsureNVE(context.Background(), &provider.NVERequest{NVE: nve, SourceInterface: lo})
+			if test.wantErr {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != "spec.anycastGateway.virtualMAC" {
+					t.Fatalf("EnsureNVE() error = %v, want violation of spec.anycastGateway.virtualMAC", err)
+				}
+				if len(c.config) != 0 {
+					t.Errorf("EnsureNVE() configured device despite error: %v", c.config)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureNVE() error = %v", err)
+			}
+
+			f := &Feature{Name: "hmm"}
+			if err := json.Unmarshal([]byte(c.config[f.XPath()]), f); err != nil || f.AdminSt != AdminStEnabled {
+				t.Errorf("EnsureNVE() did not enable feature hmm: %v", err)
+			}
+			ffw := new(FabricFwd)
+			if err := json.Unmarshal([]byte(c.config[ffw.XPath()]), ffw); err != nil {
+				t.Fatalf("EnsureNVE() did not configure fabric forwarding: %v", err)
+			}
+			if ffw.AdminSt != AdminStEnabled || ffw.Address != test.mac {
+				t.Errorf("EnsureNVE() fabric forwarding = %+v, want enabled with amac %s", ffw, test.mac)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureNVE_ReplicationMode(t *testing.T) {
+	c := &fakeClient{config: map[string]string{}}
+	p := &Provider{client: c}
+
+	nve := &v1alpha1.NetworkVirtualizationEdge{}
+	nve.Spec.HostReachability = v1alpha1.HostReachabilityTypeBGP
+	nve.Spec.ReplicationMode = v1alpha1.ReplicationModeIngressReplication
+	lo := &v1alpha1.Interface{}
+	lo.Spec.Name = "lo0"
+
+	if err := p.EnsureNVE(context.Background(), &provider.NVERequest{NVE: nve, SourceInterface: lo}); err != nil {
+		t.Fatalf("EnsureNVE() error = %v", err)
+	}
+
+	got := new(NVE)
+	if err := json.Unmarshal([]byte(c.config[got.XPath()]), got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !got.IngressReplProtoBGP {
+		t.Error("EnsureNVE() did not enable global ingress replication through BGP")
+	}
+}
+
+func TestProvider_EnsureNVE_Members(t *testing.T) {
+	enabled := true
+	tests := []struct {
+		name      string
+		member    provider.NVEMember
+		want      *VNIMember
+		wantField string
+	}{
+		{
+			name:   "multicast group",
+			member: provider.NVEMember{VNI: 100010, MulticastGroup: "239.1.1.100"},
+			want:   &VNIMember{Vni: 100010, McastGroup: "239.1.1.100", SuppressARP: VNISuppressARPOff},
+		},
+		{
+			name:   "ingress replication",
+			member: provider.NVEMember{VNI: 100010, IngressReplication: provider.IngressReplicationProtocolBGP, SuppressARP: &enabled},
+			want: func() *VNIMember {
+				v := &VNIMember{Vni: 100010, SuppressARP: VNISuppressARPEnabled}
+				v.IngReplItems.Proto = IngReplProtoBGP
+				return v
+			}(),
+		},
+		{
+			name:      "unicast group",
+			member:    provider.NVEMember{VNI: 100010, MulticastGroup: "10.0.0.1"},
+			wantField: "vnis[0].multicastGroup",
+		},
+		{
+			name:      "IPv6 group",
+			member:    provider.NVEMember{VNI: 100010, MulticastGroup: "ff0e::1"},
+			wantField: "vnis[0].multicastGroup",
+		},
+		{
+			name:      "group and ingress replication",
+			member:    provider.NVEMember{VNI: 100010, MulticastGroup: "239.1.1.100", IngressReplication: provider.IngressReplicationProtocolStatic},
+			wantField: "vnis[0].ingressReplication",
+		},
+		{
+			name:      "vni out of range",
+			member:    provider.NVEMember{VNI: 0, IngressReplication: provider.IngressReplicationProtocolBGP},
+			wantField: "vnis[0].vni",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			nve := &v1alpha1.NetworkVirtualizationEdge{}
+			nve.Spec.HostReachability = v1alpha1.HostReachabilityTypeBGP
+			lo := &v1alpha1.Interface{}
+			lo.Spec.Name = "lo0"
+
+			err := p.EnsureNVE(context.Background(), &provider.NVERequest{
+				NVE:             nve,
+				SourceInterface: lo,
+				VNIs:            []provider.NVEMember{test.member},
+			})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureNVE() error = %v, want violation of %s", err, test.wantField)
+				}
+				if len(c.config) != 0 {
+					t.Errorf("EnsureNVE() configured device despite error: %v", c.config)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureNVE() error = %v", err)
+			}
+
+			got, ok := c.config[test.want.XPath()]
+			if !ok {
+				t.Fatalf("EnsureNVE() did not configure VNI member %d", test.member.VNI)
+			}
+			want, err := json.Marshal(test.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != string(want) {
+				t.Errorf("EnsureNVE() member = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestProvider_GetNVEStatus(t *testing.T) {
+	c := &fakeClient{config: map[string]string{
+		(&NVEOper{}).XPath(): `{"operState":"up","sourceInterface":"lo0","hostReach":"bgp",` +
+			`"peers-items":{"dy_peer-items":{"DyPeer-list":[{"ip":"10.0.0.2","state":"Up"},{"ip":"10.0.0.3","state":"Down"}]}},` +
+			`"nws-items":{"opervni-items":{"OperNw-list":[{"vni":100010,"state":"Up"},{"vni":100020,"state":"Down"}]}}}`,
+	}}
+	p := &Provider{client: c}
+
+	status, err := p.GetNVEStatus(t.Context(), &provider.NVERequest{NVE: &v1alpha1.NetworkVirtualizationEdge{}})
+	if err != nil {
+		t.Fatalf("GetNVEStatus() error = %v", err)
+	}
+	if !status.OperStatus {
+		t.Error("GetNVEStatus() OperStatus = false, want true")
+	}
+	if status.SourceInterfaceName != "lo0" {
+		t.Errorf("GetNVEStatus() SourceInterfaceName = %q, want %q", status.SourceInterfaceName, "lo0")
+	}
+
+	wantPeers := []provider.NVEPeer{
+		{Address: "10.0.0.2", State: v1alpha1.NVEPeerStateUp},
+		{Address: "10.0.0.3", State: v1alpha1.NVEPeerStateDown},
+	}
+	if !slices.Equal(status.Peers, wantPeers) {
+		t.Errorf("GetNVEStatus() Peers = %+v, want %+v", status.Peers, wantPeers)
+	}
+
+	wantVNIs := []provider.NVEVNIStatus{
+		{VNI: 100010, OperStatus: true},
+		{VNI: 100020, OperStatus: false},
+	}
+	if !slices.Equal(status.VNIs, wantVNIs) {
+		t.Errorf("GetNVEStatus() VNIs = %+v, want %+v", status.VNIs, wantVNIs)
+	}
+}
+
+func TestProvider_GetNVEStatus_NotConfigured(t *testing.T) {
+	p := &Provider{client: &fakeClient{config: map[string]string{}}}
+
+	status, err := p.GetNVEStatus(t.Context(), &provider.NVERequest{NVE: &v1alpha1.NetworkVirtualizationEdge{}})
+	if err != nil {
+		t.Fatalf("GetNVEStatus() error = %v", err)
+	}
+	if status.OperStatus || len(status.Peers) != 0 || len(status.VNIs) != 0 {
+		t.Errorf("GetNVEStatus() = %+v, want zero status", status)
+	}
+}
@@ -40,6 +40,7 @@ type OSPFDom struct {
 	BwRefUnit         BwRefUnit         `json:"bwRefUnit"`
 	Ctrl              string            `json:"ctrl,omitempty"`
 	Dist              int16             `json:"dist"`
+	MaxEcmp           uint8             `json:"maxEcmp,omitempty"`
 	Name              string            `json:"name"`
 	RtrID             string            `json:"rtrId"`
 	IfItems           struct {
@@ -186,6 +187,7 @@ func (s AdjOperSt) ToNeighborState() v1alpha1.OSPFNeighborState {
 const (
 	RtLeakProtoStatic RtLeakProto = "static"
 	RtLeakProtoDirect RtLeakProto = "direct"
+	RtLeakProtoOSPF   RtLeakProto = "ospf"
 )
 
 type NtwType string
@@ -3,7 +3,17 @@
 
 package nxos
 
-import "strings"
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
 
 func init() {
 	dom := &OSPFDom{
@@ -38,4 +48,71 @@ func init() {
 	ospf := &OSPF{Name: "UNDERLAY", AdminSt: AdminStEnabled}
 	ospf.DomItems.DomList.Set(dom)
 	Register("ospf", ospf)
+
+	ecmpDom := &OSPFDom{
+		Name:              DefaultVRFName,
+		AdjChangeLogLevel: AdjChangeLogLevelNone,
+		AdminSt:           AdminStEnabled,
+		BwRef:             40000,
+		BwRefUnit:         BwRefUnitMbps,
+		Dist:              110,
+		MaxEcmp:           16,
+		RtrID:             "10.0.0.10",
+	}
+	ospfEcmp := &OSPF{Name: "UNDERLAY", AdminSt: AdminStEnabled}
+	ospfEcmp.DomItems.DomList.Set(ecmpDom)
+	Register("ospf_max_paths", ospfEcmp)
+}
+
+func TestProvider_DeleteOSPF(t *testing.T) {
+	const (
+		feature = "System/fm-items/ospf-items"
+		xpath   = "System/ospf-items/inst-items/Inst-list[name=UNDERLAY]"
+	)
+
+	tests := []struct {
+		name        string
+		config      map[string]string
+		wantDeleted []string
+	}{
+		{
+			name: "existing process",
+			config: map[string]string{
+				feature: `{"adminSt":"enabled"}`,
+				xpath:   `{"adminSt":"enabled","name":"UNDERLAY"}`,
+			},
+			wantDeleted: []string{xpath},
+		},
+		{
+			name: "missing process",
+			config: map[string]string{
+				feature: `{"adminSt":"enabled"}`,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: test.config}
+			p := &Provider{client: c}
+
+			err := p.DeleteOSPF(context.Background(), &provider.DeleteOSPFRequest{
+				OSPF: &v1alpha1.OSPF{
+					ObjectMeta: metav1.ObjectMeta{Name: "ospf"},
+					Spec:       v1alpha1.OSPFSpec{Instance: "UNDERLAY"},
+				},
+			})
+			if err != nil {
+				t.Fatalf("DeleteOSPF() error = %v", err)
+			}
+			if !slices.Equal(c.deleted, test.wantDeleted) {
+				t.Errorf("DeleteOSPF() deleted = %v, want %v", c.deleted, test.wantDeleted)
+			}
+			if _, ok := c.config[xpath]; ok {
+				t.Errorf("DeleteOSPF() process still configured")
+			}
+			if _, ok := c.config[feature]; !ok {
+				t.Errorf("DeleteOSPF() ospf feature removed")
+			}
+		})
+	}
 }
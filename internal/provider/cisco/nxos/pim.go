@@ -3,7 +3,11 @@
 
 package nxos
 
-import "github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+import (
+	"time"
+
+	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+)
 
 var (
 	_ gnmiext.DataElement = (*PIM)(nil)
@@ -13,6 +17,8 @@
 	_ gnmiext.DataElement = (*StaticRPGrp)(nil)
 	_ gnmiext.DataElement = (*AnycastPeerItems)(nil)
 	_ gnmiext.DataElement = (*PIMIfItems)(nil)
+	_ gnmiext.DataElement = (*PIMBSRItems)(nil)
+	_ gnmiext.DataElement = (*PIMAutoRPItems)(nil)
 )
 
 type PIM struct {
@@ -110,8 +116,10 @@ func (*PIMIfItems) XPath() string {
 }
 
 type PIMIf struct {
-	ID            string `json:"id"`
-	PimSparseMode bool   `json:"pimSparseMode"`
+	ID            string  `json:"id"`
+	PimSparseMode bool    `json:"pimSparseMode"`
+	HelloItvl     uint32  `json:"helloItvl,omitempty"`
+	DrPrio        *uint32 `json:"drPrio,omitempty"`
 }
 
 func (*PIMIf) IsListItem() {}
@@ -121,3 +129,76 @@ func (i *PIMIf) Key() string { return i.ID }
 func (i *PIMIf) XPath() string {
 	return "System/pim-items/inst-items/dom-items/Dom-list[name=default]/if-items/If-list[id=" + i.ID + "]"
 }
+
+const (
+	// MinPIMHelloInterval is the minimum PIM hello interval supported by the device.
+	MinPIMHelloInterval = time.Second
+	// MaxPIMHelloInterval is the maximum PIM hello interval supported by the device.
+	MaxPIMHelloInterval = 18724286 * time.Millisecond
+)
+
+const (
+	// DefaultPIMBSRPriority is the default priority of a candidate bootstrap router.
+	DefaultPIMBSRPriority = 64
+	// DefaultPIMBSRHashLen is the default hash mask length of a candidate bootstrap router.
+	DefaultPIMBSRHashLen = 30
+	// DefaultPIMRPCandPriority is the default priority of a candidate rendezvous point announced to the BSR.
+	DefaultPIMRPCandPriority = 192
+)
+
+// PIMBSRItems represents the bootstrap router (BSR) configuration in PIM.
+type PIMBSRItems struct {
+	Listen       bool        `json:"listen"`
+	Fwd          bool        `json:"fwd"`
+	BSRCandItems *PIMBSRCand `json:"bsrcand-items,omitempty"`
+	RPCandItems  struct {
+		RPCandList gnmiext.List[string, *PIMRPCand] `json:"RPCand-list,omitzero"`
+	} `json:"rpcand-items,omitzero"`
+}
+
+func (*PIMBSRItems) XPath() string {
+	return "System/pim-items/inst-items/dom-items/Dom-list[name=default]/bsr-items"
+}
+
+// PIMBSRCand represents a candidate bootstrap router.
+type PIMBSRCand struct {
+	Source  string `json:"source"`
+	Prio    uint8  `json:"prio"`
+	HashLen uint8  `json:"hashLen"`
+}
+
+// PIMRPCand represents a candidate rendezvous point announced to the bootstrap router for a group range.
+type PIMRPCand struct {
+	Source  string `json:"source"`
+	GrpList string `json:"grpList"`
+	Prio    uint8  `json:"prio"`
+}
+
+func (c *PIMRPCand) Key() string { return c.GrpList }
+
+// PIMAutoRPItems represents the Auto-RP configuration in PIM.
+type PIMAutoRPItems struct {
+	Listen     bool `json:"listen"`
+	Fwd        bool `json:"fwd"`
+	RPAnnItems struct {
+		RPAnnList gnmiext.List[string, *PIMAutoRPAnn] `json:"RPAnn-list,omitzero"`
+	} `json:"rpann-items,omitzero"`
+	MAItems *PIMAutoRPMA `json:"ma-items,omitempty"`
+}
+
+func (*PIMAutoRPItems) XPath() string {
+	return "System/pim-items/inst-items/dom-items/Dom-list[name=default]/autorp-items"
+}
+
+// PIMAutoRPAnn represents a candidate rendezvous point announced to the Auto-RP mapping agent for a group range.
+type PIMAutoRPAnn struct {
+	Source  string `json:"source"`
+	GrpList string `json:"grpList"`
+}
+
+func (a *PIMAutoRPAnn) Key() string { return a.GrpList }
+
+// PIMAutoRPMA represents an Auto-RP mapping agent.
+type PIMAutoRPMA struct {
+	Source string `json:"source"`
+}
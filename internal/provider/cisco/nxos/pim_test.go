@@ -3,6 +3,18 @@
 
 package nxos
 
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
 func init() {
 	apItems := new(AnycastPeerItems)
 	apItems.AcastRPPeerList.Set(&AnycastPeerAddr{Addr: "10.0.0.100/32", RpSetAddr: "10.0.0.2/32"})
@@ -12,7 +24,226 @@ func init() {
 	ifItems.IfList.Set(&PIMIf{ID: "eth1/1", PimSparseMode: true})
 	Register("pim_intf", ifItems)
 
+	tuned := new(PIMIfItems)
+	tuned.IfList.Set(&PIMIf{ID: "eth1/1", PimSparseMode: true, HelloItvl: 5000, DrPrio: new(uint32(100))})
+	Register("pim_intf_hello_dr", tuned)
+
 	rp := &StaticRP{Addr: "10.0.0.100/32"}
 	rp.RpgrplistItems.RPGrpListList.Set(&StaticRPGrp{GrpListName: "224.0.0.0/4"})
 	Register("pim_rp", rp)
+
+	bsr := &PIMBSRItems{Listen: true, Fwd: true}
+	bsr.BSRCandItems = &PIMBSRCand{Source: "lo0", Prio: DefaultPIMBSRPriority, HashLen: DefaultPIMBSRHashLen}
+	Register("pim_bsr", bsr)
+
+	rpCand := new(PIMBSRItems)
+	rpCand.RPCandItems.RPCandList.Set(&PIMRPCand{Source: "lo0", GrpList: "239.0.0.0/8", Prio: DefaultPIMRPCandPriority})
+	Register("pim_bsr_rp_cand", rpCand)
+}
+
+func TestPIMIf(t *testing.T) {
+	tests := []struct {
+		name    string
+		intf    provider.PIMInterface
+		want    *PIMIf
+		wantErr bool
+	}{
+		{
+			name: "device defaults",
+			intf: provider.PIMInterface{Mode: v1alpha1.PIMModeSparse},
+			want: &PIMIf{ID: "eth1/1", PimSparseMode: true},
+		},
+		{
+			name: "custom hello interval and DR priority",
+			intf: provider.PIMInterface{Mode: v1alpha1.PIMModeSparse, HelloInterval: 5 * time.Second, DRPriority: new(int64(100))},
+			want: &PIMIf{ID: "eth1/1", PimSparseMode: true, HelloItvl: 5000, DrPrio: new(uint32(100))},
+		},
+		{
+			name: "zero DR priority",
+			intf: provider.PIMInterface{Mode: v1alpha1.PIMModeSparse, DRPriority: new(int64(0))},
+			want: &PIMIf{ID: "eth1/1", PimSparseMode: true, DrPrio: new(uint32(0))},
+		},
+		{
+			name:    "hello interval below minimum",
+			intf:    provider.PIMInterface{Mode: v1alpha1.PIMModeSparse, HelloInterval: 500 * time.Millisecond},
+			wantErr: true,
+		},
+		{
+			name:    "hello interval above maximum",
+			intf:    provider.PIMInterface{Mode: v1alpha1.PIMModeSparse, HelloInterval: MaxPIMHelloInterval + time.Millisecond},
+			wantErr: true,
+		},
+		{
+			name:    "hello interval with fractional milliseconds",
+			intf:    provider.PIMInterface{Mode: v1alpha1.PIMModeSparse, HelloInterval: 1500500 * time.Microsecond},
+			wantErr: true,
+		},
+		{
+			name:    "dense mode",
+			intf:    provider.PIMInterface{Mode: v1alpha1.PIMModeDense},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := pimIf("eth1/1", test.intf)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("pimIf() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("pimIf() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+
+	// Unset parameters must not be sent to the device, so that its defaults apply.
+	b, err := json.Marshal(&PIMIf{ID: "eth1/1", PimSparseMode: true})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `{"id":"eth1/1","pimSparseMode":true}`; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+}
+
+func TestPIMRPDiscovery(t *testing.T) {
+	lo0 := &v1alpha1.Interface{Spec: v1alpha1.InterfaceSpec{Name: "Loopback0", Type: v1alpha1.InterfaceTypeLoopback}}
+
+	tests := []struct {
+		name       string
+		rps        []v1alpha1.RendezvousPoint
+		bsr        *provider.PIMBSR
+		autoRP     *provider.PIMAutoRP
+		wantBSR    *PIMBSRItems
+		wantAutoRP *PIMAutoRPItems
+		wantErr    bool
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "candidate BSR with defaults",
+			bsr: &provider.PIMBSR{
+				PIMBSR: &v1alpha1.PIMBSR{
+					Listen:       true,
+					CandidateBSR: &v1alpha1.PIMCandidateBSR{InterfaceRef: v1alpha1.LocalObjectReference{Name: "lo0"}},
+				},
+				CandidateBSRInterface: lo0,
+			},
+			wantBSR: &PIMBSRItems{
+				Listen:       true,
+				Fwd:          true,
+				BSRCandItems: &PIMBSRCand{Source: "lo0", Prio: DefaultPIMBSRPriority, HashLen: DefaultPIMBSRHashLen},
+			},
+		},
+		{
+			name: "candidate RP with group range",
+			rps: []v1alpha1.RendezvousPoint{{
+				Address:         "10.0.0.100",
+				MulticastGroups: []v1alpha1.IPPrefix{v1alpha1.MustParsePrefix("232.0.0.0/8")},
+			}},
+			bsr: &provider.PIMBSR{
+				PIMBSR: &v1alpha1.PIMBSR{
+					CandidateRP: &v1alpha1.PIMCandidateRP{
+						InterfaceRef:    v1alpha1.LocalObjectReference{Name: "lo0"},
+						MulticastGroups: []v1alpha1.IPPrefix{v1alpha1.MustParsePrefix("239.0.0.0/8")},
+						Priority:        new(int32(10)),
+					},
+				},
+				CandidateRPInterface: lo0,
+			},
+			wantBSR: func() *PIMBSRItems {
+				b := new(PIMBSRItems)
+				b.RPCandItems.RPCandList.Set(&PIMRPCand{Source: "lo0", GrpList: "239.0.0.0/8", Prio: 10})
+				return b
+			}(),
+		},
+		{
+			name: "candidate RP conflicts with static RP",
+			rps:  []v1alpha1.RendezvousPoint{{Address: "10.0.0.100"}},
+			bsr: &provider.PIMBSR{
+				PIMBSR: &v1alpha1.PIMBSR{
+					CandidateRP: &v1alpha1.PIMCandidateRP{
+						InterfaceRef:    v1alpha1.LocalObjectReference{Name: "lo0"},
+						MulticastGroups: []v1alpha1.IPPrefix{v1alpha1.MustParsePrefix("239.0.0.0/8")},
+					},
+				},
+				CandidateRPInterface: lo0,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Auto-RP candidate RP and mapping agent",
+			autoRP: &provider.PIMAutoRP{
+				PIMAutoRP: &v1alpha1.PIMAutoRP{
+					Listen:       true,
+					CandidateRP:  &v1alpha1.PIMCandidateRP{InterfaceRef: v1alpha1.LocalObjectReference{Name: "lo0"}},
+					MappingAgent: &v1alpha1.PIMAutoRPMappingAgent{InterfaceRef: v1alpha1.LocalObjectReference{Name: "lo0"}},
+				},
+				CandidateRPInterface:  lo0,
+				MappingAgentInterface: lo0,
+			},
+			wantAutoRP: func() *PIMAutoRPItems {
+				a := &PIMAutoRPItems{Listen: true, Fwd: true, MAItems: &PIMAutoRPMA{Source: "lo0"}}
+				a.RPAnnItems.RPAnnList.Set(&PIMAutoRPAnn{Source: "lo0", GrpList: "224.0.0.0/4"})
+				return a
+			}(),
+		},
+		{
+			name: "Auto-RP candidate RP with priority",
+			autoRP: &provider.PIMAutoRP{
+				PIMAutoRP: &v1alpha1.PIMAutoRP{
+					CandidateRP: &v1alpha1.PIMCandidateRP{
+						InterfaceRef: v1alpha1.LocalObjectReference{Name: "lo0"},
+						Priority:     new(int32(10)),
+					},
+				},
+				CandidateRPInterface: lo0,
+			},
+			wantErr: true,
+		},
+		{
+			name: "non-multicast group range",
+			bsr: &provider.PIMBSR{
+				PIMBSR: &v1alpha1.PIMBSR{
+					CandidateRP: &v1alpha1.PIMCandidateRP{
+						InterfaceRef:    v1alpha1.LocalObjectReference{Name: "lo0"},
+						MulticastGroups: []v1alpha1.IPPrefix{v1alpha1.MustParsePrefix("10.0.0.0/8")},
+					},
+				},
+				CandidateRPInterface: lo0,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := &provider.EnsurePIMRequest{
+				PIM:    &v1alpha1.PIM{Spec: v1alpha1.PIMSpec{RendezvousPoints: test.rps}},
+				BSR:    test.bsr,
+				AutoRP: test.autoRP,
+			}
+			bsr, autoRP, err := pimRPDiscovery(req)
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if !errors.As(err, new(*apistatus.StatusError)) {
+					t.Errorf("expected API status error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(bsr, test.wantBSR) {
+				t.Errorf("BSR = %+v, want %+v", bsr, test.wantBSR)
+			}
+			if !reflect.DeepEqual(autoRP, test.wantAutoRP) {
+				t.Errorf("Auto-RP = %+v, want %+v", autoRP, test.wantAutoRP)
+			}
+		})
+	}
 }
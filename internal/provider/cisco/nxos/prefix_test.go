@@ -3,6 +3,17 @@
 
 package nxos
 
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
 func init() {
 	p := &PrefixList{}
 	p.Name = "TEST"
@@ -16,3 +27,105 @@ func init() {
 	})
 	Register("prefix", p)
 }
+
+func TestProvider_EnsurePrefixSet(t *testing.T) {
+	const xpath = "System/rpm-items/pfxlistv4-items/RuleV4-list[name=TEST]"
+
+	tests := []struct {
+		name      string
+		entry     v1alpha1.PrefixEntry
+		want      *PrefixEntry
+		wantField string
+	}{
+		{
+			name:  "exact",
+			entry: v1alpha1.PrefixEntry{Sequence: 10, Prefix: v1alpha1.MustParsePrefix("10.0.0.0/8")},
+			want:  &PrefixEntry{Order: 10, Action: ActionPermit, Criteria: CriteriaExact, Pfx: "10.0.0.0/8"},
+		},
+		{
+			name: "ge and le",
+			entry: v1alpha1.PrefixEntry{
+				Sequence:        10,
+				Prefix:          v1alpha1.MustParsePrefix("10.0.0.0/8"),
+				MaskLengthRange: &v1alpha1.MaskLengthRange{Min: 16, Max: 24},
+			},
+			want: &PrefixEntry{Order: 10, Action: ActionPermit, Criteria: CriteriaInexact, Pfx: "10.0.0.0/8", FromPfxLen: 16, ToPfxLen: 24},
+		},
+		{
+			name: "le only",
+			entry: v1alpha1.PrefixEntry{
+				Sequence:        20,
+				Prefix:          v1alpha1.MustParsePrefix("10.0.0.0/8"),
+				MaskLengthRange: &v1alpha1.MaskLengthRange{Min: 8, Max: 32},
+				Action:          v1alpha1.PrefixEntryActionDeny,
+			},
+			want: &PrefixEntry{Order: 20, Action: ActionDeny, Criteria: CriteriaInexact, Pfx: "10.0.0.0/8", ToPfxLen: 32},
+		},
+		{
+			name: "min below prefix length",
+			entry: v1alpha1.PrefixEntry{
+				Sequence:        10,
+				Prefix:          v1alpha1.MustParsePrefix("10.0.0.0/16"),
+				MaskLengthRange: &v1alpha1.MaskLengthRange{Min: 8, Max: 24},
+			},
+			wantField: "spec.entries[0].maskLengthRange",
+		},
+		{
+			name: "max beyond address length",
+			entry: v1alpha1.PrefixEntry{
+				Sequence:        10,
+				Prefix:          v1alpha1.MustParsePrefix("10.0.0.0/8"),
+				MaskLengthRange: &v1alpha1.MaskLengthRange{Min: 8, Max: 64},
+			},
+			wantField: "spec.entries[0].maskLengthRange",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			ps := &v1alpha1.PrefixSet{}
+			ps.Spec.Name = "TEST"
+			ps.Spec.Entries = []v1alpha1.PrefixEntry{test.entry}
+
+			err := p.EnsurePrefixSet(context.Background(), &provider.PrefixSetRequest{PrefixSet: ps})
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsurePrefixSet() error = %v, want violation of %s", err, test.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsurePrefixSet() error = %v", err)
+			}
+
+			got := new(PrefixList)
+			if err := json.Unmarshal([]byte(c.config[xpath]), got); err != nil {
+				t.Fatal(err)
+			}
+			e, ok := got.EntItems.EntryList.Get(test.want.Order)
+			if !ok || *e != *test.want {
+				t.Errorf("EnsurePrefixSet() entry = %+v, want %+v", e, test.want)
+			}
+		})
+	}
+}
+
+func TestProvider_DeletePrefixSet(t *testing.T) {
+	const xpath = "System/rpm-items/pfxlistv6-items/RuleV6-list[name=TEST]"
+	c := &fakeClient{config: map[string]string{xpath: "{}"}}
+	p := &Provider{client: c}
+
+	ps := &v1alpha1.PrefixSet{}
+	ps.Spec.Name = "TEST"
+	ps.Spec.Entries = []v1alpha1.PrefixEntry{{Sequence: 10, Prefix: v1alpha1.MustParsePrefix("2001:db8::/32")}}
+
+	if err := p.DeletePrefixSet(context.Background(), &provider.PrefixSetRequest{PrefixSet: ps}); err != nil {
+		t.Fatalf("DeletePrefixSet() error = %v", err)
+	}
+	if !slices.Equal(c.deleted, []string{xpath}) {
+		t.Errorf("DeletePrefixSet() deleted = %v, want [%s]", c.deleted, xpath)
+	}
+}
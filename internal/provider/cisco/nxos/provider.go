@@ -15,6 +15,7 @@
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"maps"
 	"math"
 	"net/netip"
@@ -27,6 +28,9 @@
 
 	"github.com/go-logr/logr"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	nxv1alpha1 "github.com/ironcore-dev/network-operator/api/cisco/nx/v1alpha1"
 	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
@@ -40,13 +44,26 @@
 
 var (
 	_ provider.Provider                 = (*Provider)(nil)
+	_ provider.PingProvider             = (*Provider)(nil)
 	_ provider.DeviceProvider           = (*Provider)(nil)
+	_ provider.BreakoutProvider         = (*Provider)(nil)
 	_ provider.MaintenanceProvider      = (*Provider)(nil)
+	_ provider.ConfigSaveProvider       = (*Provider)(nil)
+	_ provider.ConfigRevisionProvider   = (*Provider)(nil)
+	_ provider.TransactionProvider      = (*Provider)(nil)
+	_ provider.DeviceEventProvider      = (*Provider)(nil)
+	_ provider.ECMPProvider             = (*Provider)(nil)
+	_ provider.LACPProvider             = (*Provider)(nil)
+	_ provider.HostnameProvider         = (*Provider)(nil)
+	_ provider.DeviceQueryProvider      = (*Provider)(nil)
+	_ provider.RunningConfigProvider    = (*Provider)(nil)
+	_ provider.RunningConfigCLIProvider = (*Provider)(nil)
 	_ provider.ProvisioningProvider     = (*Provider)(nil)
 	_ provider.ACLProvider              = (*Provider)(nil)
 	_ provider.BannerProvider           = (*Provider)(nil)
 	_ provider.BGPProvider              = (*Provider)(nil)
 	_ provider.BGPPeerProvider          = (*Provider)(nil)
+	_ provider.BGPSessionClearProvider  = (*Provider)(nil)
 	_ provider.CertificateProvider      = (*Provider)(nil)
 	_ provider.DNSProvider              = (*Provider)(nil)
 	_ provider.EVPNInstanceProvider     = (*Provider)(nil)
@@ -58,6 +75,7 @@
 	_ provider.PIMProvider              = (*Provider)(nil)
 	_ provider.SNMPProvider             = (*Provider)(nil)
 	_ provider.PrefixSetProvider        = (*Provider)(nil)
+	_ provider.QoSPolicyProvider        = (*Provider)(nil)
 	_ provider.RoutingPolicyProvider    = (*Provider)(nil)
 	_ provider.SyslogProvider           = (*Provider)(nil)
 	_ provider.UserProvider             = (*Provider)(nil)
@@ -68,34 +86,98 @@
 	_ provider.DHCPRelayProvider        = (*Provider)(nil)
 	_ provider.EthernetSegmentProvider  = (*Provider)(nil)
 	_ provider.AAAProvider              = (*Provider)(nil)
+	_ provider.StaticRouteProvider      = (*Provider)(nil)
+	_ provider.SpanningTreeProvider     = (*Provider)(nil)
+	_ provider.RouteLookupProvider      = (*Provider)(nil)
 )
 
 type Provider struct {
+	// connection holds the details the provider was connected with on [Provider.Connect].
+	connection *deviceutil.Connection
+
 	conn   *grpc.ClientConn
 	client gnmiext.Client
 	nxapi  *nxapi.Client
+
+	// version is the NX-OS version of the target device, resolved on [Provider.Connect].
+	version Version
+
+	// maxAttempts is the number of attempts to establish the gNMI session.
+	maxAttempts int
+	// backoff is the initial delay between two attempts to establish the gNMI session.
+	backoff time.Duration
+	// maxPathsPerRequest is the maximum number of paths sent in a single gNMI Set RPC.
+	maxPathsPerRequest int
+
+	// parent is the client of the provider a transaction was started on, see [Provider.Transaction].
+	// It is nil unless the provider is the one passed to the function of a transaction.
+	parent gnmiext.Client
 }
 
 // timeout is the default timeout for all HTTP/gRPC requests made by the provider.
 const timeout = 30 * time.Second
 
-func NewProvider() provider.Provider {
-	return &Provider{}
+// longTimeout is the timeout for gNMI requests on potentially large parts of the configuration,
+// e.g. access control lists with many entries or the entire running configuration.
+const longTimeout = 2 * time.Minute
+
+// ErrDeviceUnavailable is returned by [Provider.Connect] when the device could not be reached
+// within the configured number of connection attempts.
+var ErrDeviceUnavailable = errors.New("device unavailable")
+
+// ProviderOption configures a [Provider].
+type ProviderOption func(*Provider)
+
+// WithConnectRetry configures the provider to retry the gNMI capabilities handshake up to maxAttempts times
+// while the device is unavailable, e.g. during a reboot. The delay between two attempts starts at backoff
+// and doubles with each retry. Retrying stops early once the context deadline would be exceeded.
+func WithConnectRetry(maxAttempts int, backoff time.Duration) ProviderOption {
+	return func(p *Provider) {
+		p.maxAttempts = maxAttempts
+		p.backoff = backoff
+	}
+}
+
+// WithMaxPathsPerRequest configures the provider to send at most n paths in a single gNMI Set RPC,
+// splitting larger configuration changes into multiple requests. By default, the number of paths
+// per request is not limited. See [gnmiext.WithMaxPathsPerRequest].
+func WithMaxPathsPerRequest(n int) ProviderOption {
+	return func(p *Provider) {
+		p.maxPathsPerRequest = n
+	}
+}
+
+func NewProvider(opts ...ProviderOption) provider.Provider {
+	p := &Provider{maxAttempts: 1}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 func (p *Provider) Connect(ctx context.Context, conn *deviceutil.Connection) (err error) {
-	p.conn, err = grpcext.NewClient(conn, grpcext.WithDefaultTimeout(timeout))
+	p.connection = conn
+	p.conn, err = grpcext.NewClient(conn, grpcext.WithDefaultTimeout(timeout), grpcext.WithUserAgent(provider.UserAgent()))
 	if err != nil {
 		return fmt.Errorf("failed to create grpc connection: %w", err)
 	}
-	var opts []gnmiext.Option
+	opts := []gnmiext.Option{
+		gnmiext.WithMetadata(provider.Metadata()...),
+		gnmiext.WithMaxPathsPerRequest(p.maxPathsPerRequest),
+		gnmiext.WithSemaphore(provider.DeviceSemaphore(conn.Address)),
+		gnmiext.WithSetLimiter(provider.DeviceChangeLimiter(conn.Address)),
+	}
 	if logger, err := logr.FromContext(ctx); err == nil && !logger.IsZero() {
 		opts = append(opts, gnmiext.WithLogger(logger))
 	}
-	p.client, err = gnmiext.New(ctx, p.conn, opts...)
+	p.client, err = p.handshake(ctx, p.conn, opts...)
 	if err != nil {
 		return fmt.Errorf("failed to create gnmi client: %w", err)
 	}
+	p.version, err = ResolveVersion(p.client.Capabilities(), conn.CompatibleVersion)
+	if err != nil {
+		return fmt.Errorf("failed to resolve device version: %w", err)
+	}
 	// NXAPI only uses the address for URI construction.
 	c := *conn
 	c.Address = netip.MustParseAddrPort(conn.Address).Addr().String()
@@ -106,10 +188,75 @@ func (p *Provider) Connect(ctx context.Context, conn *deviceutil.Connection) (er
 	return nil
 }
 
+// handshake creates a new gNMI client on the given connection, retrying with exponential backoff
+// as long as the device reports [codes.Unavailable] and the configured attempts are not exhausted.
+func (p *Provider) handshake(ctx context.Context, conn grpc.ClientConnInterface, opts ...gnmiext.Option) (gnmiext.Client, error) {
+	backoff := p.backoff
+	for attempt := 1; ; attempt++ {
+		c, err := gnmiext.New(ctx, conn, opts...)
+		if err == nil {
+			return c, nil
+		}
+		if status.Code(err) != codes.Unavailable {
+			return nil, err
+		}
+		if attempt >= p.maxAttempts {
+			return nil, fmt.Errorf("%w after %d attempt(s): %w", ErrDeviceUnavailable, attempt, err)
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < backoff {
+			return nil, fmt.Errorf("%w: context deadline exceeded after %d attempt(s): %w", ErrDeviceUnavailable, attempt, err)
+		}
+		t := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, fmt.Errorf("%w: %w", ErrDeviceUnavailable, ctx.Err())
+		case <-t.C:
+		}
+		backoff *= 2
+	}
+}
+
+func (p *Provider) WatchDeviceEvents(ctx context.Context, fn func(provider.DeviceEvent)) error {
+	ch, err := p.client.Subscribe(ctx, eventPaths, gnmiext.SubscribeModeOnChange)
+	if err != nil {
+		return err
+	}
+	// The device first sends the current state of all paths, which are not events.
+	synced := false
+	for n := range ch {
+		// An error is only returned if it ended the stream, i.e. if it is the last notification.
+		if err != nil {
+			logr.FromContextOrDiscard(ctx).Error(err, "Failed to decode device events")
+		}
+		if err = n.Err; err != nil {
+			continue
+		}
+		if n.SyncResponse {
+			synced = true
+			continue
+		}
+		if synced {
+			for _, ev := range DeviceEvents(n) {
+				fn(ev)
+			}
+		}
+	}
+	return err
+}
+
+func (p *Provider) NormalizeInterfaceName(name string) (string, error) {
+	return ShortName(name)
+}
+
 func (p *Provider) Disconnect(_ context.Context, _ *deviceutil.Connection) error {
 	return p.conn.Close()
 }
 
+func (p *Provider) Ping(ctx context.Context) error {
+	return p.client.Ping(ctx)
+}
+
 func (p *Provider) HashProvisioningPassword(password string) (hashed, encryptType string, err error) {
 	s := [10]byte{}
 	for {
@@ -140,14 +287,30 @@ func (p *Provider) VerifyProvisioned(ctx context.Context, conn *deviceutil.Conne
 	return true
 }
 
-func (p *Provider) Reboot(ctx context.Context, conn *deviceutil.Connection) error {
-	return Reboot(ctx, p.conn)
+func (p *Provider) Reboot(ctx context.Context, conn *deviceutil.Connection, opts *provider.RebootOptions) error {
+	if opts == nil {
+		opts = new(provider.RebootOptions)
+	}
+	if opts.SaveConfig {
+		if err := p.SaveConfig(ctx); err != nil {
+			return err
+		}
+	}
+	return Reboot(ctx, p.conn, opts.Delay)
 }
 
 func (p *Provider) FactoryReset(ctx context.Context, conn *deviceutil.Connection) error {
 	return FactoryReset(ctx, p.conn)
 }
 
+// SaveConfig copies the running configuration to the startup configuration.
+func (p *Provider) SaveConfig(ctx context.Context) error {
+	if _, err := p.nxapi.Do(ctx, nxapi.NewRequest("copy running-config startup-config")); err != nil {
+		return fmt.Errorf("failed to save running configuration: %w", err)
+	}
+	return nil
+}
+
 func (p *Provider) Reprovision(ctx context.Context, conn *deviceutil.Connection) error {
 	_, err := p.nxapi.Do(ctx, nxapi.NewRequest(
 		"boot poap enable",
@@ -202,6 +365,113 @@ func (p *Provider) ListPorts(ctx context.Context) ([]provider.DevicePort, error)
 	return dp, nil
 }
 
+func (p *Provider) EnsureBreakout(ctx context.Context, port string, mode provider.BreakoutMode) error {
+	name, err := ShortNamePhysicalInterface(port)
+	if err != nil {
+		return err
+	}
+	b, err := NewBreakoutPort(name)
+	if err != nil {
+		return err
+	}
+
+	current, err := p.GetBreakout(ctx, name)
+	if err != nil {
+		return err
+	}
+	if current == mode {
+		return nil
+	}
+	if mode == provider.BreakoutModeNone {
+		return p.client.Delete(ctx, b)
+	}
+
+	m, ok := breakoutMaps[mode]
+	if !ok {
+		return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "mode",
+			Description: fmt.Sprintf("unsupported breakout mode %q", mode),
+		})
+	}
+
+	ports, err := p.ListPorts(ctx)
+	if err != nil {
+		return err
+	}
+	fp, ok := frontPort(ports, name, current)
+	if !ok {
+		return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "port",
+			Description: fmt.Sprintf("port %s not found", name),
+		})
+	}
+	if !fp.SupportsBreakout(mode) {
+		return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "mode",
+			Description: fmt.Sprintf("port %s does not support breakout mode %s, supported speeds (Gbps): %v", name, mode, fp.SupportedSpeedsGbps),
+		})
+	}
+
+	b.BreakoutMap = m
+	return p.Update(ctx, b)
+}
+
+// frontPort returns the front panel port with the given name. Once broken out, the device no longer
+// reports the front panel port itself but its breakout ports, e.g. "eth1/49/1" to "eth1/49/4" for
+// "eth1/49". In that case, the front panel port supports the combined speed of its current breakout
+// mode and of every breakout mode whose port speed is supported by its breakout ports.
+func frontPort(ports []provider.DevicePort, name string, current provider.BreakoutMode) (provider.DevicePort, bool) {
+	if i := slices.IndexFunc(ports, func(dp provider.DevicePort) bool { return dp.ID == name }); i >= 0 {
+		return ports[i], true
+	}
+	var sub []provider.DevicePort
+	for _, dp := range ports {
+		if strings.HasPrefix(dp.ID, name+"/") {
+			sub = append(sub, dp)
+		}
+	}
+	if len(sub) == 0 {
+		return provider.DevicePort{}, false
+	}
+	fp := provider.DevicePort{ID: name, Type: sub[0].Type, Transceiver: sub[0].Transceiver}
+	if n, gbps := current.Ports(); n > 0 {
+		fp.SupportedSpeedsGbps = append(fp.SupportedSpeedsGbps, n*gbps)
+	}
+	for _, mode := range slices.Sorted(maps.Keys(breakoutMaps)) {
+		n, gbps := mode.Ports()
+		if slices.Contains(sub[0].SupportedSpeedsGbps, gbps) && !slices.Contains(fp.SupportedSpeedsGbps, n*gbps) {
+			fp.SupportedSpeedsGbps = append(fp.SupportedSpeedsGbps, n*gbps)
+		}
+	}
+	return fp, true
+}
+
+func (p *Provider) GetBreakout(ctx context.Context, port string) (provider.BreakoutMode, error) {
+	name, err := ShortNamePhysicalInterface(port)
+	if err != nil {
+		return provider.BreakoutModeNone, err
+	}
+	b, err := NewBreakoutPort(name)
+	if err != nil {
+		return provider.BreakoutModeNone, err
+	}
+	if err := p.client.GetConfig(ctx, b); err != nil {
+		if errors.Is(err, gnmiext.ErrNil) {
+			return provider.BreakoutModeNone, nil
+		}
+		return provider.BreakoutModeNone, err
+	}
+	if b.BreakoutMap == BreakoutMapNone || b.BreakoutMap == "" {
+		return provider.BreakoutModeNone, nil
+	}
+	for mode, m := range breakoutMaps {
+		if m == b.BreakoutMap {
+			return mode, nil
+		}
+	}
+	return provider.BreakoutModeNone, fmt.Errorf("breakout: unknown breakout map %q of port %s", b.BreakoutMap, name)
+}
+
 func (p *Provider) GetDeviceInfo(ctx context.Context) (*provider.DeviceInfo, error) {
 	h := new(Hostname)
 	m := new(Model)
@@ -217,11 +487,12 @@ func (p *Provider) GetDeviceInfo(ctx context.Context) (*provider.DeviceInfo, err
 	}
 
 	return &provider.DeviceInfo{
-		Manufacturer:    Manufacturer,
-		Hostname:        string(*h),
-		Model:           string(*m),
-		SerialNumber:    string(*s),
-		FirmwareVersion: string(*fw),
+		Manufacturer:     Manufacturer,
+		Hostname:         string(*h),
+		Model:            string(*m),
+		SerialNumber:     string(*s),
+		FirmwareVersion:  string(*fw),
+		DataModelVersion: ModelRevision(p.client.Capabilities()),
 	}, nil
 }
 
@@ -233,7 +504,141 @@ func (p *Provider) GetLastRebootTime(ctx context.Context) (time.Time, error) {
 	return bt.Time, nil
 }
 
+// GetRunningConfig retrieves the configuration data at the root of the device's data model
+// and writes it to w as canonical JSON, i.e. compact and with the keys of all objects sorted.
+func (p *Provider) GetRunningConfig(ctx context.Context, w io.Writer) error {
+	ctx = gnmiext.WithTimeout(ctx, longTimeout)
+	r := &gnmiext.Raw{Path: "System"}
+	if err := p.client.GetConfig(ctx, r); err != nil {
+		return fmt.Errorf("failed to get running config: %w", err)
+	}
+	if err := canonicalJSON(w, r.Value); err != nil {
+		return fmt.Errorf("failed to canonicalize running config: %w", err)
+	}
+	return nil
+}
+
+// GetRunningConfigCLI retrieves the running configuration of the device via NX-API,
+// as printed by the "show running-config" command.
+func (p *Provider) GetRunningConfigCLI(ctx context.Context) (string, error) {
+	res, err := p.nxapi.Do(ctx, nxapi.NewRequest("show running-config").WithASCII())
+	if err != nil {
+		return "", fmt.Errorf("failed to get running config: %w", err)
+	}
+	if len(res) == 0 {
+		return "", errors.New("failed to get running config: empty response")
+	}
+	var cfg string
+	if err := json.Unmarshal(res[0], &cfg); err != nil {
+		return "", fmt.Errorf("failed to decode running config: %w", err)
+	}
+	return cfg, nil
+}
+
+// configRevisionPrefix prefixes the line of the running configuration with the time of its last change.
+const configRevisionPrefix = "!Running configuration last done at:"
+
+// GetConfigRevision returns the time of the last change of the running configuration, as printed in the
+// header of the "show running-config" command. Only the header line is transferred from the device.
+func (p *Provider) GetConfigRevision(ctx context.Context) (string, error) {
+	res, err := p.nxapi.Do(ctx, nxapi.NewRequest(`show running-config | include "`+configRevisionPrefix+`"`).WithASCII())
+	if err != nil {
+		return "", fmt.Errorf("failed to get running config revision: %w", err)
+	}
+	if len(res) == 0 {
+		return "", errors.New("failed to get running config revision: empty response")
+	}
+	var out string
+	if err := json.Unmarshal(res[0], &out); err != nil {
+		return "", fmt.Errorf("failed to decode running config revision: %w", err)
+	}
+	for line := range strings.Lines(out) {
+		if rev, ok := strings.CutPrefix(strings.TrimSpace(line), configRevisionPrefix); ok {
+			return strings.TrimSpace(rev), nil
+		}
+	}
+	return "", errors.New("failed to get running config revision: time of the last change not found")
+}
+
+// QueryDevice retrieves the configuration or state data at the xpath of the query.
+// The xpath is relative to the root of the device's data model, e.g. "System/intf-items".
+func (p *Provider) QueryDevice(ctx context.Context, req *provider.DeviceQueryRequest) ([]byte, error) {
+	ctx = gnmiext.WithTimeout(ctx, longTimeout)
+	r := &gnmiext.Raw{Path: req.DeviceQuery.Spec.Path}
+	get := p.client.GetState
+	if req.DeviceQuery.Spec.DataType == v1alpha1.DeviceQueryDataTypeConfig {
+		get = p.client.GetConfig
+	}
+	if err := get(ctx, r); err != nil {
+		if errors.Is(err, gnmiext.ErrNil) {
+			return nil, fmt.Errorf("no data found at path %q", r.Path)
+		}
+		return nil, err
+	}
+	return r.Value, nil
+}
+
+// EnsureECMP configures the system-wide maximum number of ECMP next-hops per route.
+// The configured value only becomes effective after a reload of the device, which is
+// reported as required as long as it differs from the value currently in effect. If the
+// value in effect is unknown, no reload is reported as required.
+func (p *Provider) EnsureECMP(ctx context.Context, req *provider.EnsureECMPRequest) (bool, error) {
+	if req.MaximumPaths < 1 || req.MaximumPaths > MaxECMPPaths {
+		return false, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "spec.ecmp.maximumPaths",
+			Description: fmt.Sprintf("maximum paths must be between 1 and %d, got %d", MaxECMPPaths, req.MaximumPaths),
+		})
+	}
+
+	if err := p.Patch(ctx, &SystemECMP{MaxPaths: req.MaximumPaths}); err != nil {
+		return false, err
+	}
+
+	oper := new(SystemECMPOper)
+	if err := p.client.GetState(ctx, oper); err != nil {
+		if errors.Is(err, gnmiext.ErrNil) {
+			return false, nil
+		}
+		return false, err
+	}
+	return *oper != 0 && int32(*oper) != req.MaximumPaths, nil
+}
+
+func (p *Provider) EnsureLACP(ctx context.Context, req *provider.EnsureLACPRequest) error {
+	if req.SystemPriority < 0 || req.SystemPriority > math.MaxUint16 {
+		return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "spec.lacp.systemPriority",
+			Description: fmt.Sprintf("system priority must be between 0 and %d, 0 restores the default, got %d", math.MaxUint16, req.SystemPriority),
+		})
+	}
+	prio := LACPSysPrio(DefaultLACPPriority)
+	if req.SystemPriority != 0 {
+		prio = LACPSysPrio(req.SystemPriority)
+	}
+	return p.Patch(ctx, &prio)
+}
+
+func (p *Provider) EnsureHostname(ctx context.Context, name string) error {
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "spec.hostname",
+			Description: fmt.Sprintf("hostname %q is invalid: %s", name, strings.Join(errs, ", ")),
+		})
+	}
+	h := Hostname(name)
+	return p.Patch(ctx, &h)
+}
+
+func (p *Provider) GetHostname(ctx context.Context) (string, error) {
+	h := new(Hostname)
+	if err := p.client.GetConfig(ctx, h); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		return "", err
+	}
+	return string(*h), nil
+}
+
 func (p *Provider) EnsureACL(ctx context.Context, req *provider.EnsureACLRequest) error {
+	ctx = gnmiext.WithTimeout(ctx, longTimeout)
 	a := new(ACL)
 	a.Name = req.ACL.Spec.Name
 	for i, entry := range req.ACL.Spec.Entries {
@@ -241,28 +646,111 @@ func (p *Provider) EnsureACL(ctx context.Context, req *provider.EnsureACLRequest
 		if err != nil {
 			return err
 		}
-		if i > 0 && entry.SourceAddress.Addr().Is6() != a.Is6 {
+		is6, ok := aclEntryIs6(req.ACL, &req.ACL.Spec.Entries[i])
+		if !ok {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       fmt.Sprintf("spec.entries[%d]", i),
+				Description: "the address family of the entry cannot be determined from its source or destination",
+			})
+		}
+		if i > 0 && is6 != a.Is6 {
 			return errors.New("acl: rule contains both ipv4 and ipv6 rules")
 		}
-		a.Is6 = entry.SourceAddress.Addr().Is6()
-		if entry.SourceAddress.Addr().Is4() != entry.DestinationAddress.Addr().Is4() {
+		a.Is6 = is6
+		if dst6, ok := aclAddressIs6(req.ACL, entry.DestinationAddress, entry.DestinationAddressGroup); ok && dst6 != is6 {
 			return errors.New("acl: rule contains mismatched ip versions in source and destination addresses")
 		}
-		a.SeqItems.ACEList.Set(&ACLEntry{
-			SeqNum:          entry.Sequence,
-			Action:          action,
-			Protocol:        ProtocolFrom(entry.Protocol),
-			SrcPrefix:       entry.SourceAddress.Addr().String(),
-			SrcPrefixLength: entry.SourceAddress.Bits(),
-			DstPrefix:       entry.DestinationAddress.Addr().String(),
-			DstPrefixLength: entry.DestinationAddress.Bits(),
-		})
+		ace := &ACLEntry{
+			SeqNum:       entry.Sequence,
+			Action:       action,
+			Protocol:     ProtocolFrom(entry.Protocol),
+			SrcAddrGroup: entry.SourceAddressGroup,
+			DstAddrGroup: entry.DestinationAddressGroup,
+			SrcPortGroup: entry.SourcePortGroup,
+			DstPortGroup: entry.DestinationPortGroup,
+			Established:  entry.Established,
+			Logging:      entry.Log,
+		}
+		if entry.SourceAddressGroup == "" {
+			ace.SrcPrefix = entry.SourceAddress.Addr().String()
+			ace.SrcPrefixLength = entry.SourceAddress.Bits()
+		}
+		if entry.DestinationAddressGroup == "" {
+			ace.DstPrefix = entry.DestinationAddress.Addr().String()
+			ace.DstPrefixLength = entry.DestinationAddress.Bits()
+		}
+		if entry.Established && ace.Protocol != ProtocolTCP {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       fmt.Sprintf("spec.entries[%d].established", i),
+				Description: fmt.Sprintf("established is only supported for TCP, got protocol %q", entry.Protocol),
+			})
+		}
+		if entry.SourcePort != nil || entry.DestinationPort != nil || entry.SourcePortGroup != "" || entry.DestinationPortGroup != "" {
+			if ace.Protocol != ProtocolTCP && ace.Protocol != ProtocolUDP {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       fmt.Sprintf("spec.entries[%d]", i),
+					Description: fmt.Sprintf("port matches are only supported for TCP and UDP, got protocol %q", entry.Protocol),
+				})
+			}
+		}
+		ace.SrcPortOp, ace.SrcPort1, ace.SrcPort2, err = PortMatchFrom(entry.SourcePort)
+		if err != nil {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       fmt.Sprintf("spec.entries[%d].sourcePort", i),
+				Description: err.Error(),
+			})
+		}
+		ace.DstPortOp, ace.DstPort1, ace.DstPort2, err = PortMatchFrom(entry.DestinationPort)
+		if err != nil {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       fmt.Sprintf("spec.entries[%d].destinationPort", i),
+				Description: err.Error(),
+			})
+		}
+		a.SeqItems.ACEList.Set(ace)
 	}
 
 	return p.Update(ctx, a)
 }
 
+// isIPv6ACL reports whether the access control list contains IPv6 rules,
+// as determined by its first entry of a known address family.
+func isIPv6ACL(acl *v1alpha1.AccessControlList) bool {
+	for i := range acl.Spec.Entries {
+		if is6, ok := aclEntryIs6(acl, &acl.Spec.Entries[i]); ok {
+			return is6
+		}
+	}
+	return false
+}
+
+// aclEntryIs6 reports whether the entry of the access control list matches IPv6 traffic, as determined
+// by its source address or address group, or by its destination if the source is unset. The second
+// result is false if the address family of neither is known.
+func aclEntryIs6(acl *v1alpha1.AccessControlList, entry *v1alpha1.ACLEntry) (is6, ok bool) {
+	if is6, ok := aclAddressIs6(acl, entry.SourceAddress, entry.SourceAddressGroup); ok {
+		return is6, true
+	}
+	return aclAddressIs6(acl, entry.DestinationAddress, entry.DestinationAddressGroup)
+}
+
+// aclAddressIs6 reports whether the prefix, or the address group of the access control list if set, is of
+// the IPv6 address family. The second result is false if the address family is unknown, i.e. if the prefix
+// is unset or the address group does not exist or has no addresses.
+func aclAddressIs6(acl *v1alpha1.AccessControlList, prefix v1alpha1.IPPrefix, group string) (is6, ok bool) {
+	if group == "" {
+		return prefix.Addr().Is6(), prefix.IsValid()
+	}
+	for _, g := range acl.Spec.ObjectGroups {
+		if g.Name == group && len(g.Addresses) > 0 {
+			return g.Addresses[0].Addr().Is6(), true
+		}
+	}
+	return false, false
+}
+
 func (p *Provider) DeleteACL(ctx context.Context, req *provider.DeleteACLRequest) error {
+	ctx = gnmiext.WithTimeout(ctx, longTimeout)
 	a := new(ACL)
 	a.Name = req.Name
 	// Check if the ACL is IPv4 by trying to fetch its config. If it does not exist, assume it's IPv6.
@@ -273,6 +761,95 @@ func (p *Provider) DeleteACL(ctx context.Context, req *provider.DeleteACLRequest
 	return p.client.Delete(ctx, a)
 }
 
+func (p *Provider) EnsureObjectGroup(ctx context.Context, req *provider.EnsureObjectGroupRequest) error {
+	var g gnmiext.DataElement
+	switch og := req.ObjectGroup; {
+	case len(og.Addresses) > 0:
+		ag := &AddrGroup{Name: og.Name, Is6: og.Addresses[0].Addr().Is6()}
+		for i, prefix := range og.Addresses {
+			if prefix.Addr().Is6() != ag.Is6 {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.objectGroups[*].addresses",
+					Description: fmt.Sprintf("address group %q contains both ipv4 and ipv6 prefixes", og.Name),
+				})
+			}
+			ag.SeqItems.AddrMemberList.Set(&AddrMember{
+				SeqNum:       int32(i+1) * 10, //nolint:gosec
+				Prefix:       prefix.Addr().String(),
+				PrefixLength: prefix.Bits(),
+			})
+		}
+		g = ag
+
+	case len(og.Ports) > 0:
+		pg := &PortGroup{Name: og.Name}
+		for i := range og.Ports {
+			op, port1, port2, err := PortMatchFrom(&og.Ports[i])
+			if err != nil {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.objectGroups[*].ports",
+					Description: fmt.Sprintf("port group %q: %v", og.Name, err),
+				})
+			}
+			pg.SeqItems.PortMemberList.Set(&PortMember{
+				SeqNum: int32(i+1) * 10, //nolint:gosec
+				PortOp: op,
+				Port1:  port1,
+				Port2:  port2,
+			})
+		}
+		g = pg
+
+	default:
+		return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "spec.objectGroups[*]",
+			Description: fmt.Sprintf("object group %q must contain either addresses or ports", og.Name),
+		})
+	}
+
+	// Remove a group of the same name but of a different type or address family, e.g. if the
+	// group was changed from IPv4 to IPv6 prefixes, as it would otherwise remain on the device.
+	for _, el := range objectGroups(req.ObjectGroup.Name) {
+		if el.XPath() == g.XPath() {
+			continue
+		}
+		if err := p.client.GetConfig(ctx, el); errors.Is(err, gnmiext.ErrNil) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err := p.client.Delete(ctx, el); err != nil {
+			return err
+		}
+	}
+
+	return p.Update(ctx, g)
+}
+
+func (p *Provider) DeleteObjectGroup(ctx context.Context, req *provider.DeleteObjectGroupRequest) error {
+	// As the names are unique across all types of object groups, delete whichever exists.
+	for _, el := range objectGroups(req.Name) {
+		if err := p.client.GetConfig(ctx, el); errors.Is(err, gnmiext.ErrNil) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err := p.client.Delete(ctx, el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// objectGroups returns all types of object groups with the given name.
+func objectGroups(name string) []gnmiext.DataElement {
+	return []gnmiext.DataElement{
+		&AddrGroup{Name: name},
+		&AddrGroup{Name: name, Is6: true},
+		&PortGroup{Name: name},
+	}
+}
+
 func (p *Provider) EnsureBanner(ctx context.Context, req *provider.EnsureBannerRequest) (reterr error) {
 	// See: https://www.cisco.com/c/en/us/td/docs/dcn/nx-os/nexus9000/104x/configuration/fundamentals/cisco-nexus-9000-series-nx-os-fundamentals-configuration-guide-release-104x/m-basic-device-management.html#task_1174841
 	lines := strings.Split(req.Message, "\n")
@@ -393,6 +970,14 @@ func (p *Provider) EnsureBGP(ctx context.Context, req *provider.EnsureBGPRequest
 	}
 	dom.RtrID = req.BGP.Spec.RouterID
 	dom.RtrIDAuto = AdminStDisabled
+	dom.LogNbrChanges = AdminStDisabled
+	if cfg.Spec.LogNeighborChanges {
+		dom.LogNbrChanges = AdminStEnabled
+	}
+	dom.BestPathItems.AsPathMultipathRelax = AdminStDisabled
+	if cfg.Spec.Bestpath != nil && cfg.Spec.Bestpath.AsPathMultipathRelax {
+		dom.BestPathItems.AsPathMultipathRelax = AdminStEnabled
+	}
 
 	// Write an ownership marker peer template into the default VRF domain.
 	// Each managed BGP domain gets its own marker keyed by VRF name, allowing
@@ -409,6 +994,9 @@ func (p *Provider) EnsureBGP(ctx context.Context, req *provider.EnsureBGPRequest
 			if rp := req.RedistributeDirectRoutePolicies[v1alpha1.BGPAddressFamilyIpv4Unicast]; rp != nil {
 				item.InterLeakPItems.InterLeakPList.Set(NewInterLeakPDirect(rp.Spec.Name))
 			}
+			if err := item.SetRedistributions(req.Redistributions[v1alpha1.BGPAddressFamilyIpv4Unicast]); err != nil {
+				return err
+			}
 			item.ExportGwIP = AdminStDisabled
 			if cfg.Spec.AddressFamilies != nil &&
 				cfg.Spec.AddressFamilies.Ipv4Unicast != nil &&
@@ -428,6 +1016,9 @@ func (p *Provider) EnsureBGP(ctx context.Context, req *provider.EnsureBGPRequest
 			if rp := req.RedistributeDirectRoutePolicies[v1alpha1.BGPAddressFamilyIpv6Unicast]; rp != nil {
 				item.InterLeakPItems.InterLeakPList.Set(NewInterLeakPDirect(rp.Spec.Name))
 			}
+			if err := item.SetRedistributions(req.Redistributions[v1alpha1.BGPAddressFamilyIpv6Unicast]); err != nil {
+				return err
+			}
 			item.ExportGwIP = AdminStDisabled
 			if cfg.Spec.AddressFamilies != nil &&
 				cfg.Spec.AddressFamilies.Ipv6Unicast != nil &&
@@ -468,9 +1059,12 @@ func (p *Provider) DeleteBGP(ctx context.Context, req *provider.DeleteBGPRequest
 	return p.deleteBGP(ctx, vrfName)
 }
 
-// deleteBGP removes the ownership marker for a BGP domain and cleans up the
-// domain for a VRF. If no remaining ownership markers or non-empty domains
-// exist, the global BGP instance (System/bgp-items/inst-items) is deleted.
+// deleteBGP removes the ownership marker and the instance-level configuration (router ID and
+// address families) of a BGP domain. Peers are owned by separate BGPPeer resources and are
+// therefore left untouched; a VRF domain is only deleted entirely once it has no peers left.
+// If no ownership markers and no peers remain on the device, the global BGP instance
+// (System/bgp-items/inst-items) is deleted and the bgp feature is disabled. The evpn feature
+// is left enabled, as it is shared with the EVPN instance configuration.
 // The function is a no-op when the BGP feature is disabled.
 func (p *Provider) deleteBGP(ctx context.Context, vrfName string) error {
 	f := &Feature{Name: "bgp"}
@@ -490,36 +1084,23 @@ func (p *Provider) deleteBGP(ctx context.Context, vrfName string) error {
 		return err
 	}
 
-	if vrfName != DefaultVRFName {
+	peers, err := p.hasBGPPeers(ctx, vrfName)
+	if err != nil {
+		return err
+	}
+	if vrfName != DefaultVRFName && !peers {
 		if err := p.client.Delete(ctx, &BGPDom{Name: vrfName}); err != nil {
 			return err
 		}
 	} else {
-		// The default VRF domain is always implicitly present when BGP is enabled,
-		// so replace it with only the remaining ownership markers, stripping all
-		// other config atomically.
-		dom := &BGPDom{Name: DefaultVRFName}
-		if err := p.client.GetConfig(ctx, dom); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		// The default VRF domain is always implicitly present when BGP is enabled and
+		// holds the ownership markers of all other domains, so only strip the instance-level config.
+		if err := p.client.Delete(ctx, &BGPDomAfItems{VRFName: vrfName}, &BGPDomRtrID{VRFName: vrfName}); err != nil {
 			return err
 		}
-		empty := &BGPDom{Name: DefaultVRFName}
-		for _, pg := range dom.PeerContItems.PeerContList {
-			if isOwnershipMarker(pg.Name) {
-				empty.PeerContItems.PeerContList.Set(&BGPPeerGroup{VRFName: DefaultVRFName, Name: pg.Name})
-			}
-		}
-		if len(empty.PeerContItems.PeerContList) > 0 {
-			if err := p.Update(ctx, empty); err != nil {
-				return err
-			}
-		} else {
-			if err := p.client.Delete(ctx, &BGPDom{Name: DefaultVRFName}); err != nil {
-				return err
-			}
-		}
 	}
 
-	// Retain the global BGP instance only if other ownership markers remain.
+	// Retain the global BGP instance if other ownership markers or any peers remain.
 	items := new(BGPDomItems)
 	if err := p.client.GetConfig(ctx, items); err != nil && !errors.Is(err, gnmiext.ErrNil) {
 		return err
@@ -530,17 +1111,82 @@ func (p *Provider) deleteBGP(ctx context.Context, vrfName string) error {
 				return nil
 			}
 		}
+		peers, err := p.hasBGPPeers(ctx, d.Name)
+		if err != nil {
+			return err
+		}
+		if peers {
+			return nil
+		}
 	}
 
-	// No operator-managed domains remain — delete the BGP instance.
-	return p.client.Delete(ctx, new(BGP))
+	// No operator-managed domains or peers remain — delete the BGP instance and disable the feature.
+	if err := p.client.Delete(ctx, new(BGP)); err != nil {
+		return err
+	}
+	return p.Update(ctx, &Feature{Name: "bgp", AdminSt: AdminStDisabled})
 }
 
-func (p *Provider) EnsureBGPPeer(ctx context.Context, req *provider.EnsureBGPPeerRequest) error {
-	// Ensure that the BGP domain exists before configuring a peer under it.
-	bgp := new(BGPDom)
-	bgp.Name = DefaultVRFName
-	if req.VRF != nil {
+// hasBGPPeers reports whether any peers are configured in the BGP domain of the given VRF.
+func (p *Provider) hasBGPPeers(ctx context.Context, vrfName string) (bool, error) {
+	items := &BGPDomPeerItems{VRFName: vrfName}
+	if err := p.client.GetConfig(ctx, items); err != nil {
+		if errors.Is(err, gnmiext.ErrNil) {
+			return false, nil
+		}
+		return false, err
+	}
+	return len(items.PeerList) > 0, nil
+}
+
+// EnsureBGPPeerGroup configures a peer template (`template peer <name>`). Templates are
+// global to the BGP instance on NX-OS and are therefore always written into the default
+// domain, regardless of the VRF of the BGP instance. Peers of any domain inherit from a
+// template by name, so names must be unique across the BGP instances of the device.
+func (p *Provider) EnsureBGPPeerGroup(ctx context.Context, req *provider.EnsureBGPPeerGroupRequest) error {
+	if isOwnershipMarker(req.PeerGroup.Name) {
+		return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "spec.peerGroups[*].name",
+			Description: fmt.Sprintf("peer group names starting with %q are reserved", ownershipMarkerPrefix),
+		})
+	}
+
+	pg := new(BGPPeerGroup)
+	pg.VRFName = DefaultVRFName
+	pg.Name = req.PeerGroup.Name
+
+	if req.SourceInterface != "" {
+		srcIf, err := ShortName(req.SourceInterface)
+		if err != nil {
+			return fmt.Errorf("bgp peer group: invalid source interface name %q: %w", req.SourceInterface, err)
+		}
+		pg.SrcIf = srcIf
+	}
+
+	for _, t := range req.PeerGroup.AddressFamilies {
+		af, err := AddressFamilyFromType(t)
+		if err != nil {
+			return fmt.Errorf("bgp peer group: %w", err)
+		}
+		pg.AfItems.PeerAfList.Set(&BGPPeerGroupAfItem{Type: af})
+	}
+
+	return p.Update(ctx, pg)
+}
+
+func (p *Provider) DeleteBGPPeerGroup(ctx context.Context, req *provider.DeleteBGPPeerGroupRequest) error {
+	pg := &BGPPeerGroup{VRFName: DefaultVRFName, Name: req.Name}
+	if err := p.client.Delete(ctx, pg); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		return err
+	}
+	return nil
+}
+
+func (p *Provider) EnsureBGPPeer(ctx context.Context, req *provider.EnsureBGPPeerRequest) error {
+	// Ensure that the BGP domain exists before configuring a peer under it.
+	bgp := new(BGPDom)
+	bgp.Name = DefaultVRFName
+	if req.VRF != nil {
 		bgp.Name = req.VRF.Spec.Name
 	}
 	if err := p.client.GetConfig(ctx, bgp); err != nil {
@@ -557,6 +1203,7 @@ func (p *Provider) EnsureBGPPeer(ctx context.Context, req *provider.EnsureBGPPee
 	pe.Asn = req.BGPPeer.Spec.ASNumber.String()
 	pe.AsnType = PeerAsnTypeNone
 	pe.Name = req.BGPPeer.Spec.Description
+	pe.PeerImp = req.BGPPeer.Spec.PeerGroup
 
 	if req.SourceInterface != "" {
 		srcIf, err := ShortName(req.SourceInterface)
@@ -566,6 +1213,23 @@ func (p *Provider) EnsureBGPPeer(ctx context.Context, req *provider.EnsureBGPPee
 		pe.SrcIf = srcIf
 	}
 
+	// The password is sent type 7 encoded. If the peer already exists with a type 7
+	// password, retain its seed so that an unchanged password encodes to the same value
+	// and the peer is not rewritten on every reconciliation.
+	if req.Password != "" {
+		enc := NewType7()
+		cur := new(BGPPeer)
+		cur.VRFName = pe.VRFName
+		cur.Addr = pe.Addr
+		if err := p.client.GetConfig(ctx, cur); err == nil && cur.PasswdType == PasswdTypeCisco {
+			if seed, _, err := ParseType7(cur.Password); err == nil {
+				enc.Seed = seed
+			}
+		}
+		pe.Password = enc.Encode(req.Password)
+		pe.PasswdType = PasswdTypeCisco
+	}
+
 	if req.BGPPeer.Spec.LocalAS != nil {
 		if req.BGPPeer.Spec.LocalAS.ASNumber.String() == req.BGP.Spec.ASNumber.String() {
 			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
@@ -594,6 +1258,53 @@ func (p *Provider) EnsureBGPPeer(ctx context.Context, req *provider.EnsureBGPPee
 		}
 	}
 
+	var peerCtrl []string
+	if req.BGPPeer.Spec.DisableConnectedCheck {
+		if req.BGPPeer.Spec.ASNumber.String() == req.BGP.Spec.ASNumber.String() {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.disableConnectedCheck",
+				Description: "disable-connected-check cannot be configured on iBGP peers",
+			})
+		}
+		peerCtrl = append(peerCtrl, PeerCtrlDisConnCheck)
+	}
+	if c := req.BGPPeer.Spec.Capabilities; c != nil {
+		if c.DontNegotiate && c.SuppressFourByteAS {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.capabilities.suppressFourByteAS",
+				Description: "suppressing the 4-byte AS capability has no effect if capability negotiation is disabled",
+			})
+		}
+		if c.DontNegotiate {
+			peerCtrl = append(peerCtrl, PeerCtrlCapNegOff)
+		}
+		if c.SuppressFourByteAS {
+			pe.CapSuppr4ByteAsn = AdminStEnabled
+		}
+	}
+	pe.Ctrl = strings.Join(peerCtrl, ",")
+
+	if t := req.BGPPeer.Spec.Timers; t != nil {
+		if d := t.ConnectRetry; d != nil {
+			if d.Duration < time.Second || d.Duration > math.MaxUint16*time.Second || d.Duration%time.Second != 0 {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.timers.connectRetry",
+					Description: fmt.Sprintf("connect retry interval %s must be a whole number of seconds between 1s and 65535s", d.Duration),
+				})
+			}
+			pe.ConnRetryIntvl = uint16(d.Duration / time.Second) // #nosec G115
+		}
+		if d := t.MinimumAdvertisementInterval; d != nil {
+			if d.Duration < time.Second || d.Duration > 600*time.Second || d.Duration%time.Second != 0 {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.timers.minimumAdvertisementInterval",
+					Description: fmt.Sprintf("minimum advertisement interval %s must be a whole number of seconds between 1s and 600s", d.Duration),
+				})
+			}
+			pe.AdvIntvl = uint16(d.Duration / time.Second) // #nosec G115
+		}
+	}
+
 	if req.BGPPeer.Spec.AddressFamilies != nil {
 		for t, af := range map[AddressFamily]*v1alpha1.BGPPeerAddressFamily{
 			AddressFamilyIPv4Unicast: req.BGPPeer.Spec.AddressFamilies.Ipv4Unicast,
@@ -613,9 +1324,46 @@ func (p *Provider) EnsureBGPPeer(ctx context.Context, req *provider.EnsureBGPPee
 			if af.SendCommunity == v1alpha1.BGPCommunityTypeExtended || af.SendCommunity == v1alpha1.BGPCommunityTypeBoth {
 				item.SendComExt = AdminStEnabled
 			}
+			if af.ASOverride && af.AllowASIn != nil {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.addressFamilies[*].allowASIn",
+					Description: fmt.Sprintf("allowas-in and as-override are mutually exclusive for address family %s", t.ToAddressFamilyType()),
+				})
+			}
+			var ctrl []string
+			if af.ASOverride {
+				ctrl = append(ctrl, ASOverride)
+			}
 			if af.RouteReflectorClient {
-				item.Ctrl = NewOption(RouteReflectorClient)
+				ctrl = append(ctrl, RouteReflectorClient)
+			}
+			if len(ctrl) > 0 {
+				item.Ctrl = NewOption(strings.Join(ctrl, ","))
+			}
+			if af.AllowASIn != nil {
+				cnt, err := allowASInOccurrences(af.AllowASIn)
+				if err != nil {
+					return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+						Field:       "spec.addressFamilies[*].allowASIn.occurrences",
+						Description: err.Error(),
+					})
+				}
+				item.AllowedSelfAsCnt = cnt
+			}
+			if af.MaxPrefixWarningPercent > 100 {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.addressFamilies[*].maxPrefixWarningPercent",
+					Description: fmt.Sprintf("warning threshold %d%% is out of range, must be between 1 and 100", af.MaxPrefixWarningPercent),
+				})
+			}
+			mp, err := NewMaxPfxP(af)
+			if err != nil {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.addressFamilies[*].maxPrefixes",
+					Description: err.Error(),
+				})
 			}
+			item.MaxPfxPItems = mp
 			afType := t.ToAddressFamilyType()
 			if name, ok := req.InboundRoutingPolicies[afType]; ok {
 				item.RtCtrlPItems.RtCtrlPList.Set(&BGPPeerAfRtCtrlP{Direction: RtCtrlDirectionIn, RtMap: name})
@@ -623,6 +1371,33 @@ func (p *Provider) EnsureBGPPeer(ctx context.Context, req *provider.EnsureBGPPee
 			if name, ok := req.OutboundRoutingPolicies[afType]; ok {
 				item.RtCtrlPItems.RtCtrlPList.Set(&BGPPeerAfRtCtrlP{Direction: RtCtrlDirectionOut, RtMap: name})
 			}
+			if lb := af.LinkBandwidth; lb != nil {
+				if item.SendComExt != AdminStEnabled {
+					return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+						Field:       "spec.addressFamilies[*].linkBandwidth",
+						Description: fmt.Sprintf("link bandwidth for address family %s requires sending extended communities to the peer", afType),
+					})
+				}
+				switch {
+				case lb.Aggregate:
+					// The aggregated link bandwidth is the sum of the link bandwidths of all multipaths,
+					// so it can only be advertised if multiple paths are actually installed.
+					if !multipathEnabled(req.BGP, t) {
+						return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+							Field:       "spec.addressFamilies[*].linkBandwidth.aggregate",
+							Description: fmt.Sprintf("aggregate link bandwidth for address family %s requires multipath to be enabled on the BGP instance", afType),
+						})
+					}
+					item.LnkBwAggr = AdminStEnabled
+				case lb.BandwidthMbps >= 1 && lb.BandwidthMbps <= maxLinkBandwidthMbps:
+					item.LnkBw = uint32(lb.BandwidthMbps)
+				default:
+					return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+						Field:       "spec.addressFamilies[*].linkBandwidth.bandwidthMbps",
+						Description: fmt.Sprintf("link bandwidth %d Mbps is out of range, must be between 1 and %d Mbps", lb.BandwidthMbps, maxLinkBandwidthMbps),
+					})
+				}
+			}
 			pe.AfItems.PeerAfList.Set(item)
 		}
 	}
@@ -630,6 +1405,8 @@ func (p *Provider) EnsureBGPPeer(ctx context.Context, req *provider.EnsureBGPPee
 	return p.Update(ctx, pe)
 }
 
+// DeleteBGPPeer removes the BGP neighbor identified by its address from the BGP domain.
+// The function is a no-op when the peer is not configured on the device.
 func (p *Provider) DeleteBGPPeer(ctx context.Context, req *provider.DeleteBGPPeerRequest) error {
 	b := new(BGPPeer)
 	b.VRFName = DefaultVRFName
@@ -637,6 +1414,12 @@ func (p *Provider) DeleteBGPPeer(ctx context.Context, req *provider.DeleteBGPPee
 		b.VRFName = req.VRF.Spec.Name
 	}
 	b.Addr = req.BGPPeer.Spec.Address
+	if err := p.client.GetConfig(ctx, b); err != nil {
+		if errors.Is(err, gnmiext.ErrNil) {
+			return nil // ErrNil: path does not exist: peer is already gone.
+		}
+		return err
+	}
 	return p.client.Delete(ctx, b)
 }
 
@@ -671,15 +1454,44 @@ func (p *Provider) GetPeerStatus(ctx context.Context, req *provider.BGPPeerStatu
 	return res, nil
 }
 
+// ClearBGPSession resets the session with the BGP peer at the address of the request in its VRF.
+func (p *Provider) ClearBGPSession(ctx context.Context, req *provider.ClearBGPSessionRequest) error {
+	if !req.Address.IsValid() {
+		return errors.New("bgp clear: invalid peer address")
+	}
+	c := &BGPPeerClear{
+		VRFName: cmp.Or(req.VRF, DefaultVRFName),
+		Addr:    req.Address.String(),
+		AdminSt: ActionAdminStStart,
+		Type:    BGPPeerClearTypeSoft,
+	}
+	switch req.Mode {
+	case provider.BGPSessionClearHard:
+		c.Type = BGPPeerClearTypeHard
+	case provider.BGPSessionClearSoft:
+		c.Dir = BGPPeerClearDirBoth
+	case provider.BGPSessionClearSoftInbound:
+		c.Dir = BGPPeerClearDirIn
+	case provider.BGPSessionClearSoftOutbound:
+		c.Dir = BGPPeerClearDirOut
+	default:
+		return fmt.Errorf("bgp clear: unsupported mode %q", req.Mode)
+	}
+	if err := p.client.Patch(ctx, c); err != nil {
+		return fmt.Errorf("bgp clear: failed to clear session with peer %s in vrf %s: %w", req.Address, c.VRFName, err)
+	}
+	return nil
+}
+
 func (p *Provider) EnsureCertificate(ctx context.Context, req *provider.EnsureCertificateRequest) error {
-	version := NXVersion(p.client.Capabilities())
+	version := p.version
 
 	logger := logr.FromContextOrDiscard(ctx).WithValues("nx-version", version)
 
-	if serial, err := p.installedCertSerial(ctx, req.ID); err == nil {
+	if info, err := p.installedCert(ctx, req.ID); err == nil {
 		want := strings.ToUpper(req.Certificate.Leaf.SerialNumber.Text(16))
-		if strings.TrimLeft(serial, "0") == strings.TrimLeft(want, "0") {
-			logger.V(1).Info("Certificate already installed with matching serial", "serial", serial)
+		if strings.TrimLeft(info.SerialNumber, "0") == strings.TrimLeft(want, "0") {
+			logger.V(1).Info("Certificate already installed with matching serial", "serial", info.SerialNumber)
 			return nil
 		}
 	}
@@ -767,16 +1579,22 @@ func (p *Provider) EnsureCertificate(ctx context.Context, req *provider.EnsureCe
 	return nil
 }
 
-// installedCertSerial queries the device for the certificate installed under the
-// given trustpoint and returns its serial number as an uppercase hex string.
+// certTimeLayout is the layout of the validity dates in the certificate output of the device.
+const certTimeLayout = "Jan _2 15:04:05 2006 MST"
+
+// errCertNotFound is returned if a trustpoint does not hold an identity certificate.
+var errCertNotFound = errors.New("certificate not found")
+
+// installedCert queries the device for the certificate installed under the
+// given trustpoint and returns its serial number, as an uppercase hex string, and validity.
 // If the trustpoint does not exist or has no certificate, an error is returned.
-func (p *Provider) installedCertSerial(ctx context.Context, trustpoint string) (string, error) {
+func (p *Provider) installedCert(ctx context.Context, trustpoint string) (provider.CertificateInfo, error) {
 	res, err := p.nxapi.Do(ctx, nxapi.NewRequest("show crypto ca certificates "+trustpoint))
 	if err != nil {
-		return "", err
+		return provider.CertificateInfo{}, err
 	}
 	if len(res) == 0 {
-		return "", errors.New("empty response")
+		return provider.CertificateInfo{}, errors.New("empty response")
 	}
 	var body struct {
 		Certificate struct {
@@ -784,14 +1602,56 @@ func (p *Provider) installedCertSerial(ctx context.Context, trustpoint string) (
 		} `json:"Certificate"`
 	}
 	if err := json.Unmarshal(res[0], &body); err != nil {
-		return "", err
+		return provider.CertificateInfo{}, err
 	}
+	if body.Certificate.Cert == "" {
+		return provider.CertificateInfo{}, errCertNotFound
+	}
+	info := provider.CertificateInfo{ID: trustpoint}
 	for line := range strings.SplitSeq(body.Certificate.Cert, "\n") {
-		if after, ok := strings.CutPrefix(line, "serial="); ok {
-			return strings.ToUpper(strings.TrimSpace(after)), nil
+		key, val, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "serial":
+			info.SerialNumber = strings.ToUpper(strings.TrimSpace(val))
+		case "notBefore":
+			if info.NotBefore, err = time.Parse(certTimeLayout, strings.TrimSpace(val)); err != nil {
+				return provider.CertificateInfo{}, fmt.Errorf("invalid certificate validity %q: %w", val, err)
+			}
+		case "notAfter":
+			if info.NotAfter, err = time.Parse(certTimeLayout, strings.TrimSpace(val)); err != nil {
+				return provider.CertificateInfo{}, fmt.Errorf("invalid certificate expiry %q: %w", val, err)
+			}
+		}
+	}
+	if info.SerialNumber == "" {
+		return provider.CertificateInfo{}, errors.New("serial not found in certificate output")
+	}
+	return info, nil
+}
+
+// GetCertificate returns the identity certificate installed under the trustpoint of the request,
+// or nil if the trustpoint does not exist or only holds CA certificates.
+func (p *Provider) GetCertificate(ctx context.Context, req *provider.GetCertificateRequest) (*provider.CertificateInfo, error) {
+	tp := new(Trustpoint)
+	tp.Name = req.ID
+	if err := p.client.GetConfig(ctx, tp); err != nil {
+		if errors.Is(err, gnmiext.ErrNil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	info, err := p.installedCert(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, errCertNotFound) {
+			return nil, nil
 		}
+		return nil, fmt.Errorf("failed to read certificate of trustpoint %q: %w", req.ID, err)
 	}
-	return "", errors.New("serial not found in certificate output")
+	return &info, nil
 }
 
 func (p *Provider) DeleteCertificate(ctx context.Context, req *provider.DeleteCertificateRequest) error {
@@ -814,6 +1674,10 @@ func (p *Provider) EnsureDNS(ctx context.Context, req *provider.EnsureDNSRequest
 	pf := new(DNSProf)
 	pf.Name = DefaultVRFName
 	pf.DomItems.Name = req.DNS.Spec.Domain
+	for i, name := range req.DNS.Spec.SearchDomains {
+		pf.DlistItems.DomExtList.Set(&DNSDomExt{Name: name, Order: int32(i + 1)})
+	}
+	vrfs := make(map[string]*DNSVrf)
 	for _, s := range req.DNS.Spec.Servers {
 		prov := new(DNSProv)
 		prov.Addr = s.Address
@@ -822,12 +1686,33 @@ func (p *Provider) EnsureDNS(ctx context.Context, req *provider.EnsureDNSRequest
 			pf.ProvItems.ProviderList.Set(prov)
 			continue
 		}
-		vrf, ok := pf.VrfItems.VrfList.Get(s.VrfName)
+		vrf, ok := vrfs[s.VrfName]
 		if !ok {
 			vrf = new(DNSVrf)
 			vrf.Name = s.VrfName
+			vrfs[s.VrfName] = vrf
 		}
 		vrf.ProvItems.ProviderList.Set(prov)
+	}
+
+	// Only the VRFs of the requested resolution order are assigned a position.
+	for i, name := range req.DNS.Spec.ResolutionOrder {
+		vrf, ok := vrfs[name]
+		if !ok {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       fmt.Sprintf("spec.resolutionOrder[%d]", i),
+				Description: fmt.Sprintf("vrf %q is not used by any server", name),
+			})
+		}
+		if err := p.client.GetConfig(ctx, &VRF{Name: name}); err != nil {
+			if errors.Is(err, gnmiext.ErrNil) {
+				return apistatus.NewFailedPreconditionError(fmt.Sprintf("dns: vrf %q must exist on the device to be used for resolution", name))
+			}
+			return err
+		}
+		vrf.Order = int32(i + 1)
+	}
+	for _, vrf := range vrfs {
 		pf.VrfItems.VrfList.Set(vrf)
 	}
 	d.ProfItems.ProfList.Set(pf)
@@ -841,16 +1726,11 @@ func (p *Provider) DeleteDNS(ctx context.Context) error {
 }
 
 func (p *Provider) EnsureEVPNInstance(ctx context.Context, req *provider.EVPNInstanceRequest) (err error) {
-	f := new(Feature)
-	f.Name = "nvo"
-	f.AdminSt = AdminStEnabled
-
-	f2 := new(Feature)
-	f2.Name = "vnsegment"
-	f2.AdminSt = AdminStEnabled
-
-	if err := p.Update(ctx, f, f2); err != nil {
-		return err
+	if vni := req.EVPNInstance.Spec.VNI; vni < minVNI || vni > maxVNI {
+		return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "spec.vni",
+			Description: fmt.Sprintf("VNI %d is out of range, must be between %d and %d", vni, minVNI, maxVNI),
+		})
 	}
 
 	updates := make([]gnmiext.DataElement, 0, 3)
@@ -874,7 +1754,32 @@ func (p *Provider) EnsureEVPNInstance(ctx context.Context, req *provider.EVPNIns
 	if req.EVPNInstance.Spec.MulticastGroupAddress != "" {
 		vni.McastGroup = NewOption(req.EVPNInstance.Spec.MulticastGroupAddress)
 	}
-	updates = append(updates, vni)
+
+	var deletes []gnmiext.DataElement
+	switch req.EVPNInstance.Spec.ReplicationMode {
+	case v1alpha1.ReplicationModeMulticast:
+		if req.EVPNInstance.Spec.MulticastGroupAddress == "" {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.multicastGroupAddress",
+				Description: "a multicast group address is required for the Multicast replication mode",
+			})
+		}
+		// Remove any ingress replication, which takes precedence over the multicast group.
+		deletes = append(deletes, &VNIIngRepl{Vni: vni.Vni})
+	case "":
+		// Remove a previously configured ingress replication override, as the VNI is patched and
+		// would keep it otherwise, so that the replication mode of the NVE applies to the VNI.
+		deletes = append(deletes, &VNIIngRepl{Vni: vni.Vni})
+	case v1alpha1.ReplicationModeIngressReplication:
+		nve := new(NVE)
+		if err := p.client.GetConfig(ctx, nve); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+			return err
+		}
+		if nve.HostReach != HostReachBGP {
+			return apistatus.NewFailedPreconditionError("evpn instance: ingress replication requires the NVE to use BGP host reachability")
+		}
+		vni.IngReplItems.Proto = IngReplProtoBGP
+	}
 
 	switch req.EVPNInstance.Spec.Type {
 	case v1alpha1.EVPNInstanceTypeBridged:
@@ -883,7 +1788,10 @@ func (p *Provider) EnsureEVPNInstance(ctx context.Context, req *provider.EVPNIns
 		if req.EVPNInstance.Spec.RouteDistinguisher != "" {
 			rd, err := RouteDistinguisher(req.EVPNInstance.Spec.RouteDistinguisher)
 			if err != nil {
-				return fmt.Errorf("evpn instance: invalid route distinguisher: %w", err)
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.routeDistinguisher",
+					Description: err.Error(),
+				})
 			}
 			evi.Rd = NewOption(rd)
 		}
@@ -895,10 +1803,13 @@ func (p *Provider) EnsureEVPNInstance(ctx context.Context, req *provider.EVPNIns
 			// This is equivalent to 'route-target both auto' on the command line.
 			targets = append(targets, v1alpha1.EVPNRouteTarget{Action: v1alpha1.RouteTargetActionBoth})
 		}
-		for _, rt := range targets {
+		for i, rt := range targets {
 			s, err := RouteTarget(rt.Value)
 			if err != nil {
-				return fmt.Errorf("evpn instance: invalid import route target: %w", err)
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       fmt.Sprintf("spec.routeTargets[%d].value", i),
+					Description: err.Error(),
+				})
 			}
 			r := &Rtt{Rtt: s}
 			switch rt.Action {
@@ -923,10 +1834,31 @@ func (p *Provider) EnsureEVPNInstance(ctx context.Context, req *provider.EVPNIns
 		vni.AssociateVrfFlag = true
 	}
 
+	f := new(Feature)
+	f.Name = "nvo"
+	f.AdminSt = AdminStEnabled
+
+	f2 := new(Feature)
+	f2.Name = "vnsegment"
+	f2.AdminSt = AdminStEnabled
+
+	if err := p.Update(ctx, f, f2); err != nil {
+		return err
+	}
+
 	if err := p.Update(ctx, updates...); err != nil {
 		return err
 	}
 
+	if err := p.client.Delete(ctx, deletes...); err != nil {
+		return err
+	}
+
+	// Patch the VNI of the NVE separately, so that it is merged into its existing configuration.
+	if err := p.Patch(ctx, vni); err != nil {
+		return err
+	}
+
 	// Patch L3VNI/Encap on the VRF separately. This merges into the existing
 	// VRF tree without replacing fields managed by EnsureVRF.
 	if req.EVPNInstance.Spec.Type == v1alpha1.EVPNInstanceTypeRouted && req.VRF != nil {
@@ -994,6 +1926,161 @@ func isPointToPoint(ipv4 *v1alpha1.InterfaceIPv4) bool {
 	return false
 }
 
+// newURPF converts the uRPF check of an interface into its DME representation,
+// rejecting verify options that are not supported in the selected mode.
+func newURPF(field string, u *v1alpha1.URPF) (URPF, error) {
+	// NX-OS has no equivalent of the allow-self-ping option of IOS.
+	if u.AllowSelfPing {
+		return "", apistatus.NewUnsupportedFieldError(apistatus.FieldViolation{
+			Field:       field + ".allowSelfPing",
+			Description: "Cisco NX-OS devices do not support allow-self-ping for uRPF",
+		})
+	}
+	switch u.Mode {
+	case v1alpha1.URPFModeStrict:
+		if u.AllowDefault {
+			return "", apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       field + ".allowDefault",
+				Description: "allow-default is only supported in loose mode",
+			})
+		}
+		return URPFStrict, nil
+	case v1alpha1.URPFModeLoose:
+		if u.AllowDefault {
+			return URPFLooseAllowDefault, nil
+		}
+		return URPFLoose, nil
+	}
+	return "", apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+		Field:       field + ".mode",
+		Description: fmt.Sprintf("unsupported urpf mode %q", u.Mode),
+	})
+}
+
+// newStormControl converts the storm-control thresholds of an interface into their DME representation.
+func newStormControl(sc *v1alpha1.StormControl) (s *StormControl, err error) {
+	s = new(StormControl)
+	if s.BcLevel, s.BcPPS, err = stormControlThreshold("spec.ethernet.stormControl.broadcast", sc.Broadcast); err != nil {
+		return nil, err
+	}
+	if s.McLevel, s.McPPS, err = stormControlThreshold("spec.ethernet.stormControl.multicast", sc.Multicast); err != nil {
+		return nil, err
+	}
+	if s.UucLevel, s.UucPPS, err = stormControlThreshold("spec.ethernet.stormControl.unknownUnicast", sc.UnknownUnicast); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// stormControlThreshold returns the level or the rate in packets per second of a storm-control threshold.
+func stormControlThreshold(field string, t *v1alpha1.StormControlThreshold) (level string, pps *int32, err error) {
+	switch {
+	case t == nil:
+		return "", nil, nil
+	case t.Percent != nil && t.PacketsPerSecond != nil:
+		return "", nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       field,
+			Description: "only one of percent or packetsPerSecond can be set",
+		})
+	case t.Percent != nil:
+		if *t.Percent < 0 || *t.Percent > 100 {
+			return "", nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       field + ".percent",
+				Description: fmt.Sprintf("percent %d must be between 0 and 100", *t.Percent),
+			})
+		}
+		return fmt.Sprintf("%d.00", *t.Percent), nil, nil
+	case t.PacketsPerSecond != nil:
+		if *t.PacketsPerSecond < 0 {
+			return "", nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       field + ".packetsPerSecond",
+				Description: fmt.Sprintf("packets per second %d must not be negative", *t.PacketsPerSecond),
+			})
+		}
+		return "", t.PacketsPerSecond, nil
+	}
+	return "", nil, nil
+}
+
+// newQueuingPolicyMap converts a bandwidth allocation template into a policy-map of type queuing.
+func newQueuingPolicyMap(q *nxv1alpha1.EgressQueuing) (*QueuingPolicyMap, error) {
+	pm := new(QueuingPolicyMap)
+	pm.Name = q.Template
+	pm.Descr = QueuingTemplateDescr
+	var total int32
+	for i, c := range q.Classes {
+		if !slices.Contains(QueuingClasses, string(c.Name)) {
+			return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       fmt.Sprintf("providerConfig.egressQueuing.classes[%d].name", i),
+				Description: fmt.Sprintf("invalid queuing class %q", c.Name),
+			})
+		}
+		if c.BandwidthPercent < 0 || c.BandwidthPercent > 100 {
+			return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       fmt.Sprintf("providerConfig.egressQueuing.classes[%d].bandwidthPercent", i),
+				Description: fmt.Sprintf("bandwidth percent %d must be between 0 and 100", c.BandwidthPercent),
+			})
+		}
+		total += c.BandwidthPercent
+		mc := &QueuingMatchClass{Name: string(c.Name)}
+		mc.SetRemBWItems.Val = c.BandwidthPercent
+		pm.CmapItems.MatchCMapList.Set(mc)
+	}
+	if total > 100 {
+		return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "providerConfig.egressQueuing.classes",
+			Description: fmt.Sprintf("bandwidth percentages sum up to %d, must not exceed 100", total),
+		})
+	}
+	return pm, nil
+}
+
+// ensureQueuingTemplate verifies that the policy-map of the bandwidth allocation template pm can be applied
+// to the interface with the given name, i.e. that it does not change the allocation of the template while it
+// is attached to other interfaces. It returns the policy-map of the template currently attached to the
+// interface if it is superseded by pm and not attached to any other interface, so that it can be removed
+// once pm has been attached. A nil pm denotes that the interface has no template.
+func (p *Provider) ensureQueuingTemplate(ctx context.Context, name string, pm *QueuingPolicyMap) (*QueuingPolicyMap, error) {
+	attached := new(QueuingServicePolicies)
+	if err := p.client.GetConfig(ctx, attached); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		return nil, err
+	}
+
+	if pm != nil {
+		if other, ok := attached.AttachedTo(pm.Name, name); ok {
+			cur := &QueuingPolicyMap{Name: pm.Name}
+			if err := p.client.GetConfig(ctx, cur); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+				return nil, err
+			}
+			if !maps.EqualFunc(cur.CmapItems.MatchCMapList, pm.CmapItems.MatchCMapList, func(a, b *QueuingMatchClass) bool {
+				return a.SetRemBWItems.Val == b.SetRemBWItems.Val
+			}) {
+				return nil, apistatus.NewFailedPreconditionError(fmt.Sprintf("queuing template %q is attached to interface %s with a different bandwidth allocation", pm.Name, other))
+			}
+		}
+	}
+
+	sp, ok := attached.IfList.Get(name)
+	if !ok || (pm != nil && sp.PmapItems.Name == pm.Name) {
+		return nil, nil
+	}
+	if _, ok := attached.AttachedTo(sp.PmapItems.Name, name); ok {
+		return nil, nil
+	}
+	stale := &QueuingPolicyMap{Name: sp.PmapItems.Name}
+	if err := p.client.GetConfig(ctx, stale); err != nil {
+		if errors.Is(err, gnmiext.ErrNil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// Keep policy-maps that have not been created for a bandwidth allocation template.
+	if stale.Descr != QueuingTemplateDescr {
+		return nil, nil
+	}
+	return stale, nil
+}
+
 func (p *Provider) EnsureInterface(ctx context.Context, req *provider.EnsureInterfaceRequest) error { //nolint:gocyclo
 	name, err := ShortName(req.Interface.Spec.Name)
 	if err != nil {
@@ -1007,6 +2094,40 @@ func (p *Provider) EnsureInterface(ctx context.Context, req *provider.EnsureInte
 		}
 	}
 
+	var pm, stalePM *QueuingPolicyMap
+	if cfg.Spec.EgressQueuing != nil {
+		if req.Interface.Spec.Type != v1alpha1.InterfaceTypePhysical && req.Interface.Spec.Type != v1alpha1.InterfaceTypeAggregate {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "providerConfig.egressQueuing",
+				Description: fmt.Sprintf("egress queuing is not supported on %s interfaces", req.Interface.Spec.Type),
+			})
+		}
+		pm, err = newQueuingPolicyMap(cfg.Spec.EgressQueuing)
+		if err != nil {
+			return err
+		}
+	}
+	if req.Interface.Spec.Type == v1alpha1.InterfaceTypePhysical || req.Interface.Spec.Type == v1alpha1.InterfaceTypeAggregate {
+		if stalePM, err = p.ensureQueuingTemplate(ctx, name, pm); err != nil {
+			return err
+		}
+	}
+
+	var l2pt *L2ProtocolTunnel
+	if cfg.Spec.L2ProtocolTunnel != nil {
+		if req.Interface.Spec.Type != v1alpha1.InterfaceTypePhysical && req.Interface.Spec.Type != v1alpha1.InterfaceTypeAggregate {
+			return fmt.Errorf("iface: l2 protocol tunneling is not supported on %s interfaces", req.Interface.Spec.Type)
+		}
+		// Customer protocol PDUs can only be tunneled on the L2 service port facing the customer.
+		if sp := req.Interface.Spec.Switchport; sp == nil || sp.Mode != v1alpha1.SwitchportModeAccess {
+			return errors.New("iface: l2 protocol tunneling requires the interface to be an access switchport")
+		}
+		l2pt, err = NewL2ProtocolTunnel(name, cfg.Spec.L2ProtocolTunnel)
+		if err != nil {
+			return err
+		}
+	}
+
 	vrf := DefaultVRFName
 	if req.VRF != nil {
 		vrf = req.VRF.Spec.Name
@@ -1038,21 +2159,264 @@ func (p *Provider) EnsureInterface(ctx context.Context, req *provider.EnsureInte
 				return fmt.Errorf("invalid unnumbered source interface name %q: %w", v.SourceInterface, err)
 			}
 		}
+
+		if req.Interface.Spec.IPMTU != 0 {
+			mtu := int32(DefaultMTU)
+			if req.Interface.Spec.MTU != 0 {
+				mtu = req.Interface.Spec.MTU
+			}
+			if req.Interface.Spec.IPMTU > mtu {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.ipMtu",
+					Description: fmt.Sprintf("ip mtu %d must not exceed the interface mtu %d", req.Interface.Spec.IPMTU, mtu),
+				})
+			}
+			addr.MTU = req.Interface.Spec.IPMTU
+		}
+
+		if ipv4 := req.Interface.Spec.IPv4; ipv4 != nil && ipv4.URPF != nil {
+			addr.URPF, err = newURPF("spec.ipv4.urpf", ipv4.URPF)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	var addr6 *AddrItem
+	if ipv6 := req.Interface.Spec.IPv6; ipv6 != nil {
+		addr6 = new(AddrItem)
+		addr6.ID = name
+		addr6.Vrf = vrf
+		addr6.Is6 = true
+
+		if len(ipv6.Addresses) > 0 && ipv6.Autoconfig {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.ipv6.autoconfig",
+				Description: "autoconfig must not be combined with static ipv6 addresses",
+			})
+		}
+
+		for _, p := range ipv6.Addresses {
+			if !p.Addr().Is6() || p.Addr().Is4In6() || p.Addr().IsLinkLocalUnicast() {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.ipv6.addresses",
+					Description: fmt.Sprintf("address %s is not a global ipv6 prefix", p),
+				})
+			}
+			addr6.AddrItems.AddrList.Set(&IntfAddr{
+				Addr: p.String(),
+				Type: IntfAddrTypePrimary,
+			})
+		}
+
+		if ll := ipv6.LinkLocalAddress; !ll.IsZero() {
+			if !ll.Is6() || ll.Is4In6() || !ll.IsLinkLocalUnicast() {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.ipv6.linkLocalAddress",
+					Description: fmt.Sprintf("link-local address %s is not within fe80::/10", ll),
+				})
+			}
+			addr6.LLAddr = ll.String()
+		}
+
+		if ipv6.Autoconfig {
+			addr6.Autoconfig = AdminStEnabled
+		}
+		if len(ipv6.Addresses) == 0 && !ipv6.Autoconfig {
+			addr6.UseLinkLocalOnly = AdminStEnabled
+		}
+
+		if ipv6.URPF != nil {
+			addr6.URPF, err = newURPF("spec.ipv6.urpf", ipv6.URPF)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	var portsec *PortSecurityIf
+	if ps := req.Interface.Spec.PortSecurity; ps != nil {
+		// Port security only applies to access switchports, reject it on routed interfaces.
+		sp := req.Interface.Spec.Switchport
+		if (req.Interface.Spec.Type != v1alpha1.InterfaceTypePhysical && req.Interface.Spec.Type != v1alpha1.InterfaceTypeAggregate) ||
+			sp == nil || sp.Mode != v1alpha1.SwitchportModeAccess || addr != nil || addr6 != nil {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.portSecurity",
+				Description: "port security is only supported on access switchports without ip configuration",
+			})
+		}
+		portsec, err = NewPortSecurityIf(name, ps)
+		if err != nil {
+			return err
+		}
+	}
+
+	var arp *ARPIf
+	if ipv4 := req.Interface.Spec.IPv4; ipv4 != nil && ipv4.ARPTimeout != nil {
+		if req.Interface.Spec.Type == v1alpha1.InterfaceTypeLoopback {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.ipv4.arpTimeout",
+				Description: "arp timeout is only supported on routed interfaces",
+			})
+		}
+		if d := ipv4.ARPTimeout.Duration; d < MinARPTimeout || d > MaxARPTimeout || d%time.Second != 0 {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.ipv4.arpTimeout",
+				Description: fmt.Sprintf("arp timeout %s must be a whole number of seconds between %s and %s", d, MinARPTimeout, MaxARPTimeout),
+			})
+		}
+		arp = new(ARPIf)
+		arp.ID = name
+		arp.Timeout = int32(ipv4.ARPTimeout.Duration / time.Second) // #nosec G115 -- validated above
+	}
+
+	var policies []*ACLInterfacePolicy
+	for i, ag := range req.AccessGroups {
+		field := fmt.Sprintf("spec.accessGroups[%d]", i)
+		if req.Interface.Spec.Type == v1alpha1.InterfaceTypeLoopback {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       field,
+				Description: "access groups are not supported on loopback interfaces",
+			})
+		}
+		pol := &ACLInterfacePolicy{
+			IfName: name,
+			Is6:    isIPv6ACL(ag.ACL),
+			Egress: ag.Direction == v1alpha1.AccessGroupDirectionEgress,
+		}
+		pol.ACLItems.Name = ag.ACL.Spec.Name
+		af := "ipv4"
+		if pol.Is6 {
+			af = "ipv6"
+		}
+		if (!pol.Is6 && addr == nil) || (pol.Is6 && addr6 == nil) {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       field,
+				Description: fmt.Sprintf("%s access control list %q requires %s configuration on the interface", af, ag.ACL.Spec.Name, af),
+			})
+		}
+		if slices.ContainsFunc(policies, func(p *ACLInterfacePolicy) bool { return p.XPath() == pol.XPath() }) {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       field,
+				Description: fmt.Sprintf("only one %s access control list can be applied in direction %s", af, ag.Direction),
+			})
+		}
+		policies = append(policies, pol)
+	}
+
+	var servicePolicies []*QoSServicePolicy
+	for i, sp := range req.ServicePolicies {
+		field := fmt.Sprintf("spec.servicePolicies[%d]", i)
+		if req.Interface.Spec.Type == v1alpha1.InterfaceTypeLoopback {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       field,
+				Description: "service policies are not supported on loopback interfaces",
+			})
+		}
+		pol := &QoSServicePolicy{
+			IfName: name,
+			Output: sp.Direction == v1alpha1.ServicePolicyDirectionOutput,
+		}
+		pol.PmapItems.Name = sp.QoSPolicy.Spec.Name
+		if slices.ContainsFunc(servicePolicies, func(p *QoSServicePolicy) bool { return p.XPath() == pol.XPath() }) {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       field,
+				Description: fmt.Sprintf("only one QoS policy can be attached in direction %s", sp.Direction),
+			})
+		}
+		servicePolicies = append(servicePolicies, pol)
 	}
 
-	deletes := make([]gnmiext.DataElement, 0, 2)
+	deletes := make([]gnmiext.DataElement, 0, 3)
+	if arp == nil && req.Interface.Spec.Type != v1alpha1.InterfaceTypeLoopback {
+		deletes = append(deletes, &ARPIf{ID: name})
+	}
+	if req.Interface.Spec.Type != v1alpha1.InterfaceTypeLoopback {
+		for _, pol := range aclInterfacePolicies(name) {
+			if !slices.ContainsFunc(policies, func(p *ACLInterfacePolicy) bool { return p.XPath() == pol.XPath() }) {
+				deletes = append(deletes, pol)
+			}
+		}
+		for _, pol := range qosServicePolicies(name) {
+			if !slices.ContainsFunc(servicePolicies, func(p *QoSServicePolicy) bool { return p.XPath() == pol.XPath() }) {
+				deletes = append(deletes, pol)
+			}
+		}
+	}
 	addrs := new(AddrList)
 	if err := p.client.GetConfig(ctx, addrs); err != nil && !errors.Is(err, gnmiext.ErrNil) {
 		return err
 	}
+	// The interface is moved to another VRF if any of its addresses is configured in a different VRF.
+	var moved bool
 	for _, a := range addrs.GetAddrItemsByInterface(name) {
 		if addr == nil || a.Vrf != vrf {
 			deletes = append(deletes, a)
 		}
+		moved = moved || a.Vrf != vrf
+	}
+	addrs6 := &AddrList{Is6: true}
+	if err := p.client.GetConfig(ctx, addrs6); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		return err
+	}
+	for _, a := range addrs6.GetAddrItemsByInterface(name) {
+		if addr6 == nil || a.Vrf != vrf {
+			deletes = append(deletes, a)
+		}
+		moved = moved || a.Vrf != vrf
+	}
+
+	// The VRF membership must be applied before the addresses, see below. Within a transaction, both
+	// would be sent in a single Set RPC, so the configuration is applied right away through the client
+	// the transaction was started on instead. Nothing has been recorded in the transaction yet.
+	if moved && p.parent != nil {
+		np := *p
+		np.client, np.parent = p.parent, nil
+		p = &np
+	}
+
+	if err := p.client.Delete(ctx, deletes...); err != nil {
+		return err
+	}
+
+	// An interface with any IP configuration must be routed, i.e. Layer3.
+	layer3 := addr != nil || addr6 != nil
+	parentLayer3 := req.AggregateParent != nil && (req.AggregateParent.Spec.IPv4 != nil || req.AggregateParent.Spec.IPv6 != nil)
+
+	// A forced speed must be supported by the port, e.g. by the transceiver plugged into it. The
+	// capabilities of the port are only queried if the speed changes, as it was checked before otherwise.
+	speed := SpeedAuto
+	if eth := req.Interface.Spec.Ethernet; eth != nil && eth.Speed != "" {
+		speed = Speed(eth.Speed)
+		if speed.Mbps() == 0 {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.ethernet.speed",
+				Description: fmt.Sprintf("unsupported speed: %s", eth.Speed),
+			})
+		}
+		if eth.Duplex == v1alpha1.DuplexHalf && speed.Mbps() >= 1000 {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.ethernet.duplex",
+				Description: fmt.Sprintf("half duplex is not supported at a speed of %s", eth.Speed),
+			})
+		}
+		current := &PhysIfSpeed{ID: name}
+		if err := p.client.GetConfig(ctx, current); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+			return err
+		}
+		if current.Speed != speed {
+			portcap := &PortCapabilities{ID: name}
+			if err := p.client.GetState(ctx, portcap); err != nil {
+				return fmt.Errorf("failed to get capabilities of port %s: %w", name, err)
+			}
+			if !slices.Contains(portcap.Speeds(), speed.Mbps()) {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.ethernet.speed",
+					Description: fmt.Sprintf("speed %s is not supported by port %s, supported speeds (Mbps): %s", eth.Speed, name, portcap.Speed),
+				})
+			}
+		}
 	}
-	if err := p.client.Delete(ctx, deletes...); err != nil {
-		return err
-	}
 
 	updates := make([]gnmiext.DataElement, 0, 4)
 	switch req.Interface.Spec.Type {
@@ -1089,9 +2453,33 @@ func (p *Provider) EnsureInterface(ctx context.Context, req *provider.EnsureInte
 			}
 		}
 
+		p.Speed = speed
+
+		if eth := req.Interface.Spec.Ethernet; eth != nil && eth.Duplex != "" {
+			switch eth.Duplex {
+			case v1alpha1.DuplexAuto:
+				p.Duplex = DuplexAuto
+			case v1alpha1.DuplexFull:
+				p.Duplex = DuplexFull
+			case v1alpha1.DuplexHalf:
+				p.Duplex = DuplexHalf
+			default:
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.ethernet.duplex",
+					Description: fmt.Sprintf("unsupported duplex mode: %s", eth.Duplex),
+				})
+			}
+		}
+
+		if req.Interface.Spec.Ethernet != nil && req.Interface.Spec.Ethernet.StormControl != nil {
+			if p.StormCtrlItems, err = newStormControl(req.Interface.Spec.Ethernet.StormControl); err != nil {
+				return err
+			}
+		}
+
 		// If this Physical interface is a member of an L3 Aggregate (port-channel),
 		// it must be Layer3 on NX-OS even though it has no IP address of its own.
-		if req.IPv4 != nil || (req.AggregateParent != nil && req.AggregateParent.Spec.IPv4 != nil) {
+		if layer3 || parentLayer3 {
 			p.Layer = Layer3
 			p.RtvrfMbrItems = NewVrfMember(name, vrf)
 			p.AccessVlan = "unknown"
@@ -1185,7 +2573,7 @@ func (p *Provider) EnsureInterface(ctx context.Context, req *provider.EnsureInte
 			pc.UserCfgdFlags |= UserFlagAdminMTU
 		}
 
-		if req.IPv4 != nil {
+		if layer3 {
 			pc.Layer = Layer3
 			pc.RtvrfMbrItems = NewVrfMember(name, vrf)
 			pc.AccessVlan = "unknown"
@@ -1206,6 +2594,26 @@ func (p *Provider) EnsureInterface(ctx context.Context, req *provider.EnsureInte
 			return fmt.Errorf("iface: unknown LACP mode: %s", m)
 		}
 
+		rate := LACPRateNormal
+		switch r := req.Interface.Spec.Aggregation.ControlProtocol.Rate; r {
+		case v1alpha1.LACPRateFast:
+			rate = LACPRateFast
+		case v1alpha1.LACPRateNormal, "":
+		default:
+			return fmt.Errorf("iface: unknown LACP rate: %s", r)
+		}
+
+		pc.MinLinks = 1
+		if n := req.Interface.Spec.Aggregation.MinLinks; n != 0 {
+			if int(n) > len(req.Members) {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       "spec.aggregation.minLinks",
+					Description: fmt.Sprintf("min-links %d exceeds the number of member interfaces (%d)", n, len(req.Members)),
+				})
+			}
+			pc.MinLinks = n
+		}
+
 		if req.Interface.Spec.Switchport != nil {
 			switch req.Interface.Spec.Switchport.Mode {
 			case v1alpha1.SwitchportModeAccess:
@@ -1230,6 +2638,11 @@ func (p *Provider) EnsureInterface(ctx context.Context, req *provider.EnsureInte
 				return err
 			}
 			pc.RsmbrIfsItems.RsMbrIfsList.Set(NewPortChannelMember(n))
+			l := &LACPIf{ID: n, Prio: DefaultLACPPriority, TxRate: rate}
+			if member.Spec.Ethernet != nil && member.Spec.Ethernet.LACPPortPriority != 0 {
+				l.Prio = member.Spec.Ethernet.LACPPortPriority
+			}
+			updates = append(updates, l)
 		}
 
 		v := new(VPCIfItems)
@@ -1342,7 +2755,7 @@ func (p *Provider) EnsureInterface(ctx context.Context, req *provider.EnsureInte
 		}
 		s.Encap = encap
 
-		if req.IPv4 != nil {
+		if layer3 {
 			s.RtvrfMbrItems = NewVrfMember(name, vrf)
 		}
 
@@ -1360,7 +2773,7 @@ func (p *Provider) EnsureInterface(ctx context.Context, req *provider.EnsureInte
 		})
 	}
 
-	if (req.Interface.Spec.Type == v1alpha1.InterfaceTypePhysical || req.Interface.Spec.Type == v1alpha1.InterfaceTypeAggregate) && req.IPv4 == nil && (req.AggregateParent == nil || req.AggregateParent.Spec.IPv4 == nil) {
+	if (req.Interface.Spec.Type == v1alpha1.InterfaceTypePhysical || req.Interface.Spec.Type == v1alpha1.InterfaceTypeAggregate) && !layer3 && !parentLayer3 {
 		stp := new(SpanningTree)
 		stp.IfName = name
 		stp.Mode = SpanningTreeModeDefault
@@ -1393,10 +2806,55 @@ func (p *Provider) EnsureInterface(ctx context.Context, req *provider.EnsureInte
 		updates = append(updates, stp)
 	}
 
+	if req.Interface.Spec.Type == v1alpha1.InterfaceTypePhysical || req.Interface.Spec.Type == v1alpha1.InterfaceTypeAggregate {
+		sp := new(QueuingServicePolicy)
+		sp.IfName = name
+		if pm != nil {
+			sp.PmapItems.Name = pm.Name
+			updates = append(updates, pm, sp)
+		} else if err := p.client.Delete(ctx, sp); err != nil {
+			return err
+		}
+
+		if l2pt != nil {
+			updates = append(updates, l2pt)
+		} else if err := p.client.Delete(ctx, &L2ProtocolTunnel{IfName: name}); err != nil {
+			return err
+		}
+
+		if portsec != nil {
+			updates = append(updates, &Feature{Name: "portsec", AdminSt: AdminStEnabled}, portsec)
+		} else if err := p.client.Delete(ctx, &PortSecurityIf{IfName: name}); err != nil {
+			return err
+		}
+	}
+
+	// Changing the VRF membership of an interface clears its IP configuration on NX-OS.
+	// Apply the new membership on its own, so that the addresses are not configured in the
+	// new VRF before the device has processed the move and removed them again.
+	if moved {
+		if err := p.Update(ctx, updates...); err != nil {
+			return err
+		}
+		updates = updates[:0]
+	}
+
 	// Add the address items last, as they depend on the interface being created first.
 	if addr != nil {
 		updates = append(updates, addr)
 	}
+	if addr6 != nil {
+		updates = append(updates, addr6)
+	}
+	if arp != nil {
+		updates = append(updates, arp)
+	}
+	for _, pol := range policies {
+		updates = append(updates, pol)
+	}
+	for _, pol := range servicePolicies {
+		updates = append(updates, pol)
+	}
 
 	switch {
 	case req.Interface.Spec.BFD != nil && req.Interface.Spec.BFD.Enabled:
@@ -1471,7 +2929,15 @@ func (p *Provider) EnsureInterface(ctx context.Context, req *provider.EnsureInte
 		}
 	}
 
-	return p.Update(ctx, updates...)
+	if err := p.Update(ctx, updates...); err != nil {
+		return err
+	}
+
+	// Remove the policy-map of a bandwidth allocation template that is no longer attached to any interface.
+	if stalePM != nil {
+		return p.client.Delete(ctx, stalePM)
+	}
+	return nil
 }
 
 func (p *Provider) DeleteInterface(ctx context.Context, req *provider.InterfaceRequest) error {
@@ -1488,13 +2954,41 @@ func (p *Provider) DeleteInterface(ctx context.Context, req *provider.InterfaceR
 	for _, addr := range addrs.GetAddrItemsByInterface(name) {
 		deletes = append(deletes, addr)
 	}
+	addrs6 := &AddrList{Is6: true}
+	if err := p.client.GetConfig(ctx, addrs6); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		return err
+	}
+	for _, addr := range addrs6.GetAddrItemsByInterface(name) {
+		deletes = append(deletes, addr)
+	}
 
 	bfd := new(BFD)
 	bfd.ID = name
 	deletes = append(deletes, bfd)
 
+	if req.Interface.Spec.Type != v1alpha1.InterfaceTypeLoopback {
+		deletes = append(deletes, &ARPIf{ID: name})
+		for _, pol := range aclInterfacePolicies(name) {
+			deletes = append(deletes, pol)
+		}
+		for _, pol := range qosServicePolicies(name) {
+			deletes = append(deletes, pol)
+		}
+	}
+
 	switch req.Interface.Spec.Type {
 	case v1alpha1.InterfaceTypePhysical:
+		sp := new(QueuingServicePolicy)
+		sp.IfName = name
+		deletes = append(deletes, sp)
+		if pm, err := p.ensureQueuingTemplate(ctx, name, nil); err != nil {
+			return err
+		} else if pm != nil {
+			deletes = append(deletes, pm)
+		}
+		deletes = append(deletes, &L2ProtocolTunnel{IfName: name})
+		deletes = append(deletes, &PortSecurityIf{IfName: name})
+
 		i := new(PhysIf)
 		i.ID = name
 		deletes = append(deletes, i)
@@ -1515,6 +3009,17 @@ func (p *Provider) DeleteInterface(ctx context.Context, req *provider.InterfaceR
 		deletes = append(deletes, lb)
 
 	case v1alpha1.InterfaceTypeAggregate:
+		sp := new(QueuingServicePolicy)
+		sp.IfName = name
+		deletes = append(deletes, sp)
+		if pm, err := p.ensureQueuingTemplate(ctx, name, nil); err != nil {
+			return err
+		} else if pm != nil {
+			deletes = append(deletes, pm)
+		}
+		deletes = append(deletes, &L2ProtocolTunnel{IfName: name})
+		deletes = append(deletes, &PortSecurityIf{IfName: name})
+
 		pc := new(PortChannel)
 		pc.ID = name
 		deletes = append(deletes, pc)
@@ -1556,7 +3061,12 @@ func (p *Provider) GetInterfaceStatus(ctx context.Context, req *provider.Interfa
 	var (
 		operSt          OperSt
 		operMsg         string
+		operMode        SwitchportMode
+		operMTU         int32
 		lldpAdjacencies []provider.LLDPAdjacency
+		members         []provider.MemberStatus
+		fec             *provider.FECStatistics
+		lacp            *provider.LACPSystem
 	)
 	switch req.Interface.Spec.Type {
 	case v1alpha1.InterfaceTypePhysical:
@@ -1569,6 +3079,8 @@ func (p *Provider) GetInterfaceStatus(ctx context.Context, req *provider.Interfa
 		}
 		operSt = phys.OperSt
 		operMsg = phys.OperStQual
+		operMode = phys.OperMode
+		operMTU = phys.OperMtu
 
 		lldpAdjacencies = make([]provider.LLDPAdjacency, 0, len(lldpAdj.AdjItems.AdjEpList))
 		for _, adj := range lldpAdj.AdjItems.AdjEpList {
@@ -1585,6 +3097,17 @@ func (p *Provider) GetInterfaceStatus(ctx context.Context, req *provider.Interfa
 			lldpAdjacencies = append(lldpAdjacencies, neighbor)
 		}
 
+		// FEC statistics are only reported for ports with FEC enabled, e.g. high-speed optics. As they are
+		// informational, failing to retrieve them must not fail the status of the interface.
+		stats := new(PhysIfFECItems)
+		stats.ID = name
+		switch err := p.client.GetState(ctx, stats); {
+		case err == nil:
+			fec = stats.ToFECStatistics(ctx)
+		case !errors.Is(err, gnmiext.ErrNil):
+			logr.FromContextOrDiscard(ctx).Error(err, "Failed to get FEC statistics", "interface", name)
+		}
+
 	case v1alpha1.InterfaceTypeLoopback:
 		lb := new(LoopbackOperItems)
 		lb.ID = name
@@ -1597,11 +3120,32 @@ func (p *Provider) GetInterfaceStatus(ctx context.Context, req *provider.Interfa
 	case v1alpha1.InterfaceTypeAggregate:
 		pc := new(PortChannelOperItems)
 		pc.ID = name
-		if err := p.client.GetState(ctx, pc); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		mbrs := new(PortChannelMemberOperItems)
+		mbrs.ID = name
+		if err := p.client.GetState(ctx, pc, mbrs); err != nil && !errors.Is(err, gnmiext.ErrNil) {
 			return provider.InterfaceStatus{}, err
 		}
 		operSt = pc.OperSt
 		operMsg = pc.OperStQual
+		operMode = pc.OperMode
+		operMTU = pc.OperMtu
+
+		members = make([]provider.MemberStatus, 0, len(mbrs.RsMbrIfsList))
+		for _, m := range mbrs.RsMbrIfsList {
+			// The target DN has the format "/System/intf-items/phys-items/PhysIf-list[id='eth1/1']".
+			_, id, ok := strings.Cut(m.TDn, "[id='")
+			if !ok {
+				continue
+			}
+			members = append(members, provider.MemberStatus{
+				Name:         strings.TrimSuffix(id, "']"),
+				BundleStatus: m.ChannelingSt.ToBundleStatus(),
+			})
+		}
+
+		if lacp, err = p.lacpSystem(ctx, name); err != nil {
+			return provider.InterfaceStatus{}, err
+		}
 
 	case v1alpha1.InterfaceTypeRoutedVLAN:
 		svi := new(SwitchVirtualInterfaceOperItems)
@@ -1639,11 +3183,55 @@ func (p *Provider) GetInterfaceStatus(ctx context.Context, req *provider.Interfa
 		OperStatus:      operSt == OperStUp,
 		OperMessage:     operMsg,
 		LLDPAdjacencies: lldpAdjacencies,
+		Members:         members,
+		FEC:             fec,
+		OperMTU:         operMTU,
+		LACP:            lacp,
+	}
+
+	// The operational mode is also reported for routed interfaces, so it is only meaningful for switchports.
+	if req.Interface.Spec.Switchport != nil {
+		status.SwitchportMode = operMode.ToSwitchportMode()
 	}
 
 	return status, nil
 }
 
+// lacpSystem returns the LACP system parameters the device presents to the partner on the given port-channel.
+// Both peers of a vPC present the system parameters of the vPC domain on their vPC port-channels, so that the
+// downstream device sees a single LACP partner.
+func (p *Provider) lacpSystem(ctx context.Context, name string) (*provider.LACPSystem, error) {
+	v := new(VPCIfItems)
+	if err := p.client.GetConfig(ctx, v); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		return nil, err
+	}
+	if v.GetListItemByInterface(name) != nil {
+		dom := new(VPCDomainSystem)
+		if err := p.client.GetState(ctx, dom); err != nil {
+			if errors.Is(err, gnmiext.ErrNil) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if dom.SystemID() == "" {
+			return nil, nil
+		}
+		return &provider.LACPSystem{SystemID: dom.SystemID(), SystemPriority: dom.SysPrio}, nil
+	}
+
+	inst := new(LACPInstOperItems)
+	if err := p.client.GetState(ctx, inst); err != nil {
+		if errors.Is(err, gnmiext.ErrNil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if inst.SysMac == "" {
+		return nil, nil
+	}
+	return &provider.LACPSystem{SystemID: inst.SystemID(), SystemPriority: inst.SysPrio}, nil
+}
+
 func (p *Provider) InterfaceNameEqual(_ context.Context, a, b string) (bool, error) {
 	shortA, err := ShortName(a)
 	if err != nil {
@@ -1775,12 +3363,61 @@ func (p *Provider) DeleteISIS(ctx context.Context, req *provider.DeleteISISReque
 	return p.client.Delete(ctx, i)
 }
 
+func (p *Provider) GetISISStatus(ctx context.Context, req *provider.ISISStatusRequest) (provider.ISISStatus, error) {
+	name := make(map[string]*v1alpha1.Interface)
+	for _, iface := range req.Interfaces {
+		n, err := ShortName(iface.Spec.Name)
+		if err != nil {
+			return provider.ISISStatus{}, err
+		}
+		name[n] = iface
+	}
+
+	st := new(ISISOperItems)
+	st.Name = req.ISIS.Spec.Instance
+
+	if err := p.client.GetState(ctx, st); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		return provider.ISISStatus{}, err
+	}
+
+	adjacencies := make([]provider.ISISAdjacency, 0)
+	for _, intf := range st.IfItems.IfList {
+		i, ok := name[intf.ID]
+		if !ok {
+			continue
+		}
+		for _, adj := range intf.AdjItems.AdjList {
+			adjacencies = append(adjacencies, provider.ISISAdjacency{
+				SystemID:           adj.SysID,
+				Interface:          i,
+				Level:              adj.Level.ToISISLevel(),
+				LastTransitionTime: adj.LastStChgTs,
+				State:              adj.OperSt.ToAdjacencyState(),
+			})
+		}
+	}
+
+	lsps := make(map[v1alpha1.ISISLevel]int32)
+	for _, lvl := range st.LvlItems.LevelList {
+		lsps[lvl.CktT.ToISISLevel()] += lvl.LSPCount()
+	}
+
+	return provider.ISISStatus{
+		OperStatus:  st.OperSt == OperStUp,
+		Adjacencies: adjacencies,
+		LSPCounts:   lsps,
+	}, nil
+}
+
 func (p *Provider) EnsureManagementAccess(ctx context.Context, req *provider.EnsureManagementAccessRequest) error {
 	gf := new(Feature)
 	gf.Name = "grpc"
 	gf.AdminSt = AdminStEnabled
 	if !req.ManagementAccess.Spec.GRPC.Enabled {
-		return errors.New("management access: gRPC must be enabled")
+		return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "spec.grpc.enabled",
+			Description: "gRPC must be enabled, it is used to manage the device",
+		})
 	}
 
 	sf := new(Feature)
@@ -1790,6 +3427,20 @@ func (p *Provider) EnsureManagementAccess(ctx context.Context, req *provider.Ens
 		sf.AdminSt = AdminStEnabled
 	}
 
+	ncf := new(Feature)
+	ncf.Name = "netconf"
+	ncf.AdminSt = AdminStDisabled
+	if req.ManagementAccess.Spec.NETCONF.Enabled {
+		ncf.AdminSt = AdminStEnabled
+	}
+
+	rcf := new(Feature)
+	rcf.Name = "restconf"
+	rcf.AdminSt = AdminStDisabled
+	if req.ManagementAccess.Spec.RESTCONF.Enabled {
+		rcf.AdminSt = AdminStEnabled
+	}
+
 	g := new(GRPC)
 	g.Port = req.ManagementAccess.Spec.GRPC.Port
 	g.UseVrf = DefaultVRFName
@@ -1824,6 +3475,15 @@ func (p *Provider) EnsureManagementAccess(ctx context.Context, req *provider.Ens
 		}
 	}
 
+	// NX-API is enabled unless explicitly disabled in the provider config,
+	// as the provider relies on it for some operations.
+	nf := new(Feature)
+	nf.Name = "nxapi"
+	nf.AdminSt = AdminStEnabled
+	if req.ProviderConfig != nil && !cfg.Spec.NXAPI.Enabled {
+		nf.AdminSt = AdminStDisabled
+	}
+
 	con := new(Console)
 	con.Timeout = int(cfg.Spec.Console.Timeout.Minutes())
 	if err := con.Validate(); err != nil {
@@ -1839,13 +3499,77 @@ func (p *Provider) EnsureManagementAccess(ctx context.Context, req *provider.Ens
 		}
 	}
 
-	patches := make([]gnmiext.DataElement, 0, 7)
-	patches = append(patches, gf, sf, g, gn, vty, con)
+	// Changing the port restarts the gRPC server, which drops the session the provider is connected through.
+	// Keep the current port for the gNMI update and change it separately via NX-API, so that the change
+	// can be verified and rolled back if the gRPC server is unreachable on the new port.
+	cur := new(GRPC)
+	if err := p.client.GetConfig(ctx, cur); errors.Is(err, gnmiext.ErrNil) {
+		cur.Default()
+	} else if err != nil {
+		return err
+	}
+	port := g.Port
+	g.Port = cur.Port
+	if port != cur.Port && nf.AdminSt != AdminStEnabled {
+		return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "spec.grpc.port",
+			Description: "changing the gRPC port requires NX-API to be enabled",
+		})
+	}
+
+	patches := make([]gnmiext.DataElement, 0, 10)
+	patches = append(patches, gf, sf, ncf, rcf, nf, g, gn, vty, con)
 	if acl.Name != "" {
 		patches = append(patches, acl)
 	}
 
-	return p.Patch(ctx, patches...)
+	if err := p.Patch(ctx, patches...); err != nil {
+		return err
+	}
+
+	if port != cur.Port {
+		return p.changeGRPCPort(ctx, cur.Port, port)
+	}
+	return nil
+}
+
+// changeGRPCPort changes the port of the gRPC server from old to port and verifies that a gNMI session
+// can be established on the new port. If not, the previous port is restored.
+func (p *Provider) changeGRPCPort(ctx context.Context, old, port int32) error {
+	log := logr.FromContextOrDiscard(ctx).WithValues("old", old, "new", port)
+	log.Info("Changing gRPC port")
+	if _, err := p.nxapi.Do(ctx, nxapi.NewRequest(fmt.Sprintf("grpc port %d", port))); err != nil {
+		return fmt.Errorf("failed to change grpc port: %w", err)
+	}
+
+	err := p.probeGRPC(ctx, port)
+	if err == nil {
+		log.Info("Verified gRPC server on new port")
+		return nil
+	}
+
+	log.Error(err, "gRPC server unreachable on new port, rolling back")
+	if _, rerr := p.nxapi.Do(ctx, nxapi.NewRequest(fmt.Sprintf("grpc port %d", old))); rerr != nil {
+		return fmt.Errorf("grpc server unreachable on port %d and rollback to port %d failed: %w", port, old, errors.Join(err, rerr))
+	}
+	return fmt.Errorf("grpc server unreachable on port %d, rolled back to port %d: %w", port, old, err)
+}
+
+// probeGRPC verifies that a gNMI session can be established with the device on the given port.
+func (p *Provider) probeGRPC(ctx context.Context, port int32) error {
+	addr, err := netip.ParseAddrPort(p.connection.Address)
+	if err != nil {
+		return fmt.Errorf("failed to parse device address: %w", err)
+	}
+	c := *p.connection
+	c.Address = netip.AddrPortFrom(addr.Addr(), uint16(port)).String() //nolint:gosec
+	conn, err := grpcext.NewClient(&c, grpcext.WithDefaultTimeout(timeout), grpcext.WithUserAgent(provider.UserAgent()))
+	if err != nil {
+		return fmt.Errorf("failed to create grpc connection: %w", err)
+	}
+	defer conn.Close() //nolint:errcheck
+	_, err = p.handshake(ctx, conn)
+	return err
 }
 
 func (p *Provider) DeleteManagementAccess(ctx context.Context) error {
@@ -1892,7 +3616,7 @@ func (p *Provider) EnsureNTP(ctx context.Context, req *provider.EnsureNTPRequest
 		prov.MinPoll = 4
 		prov.Name = s.Address
 		prov.Preferred = s.Prefer
-		prov.ProvT = ProvTypeServer
+		prov.ProvT = ProvTypeFrom(s.Mode)
 		prov.Vrf = DefaultVRFName
 		if s.VrfName != "" {
 			prov.Vrf = s.VrfName
@@ -1926,6 +3650,9 @@ type OSPFConfig struct {
 	ReferenceBandwidthMbps int32
 	// MaxLSA is the maximum number of non self-generated LSAs (min 1)
 	MaxLSA int32
+	// MaximumPaths is the maximum number of equal-cost paths (1-64) installed for ECMP.
+	// When zero, the device default is used.
+	MaximumPaths uint8
 }
 
 // RedistributionConfig represents a redistribution configuration of a route map through a specific protocol.
@@ -1990,6 +3717,15 @@ func (p *Provider) EnsureOSPF(ctx context.Context, req *provider.EnsureOSPFReque
 		}
 		dom.Dist = cfg.Distance
 	}
+	if cfg.MaximumPaths != 0 {
+		if cfg.MaximumPaths > 64 {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "providerConfig.maximumPaths",
+				Description: fmt.Sprintf("maximum paths %d is out of range, must be between 1 and 64", cfg.MaximumPaths),
+			})
+		}
+		dom.MaxEcmp = cfg.MaximumPaths
+	}
 	dom.RtrID = req.OSPF.Spec.RouterID
 	dom.Ctrl = "default-passive"
 	o.DomItems.DomList.Set(dom)
@@ -2064,9 +3800,18 @@ func (p *Provider) EnsureOSPF(ctx context.Context, req *provider.EnsureOSPFReque
 	return p.Update(ctx, updates...)
 }
 
+// DeleteOSPF removes the OSPF process identified by its instance name from the device.
+// The ospf feature is left enabled, as other processes may still be configured.
+// The function is a no-op when the process is not configured on the device.
 func (p *Provider) DeleteOSPF(ctx context.Context, req *provider.DeleteOSPFRequest) error {
 	o := new(OSPF)
 	o.Name = req.OSPF.Spec.Instance
+	if err := p.client.GetConfig(ctx, o); err != nil {
+		if errors.Is(err, gnmiext.ErrNil) {
+			return nil // ErrNil: path does not exist: process is already gone.
+		}
+		return err
+	}
 	return p.client.Delete(ctx, o)
 }
 
@@ -2159,6 +3904,11 @@ func (p *Provider) EnsurePIM(ctx context.Context, req *provider.EnsurePIMRequest
 		}
 	}
 
+	bsr, autoRP, err := pimRPDiscovery(req)
+	if err != nil {
+		return err
+	}
+
 	interfaces := make([]*v1alpha1.Interface, 0, len(req.Interfaces))
 	for _, iface := range req.Interfaces {
 		interfaces = append(interfaces, iface.Interface)
@@ -2174,19 +3924,21 @@ func (p *Provider) EnsurePIM(ctx context.Context, req *provider.EnsurePIMRequest
 	_ = req.Interfaces[len(interfaceNames)-1]
 
 	ifItems := new(PIMIfItems)
+	igmpItems := new(IGMPIfItems)
 	for i, name := range interfaceNames {
-		intf := new(PIMIf)
-		intf.ID = name
-		switch req.Interfaces[i].Mode {
-		case v1alpha1.PIMModeDense:
-			return apistatus.NewUnsupportedFieldError(apistatus.FieldViolation{
-				Field:       "spec.interfaces[*].mode",
-				Description: "PIM dense mode is not supported on Cisco NX-OS devices",
-			})
-		case v1alpha1.PIMModeSparse:
-			intf.PimSparseMode = true
+		intf, err := pimIf(name, req.Interfaces[i])
+		if err != nil {
+			return err
 		}
 		ifItems.IfList.Set(intf)
+
+		if req.Interfaces[i].IGMP != nil {
+			igmp, err := igmpIf(name, req.Interfaces[i])
+			if err != nil {
+				return err
+			}
+			igmpItems.IfList.Set(igmp)
+		}
 	}
 
 	updates := make([]gnmiext.DataElement, 0, 3)
@@ -2213,40 +3965,307 @@ func (p *Provider) EnsurePIM(ctx context.Context, req *provider.EnsurePIMRequest
 					updates = append(updates, &g)
 				}
 			}
-			for _, grp := range got.RpgrplistItems.RPGrpListList {
-				if _, ok := rp.RpgrplistItems.RPGrpListList.Get(grp.Key()); !ok {
-					g := *grp
-					g.RpAddr = rp.Addr
-					deletes = append(deletes, &g)
-				}
+			for _, grp := range got.RpgrplistItems.RPGrpListList {
+				if _, ok := rp.RpgrplistItems.RPGrpListList.Get(grp.Key()); !ok {
+					g := *grp
+					g.RpAddr = rp.Addr
+					deletes = append(deletes, &g)
+				}
+			}
+		}
+		for _, rp := range current.StaticRPList {
+			if _, ok := rpItems.StaticRPList.Get(rp.Key()); !ok {
+				deletes = append(deletes, rp)
+			}
+		}
+	} else {
+		deletes = append(deletes, rpItems)
+	}
+
+	if len(apItems.AcastRPPeerList) > 0 {
+		updates = append(updates, apItems)
+	} else {
+		deletes = append(deletes, apItems)
+	}
+
+	if len(ifItems.IfList) > 0 {
+		updates = append(updates, ifItems)
+	} else {
+		deletes = append(deletes, ifItems)
+	}
+
+	if len(igmpItems.IfList) > 0 {
+		updates = append(updates, igmpItems)
+	} else {
+		deletes = append(deletes, igmpItems)
+	}
+
+	if bsr != nil {
+		updates = append(updates, bsr)
+	} else {
+		deletes = append(deletes, new(PIMBSRItems))
+	}
+
+	if autoRP != nil {
+		updates = append(updates, autoRP)
+	} else {
+		deletes = append(deletes, new(PIMAutoRPItems))
+	}
+
+	if err := p.Update(ctx, updates...); err != nil {
+		return err
+	}
+
+	return p.client.Delete(ctx, deletes...)
+}
+
+// pimAllGroups is the group range covering all IPv4 multicast groups.
+var pimAllGroups = netip.MustParsePrefix("224.0.0.0/4")
+
+// pimGroupRanges returns the given multicast group ranges, or the range of all
+// IPv4 multicast groups if none are given.
+func pimGroupRanges(field string, groups []v1alpha1.IPPrefix) ([]netip.Prefix, error) {
+	if len(groups) == 0 {
+		return []netip.Prefix{pimAllGroups}, nil
+	}
+	ranges := make([]netip.Prefix, 0, len(groups))
+	for _, g := range groups {
+		if !g.IsValid() || !g.Addr().Is4() || g.Bits() < pimAllGroups.Bits() || !pimAllGroups.Contains(g.Addr()) {
+			return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       field,
+				Description: fmt.Sprintf("group range %q is not a valid IPv4 multicast address prefix", g),
+			})
+		}
+		ranges = append(ranges, g.Masked())
+	}
+	return ranges, nil
+}
+
+// pimPriority returns the given priority, or def if none is given.
+func pimPriority(field string, prio *int32, def uint8) (uint8, error) {
+	if prio == nil {
+		return def, nil
+	}
+	if *prio < 0 || *prio > 255 {
+		return 0, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       field,
+			Description: fmt.Sprintf("priority %d must be between 0 and 255", *prio),
+		})
+	}
+	return uint8(*prio), nil // #nosec G115
+}
+
+// pimRPDiscovery returns the BSR and Auto-RP configuration of the given request.
+// Dynamically learned RPs take precedence over static ones on NX-OS, unless the static RP is
+// configured with override, which is not used here. A candidate RP whose group range overlaps
+// with that of a static RP is therefore rejected, as it would replace the static RP for these groups.
+func pimRPDiscovery(req *provider.EnsurePIMRequest) (*PIMBSRItems, *PIMAutoRPItems, error) { //nolint:gocyclo
+	static := make(map[string][]netip.Prefix, len(req.PIM.Spec.RendezvousPoints))
+	for _, rp := range req.PIM.Spec.RendezvousPoints {
+		ranges, err := pimGroupRanges("spec.rendezvousPoints[*].multicastGroups", rp.MulticastGroups)
+		if err != nil {
+			return nil, nil, err
+		}
+		static[rp.Address] = ranges
+	}
+
+	candidateRanges := func(field string, c *v1alpha1.PIMCandidateRP) ([]netip.Prefix, error) {
+		ranges, err := pimGroupRanges(field, c.MulticastGroups)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range ranges {
+			for addr, srs := range static {
+				for _, sr := range srs {
+					if r.Overlaps(sr) {
+						return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+							Field:       field,
+							Description: fmt.Sprintf("group range %s of the candidate RP overlaps with group range %s of the static RP %s", r, sr, addr),
+						})
+					}
+				}
+			}
+		}
+		return ranges, nil
+	}
+
+	var bsr *PIMBSRItems
+	if b := req.BSR; b != nil && b.PIMBSR != nil {
+		bsr = new(PIMBSRItems)
+		bsr.Listen = b.Listen
+		bsr.Fwd = b.Listen
+		if c := b.CandidateBSR; c != nil {
+			if b.CandidateBSRInterface == nil {
+				return nil, nil, fmt.Errorf("pim: interface %q of the candidate BSR is not resolved", c.InterfaceRef.Name)
+			}
+			name, err := ShortName(b.CandidateBSRInterface.Spec.Name)
+			if err != nil {
+				return nil, nil, err
+			}
+			prio, err := pimPriority("spec.bsr.candidateBSR.priority", c.Priority, DefaultPIMBSRPriority)
+			if err != nil {
+				return nil, nil, err
+			}
+			hashLen := uint8(DefaultPIMBSRHashLen)
+			if l := c.HashMaskLength; l != nil {
+				if *l < 0 || *l > 32 {
+					return nil, nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+						Field:       "spec.bsr.candidateBSR.hashMaskLength",
+						Description: fmt.Sprintf("hash mask length %d must be between 0 and 32", *l),
+					})
+				}
+				hashLen = uint8(*l) // #nosec G115
+			}
+			bsr.BSRCandItems = &PIMBSRCand{Source: name, Prio: prio, HashLen: hashLen}
+		}
+		if c := b.CandidateRP; c != nil {
+			if b.CandidateRPInterface == nil {
+				return nil, nil, fmt.Errorf("pim: interface %q of the candidate RP is not resolved", c.InterfaceRef.Name)
+			}
+			name, err := ShortName(b.CandidateRPInterface.Spec.Name)
+			if err != nil {
+				return nil, nil, err
+			}
+			prio, err := pimPriority("spec.bsr.candidateRP.priority", c.Priority, DefaultPIMRPCandPriority)
+			if err != nil {
+				return nil, nil, err
+			}
+			ranges, err := candidateRanges("spec.bsr.candidateRP.multicastGroups", c)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, r := range ranges {
+				bsr.RPCandItems.RPCandList.Set(&PIMRPCand{Source: name, GrpList: r.String(), Prio: prio})
+			}
+		}
+	}
+
+	var autoRP *PIMAutoRPItems
+	if a := req.AutoRP; a != nil && a.PIMAutoRP != nil {
+		autoRP = new(PIMAutoRPItems)
+		autoRP.Listen = a.Listen
+		autoRP.Fwd = a.Listen
+		if c := a.CandidateRP; c != nil {
+			if c.Priority != nil {
+				return nil, nil, apistatus.NewUnsupportedFieldError(apistatus.FieldViolation{
+					Field:       "spec.autoRP.candidateRP.priority",
+					Description: "Auto-RP candidate RP priorities are not supported on Cisco NX-OS devices",
+				})
+			}
+			if a.CandidateRPInterface == nil {
+				return nil, nil, fmt.Errorf("pim: interface %q of the candidate RP is not resolved", c.InterfaceRef.Name)
+			}
+			name, err := ShortName(a.CandidateRPInterface.Spec.Name)
+			if err != nil {
+				return nil, nil, err
+			}
+			ranges, err := candidateRanges("spec.autoRP.candidateRP.multicastGroups", c)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, r := range ranges {
+				autoRP.RPAnnItems.RPAnnList.Set(&PIMAutoRPAnn{Source: name, GrpList: r.String()})
 			}
 		}
-		for _, rp := range current.StaticRPList {
-			if _, ok := rpItems.StaticRPList.Get(rp.Key()); !ok {
-				deletes = append(deletes, rp)
+		if m := a.MappingAgent; m != nil {
+			if a.MappingAgentInterface == nil {
+				return nil, nil, fmt.Errorf("pim: interface %q of the mapping agent is not resolved", m.InterfaceRef.Name)
+			}
+			name, err := ShortName(a.MappingAgentInterface.Spec.Name)
+			if err != nil {
+				return nil, nil, err
 			}
+			autoRP.MAItems = &PIMAutoRPMA{Source: name}
 		}
-	} else {
-		deletes = append(deletes, rpItems)
 	}
 
-	if len(apItems.AcastRPPeerList) > 0 {
-		updates = append(updates, apItems)
-	} else {
-		deletes = append(deletes, apItems)
+	return bsr, autoRP, nil
+}
+
+// pimIf returns the PIM configuration of the given PIM interface.
+// Unset parameters are omitted, such that the device defaults apply.
+func pimIf(name string, intf provider.PIMInterface) (*PIMIf, error) {
+	pim := new(PIMIf)
+	pim.ID = name
+	switch intf.Mode {
+	case v1alpha1.PIMModeDense:
+		return nil, apistatus.NewUnsupportedFieldError(apistatus.FieldViolation{
+			Field:       "spec.interfaces[*].mode",
+			Description: "PIM dense mode is not supported on Cisco NX-OS devices",
+		})
+	case v1alpha1.PIMModeSparse:
+		pim.PimSparseMode = true
 	}
 
-	if len(ifItems.IfList) > 0 {
-		updates = append(updates, ifItems)
-	} else {
-		deletes = append(deletes, ifItems)
+	if d := intf.HelloInterval; d != 0 {
+		if d < MinPIMHelloInterval || d > MaxPIMHelloInterval || d%time.Millisecond != 0 {
+			return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.interfaceRefs[*].helloInterval",
+				Description: fmt.Sprintf("hello interval %s must be a whole number of milliseconds between %s and %s", d, MinPIMHelloInterval, MaxPIMHelloInterval),
+			})
+		}
+		pim.HelloItvl = uint32(d / time.Millisecond) // #nosec G115
 	}
 
-	if err := p.Update(ctx, updates...); err != nil {
-		return err
+	if p := intf.DRPriority; p != nil {
+		if *p < 0 || *p > math.MaxUint32 {
+			return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.interfaceRefs[*].drPriority",
+				Description: fmt.Sprintf("DR priority %d must be between 0 and %d", *p, uint32(math.MaxUint32)),
+			})
+		}
+		pim.DrPrio = new(uint32(*p)) // #nosec G115
 	}
 
-	return p.client.Delete(ctx, deletes...)
+	return pim, nil
+}
+
+// igmpIf returns the IGMP querier configuration of the given PIM interface.
+func igmpIf(name string, intf provider.PIMInterface) (*IGMPIf, error) {
+	if intf.Interface.Spec.Switchport != nil || intf.Interface.Spec.Type == v1alpha1.InterfaceTypeLoopback {
+		return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "spec.interfaceRefs[*].igmp",
+			Description: fmt.Sprintf("IGMP querier can only be configured on Layer 3 interfaces, but %q is not", intf.Interface.Name),
+		})
+	}
+
+	igmp := new(IGMPIf)
+	igmp.ID = name
+	switch intf.IGMP.Version {
+	case v1alpha1.IGMPVersion2, "":
+		igmp.Ver = IGMPVersion2
+	case v1alpha1.IGMPVersion3:
+		igmp.Ver = IGMPVersion3
+	default:
+		return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "spec.interfaceRefs[*].igmp.version",
+			Description: fmt.Sprintf("unsupported IGMP version %q", intf.IGMP.Version),
+		})
+	}
+
+	igmp.QuerierpItems.QueryIntvl = DefaultIGMPQueryInterval
+	if d := intf.IGMP.QueryInterval; d != nil {
+		if d.Duration < time.Second || d.Duration > 18000*time.Second || d.Duration%time.Second != 0 {
+			return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.interfaceRefs[*].igmp.queryInterval",
+				Description: fmt.Sprintf("query interval %s must be a whole number of seconds between 1s and 18000s", d.Duration),
+			})
+		}
+		igmp.QuerierpItems.QueryIntvl = uint16(d.Duration / time.Second) // #nosec G115
+	}
+
+	igmp.QuerierpItems.RobustFac = DefaultIGMPRobustness
+	if r := intf.IGMP.RobustnessVariable; r != 0 {
+		if r < 1 || r > 7 {
+			return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.interfaceRefs[*].igmp.robustnessVariable",
+				Description: fmt.Sprintf("robustness variable %d must be between 1 and 7", r),
+			})
+		}
+		igmp.QuerierpItems.RobustFac = uint8(r) // #nosec G115
+	}
+
+	return igmp, nil
 }
 
 func (p *Provider) DeletePIM(ctx context.Context, _ *provider.DeletePIMRequest) error {
@@ -2262,20 +4281,29 @@ func (p *Provider) DeletePIM(ctx context.Context, _ *provider.DeletePIMRequest)
 		return err
 	}
 
-	return p.client.Delete(ctx, new(StaticRPItems), new(AnycastPeerItems), new(PIMIfItems))
+	return p.client.Delete(ctx, new(StaticRPItems), new(AnycastPeerItems), new(PIMIfItems), new(IGMPIfItems), new(PIMBSRItems), new(PIMAutoRPItems))
 }
 
 func (p *Provider) EnsurePrefixSet(ctx context.Context, req *provider.PrefixSetRequest) error {
 	s := new(PrefixList)
 	s.Name = req.PrefixSet.Spec.Name
 	s.Is6 = req.PrefixSet.Is6()
-	for _, entry := range req.PrefixSet.Spec.Entries {
+	for i, entry := range req.PrefixSet.Spec.Entries {
 		e := new(PrefixEntry)
 		e.Action = ActionPermit
+		if entry.Action == v1alpha1.PrefixEntryActionDeny {
+			e.Action = ActionDeny
+		}
 		e.Criteria = CriteriaExact
 		e.Order = entry.Sequence
 		e.Pfx = entry.Prefix.String()
 		bits := int8(entry.Prefix.Bits()) // #nosec G115
+		if r := entry.MaskLengthRange; r != nil && (r.Min < bits || r.Min > r.Max || int(r.Max) > entry.Prefix.Addr().BitLen()) {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       fmt.Sprintf("spec.entries[%d].maskLengthRange", i),
+				Description: fmt.Sprintf("mask length range %d-%d must be within %d and %d", r.Min, r.Max, bits, entry.Prefix.Addr().BitLen()),
+			})
+		}
 		if entry.MaskLengthRange != nil && (entry.MaskLengthRange.Min != bits || entry.MaskLengthRange.Max != bits) {
 			e.Criteria = CriteriaInexact
 			e.ToPfxLen = entry.MaskLengthRange.Max
@@ -2295,6 +4323,91 @@ func (p *Provider) DeletePrefixSet(ctx context.Context, req *provider.PrefixSetR
 	return p.client.Delete(ctx, s)
 }
 
+func (p *Provider) EnsureQoSPolicy(ctx context.Context, req *provider.QoSPolicyRequest) error {
+	pm := new(QoSPolicyMap)
+	pm.Name = req.QoSPolicy.Spec.Name
+
+	updates := make([]gnmiext.DataElement, 0, len(req.QoSPolicy.Spec.Classes)+1)
+	for i, c := range req.QoSPolicy.Spec.Classes {
+		cm, mc, err := newQoSClass(fmt.Sprintf("spec.classes[%d]", i), &c)
+		if err != nil {
+			return err
+		}
+		updates = append(updates, cm)
+		pm.CmapItems.MatchCMapList.Set(mc)
+	}
+	updates = append(updates, pm)
+
+	// Class-maps of classes that were removed from the policy can only be deleted
+	// once the updated policy-map no longer references them.
+	deletes, err := p.staleQoSClassMaps(ctx, pm)
+	if err != nil {
+		return err
+	}
+	if err := p.Update(ctx, updates...); err != nil {
+		return err
+	}
+	return p.client.Delete(ctx, deletes...)
+}
+
+func (p *Provider) DeleteQoSPolicy(ctx context.Context, req *provider.QoSPolicyRequest) error {
+	pm := new(QoSPolicyMap)
+	pm.Name = req.QoSPolicy.Spec.Name
+	// All class-maps referenced by the policy-map on the device are stale once it is deleted.
+	stale, err := p.staleQoSClassMaps(ctx, pm)
+	if err != nil {
+		return err
+	}
+	return p.client.Delete(ctx, append([]gnmiext.DataElement{pm}, stale...)...)
+}
+
+// staleQoSClassMaps returns the class-maps referenced by the policy-map currently configured on the device
+// that are not referenced by the given policy-map.
+func (p *Provider) staleQoSClassMaps(ctx context.Context, pm *QoSPolicyMap) ([]gnmiext.DataElement, error) {
+	cur := new(QoSPolicyMap)
+	cur.Name = pm.Name
+	if err := p.client.GetConfig(ctx, cur); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		return nil, err
+	}
+	var stale []gnmiext.DataElement
+	for _, name := range slices.Sorted(maps.Keys(cur.CmapItems.MatchCMapList)) {
+		if _, ok := pm.CmapItems.MatchCMapList.Get(name); !ok {
+			stale = append(stale, &QoSClassMap{Name: name})
+		}
+	}
+	return stale, nil
+}
+
+// newQoSClass converts a traffic class into a class-map of type qos and its markings within a policy-map.
+func newQoSClass(field string, c *v1alpha1.QoSClass) (*QoSClassMap, *QoSMatchClass, error) {
+	if len(c.Match.DSCP) == 0 && len(c.Match.CoS) == 0 {
+		return nil, nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       field + ".match",
+			Description: "at least one of dscp or cos must be set",
+		})
+	}
+	cm := &QoSClassMap{Name: c.Name, MatchType: MatchTypeAny}
+	for _, v := range c.Match.DSCP {
+		cm.DscpItems.DscpList.Set(&QoSMatchValue{Val: v})
+	}
+	for _, v := range c.Match.CoS {
+		cm.CosItems.CosList.Set(&QoSMatchValue{Val: v})
+	}
+	mc := &QoSMatchClass{Name: c.Name}
+	if set := c.Set; set != nil {
+		if set.DSCP != nil {
+			mc.SetDSCPItems = &QoSSetVal{Val: *set.DSCP}
+		}
+		if set.CoS != nil {
+			mc.SetCosItems = &QoSSetVal{Val: *set.CoS}
+		}
+		if set.QoSGroup != nil {
+			mc.SetQoSGrpItems = &QoSSetID{ID: *set.QoSGroup}
+		}
+	}
+	return cm, mc, nil
+}
+
 func (p *Provider) EnsureRoutingPolicy(ctx context.Context, req *provider.EnsureRoutingPolicyRequest) error {
 	rm := new(RouteMap)
 	rm.Name = req.Name
@@ -2306,6 +4419,10 @@ func (p *Provider) EnsureRoutingPolicy(ctx context.Context, req *provider.Ensure
 			switch v := cond.(type) {
 			case provider.MatchPrefixSetCondition:
 				e.SetPrefixSet(v.PrefixSet.Spec.Name, v.PrefixSet.Is6())
+			case provider.MatchCommunityListCondition:
+				e.SetCommunityLists(v.Names)
+			case provider.MatchTagCondition:
+				e.SetTags(v.Tags)
 			default:
 				return fmt.Errorf("routing policy: unsupported condition type %T", cond)
 			}
@@ -2357,6 +4474,16 @@ func (p *Provider) EnsureRoutingPolicy(ctx context.Context, req *provider.Ensure
 					e.SetASPathItems.AsnList = asp.ASNumber.String()
 				}
 			}
+			if stmt.Actions.BgpActions.SetLocalPreference != nil {
+				e.SprefItems = &SetPref{LocalPref: uint32(*stmt.Actions.BgpActions.SetLocalPreference)} // #nosec G115
+			}
+		}
+
+		if stmt.Actions.SetMetric != nil {
+			e.SmetricItems = &SetMetric{Metric: uint32(*stmt.Actions.SetMetric)} // #nosec G115
+		}
+		if stmt.Actions.SetNextHop.IsValid() {
+			e.SetNextHop(stmt.Actions.SetNextHop.Addr)
 		}
 
 		rm.EntItems.EntryList.Set(e)
@@ -2542,6 +4669,37 @@ func (p *Provider) DeleteSNMP(ctx context.Context, req *provider.DeleteSNMPReque
 	)
 }
 
+func (p *Provider) GetSNMPStatus(ctx context.Context, req *provider.SNMPStatusRequest) (provider.SNMPStatus, error) {
+	communities := new(SNMPCommunityItems)
+	if err := p.client.GetConfig(ctx, communities); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		return provider.SNMPStatus{}, err
+	}
+
+	hosts := new(SNMPHostItems)
+	if err := p.client.GetConfig(ctx, hosts); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		return provider.SNMPStatus{}, err
+	}
+
+	traps := new(SNMPTrapsItems)
+	if err := p.client.GetConfig(ctx, traps); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		return provider.SNMPStatus{}, err
+	}
+
+	var s provider.SNMPStatus
+	for _, c := range communities.CommSecPList {
+		s.Communities = append(s.Communities, c.Name)
+	}
+	for _, h := range hosts.HostList {
+		if !slices.Contains(s.Hosts, h.HostName) {
+			s.Hosts = append(s.Hosts, h.HostName)
+		}
+	}
+	slices.Sort(s.Communities)
+	slices.Sort(s.Hosts)
+	s.Traps = traps.Enabled()
+	return s, nil
+}
+
 type SyslogConfig struct {
 	OriginID            string
 	SourceInterfaceName string
@@ -2783,6 +4941,17 @@ func (p *Provider) EnsureVRF(ctx context.Context, req *provider.VRFRequest) erro
 }
 
 func (p *Provider) DeleteVRF(ctx context.Context, req *provider.VRFRequest) error {
+	// Interfaces that are still members of the VRF would lose their IP configuration.
+	// Refuse the deletion until they have been removed from the VRF instead.
+	m := &VRFMembers{Vrf: req.VRF.Spec.Name}
+	if err := p.client.GetState(ctx, m); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		return err
+	}
+	if members := m.Interfaces(); len(members) > 0 {
+		slices.Sort(members)
+		return apistatus.NewFailedPreconditionError(fmt.Sprintf("VRF %q still has member interfaces: %s", req.VRF.Spec.Name, strings.Join(members, ", ")))
+	}
+
 	v := new(VRF)
 	v.Name = req.VRF.Spec.Name
 	if err := p.client.Delete(ctx, v); err != nil {
@@ -2794,8 +4963,9 @@ func (p *Provider) DeleteVRF(ctx context.Context, req *provider.VRFRequest) erro
 }
 
 func (p *Provider) EnsureSystemSettings(ctx context.Context, s *nxv1alpha1.System) error {
-	long := new(VLANSystem)
-	long.LongName = s.Spec.VlanLongName
+	vlan := new(VLANSystem)
+	vlan.LongName = s.Spec.VlanLongName
+	vlan.TagNative = s.Spec.TagNativeVlan
 
 	res := new(VLANReservation)
 	*res = VLANReservation(s.Spec.ReservedVlan)
@@ -2803,7 +4973,7 @@ func (p *Provider) EnsureSystemSettings(ctx context.Context, s *nxv1alpha1.Syste
 	sys := new(SystemJumboMTU)
 	*sys = SystemJumboMTU(s.Spec.JumboMTU)
 
-	return p.Patch(ctx, long, res, sys)
+	return p.Patch(ctx, vlan, res, sys)
 }
 
 func (p *Provider) ResetSystemSettings(ctx context.Context) error {
@@ -2836,6 +5006,10 @@ type VPCDomainStatus struct {
 // `vrf` is a resource referencing the VRF to use in the keep-alive link configuration, can be nil.
 // `pc` is a resource referencing a port-channel interface to use as vPC peer-link, must not be nil.
 func (p *Provider) EnsureVPCDomain(ctx context.Context, vpcdomain *nxv1alpha1.VPCDomain, vrf *v1alpha1.VRF, pc *v1alpha1.Interface) (reterr error) {
+	if err := validateVPCDomain(vpcdomain); err != nil {
+		return err
+	}
+
 	f := new(Feature)
 	f.Name = "vpc"
 	f.AdminSt = AdminStEnabled
@@ -2905,6 +5079,43 @@ func (p *Provider) DeleteVPCDomain(ctx context.Context) error {
 	return p.client.Delete(ctx, v)
 }
 
+// validateVPCDomain validates the domain ID and the keepalive addresses of the vPC domain.
+func validateVPCDomain(vpcdomain *nxv1alpha1.VPCDomain) error {
+	var violations []apistatus.FieldViolation
+	if id := vpcdomain.Spec.DomainID; id < 1 || id > MaxVPCDomainID {
+		violations = append(violations, apistatus.FieldViolation{
+			Field:       "spec.domainId",
+			Description: fmt.Sprintf("domain ID %d must be between 1 and %d", id, MaxVPCDomainID),
+		})
+	}
+
+	ka := vpcdomain.Spec.Peer.KeepAlive
+	src, srcErr := netip.ParseAddr(ka.Source)
+	if srcErr != nil || !src.Is4() || src.IsUnspecified() {
+		violations = append(violations, apistatus.FieldViolation{
+			Field:       "spec.peer.keepalive.source",
+			Description: fmt.Sprintf("invalid keepalive source address %q", ka.Source),
+		})
+	}
+	dst, dstErr := netip.ParseAddr(ka.Destination)
+	if dstErr != nil || !dst.Is4() || dst.IsUnspecified() {
+		violations = append(violations, apistatus.FieldViolation{
+			Field:       "spec.peer.keepalive.destination",
+			Description: fmt.Sprintf("invalid keepalive destination address %q", ka.Destination),
+		})
+	} else if srcErr == nil && src == dst {
+		violations = append(violations, apistatus.FieldViolation{
+			Field:       "spec.peer.keepalive.destination",
+			Description: "keepalive destination address must differ from the source address",
+		})
+	}
+
+	if len(violations) > 0 {
+		return apistatus.NewInvalidArgumentError(violations...)
+	}
+	return nil
+}
+
 // GetStatusVPCDomain retrieves the current status of the vPC configuration on the device.
 func (p *Provider) GetStatusVPCDomain(ctx context.Context) (VPCDomainStatus, error) {
 	vdOper := new(VPCDomainOper)
@@ -3121,6 +5332,24 @@ func (p *Provider) ResetBorderGatewaySettings(ctx context.Context) error {
 // EnsureNVE ensures that the NVE configuration on the device matches the desired state specified in the NVE custom resource.
 // If no provider config is provided then the provider will use default settings.
 func (p *Provider) EnsureNVE(ctx context.Context, req *provider.NVERequest) error {
+	if req.NVE.Spec.AnycastGateway != nil {
+		if err := validateAnycastGatewayMAC(req.NVE.Spec.AnycastGateway.VirtualMAC); err != nil {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       "spec.anycastGateway.virtualMAC",
+				Description: err.Error(),
+			})
+		}
+	}
+
+	members := make([]gnmiext.DataElement, 0, len(req.VNIs))
+	for i, m := range req.VNIs {
+		v, err := nveMember(i, m)
+		if err != nil {
+			return err
+		}
+		members = append(members, v)
+	}
+
 	features := make([]gnmiext.DataElement, 0, 3)
 
 	f1 := new(Feature)
@@ -3166,6 +5395,7 @@ func (p *Provider) EnsureNVE(ctx context.Context, req *provider.NVERequest) erro
 	}
 
 	n.SuppressARP = req.NVE.Spec.SuppressARP
+	n.IngressReplProtoBGP = req.NVE.Spec.ReplicationMode == v1alpha1.ReplicationModeIngressReplication
 
 	switch req.NVE.Spec.HostReachability {
 	case v1alpha1.HostReachabilityTypeBGP:
@@ -3223,10 +5453,63 @@ func (p *Provider) EnsureNVE(ctx context.Context, req *provider.NVERequest) erro
 		ag.Address = req.NVE.Spec.AnycastGateway.VirtualMAC
 	}
 	patches = append(patches, ag)
+	patches = append(patches, members...)
 
 	return p.Patch(ctx, patches...)
 }
 
+// nveMember converts the per-VNI settings of an NVE into the corresponding member of the NVE.
+func nveMember(i int, m provider.NVEMember) (*VNIMember, error) {
+	if m.VNI < minVNI || m.VNI > maxVNI {
+		return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       fmt.Sprintf("vnis[%d].vni", i),
+			Description: fmt.Sprintf("VNI %d is out of range, must be between %d and %d", m.VNI, minVNI, maxVNI),
+		})
+	}
+	if m.MulticastGroup != "" && m.IngressReplication != "" {
+		return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       fmt.Sprintf("vnis[%d].ingressReplication", i),
+			Description: "multicast group and ingress replication are mutually exclusive",
+		})
+	}
+
+	v := new(VNIMember)
+	v.Vni = m.VNI
+	if m.MulticastGroup != "" {
+		addr, err := netip.ParseAddr(m.MulticastGroup)
+		if err != nil || !addr.Is4() || !addr.IsMulticast() {
+			return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       fmt.Sprintf("vnis[%d].multicastGroup", i),
+				Description: fmt.Sprintf("%q is not a valid IPv4 multicast group", m.MulticastGroup),
+			})
+		}
+		v.McastGroup = addr.String()
+	}
+
+	switch m.IngressReplication {
+	case "":
+	case provider.IngressReplicationProtocolBGP:
+		v.IngReplItems.Proto = IngReplProtoBGP
+	case provider.IngressReplicationProtocolStatic:
+		v.IngReplItems.Proto = IngReplProtoStatic
+	default:
+		return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       fmt.Sprintf("vnis[%d].ingressReplication", i),
+			Description: fmt.Sprintf("unsupported ingress replication protocol %q", m.IngressReplication),
+		})
+	}
+
+	v.SuppressARP = VNISuppressARPOff
+	if m.SuppressARP != nil {
+		v.SuppressARP = VNISuppressARPDisabled
+		if *m.SuppressARP {
+			v.SuppressARP = VNISuppressARPEnabled
+		}
+	}
+
+	return v, nil
+}
+
 func (p *Provider) DeleteNVE(ctx context.Context, req *provider.NVERequest) error {
 	v := new(NVE)
 	iv := new(NVEInfraVLANs)
@@ -3243,6 +5526,18 @@ func (p *Provider) GetNVEStatus(ctx context.Context, req *provider.NVERequest) (
 		return provider.NVEStatus{}, err
 	}
 	s.OperStatus = op.OperSt == OperStUp
+	for _, peer := range op.PeersItems.DyPeerItems.PeerList {
+		s.Peers = append(s.Peers, provider.NVEPeer{
+			Address: peer.IP,
+			State:   peer.State.ToNVEPeerState(),
+		})
+	}
+	for _, vni := range op.NwsItems.OperVniItems.OperNwList {
+		s.VNIs = append(s.VNIs, provider.NVEVNIStatus{
+			VNI:        vni.Vni,
+			OperStatus: vni.State == VNIStateUp,
+		})
+	}
 
 	n := new(NVE)
 	if err := p.client.GetConfig(ctx, n); err != nil && !errors.Is(err, gnmiext.ErrNil) {
@@ -3363,27 +5658,52 @@ func (p *Provider) GetLLDPStatus(ctx context.Context, req *provider.LLDPRequest)
 }
 
 func (p *Provider) Patch(ctx context.Context, patches ...gnmiext.DataElement) error {
-	if NXVersion(p.client.Capabilities()) > VersionNX10_6_2 {
+	if p.version > VersionNX10_6_2 {
 		return p.client.Patch(ctx, patches...)
 	}
 	fa, patches := separateFeatureActivation(patches)
-	if err := p.client.Patch(ctx, fa...); err != nil {
+	if err := p.featureClient().Patch(ctx, fa...); err != nil {
 		return err
 	}
 	return p.client.Patch(ctx, patches...)
 }
 
 func (p *Provider) Update(ctx context.Context, updates ...gnmiext.DataElement) error {
-	if NXVersion(p.client.Capabilities()) > VersionNX10_6_2 {
+	if p.version > VersionNX10_6_2 {
 		return p.client.Update(ctx, updates...)
 	}
 	fa, updates := separateFeatureActivation(updates)
-	if err := p.client.Update(ctx, fa...); err != nil {
+	if err := p.featureClient().Update(ctx, fa...); err != nil {
 		return err
 	}
 	return p.client.Update(ctx, updates...)
 }
 
+// featureClient returns the client used to activate features on NX-OS versions <= 10.6(2).
+// As these versions reject configuration sent together with the activation of the feature it
+// depends on, features are activated right away within a transaction rather than on commit.
+func (p *Provider) featureClient() gnmiext.Client {
+	if p.parent != nil {
+		return p.parent
+	}
+	return p.client
+}
+
+// Transaction calls fn with a copy of the provider whose gNMI configuration changes are
+// collected and sent to the device in a single Set RPC, which NX-OS applies atomically.
+// Operations carried out through NX-API, e.g. saving the configuration, are not part of the
+// transaction. On NX-OS versions <= 10.6(2), features are activated before the commit.
+func (p *Provider) Transaction(ctx context.Context, fn func(ctx context.Context, p provider.Provider) error) error {
+	return p.client.Transaction(ctx, func(c gnmiext.Client) error {
+		tx := *p
+		tx.client = c
+		if tx.parent == nil {
+			tx.parent = p.client
+		}
+		return fn(ctx, &tx)
+	})
+}
+
 // separateFeatureActivation separates feature activation configurations from other configurations.
 // This is necessary for NX-OS versions <= 10.6(2) where feature activation must be performed before applying configurations.
 // For more details, see: https://github.com/ironcore-dev/network-operator/issues/148
@@ -3414,6 +5734,14 @@ func (p *Provider) EnsureDHCPRelay(ctx context.Context, req *provider.DHCPRelayR
 		vrfName = req.VRF.Spec.Name
 	}
 
+	var trusted bool
+	opt := new(DHCPRelayInfoOption)
+	if o82 := req.DHCPRelay.Spec.Option82; o82 != nil {
+		// Messages received on untrusted interfaces that already contain option 82 are dropped.
+		trusted = o82.Policy == v1alpha1.DHCPOption82PolicyKeep
+		*opt = true
+	}
+
 	updates := new(DHCPRelayConfig)
 	for _, intf := range req.Interfaces {
 		ifName, err := ShortName(intf.Spec.Name)
@@ -3421,7 +5749,7 @@ func (p *Provider) EnsureDHCPRelay(ctx context.Context, req *provider.DHCPRelayR
 			return fmt.Errorf("dhcp relay: failed to get short name for interface %q: %w", intf.Spec.Name, err)
 		}
 
-		relay := &DHCPRelay{ID: ifName}
+		relay := &DHCPRelay{ID: ifName, Trusted: trusted}
 		for _, addr := range req.DHCPRelay.Spec.Servers {
 			a, err := netip.ParseAddr(addr)
 			if err != nil {
@@ -3432,13 +5760,13 @@ func (p *Provider) EnsureDHCPRelay(ctx context.Context, req *provider.DHCPRelayR
 		updates.RelayIfList.Set(relay)
 	}
 
-	return p.Update(ctx, f, updates)
+	return p.Update(ctx, f, updates, opt)
 }
 
 // DeleteDHCPRelay removes all DHCP relay configurations from the device.
 func (p *Provider) DeleteDHCPRelay(ctx context.Context, req *provider.DHCPRelayRequest) error {
 	config := new(DHCPRelayConfig)
-	return p.client.Delete(ctx, config)
+	return p.client.Delete(ctx, config, new(DHCPRelayInfoOption))
 }
 
 // GetDHCPRelayStatus retrieves the current DHCP relay status.
@@ -3821,6 +6149,223 @@ func (p *Provider) DeleteAAA(ctx context.Context, req *provider.DeleteAAARequest
 	return p.Update(ctx, &Feature{Name: "tacacsplus", AdminSt: AdminStDisabled})
 }
 
+// EnsureStaticRoute replaces the static route on the device, such that it only contains the requested next-hops.
+func (p *Provider) EnsureStaticRoute(ctx context.Context, req *provider.StaticRouteRequest) error {
+	r, err := newStaticRoute(req)
+	if err != nil {
+		return err
+	}
+	for _, nh := range req.NextHops {
+		n := new(StaticNextHop)
+		n.NhVrf = r.Vrf
+		n.NhIf = NextHopIfUnspecified
+		n.NhAddr = "0.0.0.0/32"
+		if r.Is6 {
+			n.NhAddr = "::/128"
+		}
+		switch {
+		case nh.Discard:
+			if nh.Address.IsValid() || nh.Interface != "" {
+				return fmt.Errorf("static route %s: discard next-hop must not have an address or interface", req.Prefix)
+			}
+			n.NhIf = NextHopIfNull
+		case !nh.Address.IsValid() && nh.Interface == "":
+			return fmt.Errorf("static route %s: next-hop must have an address or interface", req.Prefix)
+		}
+		if nh.Address.IsValid() {
+			if nh.Address.Is6() != r.Is6 || nh.Address.Is4In6() || nh.Address.IsUnspecified() {
+				return fmt.Errorf("static route %s: next-hop address %s does not match the address family of the prefix", req.Prefix, nh.Address)
+			}
+			n.NhAddr = netip.PrefixFrom(nh.Address, nh.Address.BitLen()).String()
+		}
+		if nh.Interface != "" {
+			n.NhIf, err = ShortName(nh.Interface)
+			if err != nil {
+				return fmt.Errorf("static route %s: invalid next-hop interface: %w", req.Prefix, err)
+			}
+		}
+		n.Pref = DefaultStaticRoutePref
+		if req.Distance != 0 {
+			if req.Distance < 1 || req.Distance > 255 {
+				return fmt.Errorf("static route %s: administrative distance %d must be between 1 and 255", req.Prefix, req.Distance)
+			}
+			n.Pref = req.Distance
+		}
+		n.Tag = req.Tag
+		r.NhItems.NexthopList.Set(n)
+	}
+	if r.NhItems.NexthopList.Len() == 0 {
+		return fmt.Errorf("static route %s: at least one next-hop is required", req.Prefix)
+	}
+	return p.Update(ctx, r)
+}
+
+func (p *Provider) DeleteStaticRoute(ctx context.Context, req *provider.StaticRouteRequest) error {
+	r, err := newStaticRoute(req)
+	if err != nil {
+		return err
+	}
+	return p.client.Delete(ctx, r)
+}
+
+func (p *Provider) LookupRoute(ctx context.Context, vrf string, prefix netip.Prefix) (*provider.RouteEntry, error) {
+	if !prefix.IsValid() || prefix.Addr().Is4In6() || prefix != prefix.Masked() {
+		return nil, apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "spec.route.prefix",
+			Description: fmt.Sprintf("invalid prefix %s", prefix),
+		})
+	}
+	if vrf == "" {
+		vrf = DefaultVRFName
+	}
+	if vrf != DefaultVRFName {
+		if err := p.client.GetConfig(ctx, &VRF{Name: vrf}); err != nil {
+			if errors.Is(err, gnmiext.ErrNil) {
+				return nil, apistatus.NewFailedPreconditionError(fmt.Sprintf("route lookup: VRF %s does not exist on the device", vrf))
+			}
+			return nil, err
+		}
+	}
+
+	r := new(RIBRoute)
+	r.Prefix = prefix.String()
+	r.Vrf = vrf
+	r.Is6 = prefix.Addr().Is6()
+	if err := p.client.GetState(ctx, r); err != nil {
+		if errors.Is(err, gnmiext.ErrNil) {
+			return nil, apistatus.NewFailedPreconditionError(fmt.Sprintf("route lookup: no route for %s in VRF %s", prefix, vrf))
+		}
+		return nil, err
+	}
+
+	entry := &provider.RouteEntry{Prefix: prefix, VRF: vrf}
+	for _, nh := range r.NextHops() {
+		n := provider.RouteEntryNextHop{
+			Interface: nh.IfName,
+			Distance:  nh.Pref,
+			Metric:    nh.Metric,
+		}
+		// The owner is reported as "<protocol>-<instance>", e.g. "bgp-65000".
+		n.Protocol, _, _ = strings.Cut(nh.Owner, "-")
+		if addr, err := netip.ParseAddr(nh.Addr); err == nil && !addr.IsUnspecified() {
+			n.Address = addr
+		} else if pfx, err := netip.ParsePrefix(nh.Addr); err == nil && !pfx.Addr().IsUnspecified() {
+			n.Address = pfx.Addr()
+		}
+		if nh.Vrf != vrf {
+			n.VRF = nh.Vrf
+		}
+		entry.NextHops = append(entry.NextHops, n)
+	}
+	return entry, nil
+}
+
+// newStaticRoute returns the static route for the prefix and VRF of the request, without any next-hops.
+func newStaticRoute(req *provider.StaticRouteRequest) (*StaticRoute, error) {
+	if !req.Prefix.IsValid() || req.Prefix.Addr().Is4In6() || req.Prefix != req.Prefix.Masked() {
+		return nil, fmt.Errorf("static route: invalid prefix %s", req.Prefix)
+	}
+	r := new(StaticRoute)
+	r.Prefix = req.Prefix.String()
+	r.Is6 = req.Prefix.Addr().Is6()
+	r.Vrf = DefaultVRFName
+	if req.VRF != "" {
+		r.Vrf = req.VRF
+	}
+	return r, nil
+}
+
+func (p *Provider) EnsureSpanningTree(ctx context.Context, req *provider.SpanningTreeRequest) error {
+	if req.RootGuard {
+		return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "rootGuard",
+			Description: "root guard cannot be enabled by default, it must be enabled per interface",
+		})
+	}
+
+	stp := new(STPInst)
+	stp.BPDUGuard = AdminStDisabled
+	if req.BPDUGuard {
+		stp.BPDUGuard = AdminStEnabled
+	}
+
+	switch req.Mode {
+	case provider.SpanningTreeModeRSTP:
+		stp.Mode = STPModeRapidPVST
+	case provider.SpanningTreeModeMST:
+		stp.Mode = STPModeMST
+	default:
+		return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+			Field:       "mode",
+			Description: fmt.Sprintf("unsupported spanning tree mode %q", req.Mode),
+		})
+	}
+
+	for i, prio := range req.Priorities {
+		if prio.Priority < 0 || prio.Priority > MaxSTPPriority || prio.Priority%STPPriorityStep != 0 {
+			return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+				Field:       fmt.Sprintf("priorities[%d].priority", i),
+				Description: fmt.Sprintf("priority %d must be a multiple of %d between 0 and %d", prio.Priority, STPPriorityStep, MaxSTPPriority),
+			})
+		}
+		switch stp.Mode {
+		case STPModeRapidPVST:
+			if prio.ID < 1 || prio.ID > 4094 {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       fmt.Sprintf("priorities[%d].id", i),
+					Description: fmt.Sprintf("VLAN ID %d must be between 1 and 4094", prio.ID),
+				})
+			}
+			if _, ok := stp.VlanItems.VlanList.Get(prio.ID); ok {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       fmt.Sprintf("priorities[%d].id", i),
+					Description: fmt.Sprintf("duplicate priority for VLAN %d", prio.ID),
+				})
+			}
+			stp.VlanItems.VlanList.Set(&STPVlan{ID: prio.ID, BridgePriority: prio.Priority})
+		case STPModeMST:
+			if prio.ID < 0 || prio.ID > MaxSTPInstance {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       fmt.Sprintf("priorities[%d].id", i),
+					Description: fmt.Sprintf("MST instance %d must be between 0 and %d", prio.ID, MaxSTPInstance),
+				})
+			}
+			if _, ok := stp.MstentItems.MstItems.MstList.Get(prio.ID); ok {
+				return apistatus.NewInvalidArgumentError(apistatus.FieldViolation{
+					Field:       fmt.Sprintf("priorities[%d].id", i),
+					Description: fmt.Sprintf("duplicate priority for MST instance %d", prio.ID),
+				})
+			}
+			stp.MstentItems.MstItems.MstList.Set(&STPMst{ID: prio.ID, BridgePriority: prio.Priority})
+		}
+	}
+
+	// The priorities are merged into the existing configuration, so the priorities of
+	// VLANs and instances that are no longer requested must be removed explicitly.
+	current := new(STPInst)
+	if err := p.client.GetConfig(ctx, current); err != nil && !errors.Is(err, gnmiext.ErrNil) {
+		return err
+	}
+	var deletes []gnmiext.DataElement
+	for id, v := range current.VlanItems.VlanList {
+		if _, ok := stp.VlanItems.VlanList.Get(id); !ok {
+			deletes = append(deletes, v)
+		}
+	}
+	for id, m := range current.MstentItems.MstItems.MstList {
+		if _, ok := stp.MstentItems.MstItems.MstList.Get(id); !ok {
+			deletes = append(deletes, m)
+		}
+	}
+	if err := p.client.Delete(ctx, deletes...); err != nil {
+		return err
+	}
+
+	return p.Patch(ctx, stp)
+}
+
 func init() {
-	provider.Register("cisco-nxos-gnmi", NewProvider)
+	provider.Register("cisco-nxos-gnmi", func() provider.Provider {
+		return NewProvider(WithConnectRetry(3, 2*time.Second), WithMaxPathsPerRequest(provider.MaxPathsPerRequest()))
+	})
 }
@@ -5,17 +5,32 @@
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"maps"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"slices"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/tidwall/gjson"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/ironcore-dev/network-operator/internal/deviceutil"
+	"github.com/ironcore-dev/network-operator/internal/provider"
 	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+	"github.com/ironcore-dev/network-operator/internal/transport/nxapi"
 )
 
 type TestCase struct {
@@ -144,3 +159,363 @@ func jsonEqual(a, b []byte) bool {
 	}
 	return cmp.Equal(v1, v2, jsonNormalizer)
 }
+
+func TestProvider_Handshake(t *testing.T) {
+	tests := []struct {
+		name         string
+		failures     int
+		maxAttempts  int
+		backoff      time.Duration
+		timeout      time.Duration
+		wantErr      error
+		wantAttempts int
+	}{
+		{
+			name:         "succeeds after two failures",
+			backoff:      time.Millisecond,
+			failures:     2,
+			maxAttempts:  3,
+			wantAttempts: 3,
+		},
+		{
+			name:         "always unavailable",
+			backoff:      time.Millisecond,
+			failures:     -1,
+			maxAttempts:  3,
+			wantErr:      ErrDeviceUnavailable,
+			wantAttempts: 3,
+		},
+		{
+			name:         "no retry by default",
+			backoff:      time.Millisecond,
+			failures:     1,
+			maxAttempts:  1,
+			wantErr:      ErrDeviceUnavailable,
+			wantAttempts: 1,
+		},
+		{
+			name:         "context deadline before next attempt",
+			failures:     -1,
+			maxAttempts:  10,
+			backoff:      time.Minute,
+			timeout:      time.Second,
+			wantErr:      ErrDeviceUnavailable,
+			wantAttempts: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conn := &unavailableConn{failures: test.failures}
+			p := NewProvider(WithConnectRetry(test.maxAttempts, test.backoff)).(*Provider)
+
+			ctx := context.Background()
+			if test.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				defer cancel()
+			}
+
+			_, err := p.handshake(ctx, conn)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("handshake() error = %v, want %v", err, test.wantErr)
+			}
+			if conn.attempts != test.wantAttempts {
+				t.Errorf("handshake() attempts = %d, want %d", conn.attempts, test.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestProvider_HandshakeNoRetryOnOtherErrors(t *testing.T) {
+	conn := &unavailableConn{err: status.Error(codes.Unauthenticated, "bad credentials")}
+	p := NewProvider(WithConnectRetry(3, time.Millisecond)).(*Provider)
+
+	_, err := p.handshake(context.Background(), conn)
+	if err == nil || errors.Is(err, ErrDeviceUnavailable) {
+		t.Errorf("handshake() error = %v, want non-retryable error", err)
+	}
+	if conn.attempts != 1 {
+		t.Errorf("handshake() attempts = %d, want 1", conn.attempts)
+	}
+}
+
+var _ grpc.ClientConnInterface = (*unavailableConn)(nil)
+
+// unavailableConn is a [grpc.ClientConnInterface] whose Capabilities RPC fails with [codes.Unavailable]
+// for the first failures calls (or always, if failures is negative) and succeeds afterwards.
+// If err is set, every call fails with err instead.
+type unavailableConn struct {
+	failures int
+	attempts int
+	err      error
+}
+
+func (c *unavailableConn) Invoke(_ context.Context, method string, _, reply any, _ ...grpc.CallOption) error {
+	if method != "/gnmi.gNMI/Capabilities" {
+		return status.Errorf(codes.Unimplemented, "method %s not mocked", method)
+	}
+	c.attempts++
+	if c.err != nil {
+		return c.err
+	}
+	if c.failures < 0 || c.attempts <= c.failures {
+		return status.Error(codes.Unavailable, "connection refused")
+	}
+	proto.Merge(reply.(*gpb.CapabilityResponse), &gpb.CapabilityResponse{
+		SupportedEncodings: []gpb.Encoding{gpb.Encoding_JSON},
+	})
+	return nil
+}
+
+func (c *unavailableConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Error(codes.Unimplemented, "streaming not mocked")
+}
+
+var _ gnmiext.Client = (*fakeClient)(nil)
+
+// fakeClient is an in-memory [gnmiext.Client] that stores the JSON payload configured on the device by xpath.
+// Values are only returned for the exact xpath they were stored at.
+type fakeClient struct {
+	config  map[string]string
+	deleted []string
+	// sets records the xpaths updated by each Set RPC, i.e. each call to Update or Patch
+	// and each committed transaction.
+	sets [][]string
+}
+
+func (c *fakeClient) Capabilities() *gnmiext.Capabilities { return &gnmiext.Capabilities{} }
+
+func (c *fakeClient) Ping(context.Context) error { return nil }
+
+func (c *fakeClient) GetConfig(_ context.Context, el ...gnmiext.DataElement) error {
+	for _, e := range el {
+		v, ok := c.config[e.XPath()]
+		if !ok {
+			return gnmiext.ErrNil
+		}
+		if err := json.Unmarshal([]byte(v), e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *fakeClient) GetState(ctx context.Context, el ...gnmiext.DataElement) error {
+	return c.GetConfig(ctx, el...)
+}
+
+func (c *fakeClient) Patch(ctx context.Context, el ...gnmiext.DataElement) error {
+	return c.Update(ctx, el...)
+}
+
+func (c *fakeClient) Update(_ context.Context, el ...gnmiext.DataElement) error {
+	if len(el) == 0 {
+		return nil
+	}
+	xpaths := make([]string, 0, len(el))
+	for _, e := range el {
+		b, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		c.config[e.XPath()] = string(b)
+		xpaths = append(xpaths, e.XPath())
+	}
+	c.sets = append(c.sets, xpaths)
+	return nil
+}
+
+// Delete removes the values stored at the xpaths and all of their descendants.
+func (c *fakeClient) Delete(_ context.Context, el ...gnmiext.DataElement) error {
+	for _, e := range el {
+		for k := range c.config {
+			if k == e.XPath() || strings.HasPrefix(k, e.XPath()+"/") {
+				delete(c.config, k)
+			}
+		}
+		c.deleted = append(c.deleted, e.XPath())
+	}
+	return nil
+}
+
+func (c *fakeClient) Subscribe(context.Context, []string, gnmiext.SubscribeMode) (<-chan gnmiext.Notification, error) {
+	return nil, errors.New("subscribe not supported by fake client")
+}
+
+// Transaction applies the changes made by fn to a copy of the configuration, which are only
+// transferred once fn returns successfully, so that a failing transaction has no effect.
+func (c *fakeClient) Transaction(_ context.Context, fn func(gnmiext.Client) error) error {
+	tx := &fakeClient{config: maps.Clone(c.config)}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	// As the device, process the deletes before the updates.
+	for _, xpath := range tx.deleted {
+		for k := range c.config {
+			if k == xpath || strings.HasPrefix(k, xpath+"/") {
+				delete(c.config, k)
+			}
+		}
+		c.deleted = append(c.deleted, xpath)
+	}
+	// Only transfer the changes, as the configuration may have been changed outside of the transaction.
+	if xpaths := slices.Concat(tx.sets...); len(xpaths) > 0 {
+		for _, xpath := range xpaths {
+			if v, ok := tx.config[xpath]; ok {
+				c.config[xpath] = v
+			}
+		}
+		c.sets = append(c.sets, xpaths)
+	}
+	return nil
+}
+
+// fakeNXAPI is a fake NX-API endpoint, which records the commands it receives.
+type fakeNXAPI struct {
+	mu   sync.Mutex
+	cmds []string
+}
+
+// newFakeNXAPI starts a fake NX-API endpoint and returns a client connected to it. The endpoint answers
+// each command with the JSON-RPC result returned by reply, or null if reply is nil. If reply returns an
+// error for any command of a request, the request fails with the error as JSON-RPC error.
+func newFakeNXAPI(t *testing.T, reply func(cmd string) (string, error)) (*fakeNXAPI, *nxapi.Client) {
+	t.Helper()
+	f := new(fakeNXAPI)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req []struct {
+			Params struct {
+				Cmd string `json:"cmd"`
+			} `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		res := make([]map[string]any, len(req))
+		status := http.StatusOK
+		f.mu.Lock()
+		for i, c := range req {
+			f.cmds = append(f.cmds, c.Params.Cmd)
+			res[i] = map[string]any{"jsonrpc": "2.0", "id": i + 1, "result": nil}
+			if reply == nil {
+				continue
+			}
+			result, err := reply(c.Params.Cmd)
+			if err != nil {
+				res[i]["error"] = map[string]any{"code": -32602, "message": err.Error()}
+				status = http.StatusBadRequest
+				continue
+			}
+			res[i]["result"] = json.RawMessage(result)
+		}
+		f.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json-rpc")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(res) //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := nxapi.NewClient(&deviceutil.Connection{Address: srv.Listener.Addr().String()})
+	if err != nil {
+		t.Fatalf("failed to create nxapi client: %v", err)
+	}
+	return f, client
+}
+
+// Commands returns the commands received by the endpoint so far.
+func (f *fakeNXAPI) Commands() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.cmds)
+}
+
+func TestProvider_Transaction(t *testing.T) {
+	const featureXPath = "System/fm-items/bgp-items"
+
+	tests := []struct {
+		name        string
+		version     Version
+		fnErr       error
+		wantHost    bool
+		wantFeature bool
+	}{
+		{
+			name:        "commit",
+			version:     VersionNX10_6_2,
+			wantHost:    true,
+			wantFeature: true,
+		},
+		{
+			name:        "rollback",
+			version:     VersionNX10_6_3,
+			fnErr:       errors.New("failed"),
+			wantHost:    false,
+			wantFeature: false,
+		},
+		{
+			// Features are activated right away on NX-OS versions <= 10.6(2), so that they remain active.
+			name:        "rollback with early feature activation",
+			version:     VersionNX10_6_2,
+			fnErr:       errors.New("failed"),
+			wantHost:    false,
+			wantFeature: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c, version: test.version}
+
+			err := p.Transaction(t.Context(), func(ctx context.Context, tp provider.Provider) error {
+				h := Hostname("leaf1")
+				if err := tp.(*Provider).Update(ctx, &Feature{Name: "bgp", AdminSt: AdminStEnabled}, &h); err != nil {
+					return err
+				}
+				if p.client == tp.(*Provider).client {
+					t.Error("Transaction() passed a provider that applies changes right away")
+				}
+				return test.fnErr
+			})
+			if !errors.Is(err, test.fnErr) {
+				t.Fatalf("Transaction() error = %v, want %v", err, test.fnErr)
+			}
+			if _, ok := c.config[new(Hostname).XPath()]; ok != test.wantHost {
+				t.Errorf("Transaction() configured hostname = %v, want %v", ok, test.wantHost)
+			}
+			if _, ok := c.config[featureXPath]; ok != test.wantFeature {
+				t.Errorf("Transaction() activated feature = %v, want %v", ok, test.wantFeature)
+			}
+		})
+	}
+}
+
+func TestProvider_SaveConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:    "rpc error",
+			err:     errors.New("Permission denied"),
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api, client := newFakeNXAPI(t, func(string) (string, error) { return "null", test.err })
+
+			p := &Provider{nxapi: client}
+			err := p.SaveConfig(t.Context())
+			if (err != nil) != test.wantErr {
+				t.Fatalf("SaveConfig() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if want := []string{"copy running-config startup-config"}; !slices.Equal(api.Commands(), want) {
+				t.Errorf("SaveConfig() nxapi commands = %v, want %v", api.Commands(), want)
+			}
+		})
+	}
+}
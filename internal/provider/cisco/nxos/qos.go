@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+)
+
+var (
+	_ gnmiext.DataElement = (*QueuingPolicyMap)(nil)
+	_ gnmiext.DataElement = (*QueuingServicePolicy)(nil)
+	_ gnmiext.DataElement = (*QueuingServicePolicies)(nil)
+	_ gnmiext.DataElement = (*QoSClassMap)(nil)
+	_ gnmiext.DataElement = (*QoSPolicyMap)(nil)
+	_ gnmiext.DataElement = (*QoSServicePolicy)(nil)
+)
+
+// QueuingClasses are the system-defined egress queuing classes of the 8-queue model.
+var QueuingClasses = []string{
+	"c-out-8q-q-default",
+	"c-out-8q-q1",
+	"c-out-8q-q2",
+	"c-out-8q-q3",
+	"c-out-8q-q4",
+	"c-out-8q-q5",
+	"c-out-8q-q6",
+	"c-out-8q-q7",
+}
+
+// QueuingTemplateDescr is the description of the queuing policy-maps that realize a bandwidth allocation
+// template, which tells them apart from the policy-maps configured otherwise.
+const QueuingTemplateDescr = "bandwidth allocation template"
+
+// QueuingPolicyMap represents a policy-map of type queuing.
+type QueuingPolicyMap struct {
+	Name      string `json:"name"`
+	Descr     string `json:"descr,omitempty"`
+	CmapItems struct {
+		MatchCMapList gnmiext.List[string, *QueuingMatchClass] `json:"MatchCMap-list,omitzero"`
+	} `json:"cmap-items,omitzero"`
+}
+
+func (*QueuingPolicyMap) IsListItem() {}
+
+func (p *QueuingPolicyMap) XPath() string {
+	return "System/ipqos-items/queuing-items/p-items/name-items/PMapInst-list[name=" + p.Name + "]"
+}
+
+// QueuingMatchClass represents a queuing class within a policy-map of type queuing.
+type QueuingMatchClass struct {
+	Name          string `json:"name"`
+	SetRemBWItems struct {
+		Val int32 `json:"val"`
+	} `json:"setRemBW-items"`
+}
+
+func (c *QueuingMatchClass) Key() string { return c.Name }
+
+// QueuingServicePolicy represents the attachment of a queuing policy-map to the egress of an interface.
+type QueuingServicePolicy struct {
+	IfName    string `json:"name"`
+	PmapItems struct {
+		Name string `json:"name"`
+	} `json:"pmap-items"`
+}
+
+func (*QueuingServicePolicy) IsListItem() {}
+
+func (s *QueuingServicePolicy) Key() string { return s.IfName }
+
+func (s *QueuingServicePolicy) XPath() string {
+	return "System/ipqos-items/queuing-items/policy-items/out-items/intf-items/If-list[name=" + s.IfName + "]"
+}
+
+// QueuingServicePolicies represents the queuing policy-maps attached to the egress of all interfaces.
+type QueuingServicePolicies struct {
+	IfList gnmiext.List[string, *QueuingServicePolicy] `json:"If-list,omitzero"`
+}
+
+func (*QueuingServicePolicies) XPath() string {
+	return "System/ipqos-items/queuing-items/policy-items/out-items/intf-items"
+}
+
+// AttachedTo returns the first interface other than the excluded one to which the policy-map with the
+// given name is attached, and reports whether there is any.
+func (s *QueuingServicePolicies) AttachedTo(pmap, exclude string) (string, bool) {
+	var name string
+	for _, sp := range s.IfList {
+		if sp.IfName != exclude && sp.PmapItems.Name == pmap && (name == "" || sp.IfName < name) {
+			name = sp.IfName
+		}
+	}
+	return name, name != ""
+}
+
+// QoSClassMap represents a class-map of type qos, which classifies traffic by its DSCP or CoS markings.
+type QoSClassMap struct {
+	Name      string    `json:"name"`
+	MatchType MatchType `json:"matchType"`
+	DscpItems struct {
+		DscpList gnmiext.List[int32, *QoSMatchValue] `json:"Dscp-list,omitzero"`
+	} `json:"dscp-items,omitzero"`
+	CosItems struct {
+		CosList gnmiext.List[int32, *QoSMatchValue] `json:"Cos-list,omitzero"`
+	} `json:"cos-items,omitzero"`
+}
+
+func (*QoSClassMap) IsListItem() {}
+
+func (c *QoSClassMap) XPath() string {
+	return "System/ipqos-items/dflt-items/c-items/name-items/CMapInst-list[name=" + c.Name + "]"
+}
+
+// MatchType is the logical operator applied to the match criteria of a class-map.
+type MatchType string
+
+const (
+	MatchTypeAny MatchType = "match-any"
+	MatchTypeAll MatchType = "match-all"
+)
+
+// QoSMatchValue represents a single DSCP or CoS value matched by a class-map.
+type QoSMatchValue struct {
+	Val int32 `json:"val"`
+}
+
+func (v *QoSMatchValue) Key() int32 { return v.Val }
+
+// QoSPolicyMap represents a policy-map of type qos.
+type QoSPolicyMap struct {
+	Name      string `json:"name"`
+	CmapItems struct {
+		MatchCMapList gnmiext.List[string, *QoSMatchClass] `json:"MatchCMap-list,omitzero"`
+	} `json:"cmap-items,omitzero"`
+}
+
+func (*QoSPolicyMap) IsListItem() {}
+
+func (p *QoSPolicyMap) XPath() string {
+	return "System/ipqos-items/dflt-items/p-items/name-items/PMapInst-list[name=" + p.Name + "]"
+}
+
+// QoSMatchClass represents a class-map of type qos within a policy-map and the markings it sets.
+type QoSMatchClass struct {
+	Name           string     `json:"name"`
+	SetDSCPItems   *QoSSetVal `json:"setDSCP-items,omitempty"`
+	SetCosItems    *QoSSetVal `json:"setCos-items,omitempty"`
+	SetQoSGrpItems *QoSSetID  `json:"setQoSGrp-items,omitempty"`
+}
+
+func (c *QoSMatchClass) Key() string { return c.Name }
+
+type QoSSetVal struct {
+	Val int32 `json:"val"`
+}
+
+type QoSSetID struct {
+	ID int32 `json:"id"`
+}
+
+// QoSServicePolicy represents the attachment of a qos policy-map to the input or output of an interface.
+type QoSServicePolicy struct {
+	IfName    string `json:"name"`
+	PmapItems struct {
+		Name string `json:"name"`
+	} `json:"pmap-items"`
+	// Output indicates whether the policy-map is attached to the output of the interface.
+	Output bool `json:"-"`
+}
+
+func (*QoSServicePolicy) IsListItem() {}
+
+func (s *QoSServicePolicy) XPath() string {
+	dir := "in-items"
+	if s.Output {
+		dir = "out-items"
+	}
+	return "System/ipqos-items/dflt-items/policy-items/" + dir + "/intf-items/If-list[name=" + s.IfName + "]"
+}
+
+// qosServicePolicies returns the qos service policies of both directions that can be attached to the interface.
+func qosServicePolicies(name string) []*QoSServicePolicy {
+	return []*QoSServicePolicy{
+		{IfName: name},
+		{IfName: name, Output: true},
+	}
+}
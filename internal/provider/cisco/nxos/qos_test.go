@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	nxv1alpha1 "github.com/ironcore-dev/network-operator/api/cisco/nx/v1alpha1"
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
+func init() {
+	pm := &QueuingPolicyMap{Name: "UPLINK", Descr: QueuingTemplateDescr}
+	for _, c := range []struct {
+		name string
+		val  int32
+	}{{"c-out-8q-q-default", 50}, {"c-out-8q-q3", 30}, {"c-out-8q-q7", 20}} {
+		mc := &QueuingMatchClass{Name: c.name}
+		mc.SetRemBWItems.Val = c.val
+		pm.CmapItems.MatchCMapList.Set(mc)
+	}
+	Register("queuing_policy_map", pm)
+
+	sp := &QueuingServicePolicy{IfName: "eth1/1"}
+	sp.PmapItems.Name = "UPLINK"
+	Register("queuing_service_policy", sp)
+
+	cm := &QoSClassMap{Name: "VOICE", MatchType: MatchTypeAny}
+	cm.DscpItems.DscpList.Set(&QoSMatchValue{Val: 46})
+	Register("qos_class_map", cm)
+
+	qpm := &QoSPolicyMap{Name: "UPLINK-EGRESS"}
+	qpm.CmapItems.MatchCMapList.Set(&QoSMatchClass{Name: "VOICE", SetQoSGrpItems: &QoSSetID{ID: 5}})
+	Register("qos_policy_map", qpm)
+
+	qsp := &QoSServicePolicy{IfName: "eth1/1", Output: true}
+	qsp.PmapItems.Name = "UPLINK-EGRESS"
+	Register("qos_service_policy", qsp)
+}
+
+func TestNewQueuingPolicyMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		classes []nxv1alpha1.QueuingClass
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			classes: []nxv1alpha1.QueuingClass{
+				{Name: nxv1alpha1.QueuingClassDefault, BandwidthPercent: 50},
+				{Name: nxv1alpha1.QueuingClassQ3, BandwidthPercent: 50},
+			},
+		},
+		{
+			name: "exceeds 100 percent",
+			classes: []nxv1alpha1.QueuingClass{
+				{Name: nxv1alpha1.QueuingClassDefault, BandwidthPercent: 60},
+				{Name: nxv1alpha1.QueuingClassQ3, BandwidthPercent: 50},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "invalid class",
+			classes: []nxv1alpha1.QueuingClass{{Name: "c-out-q1", BandwidthPercent: 10}},
+			wantErr: true,
+		},
+		{
+			name:    "negative percent",
+			classes: []nxv1alpha1.QueuingClass{{Name: nxv1alpha1.QueuingClassQ1, BandwidthPercent: -10}},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pm, err := newQueuingPolicyMap(&nxv1alpha1.EgressQueuing{Template: "UPLINK", Classes: test.classes})
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("newQueuingPolicyMap() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newQueuingPolicyMap() error = %v", err)
+			}
+			if pm.Name != "UPLINK" || pm.CmapItems.MatchCMapList.Len() != len(test.classes) {
+				t.Fatalf("newQueuingPolicyMap() = %+v, want policy-map UPLINK with %d classes", pm, len(test.classes))
+			}
+			for _, c := range test.classes {
+				mc, ok := pm.CmapItems.MatchCMapList.Get(string(c.Name))
+				if !ok || mc.SetRemBWItems.Val != c.BandwidthPercent {
+					t.Errorf("newQueuingPolicyMap() class %s = %+v, want %d percent", c.Name, mc, c.BandwidthPercent)
+				}
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureQueuingTemplate(t *testing.T) {
+	const (
+		attached = "System/ipqos-items/queuing-items/policy-items/out-items/intf-items"
+		uplink   = "System/ipqos-items/queuing-items/p-items/name-items/PMapInst-list[name=UPLINK]"
+		custom   = "System/ipqos-items/queuing-items/p-items/name-items/PMapInst-list[name=CUSTOM]"
+	)
+
+	newPM := func(val int32) *QueuingPolicyMap {
+		pm := &QueuingPolicyMap{Name: "UPLINK", Descr: QueuingTemplateDescr}
+		mc := &QueuingMatchClass{Name: "c-out-8q-q-default"}
+		mc.SetRemBWItems.Val = val
+		pm.CmapItems.MatchCMapList.Set(mc)
+		return pm
+	}
+
+	tests := []struct {
+		name      string
+		config    map[string]string
+		pm        *QueuingPolicyMap
+		wantStale string
+		wantErr   bool
+	}{
+		{
+			name:   "same allocation on other interface",
+			config: map[string]string{attached: `{"If-list":[{"name":"eth1/2","pmap-items":{"name":"UPLINK"}}]}`, uplink: `{"name":"UPLINK","descr":"bandwidth allocation template","cmap-items":{"MatchCMap-list":[{"name":"c-out-8q-q-default","setRemBW-items":{"val":50}}]}}`},
+			pm:     newPM(50),
+		},
+		{
+			name:    "different allocation on other interface",
+			config:  map[string]string{attached: `{"If-list":[{"name":"eth1/2","pmap-items":{"name":"UPLINK"}}]}`, uplink: `{"name":"UPLINK","descr":"bandwidth allocation template","cmap-items":{"MatchCMap-list":[{"name":"c-out-8q-q-default","setRemBW-items":{"val":50}}]}}`},
+			pm:      newPM(60),
+			wantErr: true,
+		},
+		{
+			name:      "template removed from interface",
+			config:    map[string]string{attached: `{"If-list":[{"name":"eth1/1","pmap-items":{"name":"UPLINK"}}]}`, uplink: `{"name":"UPLINK","descr":"bandwidth allocation template"}`},
+			wantStale: "UPLINK",
+		},
+		{
+			name:   "template still attached to other interface",
+			config: map[string]string{attached: `{"If-list":[{"name":"eth1/1","pmap-items":{"name":"UPLINK"}},{"name":"eth1/2","pmap-items":{"name":"UPLINK"}}]}`, uplink: `{"name":"UPLINK","descr":"bandwidth allocation template"}`},
+		},
+		{
+			name:   "policy-map not created for a template",
+			config: map[string]string{attached: `{"If-list":[{"name":"eth1/1","pmap-items":{"name":"CUSTOM"}}]}`, custom: `{"name":"CUSTOM"}`},
+			pm:     newPM(50),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &Provider{client: &fakeClient{config: test.config}}
+			stale, err := p.ensureQueuingTemplate(context.Background(), "eth1/1", test.pm)
+			if test.wantErr {
+				if s, ok := apistatus.FromError(err); !ok || s.Code != apistatus.CodeFailedPrecondition {
+					t.Fatalf("ensureQueuingTemplate() error = %v, want failed precondition", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ensureQueuingTemplate() error = %v", err)
+			}
+			var got string
+			if stale != nil {
+				got = stale.Name
+			}
+			if got != test.wantStale {
+				t.Errorf("ensureQueuingTemplate() stale policy-map = %q, want %q", got, test.wantStale)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureQoSPolicy(t *testing.T) {
+	const (
+		policyMap = "System/ipqos-items/dflt-items/p-items/name-items/PMapInst-list[name=UPLINK-EGRESS]"
+		voice     = "System/ipqos-items/dflt-items/c-items/name-items/CMapInst-list[name=VOICE]"
+		video     = "System/ipqos-items/dflt-items/c-items/name-items/CMapInst-list[name=VIDEO]"
+	)
+
+	c := &fakeClient{config: map[string]string{
+		policyMap: `{"name":"UPLINK-EGRESS","cmap-items":{"MatchCMap-list":[{"name":"VIDEO","setQoSGrp-items":{"id":4}}]}}`,
+		video:     `{"name":"VIDEO","matchType":"match-any","dscp-items":{"Dscp-list":[{"val":34}]}}`,
+	}}
+	p := &Provider{client: c}
+
+	qos := &v1alpha1.QoSPolicy{}
+	qos.Spec.Name = "UPLINK-EGRESS"
+	qos.Spec.Classes = []v1alpha1.QoSClass{{
+		Name:  "VOICE",
+		Match: v1alpha1.QoSClassMatch{DSCP: []int32{46}},
+		Set:   &v1alpha1.QoSClassSet{QoSGroup: new(int32(5))},
+	}}
+
+	if err := p.EnsureQoSPolicy(context.Background(), &provider.QoSPolicyRequest{QoSPolicy: qos}); err != nil {
+		t.Fatalf("EnsureQoSPolicy() error = %v", err)
+	}
+
+	want := map[string]string{
+		policyMap: `{"name":"UPLINK-EGRESS","cmap-items":{"MatchCMap-list":[{"name":"VOICE","setQoSGrp-items":{"id":5}}]}}`,
+		voice:     `{"name":"VOICE","matchType":"match-any","dscp-items":{"Dscp-list":[{"val":46}]}}`,
+	}
+	for xpath, w := range want {
+		if got := c.config[xpath]; got != w {
+			t.Errorf("EnsureQoSPolicy() config at %s = %q, want %q", xpath, got, w)
+		}
+	}
+	if _, ok := c.config[video]; ok || !slices.Contains(c.deleted, video) {
+		t.Errorf("EnsureQoSPolicy() did not remove the stale class-map VIDEO")
+	}
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+)
+
+var (
+	_ gnmiext.DataElement = (*StaticRoute)(nil)
+	_ gnmiext.DataElement = (*RIBRoute)(nil)
+)
+
+const (
+	// NextHopIfUnspecified is the interface of a next-hop that is only reached via its address.
+	NextHopIfUnspecified = "unspecified"
+	// NextHopIfNull is the interface of a next-hop that discards all traffic.
+	NextHopIfNull = "null0"
+	// DefaultStaticRoutePref is the default administrative distance of a static route.
+	DefaultStaticRoutePref = 1
+)
+
+// StaticRoute represents a static route of a VRF.
+type StaticRoute struct {
+	Prefix  string `json:"prefix"`
+	NhItems struct {
+		NexthopList gnmiext.List[string, *StaticNextHop] `json:"Nexthop-list,omitzero"`
+	} `json:"nh-items,omitzero"`
+	// Vrf is the name of the VRF of the route. This field is not serialized to JSON
+	// and is only used internally to determine the correct XPath for the route.
+	Vrf string `json:"-"`
+	// Is6 indicates whether this is an IPv6 route. This field is not serialized to JSON
+	// and is only used internally to determine the correct XPath for the route.
+	Is6 bool `json:"-"`
+}
+
+func (*StaticRoute) IsListItem() {}
+
+func (r *StaticRoute) XPath() string {
+	if r.Is6 {
+		return "System/ipv6-items/inst-items/dom-items/Dom-list[name=" + r.Vrf + "]/rt-items/Route-list[prefix=" + r.Prefix + "]"
+	}
+	return "System/ipv4-items/inst-items/dom-items/Dom-list[name=" + r.Vrf + "]/rt-items/Route-list[prefix=" + r.Prefix + "]"
+}
+
+// StaticNextHop represents a next-hop of a static route.
+type StaticNextHop struct {
+	NhAddr string `json:"nhAddr"`
+	NhIf   string `json:"nhIf"`
+	NhVrf  string `json:"nhVrf"`
+	Pref   int32  `json:"pref"`
+	Tag    uint32 `json:"tag"`
+}
+
+func (n *StaticNextHop) Key() string { return n.NhAddr + "|" + n.NhIf + "|" + n.NhVrf }
+
+// RIBRoute represents the entry of a prefix in the unicast routing table (URIB) of a VRF.
+type RIBRoute struct {
+	Prefix   string `json:"prefix"`
+	Nh4Items struct {
+		Nh4List []*RIBNextHop `json:"Nh4-list,omitempty"`
+	} `json:"nh4-items,omitzero"`
+	Nh6Items struct {
+		Nh6List []*RIBNextHop `json:"Nh6-list,omitempty"`
+	} `json:"nh6-items,omitzero"`
+	Vrf string `json:"-"`
+	Is6 bool   `json:"-"`
+}
+
+// NextHops returns the next-hops of the route of the respective address family.
+func (r *RIBRoute) NextHops() []*RIBNextHop {
+	if r.Is6 {
+		return r.Nh6Items.Nh6List
+	}
+	return r.Nh4Items.Nh4List
+}
+
+func (r *RIBRoute) XPath() string {
+	if r.Is6 {
+		return "System/urib-items/table6-items/Table6-list[vrfName=" + r.Vrf + "]/route6-items/Route6-list[prefix=" + r.Prefix + "]"
+	}
+	return "System/urib-items/table4-items/Table4-list[vrfName=" + r.Vrf + "]/route4-items/Route4-list[prefix=" + r.Prefix + "]"
+}
+
+// RIBNextHop represents a next-hop of an entry in the unicast routing table.
+type RIBNextHop struct {
+	Addr   string `json:"addr"`
+	IfName string `json:"ifName"`
+	Owner  string `json:"owner"`
+	Metric uint32 `json:"metric"`
+	Pref   uint32 `json:"pref"`
+	Vrf    string `json:"vrf"`
+}
@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/netip"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
+func init() {
+	r := &StaticRoute{Prefix: "10.0.0.0/8", Vrf: DefaultVRFName}
+	r.NhItems.NexthopList.Set(&StaticNextHop{
+		NhAddr: "192.168.1.1/32",
+		NhIf:   NextHopIfUnspecified,
+		NhVrf:  DefaultVRFName,
+		Pref:   DefaultStaticRoutePref,
+		Tag:    100,
+	})
+	Register("static_route", r)
+}
+
+func TestProvider_EnsureStaticRoute(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *provider.StaticRouteRequest
+		xpath   string
+		want    []*StaticNextHop
+		wantErr bool
+	}{
+		{
+			name: "next-hop address",
+			req: &provider.StaticRouteRequest{
+				Prefix:   netip.MustParsePrefix("10.0.0.0/8"),
+				NextHops: []provider.StaticRouteNextHop{{Address: netip.MustParseAddr("192.168.1.1")}},
+				Distance: 200,
+				Tag:      100,
+			},
+			xpath: "System/ipv4-items/inst-items/dom-items/Dom-list[name=default]/rt-items/Route-list[prefix=10.0.0.0/8]",
+			want: []*StaticNextHop{
+				{NhAddr: "192.168.1.1/32", NhIf: NextHopIfUnspecified, NhVrf: "default", Pref: 200, Tag: 100},
+			},
+		},
+		{
+			name: "null0",
+			req: &provider.StaticRouteRequest{
+				Prefix:   netip.MustParsePrefix("2001:db8::/32"),
+				NextHops: []provider.StaticRouteNextHop{{Discard: true}},
+			},
+			xpath: "System/ipv6-items/inst-items/dom-items/Dom-list[name=default]/rt-items/Route-list[prefix=2001:db8::/32]",
+			want: []*StaticNextHop{
+				{NhAddr: "::/128", NhIf: NextHopIfNull, NhVrf: "default", Pref: DefaultStaticRoutePref},
+			},
+		},
+		{
+			name: "vrf with interface next-hop",
+			req: &provider.StaticRouteRequest{
+				Prefix: netip.MustParsePrefix("0.0.0.0/0"),
+				VRF:    "management",
+				NextHops: []provider.StaticRouteNextHop{
+					{Address: netip.MustParseAddr("10.1.1.1"), Interface: "Ethernet1/1"},
+					{Interface: "mgmt0"},
+				},
+			},
+			xpath: "System/ipv4-items/inst-items/dom-items/Dom-list[name=management]/rt-items/Route-list[prefix=0.0.0.0/0]",
+			want: []*StaticNextHop{
+				{NhAddr: "0.0.0.0/32", NhIf: "mgmt0", NhVrf: "management", Pref: DefaultStaticRoutePref},
+				{NhAddr: "10.1.1.1/32", NhIf: "eth1/1", NhVrf: "management", Pref: DefaultStaticRoutePref},
+			},
+		},
+		{
+			name: "address family mismatch",
+			req: &provider.StaticRouteRequest{
+				Prefix:   netip.MustParsePrefix("10.0.0.0/8"),
+				NextHops: []provider.StaticRouteNextHop{{Address: netip.MustParseAddr("2001:db8::1")}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "discard with address",
+			req: &provider.StaticRouteRequest{
+				Prefix:   netip.MustParsePrefix("10.0.0.0/8"),
+				NextHops: []provider.StaticRouteNextHop{{Address: netip.MustParseAddr("192.168.1.1"), Discard: true}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "host bits set",
+			req: &provider.StaticRouteRequest{
+				Prefix:   netip.MustParsePrefix("10.0.0.1/8"),
+				NextHops: []provider.StaticRouteNextHop{{Discard: true}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "no next-hops",
+			req:     &provider.StaticRouteRequest{Prefix: netip.MustParsePrefix("10.0.0.0/8")},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			err := p.EnsureStaticRoute(context.Background(), test.req)
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("EnsureStaticRoute() error = nil, want error")
+				}
+				if len(c.config) != 0 {
+					t.Errorf("EnsureStaticRoute() configured device despite error: %v", c.config)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureStaticRoute() error = %v", err)
+			}
+
+			b, ok := c.config[test.xpath]
+			if !ok {
+				t.Fatalf("EnsureStaticRoute() did not configure %s, got %v", test.xpath, c.config)
+			}
+			r := new(StaticRoute)
+			if err := json.Unmarshal([]byte(b), r); err != nil {
+				t.Fatal(err)
+			}
+			got := slices.SortedFunc(maps.Values(r.NhItems.NexthopList), func(a, b *StaticNextHop) int {
+				return strings.Compare(a.Key(), b.Key())
+			})
+			if len(got) != len(test.want) {
+				t.Fatalf("EnsureStaticRoute() next-hops = %d, want %d", len(got), len(test.want))
+			}
+			for i := range got {
+				if *got[i] != *test.want[i] {
+					t.Errorf("EnsureStaticRoute() next-hop[%d] = %+v, want %+v", i, got[i], test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestProvider_DeleteStaticRoute(t *testing.T) {
+	const xpath = "System/ipv4-items/inst-items/dom-items/Dom-list[name=default]/rt-items/Route-list[prefix=10.0.0.0/8]"
+	c := &fakeClient{config: map[string]string{xpath: "{}"}}
+	p := &Provider{client: c}
+
+	if err := p.DeleteStaticRoute(context.Background(), &provider.StaticRouteRequest{Prefix: netip.MustParsePrefix("10.0.0.0/8")}); err != nil {
+		t.Fatalf("DeleteStaticRoute() error = %v", err)
+	}
+	if _, ok := c.config[xpath]; ok || !slices.Equal(c.deleted, []string{xpath}) {
+		t.Errorf("DeleteStaticRoute() deleted = %v, want [%s]", c.deleted, xpath)
+	}
+}
+
+func TestProvider_LookupRoute(t *testing.T) {
+	const (
+		xpath4 = "System/urib-items/table4-items/Table4-list[vrfName=default]/route4-items/Route4-list[prefix=10.0.0.0/8]"
+		xpath6 = "System/urib-items/table6-items/Table6-list[vrfName=tenant]/route6-items/Route6-list[prefix=2001:db8::/32]"
+		vrf    = "System/inst-items/Inst-list[name=tenant]"
+	)
+	config := map[string]string{
+		xpath4: `{"prefix":"10.0.0.0/8","nh4-items":{"Nh4-list":[{"addr":"192.168.1.1/32","ifName":"eth1/1","owner":"ospf-UNDERLAY","metric":41,"pref":110,"vrf":"default"}]}}`,
+		xpath6: `{"prefix":"2001:db8::/32","nh6-items":{"Nh6-list":[{"addr":"::/128","ifName":"null0","owner":"static","metric":0,"pref":1,"vrf":"tenant"},{"addr":"2001:db8:ffff::1/128","ifName":"","owner":"bgp-65000","metric":0,"pref":200,"vrf":"default"}]}}`,
+		vrf:    `{"name":"tenant"}`,
+	}
+
+	tests := []struct {
+		name     string
+		vrf      string
+		prefix   netip.Prefix
+		want     *provider.RouteEntry
+		wantCode apistatus.Code
+	}{
+		{
+			name:   "default vrf",
+			prefix: netip.MustParsePrefix("10.0.0.0/8"),
+			want: &provider.RouteEntry{
+				Prefix: netip.MustParsePrefix("10.0.0.0/8"),
+				VRF:    DefaultVRFName,
+				NextHops: []provider.RouteEntryNextHop{
+					{Address: netip.MustParseAddr("192.168.1.1"), Interface: "eth1/1", Protocol: "ospf", Distance: 110, Metric: 41},
+				},
+			},
+		},
+		{
+			name:   "ipv6 in vrf",
+			vrf:    "tenant",
+			prefix: netip.MustParsePrefix("2001:db8::/32"),
+			want: &provider.RouteEntry{
+				Prefix: netip.MustParsePrefix("2001:db8::/32"),
+				VRF:    "tenant",
+				NextHops: []provider.RouteEntryNextHop{
+					{Interface: "null0", Protocol: "static", Distance: 1},
+					{Address: netip.MustParseAddr("2001:db8:ffff::1"), VRF: "default", Protocol: "bgp", Distance: 200},
+				},
+			},
+		},
+		{
+			name:     "host bits set",
+			prefix:   netip.MustParsePrefix("10.0.0.1/8"),
+			wantCode: apistatus.CodeInvalidArgument,
+		},
+		{
+			name:     "unknown vrf",
+			vrf:      "unknown",
+			prefix:   netip.MustParsePrefix("10.0.0.0/8"),
+			wantCode: apistatus.CodeFailedPrecondition,
+		},
+		{
+			name:     "no route",
+			prefix:   netip.MustParsePrefix("172.16.0.0/12"),
+			wantCode: apistatus.CodeFailedPrecondition,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &Provider{client: &fakeClient{config: config}}
+
+			got, err := p.LookupRoute(context.Background(), test.vrf, test.prefix)
+			if test.wantCode != 0 {
+				if s, ok := apistatus.FromError(err); !ok || s.Code != test.wantCode {
+					t.Fatalf("LookupRoute() error = %v, want code %v", err, test.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LookupRoute() error = %v", err)
+			}
+			if got.Prefix != test.want.Prefix || got.VRF != test.want.VRF || !slices.Equal(got.NextHops, test.want.NextHops) {
+				t.Errorf("LookupRoute() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
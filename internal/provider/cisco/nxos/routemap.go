@@ -4,6 +4,8 @@
 package nxos
 
 import (
+	"net/netip"
+
 	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
 )
 
@@ -56,6 +58,21 @@ type RouteMapEntry struct {
 	SetASPathItems struct {
 		AsnList string `json:"asnList"`
 	} `json:"setaspath-items,omitzero"`
+	MregcommItems struct {
+		Criteria          string `json:"criteria"`
+		RsregCommAttItems struct {
+			RsRegCommAttList gnmiext.List[string, *RsRegCommAtt] `json:"RsRegCommAtt-list,omitzero"`
+		} `json:"rsregCommAtt-items,omitzero"`
+	} `json:"mregcomm-items,omitzero"`
+	MrttagItems struct {
+		ItemItems struct {
+			ItemList gnmiext.List[uint32, *TagItem] `json:"Item-list,omitzero"`
+		} `json:"item-items,omitzero"`
+	} `json:"mrttag-items,omitzero"`
+	SprefItems   *SetPref   `json:"spref-items,omitempty"`
+	SmetricItems *SetMetric `json:"smetric-items,omitempty"`
+	NhItems      *SetNh     `json:"nh-items,omitempty"`
+	Nh6Items     *SetNh     `json:"nh6-items,omitempty"`
 }
 
 func (e *RouteMapEntry) Key() int32 { return e.Order }
@@ -91,12 +108,60 @@ func (e *RouteMapEntry) SetPrefixSet(name string, isV6 bool) {
 	e.MrtdstItems.RsrtDstAttItems.RsRtDstAttList.Set(&RsRtDstAtt{TDn: tdn})
 }
 
+// SetCommunityLists matches routes with communities permitted by any of the given community-lists.
+func (e *RouteMapEntry) SetCommunityLists(names []string) {
+	for _, name := range names {
+		e.MregcommItems.Criteria = "sub-group"
+		e.MregcommItems.RsregCommAttItems.RsRegCommAttList.Set(&RsRegCommAtt{TDn: "/System/rpm-items/rtregcom-items/RuleRegCom-list[name='" + name + "']"})
+	}
+}
+
+// SetTags matches routes carrying any of the given tags.
+func (e *RouteMapEntry) SetTags(tags []uint32) {
+	for _, tag := range tags {
+		e.MrttagItems.ItemItems.ItemList.Set(&TagItem{Tag: tag})
+	}
+}
+
+// SetNextHop sets the next-hop address of the matched routes.
+func (e *RouteMapEntry) SetNextHop(addr netip.Addr) {
+	if addr.Is6() {
+		e.Nh6Items = &SetNh{Addr: addr.String()}
+		return
+	}
+	e.NhItems = &SetNh{Addr: addr.String()}
+}
+
 type RsRtDstAtt struct {
 	TDn string `json:"tDn"`
 }
 
 func (r *RsRtDstAtt) Key() string { return r.TDn }
 
+type RsRegCommAtt struct {
+	TDn string `json:"tDn"`
+}
+
+func (r *RsRegCommAtt) Key() string { return r.TDn }
+
+type TagItem struct {
+	Tag uint32 `json:"tag"`
+}
+
+func (t *TagItem) Key() uint32 { return t.Tag }
+
+type SetPref struct {
+	LocalPref uint32 `json:"localPref"`
+}
+
+type SetMetric struct {
+	Metric uint32 `json:"metric"`
+}
+
+type SetNh struct {
+	Addr string `json:"addr"`
+}
+
 type CommItem struct {
 	Community string `json:"community"`
 }
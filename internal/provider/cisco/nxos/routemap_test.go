@@ -3,6 +3,15 @@
 
 package nxos
 
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
 func init() {
 	e := &RouteMapEntry{}
 	e.Order = 10
@@ -87,4 +96,69 @@ func init() {
 	pfxV6RM.Name = "RM-PREFIXSET-V6"
 	pfxV6RM.EntItems.EntryList.Set(pfxV6Entry)
 	Register("route_map_prefixset_v6", pfxV6RM)
+
+	matchSetEntry := &RouteMapEntry{}
+	matchSetEntry.Order = 10
+	matchSetEntry.Action = ActionPermit
+	matchSetEntry.SetPrefixSet("PL-LOOPBACKS", false)
+	matchSetEntry.SetCommunityLists([]string{"CL-FABRIC"})
+	matchSetEntry.SetTags([]uint32{100})
+	matchSetEntry.SprefItems = &SetPref{LocalPref: 200}
+	matchSetEntry.SmetricItems = &SetMetric{Metric: 0}
+	matchSetEntry.SetNextHop(netip.MustParseAddr("10.0.0.1"))
+
+	matchSetRM := &RouteMap{}
+	matchSetRM.Name = "RM-MATCH-SET"
+	matchSetRM.EntItems.EntryList.Set(matchSetEntry)
+	Register("route_map_match_set", matchSetRM)
+}
+
+func TestProvider_EnsureRoutingPolicy(t *testing.T) {
+	ps := &v1alpha1.PrefixSet{}
+	ps.Spec.Name = "PL-LOOPBACKS"
+	ps.Spec.Entries = []v1alpha1.PrefixEntry{{Sequence: 10, Prefix: v1alpha1.MustParsePrefix("10.0.0.0/24")}}
+
+	c := &fakeClient{config: map[string]string{}}
+	p := &Provider{client: c}
+
+	err := p.EnsureRoutingPolicy(t.Context(), &provider.EnsureRoutingPolicyRequest{
+		Name: "RM-MATCH-SET",
+		Statements: []provider.PolicyStatement{{
+			Sequence: 10,
+			Conditions: []provider.PolicyCondition{
+				provider.MatchPrefixSetCondition{PrefixSet: ps},
+				provider.MatchCommunityListCondition{Names: []string{"CL-FABRIC"}},
+				provider.MatchTagCondition{Tags: []uint32{100}},
+			},
+			Actions: v1alpha1.PolicyActions{
+				RouteDisposition: v1alpha1.AcceptRoute,
+				BgpActions:       &v1alpha1.BgpActions{SetLocalPreference: new(int64(200))},
+				SetMetric:        new(int64(0)),
+				SetNextHop:       v1alpha1.MustParseAddr("10.0.0.1"),
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("EnsureRoutingPolicy() error = %v", err)
+	}
+
+	e := &RouteMapEntry{}
+	e.Order = 10
+	e.Action = ActionPermit
+	e.SetPrefixSet("PL-LOOPBACKS", false)
+	e.SetCommunityLists([]string{"CL-FABRIC"})
+	e.SetTags([]uint32{100})
+	e.SprefItems = &SetPref{LocalPref: 200}
+	e.SmetricItems = &SetMetric{Metric: 0}
+	e.SetNextHop(netip.MustParseAddr("10.0.0.1"))
+	rm := &RouteMap{Name: "RM-MATCH-SET"}
+	rm.EntItems.EntryList.Set(e)
+
+	want, err := json.Marshal(rm)
+	if err != nil {
+		t.Fatalf("failed to marshal route-map: %v", err)
+	}
+	if got := c.config[rm.XPath()]; got != string(want) {
+		t.Errorf("EnsureRoutingPolicy() config = %s, want %s", got, want)
+	}
 }
@@ -4,7 +4,9 @@
 package nxos
 
 import (
+	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
 )
@@ -249,6 +251,30 @@ func (*SNMPTrapsItems) XPath() string {
 	return "System/snmp-items/inst-items/traps-items"
 }
 
+// Enabled returns the enabled trap notifications in the format used by the SNMP spec,
+// i.e. the trap group and the trap name separated by a space, e.g. "link linkUp".
+func (t *SNMPTrapsItems) Enabled() []string {
+	var traps []string
+	rv := reflect.ValueOf(t).Elem()
+	for i := range rv.NumField() {
+		group := rv.Field(i)
+		for j := range group.NumField() {
+			trap, ok := group.Field(j).Interface().(*SNMPTraps)
+			if !ok || trap == nil || trap.Trapstatus != AdminStEnable {
+				continue
+			}
+			traps = append(traps, trapName(rv.Type().Field(i))+" "+trapName(group.Type().Field(j)))
+		}
+	}
+	return traps
+}
+
+// trapName returns the name of a trap or trap group as derived from the JSON tag of the field.
+func trapName(f reflect.StructField) string {
+	tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	return strings.TrimSuffix(tag, "-items")
+}
+
 type SNMPTraps struct {
 	Trapstatus AdminSt4 `json:"trapstatus"`
 }
@@ -3,6 +3,15 @@
 
 package nxos
 
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
 func init() {
 	host := &SNMPHost{
 		HostName:  "foo.bar",
@@ -38,3 +47,25 @@ func init() {
 	traps.CfsItems.StatechangenotifItems = &SNMPTraps{Trapstatus: AdminStEnable}
 	Register("snmp_traps", traps)
 }
+
+func TestProvider_GetSNMPStatus(t *testing.T) {
+	c := &fakeClient{config: map[string]string{
+		(&SNMPCommunityItems{}).XPath(): `{"CommSecP-list":[{"name":"public"},{"name":"monitoring"}]}`,
+		(&SNMPHostItems{}).XPath():      `{"Host-list":[{"hostName":"10.0.0.1","udpPortID":162},{"hostName":"10.0.0.1","udpPortID":1162}]}`,
+		(&SNMPTrapsItems{}).XPath():     `{"link-items":{"linkUp-items":{"trapstatus":"enable"},"linkDown-items":{"trapstatus":"disable"}},"cfs-items":{"statechangenotif-items":{"trapstatus":"enable"}}}`,
+	}}
+	p := &Provider{client: c}
+
+	got, err := p.GetSNMPStatus(context.Background(), &provider.SNMPStatusRequest{})
+	if err != nil {
+		t.Fatalf("GetSNMPStatus() error = %v", err)
+	}
+	want := provider.SNMPStatus{
+		Communities: []string{"monitoring", "public"},
+		Hosts:       []string{"10.0.0.1"},
+		Traps:       []string{"cfs statechangenotif", "link linkUp"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetSNMPStatus() mismatch (-want +got):\n%s", diff)
+	}
+}
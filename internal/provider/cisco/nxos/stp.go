@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"strconv"
+
+	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+)
+
+var _ gnmiext.DataElement = (*STPInst)(nil)
+
+// STPInst represents the global spanning tree configuration of the device.
+type STPInst struct {
+	Mode      STPMode `json:"mode"`
+	BPDUGuard AdminSt `json:"bpduguard"`
+	VlanItems struct {
+		VlanList gnmiext.List[int32, *STPVlan] `json:"Vlan-list,omitzero"`
+	} `json:"vlan-items,omitzero"`
+	MstentItems struct {
+		MstItems struct {
+			MstList gnmiext.List[int32, *STPMst] `json:"Mst-list,omitzero"`
+		} `json:"mst-items,omitzero"`
+	} `json:"mstent-items,omitzero"`
+}
+
+func (*STPInst) XPath() string {
+	return "System/stp-items/inst-items"
+}
+
+// STPVlan represents the spanning tree configuration of a VLAN in rapid per-VLAN spanning tree mode.
+type STPVlan struct {
+	ID             int32 `json:"id"`
+	BridgePriority int32 `json:"bridgePriority"`
+}
+
+func (*STPVlan) IsListItem() {}
+
+func (v *STPVlan) Key() int32 { return v.ID }
+
+func (v *STPVlan) XPath() string {
+	return "System/stp-items/inst-items/vlan-items/Vlan-list[id=" + strconv.Itoa(int(v.ID)) + "]"
+}
+
+// STPMst represents the spanning tree configuration of an instance in multiple spanning tree mode.
+type STPMst struct {
+	ID             int32 `json:"id"`
+	BridgePriority int32 `json:"bridgePriority"`
+}
+
+func (*STPMst) IsListItem() {}
+
+func (m *STPMst) Key() int32 { return m.ID }
+
+func (m *STPMst) XPath() string {
+	return "System/stp-items/inst-items/mstent-items/mst-items/Mst-list[id=" + strconv.Itoa(int(m.ID)) + "]"
+}
+
+type STPMode string
+
+const (
+	STPModeRapidPVST STPMode = "pvrst"
+	STPModeMST       STPMode = "mst"
+)
+
+const (
+	// STPPriorityStep is the increment in which bridge priorities can be configured.
+	STPPriorityStep = 4096
+	// MaxSTPPriority is the highest configurable bridge priority.
+	MaxSTPPriority = 61440
+	// MaxSTPInstance is the highest multiple spanning tree instance ID.
+	MaxSTPInstance = 4094
+)
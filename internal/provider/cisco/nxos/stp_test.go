@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
+func init() {
+	stp := &STPInst{Mode: STPModeMST, BPDUGuard: AdminStEnabled}
+	stp.MstentItems.MstItems.MstList.Set(&STPMst{ID: 1, BridgePriority: 8192})
+	Register("stp_mst", stp)
+}
+
+func TestProvider_EnsureSpanningTree(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *provider.SpanningTreeRequest
+		want      string
+		wantField string
+	}{
+		{
+			name: "mst with instance priority",
+			req: &provider.SpanningTreeRequest{
+				Mode:       provider.SpanningTreeModeMST,
+				Priorities: []provider.SpanningTreePriority{{ID: 1, Priority: 8192}},
+				BPDUGuard:  true,
+			},
+			want: `{"mode":"mst","bpduguard":"enabled","mstent-items":{"mst-items":{"Mst-list":[{"id":1,"bridgePriority":8192}]}}}`,
+		},
+		{
+			name: "rstp with vlan priority",
+			req: &provider.SpanningTreeRequest{
+				Mode:       provider.SpanningTreeModeRSTP,
+				Priorities: []provider.SpanningTreePriority{{ID: 10, Priority: 0}},
+			},
+			want: `{"mode":"pvrst","bpduguard":"disabled","vlan-items":{"Vlan-list":[{"id":10,"bridgePriority":0}]}}`,
+		},
+		{
+			name: "priority not a multiple of 4096",
+			req: &provider.SpanningTreeRequest{
+				Mode:       provider.SpanningTreeModeMST,
+				Priorities: []provider.SpanningTreePriority{{ID: 1, Priority: 1000}},
+			},
+			wantField: "priorities[0].priority",
+		},
+		{
+			name: "priority out of range",
+			req: &provider.SpanningTreeRequest{
+				Mode:       provider.SpanningTreeModeRSTP,
+				Priorities: []provider.SpanningTreePriority{{ID: 10, Priority: 65536}},
+			},
+			wantField: "priorities[0].priority",
+		},
+		{
+			name: "invalid vlan",
+			req: &provider.SpanningTreeRequest{
+				Mode:       provider.SpanningTreeModeRSTP,
+				Priorities: []provider.SpanningTreePriority{{ID: 4095, Priority: 4096}},
+			},
+			wantField: "priorities[0].id",
+		},
+		{
+			name: "root guard default",
+			req: &provider.SpanningTreeRequest{
+				Mode:      provider.SpanningTreeModeRSTP,
+				RootGuard: true,
+			},
+			wantField: "rootGuard",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			err := p.EnsureSpanningTree(t.Context(), test.req)
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureSpanningTree() error = %v, want violation of %s", err, test.wantField)
+				}
+				if len(c.config) != 0 {
+					t.Errorf("EnsureSpanningTree() config = %v, want none", c.config)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureSpanningTree() error = %v", err)
+			}
+			if got := c.config[(&STPInst{}).XPath()]; got != test.want {
+				t.Errorf("EnsureSpanningTree() config = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureSpanningTree_StalePriorities(t *testing.T) {
+	c := &fakeClient{config: map[string]string{
+		(&STPInst{}).XPath(): `{"mode":"pvrst","bpduguard":"disabled","vlan-items":{"Vlan-list":[{"id":10,"bridgePriority":4096},{"id":20,"bridgePriority":8192}]}}`,
+	}}
+	p := &Provider{client: c}
+
+	req := &provider.SpanningTreeRequest{
+		Mode:       provider.SpanningTreeModeRSTP,
+		Priorities: []provider.SpanningTreePriority{{ID: 10, Priority: 4096}},
+	}
+	if err := p.EnsureSpanningTree(t.Context(), req); err != nil {
+		t.Fatalf("EnsureSpanningTree() error = %v", err)
+	}
+
+	want := []string{(&STPVlan{ID: 20}).XPath()}
+	if !slices.Equal(c.deleted, want) {
+		t.Errorf("EnsureSpanningTree() deleted = %v, want %v", c.deleted, want)
+	}
+	if got, want := c.config[(&STPInst{}).XPath()], `{"mode":"pvrst","bpduguard":"disabled","vlan-items":{"Vlan-list":[{"id":10,"bridgePriority":4096}]}}`; got != want {
+		t.Errorf("EnsureSpanningTree() config = %s, want %s", got, want)
+	}
+}
@@ -23,6 +23,8 @@
 var (
 	_ gnmiext.DataElement = (*SystemJumboMTU)(nil)
 	_ gnmiext.Defaultable = (*SystemJumboMTU)(nil)
+	_ gnmiext.DataElement = (*SystemECMP)(nil)
+	_ gnmiext.DataElement = (*SystemECMPOper)(nil)
 	_ gnmiext.DataElement = (*Model)(nil)
 	_ gnmiext.DataElement = (*SerialNumber)(nil)
 	_ gnmiext.DataElement = (*FirmwareVersion)(nil)
@@ -39,6 +41,27 @@ func (s *SystemJumboMTU) Default() {
 	*s = 9216
 }
 
+// MaxECMPPaths is the maximum number of ECMP next-hops supported by the platform.
+const MaxECMPPaths = 64
+
+// SystemECMP represents the system-wide maximum number of ECMP next-hops per route.
+// Changes only become effective after a reload of the device.
+type SystemECMP struct {
+	MaxPaths int32 `json:"maxPaths"`
+}
+
+func (*SystemECMP) XPath() string {
+	return "System/ecmp-items/inst-items"
+}
+
+// SystemECMPOper is the maximum number of ECMP next-hops per route that is currently
+// effective on the device, i.e. the value configured at the time of the last reload.
+type SystemECMPOper int32
+
+func (*SystemECMPOper) XPath() string {
+	return "System/ecmp-items/inst-items/operMaxPaths"
+}
+
 // Hostname is the configured hostname of the device.
 type Hostname string
 
@@ -100,10 +123,10 @@ func (t *UnixTime) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-func Reboot(ctx context.Context, conn *grpc.ClientConn) error {
+func Reboot(ctx context.Context, conn *grpc.ClientConn, delay time.Duration) error {
 	req := &systempb.RebootRequest{
 		Method:  systempb.RebootMethod_COLD,
-		Delay:   0,  // Unsupported on NX-OS, must be 0
+		Delay:   uint64(delay.Nanoseconds()),
 		Message: "", // Unsupported on NX-OS, must be empty
 		Force:   true,
 	}
@@ -3,7 +3,272 @@
 
 package nxos
 
+import (
+	"context"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	systempb "github.com/openconfig/gnoi/system"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
 func init() {
 	mtu := SystemJumboMTU(9214)
 	Register("system", &mtu)
+
+	Register("system_ecmp", &SystemECMP{MaxPaths: 64})
+}
+
+func TestProvider_EnsureECMP(t *testing.T) {
+	const (
+		xpath = "System/ecmp-items/inst-items"
+		oper  = xpath + "/operMaxPaths"
+	)
+
+	tests := []struct {
+		name       string
+		config     map[string]string
+		paths      int32
+		wantReload bool
+		wantErr    bool
+	}{
+		{
+			name:       "reload required",
+			config:     map[string]string{oper: `16`},
+			paths:      64,
+			wantReload: true,
+		},
+		{
+			name:   "already effective",
+			config: map[string]string{oper: `64`},
+			paths:  64,
+		},
+		{
+			name:   "value in effect unknown",
+			config: map[string]string{},
+			paths:  64,
+		},
+		{
+			name:   "value in effect not reported",
+			config: map[string]string{oper: `0`},
+			paths:  64,
+		},
+		{
+			name:    "exceeds platform maximum",
+			config:  map[string]string{},
+			paths:   128,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: test.config}
+			p := &Provider{client: c}
+
+			reload, err := p.EnsureECMP(context.Background(), &provider.EnsureECMPRequest{MaximumPaths: test.paths})
+			if test.wantErr {
+				if _, ok := apistatus.FromError(err); !ok {
+					t.Fatalf("EnsureECMP() error = %v, want status error", err)
+				}
+				if _, ok := c.config[xpath]; ok {
+					t.Errorf("EnsureECMP() configured invalid value")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureECMP() error = %v", err)
+			}
+			if reload != test.wantReload {
+				t.Errorf("EnsureECMP() reload = %v, want %v", reload, test.wantReload)
+			}
+			if got := c.config[xpath]; got != `{"maxPaths":64}` {
+				t.Errorf("EnsureECMP() config = %s, want %s", got, `{"maxPaths":64}`)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureLACP(t *testing.T) {
+	const xpath = "System/lacp-items/inst-items/sysPrio"
+
+	tests := []struct {
+		name     string
+		priority int32
+		want     string
+		wantErr  bool
+	}{
+		{name: "configured", priority: 4096, want: "4096"},
+		{name: "default", priority: 0, want: "32768"},
+		{name: "out of range", priority: 65536, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			err := p.EnsureLACP(context.Background(), &provider.EnsureLACPRequest{SystemPriority: test.priority})
+			if test.wantErr {
+				if _, ok := apistatus.FromError(err); !ok {
+					t.Fatalf("EnsureLACP() error = %v, want status error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureLACP() error = %v", err)
+			}
+			if got := c.config[xpath]; got != test.want {
+				t.Errorf("EnsureLACP() config = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestProvider_EnsureHostname(t *testing.T) {
+	const xpath = "System/name"
+
+	tests := []struct {
+		name     string
+		hostname string
+		wantErr  bool
+	}{
+		{name: "valid", hostname: "leaf1"},
+		{name: "with hyphen", hostname: "spine-01"},
+		{name: "uppercase", hostname: "Leaf1", wantErr: true},
+		{name: "leading hyphen", hostname: "-leaf1", wantErr: true},
+		{name: "fqdn", hostname: "leaf1.example.com", wantErr: true},
+		{name: "empty", hostname: "", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			err := p.EnsureHostname(context.Background(), test.hostname)
+			if test.wantErr {
+				if _, ok := apistatus.FromError(err); !ok {
+					t.Fatalf("EnsureHostname() error = %v, want status error", err)
+				}
+				if len(c.config) != 0 {
+					t.Errorf("EnsureHostname() configured device despite error: %v", c.config)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureHostname() error = %v", err)
+			}
+			if got, want := c.config[xpath], `"`+test.hostname+`"`; got != want {
+				t.Errorf("EnsureHostname() config = %s, want %s", got, want)
+			}
+
+			got, err := p.GetHostname(context.Background())
+			if err != nil {
+				t.Fatalf("GetHostname() error = %v", err)
+			}
+			if got != test.hostname {
+				t.Errorf("GetHostname() = %q, want %q", got, test.hostname)
+			}
+		})
+	}
+}
+
+func TestProvider_GetHostname_NotConfigured(t *testing.T) {
+	p := &Provider{client: &fakeClient{config: map[string]string{}}}
+
+	got, err := p.GetHostname(context.Background())
+	if err != nil {
+		t.Fatalf("GetHostname() error = %v", err)
+	}
+	if got != "" {
+		t.Errorf("GetHostname() = %q, want empty", got)
+	}
+}
+
+// systemServer is a minimal gNOI system server that records the reboot requests it receives.
+type systemServer struct {
+	systempb.UnimplementedSystemServer
+
+	mu     sync.Mutex
+	events *[]string
+	reqs   []*systempb.RebootRequest
+}
+
+func (s *systemServer) Reboot(_ context.Context, req *systempb.RebootRequest) (*systempb.RebootResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	*s.events = append(*s.events, "reboot")
+	s.reqs = append(s.reqs, req)
+	return &systempb.RebootResponse{}, nil
+}
+
+func TestProvider_Reboot(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       *provider.RebootOptions
+		wantEvents []string
+		wantDelay  uint64
+	}{
+		{
+			name:       "default",
+			wantEvents: []string{"reboot"},
+		},
+		{
+			name:       "save config first",
+			opts:       &provider.RebootOptions{SaveConfig: true},
+			wantEvents: []string{"copy running-config startup-config", "reboot"},
+		},
+		{
+			name:       "delay",
+			opts:       &provider.RebootOptions{Delay: 5 * time.Minute, SaveConfig: true},
+			wantEvents: []string{"copy running-config startup-config", "reboot"},
+			wantDelay:  uint64(5 * time.Minute),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var events []string
+
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatalf("failed to listen: %v", err)
+			}
+			sys := &systemServer{events: &events}
+			srv := grpc.NewServer()
+			systempb.RegisterSystemServer(srv, sys)
+			go srv.Serve(lis) //nolint:errcheck
+			defer srv.Stop()
+
+			conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				t.Fatalf("failed to create grpc client: %v", err)
+			}
+			defer conn.Close()
+
+			_, client := newFakeNXAPI(t, func(cmd string) (string, error) {
+				sys.mu.Lock()
+				defer sys.mu.Unlock()
+				events = append(events, cmd)
+				return "null", nil
+			})
+
+			p := &Provider{conn: conn, nxapi: client}
+			if err := p.Reboot(t.Context(), nil, test.opts); err != nil {
+				t.Fatalf("Reboot() error = %v", err)
+			}
+			if !slices.Equal(events, test.wantEvents) {
+				t.Errorf("Reboot() requests = %v, want %v", events, test.wantEvents)
+			}
+			want := &systempb.RebootRequest{Method: systempb.RebootMethod_COLD, Delay: test.wantDelay, Force: true}
+			if len(sys.reqs) != 1 || !proto.Equal(sys.reqs[0], want) {
+				t.Errorf("Reboot() reboot requests = %v, want [%v]", sys.reqs, want)
+			}
+		})
+	}
 }
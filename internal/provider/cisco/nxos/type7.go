@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+// type7Key is the well-known key used by the Cisco type 7 password encoding.
+const type7Key = "dsfd;kfoA,.iyewrkldJKDHSUBsgvca69834ncxv9873254k;fg87"
+
+// Type7 implements the reversible Cisco type 7 password encoding.
+// The encoded value is deterministic for a given Seed, which allows comparing
+// a plain text password with an already configured one.
+type Type7 struct{ Seed int }
+
+// NewType7 returns a [Type7] encoder with a random seed.
+func NewType7() Type7 {
+	return Type7{Seed: rand.IntN(16)}
+}
+
+// Encode encodes the plain text password as a type 7 string.
+func (t Type7) Encode(password string) string {
+	seed := t.Seed % len(type7Key)
+	var sb strings.Builder
+	sb.Grow(2 + 2*len(password))
+	fmt.Fprintf(&sb, "%02d", seed)
+	for i := range len(password) {
+		fmt.Fprintf(&sb, "%02X", password[i]^type7Key[(seed+i)%len(type7Key)])
+	}
+	return sb.String()
+}
+
+// ParseType7 decodes the type 7 string s and returns the seed used to encode it.
+func ParseType7(s string) (seed int, password string, err error) {
+	if len(s) < 2 || len(s)%2 != 0 {
+		return 0, "", errors.New("invalid type 7 string length")
+	}
+	seed, err = strconv.Atoi(s[:2])
+	if err != nil || seed >= len(type7Key) {
+		return 0, "", errors.New("invalid type 7 seed")
+	}
+	b, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid type 7 encoding: %w", err)
+	}
+	for i := range b {
+		b[i] ^= type7Key[(seed+i)%len(type7Key)]
+	}
+	return seed, string(b), nil
+}
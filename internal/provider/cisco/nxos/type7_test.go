@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"testing"
+)
+
+func TestType7(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     int
+		password string
+		want     string
+	}{
+		{name: "cisco", seed: 2, password: "cisco", want: "02050D480809"},
+		{name: "empty", seed: 7, password: "", want: "07"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Type7{Seed: test.seed}.Encode(test.password)
+			if got != test.want {
+				t.Errorf("Type7.Encode() = %v, want %v", got, test.want)
+			}
+			seed, password, err := ParseType7(got)
+			if err != nil {
+				t.Fatalf("ParseType7() error = %v", err)
+			}
+			if seed != test.seed || password != test.password {
+				t.Errorf("ParseType7() = (%d, %q), want (%d, %q)", seed, password, test.seed, test.password)
+			}
+		})
+	}
+}
+
+func TestParseType7_Invalid(t *testing.T) {
+	for _, s := range []string{"", "0", "xx0D", "99050D", "02050G"} {
+		if _, _, err := ParseType7(s); err == nil {
+			t.Errorf("ParseType7(%q) expected error, got nil", s)
+		}
+	}
+}
@@ -3,7 +3,11 @@
 
 package nxos
 
-import "github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+import (
+	"fmt"
+
+	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+)
 
 // Version represents the operating system version of the target device.
 // Versions are ordered so that comparison operators (>, >=, <, <=) reflect
@@ -23,6 +27,9 @@
 	VersionNX10_6_2         // 10.6(2)
 	VersionNX10_6_3         // 10.6(3)
 	VersionNX10_7_1         // 10.7(1)
+
+	// versionLatest is the most recent known version. It must be kept in sync with the list above.
+	versionLatest = VersionNX10_7_1
 )
 
 func (v Version) String() string {
@@ -54,6 +61,16 @@ func (v Version) String() string {
 	}
 }
 
+// ParseVersion parses an NX-OS release string, e.g. "10.4(3)", into the corresponding [Version].
+func ParseVersion(s string) (Version, error) {
+	for v := VersionUnknown + 1; v <= versionLatest; v++ {
+		if v.String() == s {
+			return v, nil
+		}
+	}
+	return VersionUnknown, fmt.Errorf("unsupported nx-os version %q", s)
+}
+
 // nxosVersions maps the revision date of the Cisco-NX-OS-device yang model to the corresponding [Version].
 // It is used to determine the version of the target device based on the capabilities returned by the device.
 var nxosVersions = map[string]Version{
@@ -73,17 +90,36 @@ func (v Version) String() string {
 	"9999-01-01": VersionNX10_7_1,
 }
 
-// NXVersion returns the NX-OS operating system version of the target device based on the supported models.
-// If the version cannot be determined, [VersionUnknown] is returned.
-func NXVersion(c *gnmiext.Capabilities) Version {
-	version := VersionUnknown
+// ModelRevision returns the revision date of the Cisco-NX-OS-device yang model supported by the target device,
+// or an empty string if the model is not supported.
+func ModelRevision(c *gnmiext.Capabilities) string {
 	for _, m := range c.SupportedModels {
 		if m.Name == "Cisco-NX-OS-device" && m.Organization == "Cisco Systems, Inc." {
-			if v, ok := nxosVersions[m.Version]; ok {
-				version = v
-			}
-			break
+			return m.Version
 		}
 	}
-	return version
+	return ""
+}
+
+// NXVersion returns the NX-OS operating system version of the target device based on the supported models.
+// If the version cannot be determined, [VersionUnknown] is returned.
+func NXVersion(c *gnmiext.Capabilities) Version {
+	if v, ok := nxosVersions[ModelRevision(c)]; ok {
+		return v
+	}
+	return VersionUnknown
+}
+
+// ResolveVersion returns the NX-OS operating system version of the target device based on the supported models.
+// If the version cannot be determined and compatible is not empty, compatible is parsed as the release the device
+// is known to be compatible with, e.g. when the device runs a newer release whose model revision is not yet known.
+// If compatible is empty, an error is returned for model revisions that are not known.
+func ResolveVersion(c *gnmiext.Capabilities, compatible string) (Version, error) {
+	if v := NXVersion(c); v != VersionUnknown {
+		return v, nil
+	}
+	if compatible == "" {
+		return VersionUnknown, fmt.Errorf("unknown nx-os model revision %q", ModelRevision(c))
+	}
+	return ParseVersion(compatible)
 }
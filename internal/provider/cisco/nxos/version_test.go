@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package nxos
+
+import (
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
+)
+
+func TestParseVersion(t *testing.T) {
+	for v := VersionUnknown + 1; v <= versionLatest; v++ {
+		got, err := ParseVersion(v.String())
+		if err != nil {
+			t.Fatalf("ParseVersion(%q) error = %v", v.String(), err)
+		}
+		if got != v {
+			t.Errorf("ParseVersion(%q) = %v, want %v", v.String(), got, v)
+		}
+	}
+	for _, s := range []string{"", "Unknown", "10.6.2", "9.3(9)"} {
+		if _, err := ParseVersion(s); err == nil {
+			t.Errorf("ParseVersion(%q) expected error, got nil", s)
+		}
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	caps := func(revision string) *gnmiext.Capabilities {
+		return &gnmiext.Capabilities{
+			SupportedModels: []gnmiext.Model{
+				{Name: "Cisco-NX-OS-device", Organization: "Cisco Systems, Inc.", Version: revision},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		caps       *gnmiext.Capabilities
+		compatible string
+		want       Version
+		wantErr    bool
+	}{
+		{
+			name: "known revision",
+			caps: caps("2024-03-26"),
+			want: VersionNX10_4_3,
+		},
+		{
+			name:       "known revision ignores compatible version",
+			caps:       caps("2024-03-26"),
+			compatible: "10.6(2)",
+			want:       VersionNX10_4_3,
+		},
+		{
+			name:    "unknown revision",
+			caps:    caps("2024-04-26"),
+			want:    VersionUnknown,
+			wantErr: true,
+		},
+		{
+			name:    "missing model",
+			caps:    &gnmiext.Capabilities{},
+			want:    VersionUnknown,
+			wantErr: true,
+		},
+		{
+			name:       "unknown revision with compatible version",
+			caps:       caps("2024-04-26"),
+			compatible: "10.4(3)",
+			want:       VersionNX10_4_3,
+		},
+		{
+			name:       "unknown revision with invalid compatible version",
+			caps:       caps("2024-04-26"),
+			compatible: "10.4.3",
+			wantErr:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ResolveVersion(test.caps, test.compatible)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ResolveVersion() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("ResolveVersion() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
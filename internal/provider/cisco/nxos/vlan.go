@@ -21,7 +21,8 @@
 
 // VLANSystem represents the settings shared among all VLANs
 type VLANSystem struct {
-	LongName bool `json:"longName"`
+	LongName  bool `json:"longName"`
+	TagNative bool `json:"tagNative"`
 }
 
 func (*VLANSystem) XPath() string {
@@ -30,6 +31,7 @@ func (*VLANSystem) XPath() string {
 
 func (v *VLANSystem) Default() {
 	v.LongName = false
+	v.TagNative = false
 }
 
 // VLANReservation represents the settings for VLAN reservations
@@ -7,4 +7,5 @@ func init() {
 	Register("vlan", &VLAN{AdminSt: BdStateActive, BdState: BdStateActive, FabEncap: "vlan-10", Name: NewOption("Test")})
 	Register("vlan_reservation", new(VLANReservation(3850)))
 	Register("vlan_system", &VLANSystem{LongName: true})
+	Register("vlan_system_tag_native", &VLANSystem{TagNative: true})
 }
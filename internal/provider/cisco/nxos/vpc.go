@@ -5,6 +5,7 @@
 
 import (
 	"fmt"
+	"net"
 	"regexp"
 	"slices"
 	"strconv"
@@ -16,9 +17,13 @@
 
 var (
 	_ gnmiext.DataElement = (*VPCDomain)(nil)
+	_ gnmiext.DataElement = (*VPCDomainSystem)(nil)
 	_ gnmiext.DataElement = (*VPCIf)(nil)
 )
 
+// MaxVPCDomainID is the highest vPC domain ID supported by NX-OS.
+const MaxVPCDomainID = 1000
+
 // VPCDomain represents the domain of a virtual Port Channel (vPC)
 type VPCDomain struct {
 	AdminSt                 AdminSt `json:"adminSt"`
@@ -62,6 +67,28 @@ func (*VPCDomainOper) XPath() string {
 	return "System/vpc-items/inst-items/dom-items"
 }
 
+// VPCDomainSystem represents the LACP system parameters of a vPC domain, which both vPC peers
+// present to the partners of their vPC port-channels instead of their own.
+type VPCDomainSystem struct {
+	// OperSysMac is the system MAC address in effect, i.e. the configured one or the one derived
+	// by the device from the domain ID.
+	OperSysMac string `json:"operSysMac,omitempty"`
+	SysPrio    uint16 `json:"sysPrio"`
+}
+
+func (*VPCDomainSystem) XPath() string {
+	return "System/vpc-items/inst-items/dom-items"
+}
+
+// SystemID returns the system MAC address of the vPC domain in canonical notation, or an empty
+// string if the device has not reported it yet.
+func (d *VPCDomainSystem) SystemID() string {
+	if mac, err := net.ParseMAC(d.OperSysMac); err == nil && len(mac) == 6 && [6]byte(mac) != [6]byte{} {
+		return mac.String()
+	}
+	return ""
+}
+
 // VPCDomainRole represents the role of a vPC peer.
 type VPCDomainRole string
 
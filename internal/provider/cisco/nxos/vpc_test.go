@@ -3,6 +3,17 @@
 
 package nxos
 
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	nxv1alpha1 "github.com/ironcore-dev/network-operator/api/cisco/nx/v1alpha1"
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+)
+
 func init() {
 	vd := &VPCDomain{
 		AdminSt:                 AdminStEnabled,
@@ -29,3 +40,102 @@ func init() {
 	vi.SetPortChannel("po10")
 	Register("vpc_member", vi)
 }
+
+func TestProvider_EnsureVPCDomain(t *testing.T) {
+	tests := []struct {
+		name      string
+		domainID  int16
+		src, dst  string
+		wantField string
+	}{
+		{
+			name:     "keepalive over mgmt vrf",
+			domainID: 2,
+			src:      "10.114.235.155",
+			dst:      "10.114.235.156",
+		},
+		{
+			name:      "domain id out of range",
+			domainID:  1001,
+			src:       "10.114.235.155",
+			dst:       "10.114.235.156",
+			wantField: "spec.domainId",
+		},
+		{
+			name:      "ipv6 source",
+			domainID:  2,
+			src:       "2001:db8::1",
+			dst:       "10.114.235.156",
+			wantField: "spec.peer.keepalive.source",
+		},
+		{
+			name:      "same source and destination",
+			domainID:  2,
+			src:       "10.114.235.155",
+			dst:       "10.114.235.155",
+			wantField: "spec.peer.keepalive.destination",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &fakeClient{config: map[string]string{}}
+			p := &Provider{client: c}
+
+			vd := &nxv1alpha1.VPCDomain{}
+			vd.Spec.DomainID = test.domainID
+			vd.Spec.AdminState = v1alpha1.AdminStateUp
+			vd.Spec.RolePriority = 100
+			vd.Spec.SystemPriority = 10
+			vd.Spec.Peer.AdminState = v1alpha1.AdminStateUp
+			vd.Spec.Peer.KeepAlive.Source = test.src
+			vd.Spec.Peer.KeepAlive.Destination = test.dst
+			vd.Spec.Peer.KeepAlive.VrfName = ManagementVRFName
+
+			pc := &v1alpha1.Interface{}
+			pc.Spec.Name = "po1"
+
+			err := p.EnsureVPCDomain(context.Background(), vd, nil, pc)
+			if test.wantField != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || len(s.FieldViolations) != 1 || s.FieldViolations[0].Field != test.wantField {
+					t.Fatalf("EnsureVPCDomain() error = %v, want violation of %s", err, test.wantField)
+				}
+				if len(c.config) != 0 {
+					t.Errorf("EnsureVPCDomain() configured device despite error: %v", c.config)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureVPCDomain() error = %v", err)
+			}
+
+			got := new(VPCDomain)
+			if err := json.Unmarshal([]byte(c.config[got.XPath()]), got); err != nil {
+				t.Fatalf("EnsureVPCDomain() did not configure the vPC domain: %v", err)
+			}
+			if got.ID != test.domainID || got.RolePrio != 100 || got.SysPrio != 10 {
+				t.Errorf("EnsureVPCDomain() domain = %+v", got)
+			}
+			ka := got.KeepAliveItems
+			if ka.SrcIP != test.src || ka.DestIP != test.dst || ka.VRF != ManagementVRFName {
+				t.Errorf("EnsureVPCDomain() keepalive = %s -> %s (vrf %s), want %s -> %s (vrf %s)", ka.SrcIP, ka.DestIP, ka.VRF, test.src, test.dst, ManagementVRFName)
+			}
+			if ka.PeerLinkItems.ID != "po1" || ka.PeerLinkItems.AdminSt != AdminStEnabled {
+				t.Errorf("EnsureVPCDomain() peer-link = %+v, want po1 enabled", ka.PeerLinkItems)
+			}
+		})
+	}
+}
+
+func TestProvider_DeleteVPCDomain(t *testing.T) {
+	xpath := new(VPCDomain).XPath()
+	c := &fakeClient{config: map[string]string{xpath: `{"id":2}`}}
+	p := &Provider{client: c}
+
+	if err := p.DeleteVPCDomain(context.Background()); err != nil {
+		t.Fatalf("DeleteVPCDomain() error = %v", err)
+	}
+	if _, ok := c.config[xpath]; ok || !slices.Equal(c.deleted, []string{xpath}) {
+		t.Errorf("DeleteVPCDomain() deleted = %v, want [%s]", c.deleted, xpath)
+	}
+}
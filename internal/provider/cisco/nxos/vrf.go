@@ -3,6 +3,8 @@
 package nxos
 
 import (
+	"strings"
+
 	"github.com/ironcore-dev/network-operator/internal/transport/gnmiext"
 )
 
@@ -15,6 +17,7 @@
 	_ gnmiext.DataElement = (*VRF)(nil)
 	_ gnmiext.DataElement = (*VRFEncap)(nil)
 	_ gnmiext.DataElement = (*VRFDomItems)(nil)
+	_ gnmiext.DataElement = (*VRFMembers)(nil)
 )
 
 // VRF represents the VRF YANG container with name and description patched by [Provider.EnsureVRF].
@@ -113,3 +116,30 @@ func (r *Rtt) Key() string { return r.Rtt }
 	RttEntryTypeImport RttEntryType = "import"
 	RttEntryTypeExport RttEntryType = "export"
 )
+
+// VRFMembers represents the interfaces that are members of a VRF, i.e. the targets of the
+// rtvrfMbr relations of the interfaces referring to the VRF.
+type VRFMembers struct {
+	Vrf          string `json:"-"`
+	RtVrfMbrList []struct {
+		// TDn is the distinguished name of the member interface, e.g. "sys/intf/phys-[eth1/1]".
+		TDn string `json:"tDn"`
+	} `json:"RtVrfMbr-list,omitzero"`
+}
+
+func (v *VRFMembers) XPath() string {
+	return "System/inst-items/Inst-list[name=" + v.Vrf + "]/rtvrfMbr-items"
+}
+
+// Interfaces returns the names of the member interfaces.
+func (v *VRFMembers) Interfaces() []string {
+	names := make([]string, 0, len(v.RtVrfMbrList))
+	for _, m := range v.RtVrfMbrList {
+		name := m.TDn
+		if i, j := strings.LastIndex(name, "["), strings.LastIndex(name, "]"); i >= 0 && j > i {
+			name = name[i+1 : j]
+		}
+		names = append(names, name)
+	}
+	return names
+}
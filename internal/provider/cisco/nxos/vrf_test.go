@@ -3,6 +3,16 @@
 
 package nxos
 
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/ironcore-dev/network-operator/api/core/v1alpha1"
+	"github.com/ironcore-dev/network-operator/internal/apistatus"
+	"github.com/ironcore-dev/network-operator/internal/provider"
+)
+
 func init() {
 	// Note: These route targets will be sorted alphabetically in the output
 	rttExportEvpn := new(RttEntry)
@@ -60,3 +70,60 @@ func init() {
 	domItems.DomList.Set(dom)
 	Register("vrf_dom", domItems)
 }
+
+func TestProvider_DeleteVRF(t *testing.T) {
+	const (
+		vrf     = "System/inst-items/Inst-list[name=TENANT]"
+		members = vrf + "/rtvrfMbr-items"
+		other   = "System/inst-items/Inst-list[name=OTHER]/rtvrfMbr-items"
+	)
+
+	tests := []struct {
+		name        string
+		config      map[string]string
+		wantMessage string
+	}{
+		{
+			name:   "without member interfaces",
+			config: map[string]string{other: `{"RtVrfMbr-list":[{"tDn":"sys/intf/phys-[eth1/2]"}]}`},
+		},
+		{
+			name:   "with empty member list",
+			config: map[string]string{members: `{}`},
+		},
+		{
+			name: "with member interfaces",
+			config: map[string]string{
+				members: `{"RtVrfMbr-list":[{"tDn":"sys/intf/svi-[vlan10]"},{"tDn":"sys/intf/phys-[eth1/1]"}]}`,
+			},
+			wantMessage: `VRF "TENANT" still has member interfaces: eth1/1, vlan10`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.config[vrf] = `{"name":"TENANT"}`
+			c := &fakeClient{config: test.config}
+			p := &Provider{client: c}
+
+			err := p.DeleteVRF(context.Background(), &provider.VRFRequest{
+				VRF: &v1alpha1.VRF{Spec: v1alpha1.VRFSpec{Name: "TENANT"}},
+			})
+			if test.wantMessage != "" {
+				s, ok := apistatus.FromError(err)
+				if !ok || s.Code != apistatus.CodeFailedPrecondition || s.Message != test.wantMessage {
+					t.Fatalf("DeleteVRF() error = %v, want failed precondition %q", err, test.wantMessage)
+				}
+				if slices.Contains(c.deleted, vrf) {
+					t.Errorf("DeleteVRF() deleted VRF with member interfaces")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DeleteVRF() error = %v", err)
+			}
+			if !slices.Contains(c.deleted, vrf) {
+				t.Errorf("DeleteVRF() did not delete %s, deleted = %v", vrf, c.deleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+var (
+	limMu sync.Mutex
+	// limiters holds the configuration change limiters of all devices, keyed by the endpoint address of the device.
+	limiters = make(map[string]*rate.Limiter)
+	// minChangeIntervalPerDevice is the minimum interval enforced by the limiters. Zero means no limit.
+	minChangeIntervalPerDevice time.Duration
+)
+
+// SetMinChangeIntervalPerDevice sets the minimum time between two configuration changes that all providers
+// combined apply to a single device, so that devices with a weak management CPU are not overwhelmed by
+// frequent changes. Pending changes are queued and applied one after another at the allowed rate.
+// A value of zero or less disables the limit. It must be called before any provider connects to a device.
+func SetMinChangeIntervalPerDevice(d time.Duration) {
+	limMu.Lock()
+	defer limMu.Unlock()
+	minChangeIntervalPerDevice = max(d, 0)
+	clear(limiters)
+}
+
+// DeviceChangeLimiter returns the limiter that spaces out the configuration changes applied to the device
+// with the given endpoint address. Providers wait for the limiter before each change, see [rate.Limiter.Wait].
+// It returns nil if the rate of changes is not limited.
+func DeviceChangeLimiter(address string) *rate.Limiter {
+	limMu.Lock()
+	defer limMu.Unlock()
+	if minChangeIntervalPerDevice == 0 {
+		return nil
+	}
+	lim, ok := limiters[address]
+	if !ok {
+		lim = rate.NewLimiter(rate.Every(minChangeIntervalPerDevice), 1)
+		limiters[address] = lim
+	}
+	return lim
+}
@@ -17,7 +17,10 @@
 	"github.com/ironcore-dev/network-operator/internal/transport/grpcext"
 )
 
-var _ provider.Provider = (*Provider)(nil)
+var (
+	_ provider.Provider     = (*Provider)(nil)
+	_ provider.PingProvider = (*Provider)(nil)
+)
 
 // Provider implements the OpenConfig provider using gnmiext.Client.
 type Provider struct {
@@ -34,11 +37,15 @@ func NewProvider() provider.Provider {
 func (p *Provider) Connect(ctx context.Context, conn *deviceutil.Connection) (err error) {
 	// timeout is the default timeout for all gRPC requests made by the provider.
 	const timeout = 30 * time.Second
-	p.conn, err = grpcext.NewClient(conn, grpcext.WithDefaultTimeout(timeout))
+	p.conn, err = grpcext.NewClient(conn, grpcext.WithDefaultTimeout(timeout), grpcext.WithUserAgent(provider.UserAgent()))
 	if err != nil {
 		return fmt.Errorf("failed to create grpc connection: %w", err)
 	}
-	var opts []gnmiext.Option
+	opts := []gnmiext.Option{
+		gnmiext.WithMetadata(provider.Metadata()...),
+		gnmiext.WithSemaphore(provider.DeviceSemaphore(conn.Address)),
+		gnmiext.WithSetLimiter(provider.DeviceChangeLimiter(conn.Address)),
+	}
 	if logger, err := logr.FromContext(ctx); err == nil && !logger.IsZero() {
 		opts = append(opts, gnmiext.WithLogger(logger))
 	}
@@ -54,6 +61,11 @@ func (p *Provider) Disconnect(_ context.Context, _ *deviceutil.Connection) error
 	return p.conn.Close()
 }
 
+// Ping checks that the gNMI server of the device is reachable and responsive.
+func (p *Provider) Ping(ctx context.Context) error {
+	return p.client.Ping(ctx)
+}
+
 func init() {
 	provider.Register("openconfig", NewProvider)
 }
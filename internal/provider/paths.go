@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import "sync/atomic"
+
+// maxPathsPerRequest is the maximum number of paths in a single configuration request. Zero means no limit.
+var maxPathsPerRequest atomic.Int64
+
+// SetMaxPathsPerRequest sets the maximum number of paths that a provider sends to a device in a single
+// configuration request, so that large changes are split into multiple requests for devices that reject
+// or time out on oversized ones. A value of zero or less disables the limit. Providers that cannot split
+// their requests ignore it. It must be called before any provider is created.
+func SetMaxPathsPerRequest(n int) {
+	maxPathsPerRequest.Store(int64(max(n, 0)))
+}
+
+// MaxPathsPerRequest returns the maximum number of paths in a single configuration request,
+// as set by [SetMaxPathsPerRequest]. It returns zero if the number of paths is not limited.
+func MaxPathsPerRequest() int {
+	return int(maxPathsPerRequest.Load())
+}
@@ -6,9 +6,12 @@
 	"context"
 	"crypto/tls"
 	"fmt"
+	"io"
 	"maps"
 	"net/netip"
 	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,6 +29,15 @@ type Provider interface {
 	Disconnect(context.Context, *deviceutil.Connection) error
 }
 
+// PingProvider is the interface for probing whether a device is reachable and responsive.
+type PingProvider interface {
+	Provider
+
+	// Ping checks with a lightweight request that the device is reachable and responds,
+	// without retrieving any configuration or state. It must be called after Connect.
+	Ping(context.Context) error
+}
+
 type DeviceProvider interface {
 	Provider
 
@@ -44,11 +56,163 @@ type MaintenanceProvider interface {
 	Provider
 
 	// Reboot initiates a reboot of the device.
-	Reboot(context.Context, *deviceutil.Connection) error
+	Reboot(context.Context, *deviceutil.Connection, *RebootOptions) error
 	// FactoryReset performs a factory reset of the device.
 	FactoryReset(context.Context, *deviceutil.Connection) error
 }
 
+// RebootOptions are the options of a device reboot.
+type RebootOptions struct {
+	// SaveConfig saves the running configuration to the startup configuration before the reboot.
+	SaveConfig bool
+	// Delay is the time after which the device reboots. Zero reboots the device immediately.
+	Delay time.Duration
+}
+
+// ConfigSaveProvider is the interface for persisting the running configuration of a device.
+type ConfigSaveProvider interface {
+	Provider
+
+	// SaveConfig saves the running configuration of the device to its startup configuration,
+	// so that it persists across reloads.
+	SaveConfig(context.Context) error
+}
+
+// ConfigRevisionProvider is the interface for detecting changes of the running configuration of a device
+// without retrieving it, e.g. to save it only after it changed.
+type ConfigRevisionProvider interface {
+	Provider
+
+	// GetConfigRevision returns an opaque identifier of the running configuration of the device,
+	// e.g. the time of its last change, which changes whenever the running configuration changes.
+	GetConfigRevision(context.Context) (string, error)
+}
+
+// TransactionProvider is the interface for applying the configuration changes of multiple
+// operations as a single unit, e.g. for tightly-coupled objects that must not be left
+// partially configured on the device.
+type TransactionProvider interface {
+	Provider
+
+	// Transaction calls fn with a provider that collects the configuration changes of the
+	// operations invoked on it and applies them all at once after fn returns. If fn or the
+	// application of the changes fails, the configuration of the device is left unchanged.
+	// The provider passed to fn is only valid for the duration of the call and implements
+	// the same interfaces as the provider Transaction is called on. It must be called after Connect.
+	Transaction(ctx context.Context, fn func(ctx context.Context, p Provider) error) error
+}
+
+// ECMPProvider is the interface for configuring the system-wide equal-cost multi-path (ECMP) settings of a device.
+type ECMPProvider interface {
+	Provider
+
+	// EnsureECMP call is responsible for the realization of the system-wide ECMP settings on the provider.
+	// It reports whether the device must be reloaded for the settings to take effect.
+	EnsureECMP(context.Context, *EnsureECMPRequest) (reloadRequired bool, err error)
+}
+
+type EnsureECMPRequest struct {
+	// MaximumPaths is the maximum number of equal-cost next-hops that can be installed for a single route.
+	MaximumPaths int32
+}
+
+// LACPProvider is the interface for configuring the system-wide Link Aggregation Control Protocol (LACP) settings of a device.
+type LACPProvider interface {
+	Provider
+
+	// EnsureLACP call is responsible for the realization of the system-wide LACP settings on the provider.
+	EnsureLACP(context.Context, *EnsureLACPRequest) error
+}
+
+type EnsureLACPRequest struct {
+	// SystemPriority is the LACP system priority of the device.
+	// A value of zero restores the default system priority of the device.
+	SystemPriority int32
+}
+
+// HostnameProvider is the interface for managing the hostname of a device.
+type HostnameProvider interface {
+	Provider
+
+	// EnsureHostname call is responsible for configuring the hostname of the device.
+	EnsureHostname(ctx context.Context, name string) error
+	// GetHostname call retrieves the hostname currently configured on the device.
+	GetHostname(context.Context) (string, error)
+}
+
+// DeviceEventProvider is the interface for streaming device-originated events, such as
+// interfaces or BGP sessions going down.
+type DeviceEventProvider interface {
+	Provider
+
+	// WatchDeviceEvents subscribes to events on the connected device and calls the given function for each event.
+	// The call blocks until the context is canceled or the subscription fails.
+	WatchDeviceEvents(context.Context, func(DeviceEvent)) error
+
+	// NormalizeInterfaceName returns the canonical form of the given interface name, so that names
+	// referring to the same interface compare equal, e.g. "Ethernet1/1" and "eth1/1" on Cisco NX-OS.
+	NormalizeInterfaceName(string) (string, error)
+}
+
+// DeviceQueryProvider is the interface for read-only diagnostic queries against arbitrary paths on the device.
+type DeviceQueryProvider interface {
+	Provider
+
+	// QueryDevice retrieves the data at the path of the query and returns it as JSON.
+	// Implementations must not modify the configuration of the device.
+	QueryDevice(context.Context, *DeviceQueryRequest) ([]byte, error)
+}
+
+type DeviceQueryRequest struct {
+	DeviceQuery *v1alpha1.DeviceQuery
+}
+
+// RunningConfigProvider is the interface for retrieving the full running configuration of a device,
+// e.g. to detect changes made out-of-band by comparing its hash against the last applied state.
+type RunningConfigProvider interface {
+	Provider
+
+	// GetRunningConfig retrieves the running configuration of the device and writes it to the writer
+	// in a canonical form, such that equal configurations are always written as identical bytes.
+	// The configuration is written as it is encoded, e.g. to compute its hash without holding it twice.
+	GetRunningConfig(context.Context, io.Writer) error
+}
+
+// RunningConfigCLIProvider is the interface for retrieving the running configuration of a device
+// in the text form printed by its command line interface, e.g. for human review during audits.
+type RunningConfigCLIProvider interface {
+	Provider
+
+	// GetRunningConfigCLI retrieves the running configuration of the device as printed by its CLI.
+	// Implementations must not modify the configuration of the device.
+	GetRunningConfigCLI(context.Context) (string, error)
+}
+
+// DeviceEventKind is the kind of object a [DeviceEvent] refers to.
+type DeviceEventKind string
+
+const (
+	// DeviceEventKindInterface indicates an event for an interface, identified by its name.
+	DeviceEventKindInterface DeviceEventKind = "Interface"
+	// DeviceEventKindBGPPeer indicates an event for a BGP peer, identified by its address.
+	DeviceEventKindBGPPeer DeviceEventKind = "BGPPeer"
+)
+
+// DeviceEvent is an event reported by the device.
+type DeviceEvent struct {
+	// Kind is the kind of object the event refers to.
+	Kind DeviceEventKind
+	// Name identifies the object on the device, i.e. the interface name as reported
+	// by the device or the address of the BGP peer.
+	Name string
+	// Up indicates whether the object transitioned into an operational state.
+	Up bool
+	// Message is a human-readable description of the event.
+	Message string
+	// Timestamp is the time the event occurred on the device.
+	Timestamp time.Time
+}
+
 // ProvisioningProvider is the interface for the realization of the provisioning-related operations over different providers.
 type ProvisioningProvider interface {
 	// Reprovision prepares the device for reprovisioning by resetting it and reenabling provisioning mechanisms.
@@ -71,6 +235,13 @@ type DevicePort struct {
 	Transceiver string
 }
 
+// SupportsBreakout reports whether the port can be broken out into the given mode,
+// i.e. whether the port supports the combined speed of the ports of the mode.
+func (p DevicePort) SupportsBreakout(mode BreakoutMode) bool {
+	n, gbps := mode.Ports()
+	return n > 0 && slices.Contains(p.SupportedSpeedsGbps, n*gbps)
+}
+
 type DeviceInfo struct {
 	// Hostname is the hostname of the device.
 	Hostname string
@@ -82,6 +253,51 @@ type DeviceInfo struct {
 	SerialNumber string
 	// FirmwareVersion is the firmware version running on the device, e.g. "10.4(3)".
 	FirmwareVersion string
+	// DataModelVersion is the version of the data model exposed by the device, e.g. the revision of its YANG model.
+	// It is optional and left empty if the provider cannot determine it.
+	DataModelVersion string
+}
+
+// BreakoutProvider is the interface for splitting high-speed physical ports into multiple lower-speed ports.
+type BreakoutProvider interface {
+	Provider
+
+	// EnsureBreakout breaks out the physical port into the ports of the given mode, e.g. a 100G port
+	// into four 25G ports. The mode must be supported by the port, see [DevicePort.SupportsBreakout].
+	// [BreakoutModeNone] restores the port to a single port.
+	EnsureBreakout(ctx context.Context, port string, mode BreakoutMode) error
+	// GetBreakout returns the current breakout mode of the physical port.
+	GetBreakout(ctx context.Context, port string) (BreakoutMode, error)
+}
+
+// BreakoutMode is the number and the speed of the ports a physical port is broken out into.
+type BreakoutMode string
+
+const (
+	// BreakoutModeNone indicates that the port is not broken out.
+	BreakoutModeNone   BreakoutMode = ""
+	BreakoutMode4x10G  BreakoutMode = "4x10G"
+	BreakoutMode4x25G  BreakoutMode = "4x25G"
+	BreakoutMode2x50G  BreakoutMode = "2x50G"
+	BreakoutMode4x100G BreakoutMode = "4x100G"
+)
+
+// Ports returns the number of ports of the breakout mode and their speed in Gbps.
+// It returns zero for both if the mode is [BreakoutModeNone] or invalid.
+func (m BreakoutMode) Ports() (n, gbps int32) {
+	count, speed, ok := strings.Cut(string(m), "x")
+	if !ok || !strings.HasSuffix(speed, "G") {
+		return 0, 0
+	}
+	a, err := strconv.ParseInt(count, 10, 32)
+	if err != nil || a <= 0 {
+		return 0, 0
+	}
+	b, err := strconv.ParseInt(strings.TrimSuffix(speed, "G"), 10, 32)
+	if err != nil || b <= 0 {
+		return 0, 0
+	}
+	return int32(a), int32(b)
 }
 
 // InterfaceProvider is the interface for the realization of the Interface objects over different providers.
@@ -119,6 +335,23 @@ type EnsureInterfaceRequest struct {
 	// If unset, the interface is part of the default VRF.
 	// Only applicable for layer3 interfaces.
 	VRF *v1alpha1.VRF
+	// AccessGroups are the access control lists bound to the interface.
+	// Only applicable for layer3 interfaces.
+	AccessGroups []AccessGroup
+	// ServicePolicies are the QoS policies attached to the interface.
+	ServicePolicies []ServicePolicy
+}
+
+// AccessGroup is an access control list bound to an interface in a given direction.
+type AccessGroup struct {
+	Direction v1alpha1.AccessGroupDirection
+	ACL       *v1alpha1.AccessControlList
+}
+
+// ServicePolicy is a QoS policy attached to an interface in a given direction.
+type ServicePolicy struct {
+	Direction v1alpha1.ServicePolicyDirection
+	QoSPolicy *v1alpha1.QoSPolicy
 }
 
 type InterfaceRequest struct {
@@ -148,6 +381,63 @@ type InterfaceStatus struct {
 	OperMessage string
 	// LLDPAdjacencies provides information about the directly connected neighbors on this interface, if available.
 	LLDPAdjacencies []LLDPAdjacency
+	// SwitchportMode is the switchport mode operationally applied on the interface.
+	// Leave empty if the interface is not a switchport or the provider does not report the mode.
+	SwitchportMode v1alpha1.SwitchportMode
+	// Members provides the bundle status of each member of an aggregate interface.
+	// Leave empty if the interface is not an aggregate or the provider does not report the member status.
+	Members []MemberStatus
+	// FEC provides the forward error correction (FEC) statistics of the interface.
+	// Leave nil if the interface does not use FEC or the provider does not report the statistics.
+	FEC *FECStatistics
+	// OperMTU is the MTU operationally in effect on the interface, which may differ from the configured MTU.
+	// Leave zero if the provider does not report the operational MTU of the interface.
+	OperMTU int32
+	// LACP provides the LACP system parameters the device presents to the partner on an aggregate interface.
+	// Leave nil if the interface is not an aggregate or the provider does not report the parameters.
+	LACP *LACPSystem
+}
+
+// FECStatistics represents the forward error correction (FEC) statistics of an interface,
+// which indicate the health of the optics and the link before errors become uncorrectable.
+type FECStatistics struct {
+	// CorrectedCodewords is the number of codewords received with errors that were corrected by FEC.
+	CorrectedCodewords uint64
+	// UncorrectedCodewords is the number of codewords received with errors that could not be corrected by FEC.
+	UncorrectedCodewords uint64
+	// PreFECBER is the bit error rate of the link before FEC is applied, nil if not reported.
+	PreFECBER *float64
+	// PostFECBER is the bit error rate of the link after FEC is applied, nil if not reported.
+	PostFECBER *float64
+}
+
+// MemberStatus represents the operational status of a member interface within an aggregate interface.
+type MemberStatus struct {
+	// Name is the name of the member interface as reported by the device.
+	Name string
+	// BundleStatus indicates whether the member is actively bundled in the aggregate.
+	BundleStatus BundleStatus
+}
+
+// BundleStatus represents the bundling state of an aggregate member interface.
+type BundleStatus string
+
+const (
+	// BundleStatusActive indicates that the member is bundled and forwarding traffic as part of the aggregate.
+	BundleStatusActive BundleStatus = "Active"
+	// BundleStatusIndividual indicates that the member is up, but operates as an individual link
+	// because it was not bundled, e.g. due to missing LACP PDUs from the peer.
+	BundleStatusIndividual BundleStatus = "Individual"
+	// BundleStatusDown indicates that the member is down or suspended.
+	BundleStatusDown BundleStatus = "Down"
+)
+
+// LACPSystem represents the LACP system parameters identifying a device to its LACP partners.
+type LACPSystem struct {
+	// SystemID is the MAC address of the system.
+	SystemID string
+	// SystemPriority is the priority of the system.
+	SystemPriority uint16
 }
 
 // LLDPAdjacency represents information about a directly connected neighbor on an interface, as discovered through LLDP.
@@ -243,6 +533,12 @@ type ACLProvider interface {
 	EnsureACL(context.Context, *EnsureACLRequest) error
 	// DeleteACL call is responsible for AccessControlList deletion on the provider.
 	DeleteACL(context.Context, *DeleteACLRequest) error
+	// EnsureObjectGroup call is responsible for the realization of an object group
+	// referenced by AccessControlList entries on the provider.
+	EnsureObjectGroup(context.Context, *EnsureObjectGroupRequest) error
+	// DeleteObjectGroup call is responsible for object group deletion on the provider.
+	// It is only called once no AccessControlList entry references the object group anymore.
+	DeleteObjectGroup(context.Context, *DeleteObjectGroupRequest) error
 }
 
 type EnsureACLRequest struct {
@@ -255,6 +551,16 @@ type DeleteACLRequest struct {
 	ProviderConfig *ProviderConfig
 }
 
+type EnsureObjectGroupRequest struct {
+	ObjectGroup    *v1alpha1.ACLObjectGroup
+	ProviderConfig *ProviderConfig
+}
+
+type DeleteObjectGroupRequest struct {
+	Name           string
+	ProviderConfig *ProviderConfig
+}
+
 // CertificateProvider is the interface for the realization of the Certificate objects over different providers.
 type CertificateProvider interface {
 	Provider
@@ -263,6 +569,9 @@ type CertificateProvider interface {
 	EnsureCertificate(context.Context, *EnsureCertificateRequest) error
 	// DeleteCertificate call is responsible for Certificate deletion on the provider.
 	DeleteCertificate(context.Context, *DeleteCertificateRequest) error
+	// GetCertificate call is responsible for retrieving the certificate installed under the given ID on the provider.
+	// It returns nil if no certificate is installed under the ID.
+	GetCertificate(context.Context, *GetCertificateRequest) (*CertificateInfo, error)
 }
 
 type EnsureCertificateRequest struct {
@@ -276,6 +585,23 @@ type DeleteCertificateRequest struct {
 	ProviderConfig *ProviderConfig
 }
 
+type GetCertificateRequest struct {
+	ID             string
+	ProviderConfig *ProviderConfig
+}
+
+// CertificateInfo represents a certificate installed on the device.
+type CertificateInfo struct {
+	// ID is the certificate management id the certificate is installed under, e.g. the trustpoint name.
+	ID string
+	// SerialNumber is the serial number of the certificate as an uppercase hex string.
+	SerialNumber string
+	// NotBefore is the time from which on the certificate is valid.
+	NotBefore time.Time
+	// NotAfter is the time at which the certificate expires.
+	NotAfter time.Time
+}
+
 // SNMPProvider is the interface for the realization of the SNMP objects over different providers.
 type SNMPProvider interface {
 	Provider
@@ -284,6 +610,8 @@ type SNMPProvider interface {
 	EnsureSNMP(context.Context, *EnsureSNMPRequest) error
 	// DeleteSNMP call is responsible for SNMP deletion on the provider.
 	DeleteSNMP(context.Context, *DeleteSNMPRequest) error
+	// GetSNMPStatus call retrieves the SNMP configuration currently applied on the provider.
+	GetSNMPStatus(context.Context, *SNMPStatusRequest) (SNMPStatus, error)
 }
 
 type EnsureSNMPRequest struct {
@@ -295,6 +623,21 @@ type DeleteSNMPRequest struct {
 	ProviderConfig *ProviderConfig
 }
 
+type SNMPStatusRequest struct {
+	SNMP           *v1alpha1.SNMP
+	ProviderConfig *ProviderConfig
+}
+
+// SNMPStatus represents the SNMP configuration read back from the device.
+type SNMPStatus struct {
+	// Communities contains the names of the SNMP communities configured on the device.
+	Communities []string
+	// Hosts contains the addresses of the SNMP notification hosts configured on the device.
+	Hosts []string
+	// Traps contains the trap notifications enabled on the device, in the format used by [v1alpha1.SNMPSpec].
+	Traps []string
+}
+
 // SyslogProvider is the interface for the realization of the Syslog objects over different providers.
 type SyslogProvider interface {
 	Provider
@@ -333,6 +676,8 @@ type ISISProvider interface {
 	EnsureISIS(context.Context, *EnsureISISRequest) error
 	// DeleteISIS call is responsible for ISIS deletion on the provider.
 	DeleteISIS(context.Context, *DeleteISISRequest) error
+	// GetISISStatus call is responsible for retrieving the current status of the ISIS from the provider.
+	GetISISStatus(context.Context, *ISISStatusRequest) (ISISStatus, error)
 }
 
 type EnsureISISRequest struct {
@@ -346,6 +691,29 @@ type DeleteISISRequest struct {
 	ProviderConfig *ProviderConfig
 }
 
+type ISISStatusRequest struct {
+	ISIS           *v1alpha1.ISIS
+	Interfaces     []*v1alpha1.Interface
+	ProviderConfig *ProviderConfig
+}
+
+type ISISStatus struct {
+	// OperStatus indicates whether the isis instance is operationally up (true) or down (false).
+	OperStatus bool
+	// Adjacencies is a list of ISIS adjacencies and their states.
+	Adjacencies []ISISAdjacency
+	// LSPCounts is the number of LSPs in the link-state database per level.
+	LSPCounts map[v1alpha1.ISISLevel]int32
+}
+
+type ISISAdjacency struct {
+	SystemID           string
+	Interface          *v1alpha1.Interface
+	Level              v1alpha1.ISISLevel
+	LastTransitionTime time.Time
+	State              v1alpha1.ISISAdjacencyState
+}
+
 // VRFProvider is the interface for the realization of the VRF objects over different providers.
 type VRFProvider interface {
 	Provider
@@ -376,11 +744,39 @@ type EnsurePIMRequest struct {
 	PIM            *v1alpha1.PIM
 	Interfaces     []PIMInterface
 	ProviderConfig *ProviderConfig
+	// BSR is the bootstrap router configuration of PIM.Spec.BSR, if any.
+	BSR *PIMBSR
+	// AutoRP is the Auto-RP configuration of PIM.Spec.AutoRP, if any.
+	AutoRP *PIMAutoRP
+}
+
+// PIMBSR is the bootstrap router configuration with its referenced interfaces resolved.
+type PIMBSR struct {
+	*v1alpha1.PIMBSR
+	// CandidateBSRInterface is the interface referenced by CandidateBSR, if any.
+	CandidateBSRInterface *v1alpha1.Interface
+	// CandidateRPInterface is the interface referenced by CandidateRP, if any.
+	CandidateRPInterface *v1alpha1.Interface
+}
+
+// PIMAutoRP is the Auto-RP configuration with its referenced interfaces resolved.
+type PIMAutoRP struct {
+	*v1alpha1.PIMAutoRP
+	// CandidateRPInterface is the interface referenced by CandidateRP, if any.
+	CandidateRPInterface *v1alpha1.Interface
+	// MappingAgentInterface is the interface referenced by MappingAgent, if any.
+	MappingAgentInterface *v1alpha1.Interface
 }
 
 type PIMInterface struct {
 	Interface *v1alpha1.Interface
 	Mode      v1alpha1.PIMInterfaceMode
+	// HelloInterval is the interval at which PIM hello messages are sent, or zero if not specified.
+	HelloInterval time.Duration
+	// DRPriority is the priority of the interface in the designated router election, if any.
+	DRPriority *int64
+	// IGMP are the IGMP querier parameters of the interface, if any.
+	IGMP *v1alpha1.IGMPQuerier
 }
 
 type DeletePIMRequest struct {
@@ -396,6 +792,12 @@ type BGPProvider interface {
 	EnsureBGP(context.Context, *EnsureBGPRequest) error
 	// DeleteBGP call is responsible for BGP deletion on the provider.
 	DeleteBGP(context.Context, *DeleteBGPRequest) error
+	// EnsureBGPPeerGroup call is responsible for the realization of a peer group
+	// of the BGP instance on the provider, including its update source and address
+	// families shared by all of its members.
+	EnsureBGPPeerGroup(context.Context, *EnsureBGPPeerGroupRequest) error
+	// DeleteBGPPeerGroup call is responsible for peer group deletion on the provider.
+	DeleteBGPPeerGroup(context.Context, *DeleteBGPPeerGroupRequest) error
 }
 
 type EnsureBGPRequest struct {
@@ -408,6 +810,19 @@ type EnsureBGPRequest struct {
 	// RoutingPolicy to apply when redistributing directly connected routes.
 	// Absent key means no redistribution is configured for that family.
 	RedistributeDirectRoutePolicies map[v1alpha1.BGPAddressFamilyType]*v1alpha1.RoutingPolicy
+	// Redistributions maps each address family to the redistribution of routes
+	// of other protocols configured for it, with their resolved RoutingPolicy.
+	// Absent key means no such redistribution is configured for that family.
+	Redistributions map[v1alpha1.BGPAddressFamilyType][]BGPRedistribution
+}
+
+// BGPRedistribution is the redistribution of the routes of a protocol into a BGP address family.
+type BGPRedistribution struct {
+	Protocol v1alpha1.BGPRedistributionProtocol
+	// Instance is the process tag of the OSPF instance, if the protocol is OSPF.
+	Instance string
+	// RoutingPolicy is the resolved RoutingPolicy to apply during redistribution.
+	RoutingPolicy *v1alpha1.RoutingPolicy
 }
 
 type DeleteBGPRequest struct {
@@ -418,6 +833,27 @@ type DeleteBGPRequest struct {
 	VRF *v1alpha1.VRF
 }
 
+type EnsureBGPPeerGroupRequest struct {
+	PeerGroup      *v1alpha1.BGPPeerGroup
+	ProviderConfig *ProviderConfig
+	// BGP is the BGP instance the peer group belongs to.
+	BGP *v1alpha1.BGP
+	// VRF is the resolved VRF referenced by BGP.Spec.VrfRef.
+	// When nil, the provider shall use the default VRF.
+	VRF *v1alpha1.VRF
+	// SourceInterface is the device-level name of the interface resolved from
+	// PeerGroup.LocalAddress. Empty means no update source is configured.
+	SourceInterface string
+}
+
+type DeleteBGPPeerGroupRequest struct {
+	Name           string
+	ProviderConfig *ProviderConfig
+	// VRF is the resolved VRF referenced by BGP.Spec.VrfRef.
+	// When nil, the provider shall use the default VRF.
+	VRF *v1alpha1.VRF
+}
+
 // BGPPeerProvider is the interface for the realization of the BGPPeer objects over different providers.
 type BGPPeerProvider interface {
 	Provider
@@ -445,6 +881,9 @@ type EnsureBGPPeerRequest struct {
 	// OutboundRoutingPolicies maps each address family to the device-level name of
 	// the outbound routing policy to apply. Absent key means no policy is configured.
 	OutboundRoutingPolicies map[v1alpha1.BGPAddressFamilyType]string
+	// Password is the plain text TCP MD5 authentication password resolved from BGPPeer.Spec.Password.
+	// Empty means no authentication is configured. Providers must never log this value.
+	Password string
 }
 
 type DeleteBGPPeerRequest struct {
@@ -476,6 +915,38 @@ type PrefixStats struct {
 	Advertised uint32
 }
 
+// BGPSessionClearProvider is the interface for resetting established BGP sessions on demand,
+// e.g. after a peer got stuck or its policies changed. It is never called during reconciliation.
+type BGPSessionClearProvider interface {
+	Provider
+
+	// ClearBGPSession resets the session with the BGP peer at the address of the request in its VRF.
+	ClearBGPSession(context.Context, *ClearBGPSessionRequest) error
+}
+
+type ClearBGPSessionRequest struct {
+	// VRF is the name of the VRF the peer is configured in. Defaults to the default VRF if empty.
+	VRF string
+	// Address is the address of the BGP peer.
+	Address netip.Addr
+	// Mode defines how the session is reset.
+	Mode BGPSessionClearMode
+}
+
+// BGPSessionClearMode defines how a BGP session is reset.
+type BGPSessionClearMode string
+
+const (
+	// BGPSessionClearHard tears down the session and re-establishes it.
+	BGPSessionClearHard BGPSessionClearMode = "Hard"
+	// BGPSessionClearSoft re-applies the inbound and outbound policies without tearing down the session.
+	BGPSessionClearSoft BGPSessionClearMode = "Soft"
+	// BGPSessionClearSoftInbound re-applies the inbound policies without tearing down the session.
+	BGPSessionClearSoftInbound BGPSessionClearMode = "SoftInbound"
+	// BGPSessionClearSoftOutbound re-applies the outbound policies without tearing down the session.
+	BGPSessionClearSoftOutbound BGPSessionClearMode = "SoftOutbound"
+)
+
 // OSPFProvider is the interface for the realization of the OSPF objects over different providers.
 type OSPFProvider interface {
 	Provider
@@ -581,6 +1052,22 @@ type PrefixSetRequest struct {
 	ProviderConfig *ProviderConfig
 }
 
+// QoSPolicyProvider is the interface for the realization of the QoSPolicy objects over different providers.
+type QoSPolicyProvider interface {
+	Provider
+
+	// EnsureQoSPolicy call is responsible for QoSPolicy realization on the provider,
+	// including the classes the policy is composed of.
+	EnsureQoSPolicy(context.Context, *QoSPolicyRequest) error
+	// DeleteQoSPolicy call is responsible for QoSPolicy deletion on the provider.
+	DeleteQoSPolicy(context.Context, *QoSPolicyRequest) error
+}
+
+type QoSPolicyRequest struct {
+	QoSPolicy      *v1alpha1.QoSPolicy
+	ProviderConfig *ProviderConfig
+}
+
 // RoutingPolicyProvider is the interface for the realization of the RoutingPolicy objects over different providers.
 type RoutingPolicyProvider interface {
 	Provider
@@ -611,6 +1098,18 @@ type MatchPrefixSetCondition struct {
 
 func (MatchPrefixSetCondition) isPolicyCondition() {}
 
+type MatchCommunityListCondition struct {
+	Names []string
+}
+
+func (MatchCommunityListCondition) isPolicyCondition() {}
+
+type MatchTagCondition struct {
+	Tags []uint32
+}
+
+func (MatchTagCondition) isPolicyCondition() {}
+
 type DeleteRoutingPolicyRequest struct {
 	Name string
 }
@@ -657,7 +1156,34 @@ type NVERequest struct {
 	SourceInterface        *v1alpha1.Interface
 	AnycastSourceInterface *v1alpha1.Interface
 	ProviderConfig         *ProviderConfig
-}
+	// VNIs are the VNI members whose settings are configured on the NVE.
+	// The members are merged into the existing VNI configuration, e.g. as created for an EVPNInstance.
+	VNIs []NVEMember
+}
+
+// NVEMember represents the per-VNI configuration of an NVE.
+type NVEMember struct {
+	// VNI is the VXLAN Network Identifier of the member.
+	VNI int32
+	// MulticastGroup is the IPv4 multicast group address used for BUM traffic of the VNI.
+	// It is mutually exclusive with IngressReplication. If empty, the multicast group of the VNI is left unchanged.
+	MulticastGroup string
+	// IngressReplication is the protocol used to replicate BUM traffic of the VNI to remote NVEs.
+	// It is mutually exclusive with MulticastGroup. If empty, the ingress replication of the VNI is left unchanged.
+	IngressReplication IngressReplicationProtocol
+	// SuppressARP overrides the device-level ARP suppression setting of the NVE for the VNI, if set.
+	SuppressARP *bool
+}
+
+// IngressReplicationProtocol is the protocol used to discover the remote NVEs for ingress replication.
+type IngressReplicationProtocol string
+
+const (
+	// IngressReplicationProtocolBGP discovers the remote NVEs through BGP EVPN.
+	IngressReplicationProtocolBGP IngressReplicationProtocol = "BGP"
+	// IngressReplicationProtocolStatic uses a statically configured list of remote NVEs.
+	IngressReplicationProtocolStatic IngressReplicationProtocol = "Static"
+)
 
 type NVEStatus struct {
 	// OperStatus indicates whether the NVE is operationally up (true) or down (false).
@@ -672,6 +1198,22 @@ type NVEStatus struct {
 	AnycastSourceInterfaceName string
 	// HostReachabilityType is the type of host reachability configured on the remote device.
 	HostReachabilityType string
+	// Peers are the remote VTEPs discovered by the NVE.
+	Peers []NVEPeer
+	// VNIs are the VNIs configured on the NVE and their operational status.
+	VNIs []NVEVNIStatus
+}
+
+// NVEPeer represents a remote VTEP discovered by the NVE.
+type NVEPeer struct {
+	Address string
+	State   v1alpha1.NVEPeerState
+}
+
+// NVEVNIStatus represents the operational status of a VNI on the NVE.
+type NVEVNIStatus struct {
+	VNI        int32
+	OperStatus bool
 }
 
 // LLDPProvider is an interface to configure LLDP on a device.
@@ -760,6 +1302,115 @@ type EthernetSegmentStatus struct {
 	OperStatus bool
 }
 
+// StaticRouteProvider is the interface for the realization of static routes over different providers.
+type StaticRouteProvider interface {
+	Provider
+
+	// EnsureStaticRoute call is responsible for static route realization on the provider.
+	EnsureStaticRoute(context.Context, *StaticRouteRequest) error
+	// DeleteStaticRoute call is responsible for static route deletion on the provider.
+	DeleteStaticRoute(context.Context, *StaticRouteRequest) error
+}
+
+// StaticRouteRequest is the request for handling a static route on the provider.
+type StaticRouteRequest struct {
+	// Prefix is the destination prefix of the route.
+	Prefix netip.Prefix
+	// VRF is the name of the VRF the route is installed in. If empty, the default VRF is used.
+	VRF string
+	// NextHops are the next-hops of the route. Multiple next-hops are installed as equal-cost paths.
+	NextHops []StaticRouteNextHop
+	// Distance is the administrative distance of the route. If zero, the default of the provider is used.
+	Distance int32
+	// Tag is the tag of the route, which can be matched by routing policies.
+	Tag uint32
+}
+
+// StaticRouteNextHop represents a next-hop of a static route.
+type StaticRouteNextHop struct {
+	// Address is the IP address of the next-hop. It must be of the same address family as the prefix of the route.
+	Address netip.Addr
+	// Interface is the name of the outgoing interface of the next-hop.
+	Interface string
+	// Discard drops all traffic matching the route, i.e. a route to Null0.
+	// It is mutually exclusive with Address and Interface.
+	Discard bool
+}
+
+// RouteLookupProvider is the interface for looking up the routes installed in the routing tables of a device.
+type RouteLookupProvider interface {
+	Provider
+
+	// LookupRoute retrieves the entry of the routing table of the VRF for exactly the given prefix.
+	// If the VRF is empty, the default VRF is used. Implementations must not modify the configuration of the device.
+	LookupRoute(ctx context.Context, vrf string, prefix netip.Prefix) (*RouteEntry, error)
+}
+
+// RouteEntry is an entry of the routing table of a VRF.
+type RouteEntry struct {
+	// Prefix is the destination prefix of the route.
+	Prefix netip.Prefix `json:"prefix"`
+	// VRF is the name of the VRF of the routing table.
+	VRF string `json:"vrf"`
+	// NextHops are the next-hops the prefix resolves to.
+	NextHops []RouteEntryNextHop `json:"nextHops,omitempty"`
+}
+
+// RouteEntryNextHop is a next-hop of an entry of the routing table.
+type RouteEntryNextHop struct {
+	// Address is the IP address of the next-hop, if any.
+	Address netip.Addr `json:"address,omitzero"`
+	// Interface is the name of the outgoing interface of the next-hop, if any.
+	Interface string `json:"interface,omitempty"`
+	// VRF is the name of the VRF the next-hop is resolved in, if different from the VRF of the route.
+	VRF string `json:"vrf,omitempty"`
+	// Protocol is the routing protocol that installed the next-hop, e.g. "bgp" or "static".
+	Protocol string `json:"protocol"`
+	// Distance is the administrative distance of the next-hop.
+	Distance uint32 `json:"distance"`
+	// Metric is the metric of the next-hop.
+	Metric uint32 `json:"metric"`
+}
+
+// SpanningTreeProvider is the interface for configuring the spanning tree protocol of a device.
+type SpanningTreeProvider interface {
+	Provider
+
+	// EnsureSpanningTree call is responsible for the realization of the global spanning tree settings on the provider.
+	EnsureSpanningTree(context.Context, *SpanningTreeRequest) error
+}
+
+// SpanningTreeMode is the spanning tree protocol variant run by a device.
+type SpanningTreeMode string
+
+const (
+	// SpanningTreeModeRSTP runs a rapid spanning tree per VLAN.
+	SpanningTreeModeRSTP SpanningTreeMode = "RSTP"
+	// SpanningTreeModeMST runs the multiple spanning tree protocol.
+	SpanningTreeModeMST SpanningTreeMode = "MST"
+)
+
+// SpanningTreeRequest is the request for configuring the spanning tree protocol on the provider.
+type SpanningTreeRequest struct {
+	// Mode is the spanning tree protocol variant.
+	Mode SpanningTreeMode
+	// Priorities are the bridge priorities of the spanning trees. With [SpanningTreeModeRSTP],
+	// they are configured per VLAN, and with [SpanningTreeModeMST] per instance.
+	Priorities []SpanningTreePriority
+	// BPDUGuard enables BPDU guard on all edge ports by default.
+	BPDUGuard bool
+	// RootGuard enables root guard on all ports by default.
+	RootGuard bool
+}
+
+// SpanningTreePriority is the bridge priority of a single spanning tree.
+type SpanningTreePriority struct {
+	// ID is the VLAN ID or the MST instance ID of the spanning tree, depending on the mode.
+	ID int32
+	// Priority is the bridge priority. It must be a multiple of 4096 between 0 and 61440.
+	Priority int32
+}
+
 var mu sync.RWMutex
 
 // ProviderFunc returns a new [Provider] instance.
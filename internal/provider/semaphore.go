@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import "sync"
+
+var (
+	semMu sync.Mutex
+	// sems holds the semaphores of all devices, keyed by the endpoint address of the device.
+	sems = make(map[string]chan struct{})
+	// maxConcurrentRequestsPerDevice is the capacity of the semaphores. Zero means no limit.
+	maxConcurrentRequestsPerDevice int
+)
+
+// SetMaxConcurrentRequestsPerDevice sets the maximum number of requests that all providers combined
+// send concurrently to a single device, so that reconciling many resources of the same device at once
+// does not overwhelm the management endpoint of the device. A value of zero or less disables the limit.
+// It must be called before any provider connects to a device.
+func SetMaxConcurrentRequestsPerDevice(n int) {
+	semMu.Lock()
+	defer semMu.Unlock()
+	maxConcurrentRequestsPerDevice = max(n, 0)
+	clear(sems)
+}
+
+// DeviceSemaphore returns the semaphore that bounds the number of concurrent requests to the device
+// with the given endpoint address. Providers acquire the semaphore by sending to the channel and
+// release it by receiving from it. It returns nil if the number of requests is not limited.
+func DeviceSemaphore(address string) chan struct{} {
+	semMu.Lock()
+	defer semMu.Unlock()
+	if maxConcurrentRequestsPerDevice == 0 {
+		return nil
+	}
+	sem, ok := sems[address]
+	if !ok {
+		sem = make(chan struct{}, maxConcurrentRequestsPerDevice)
+		sems[address] = sem
+	}
+	return sem
+}
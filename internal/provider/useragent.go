@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"sync"
+
+	"github.com/ironcore-dev/network-operator/internal/transport/grpcext"
+)
+
+var (
+	versionMu sync.Mutex
+	// version is the version of the operator. Empty means unknown.
+	version string
+)
+
+// SetVersion sets the version of the operator, by which providers identify the operator in the
+// device-side session logs, see [UserAgent] and [Metadata]. It must be called before any provider
+// connects to a device.
+func SetVersion(v string) {
+	versionMu.Lock()
+	defer versionMu.Unlock()
+	version = v
+}
+
+// UserAgent returns the user-agent providers send with their gRPC requests, which is
+// [grpcext.DefaultUserAgent] followed by the version of the operator, if known.
+func UserAgent() string {
+	versionMu.Lock()
+	defer versionMu.Unlock()
+	if version == "" {
+		return grpcext.DefaultUserAgent
+	}
+	return grpcext.DefaultUserAgent + "/" + version
+}
+
+// Metadata returns the key-value pairs providers attach as metadata to their gNMI requests.
+func Metadata() []string {
+	versionMu.Lock()
+	defer versionMu.Unlock()
+	if version == "" {
+		return nil
+	}
+	return []string{"x-operator-version", version}
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ratelimit implements the rate limiter used by the controllers to requeue failed reconciliations.
+package ratelimit
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Backoff defines the exponential backoff between two attempts to reconcile an item that failed to reconcile.
+type Backoff struct {
+	// BaseDelay is the delay before the first retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two retries.
+	MaxDelay time.Duration
+	// Multiplier is the factor the delay is multiplied with after each failed retry.
+	Multiplier float64
+}
+
+// DefaultBackoff is the per-item backoff of the controller-runtime default rate limiter.
+var DefaultBackoff = Backoff{
+	BaseDelay:  5 * time.Millisecond,
+	MaxDelay:   1000 * time.Second,
+	Multiplier: 2,
+}
+
+// Validate returns an error if the backoff parameters are invalid.
+func (b Backoff) Validate() error {
+	if b.BaseDelay <= 0 {
+		return errors.New("backoff base delay must be positive")
+	}
+	if b.MaxDelay < b.BaseDelay {
+		return errors.New("backoff max delay must not be less than the base delay")
+	}
+	if b.Multiplier < 1 || math.IsInf(b.Multiplier, 0) || math.IsNaN(b.Multiplier) {
+		return errors.New("backoff multiplier must be a finite number of at least 1")
+	}
+	return nil
+}
+
+// New returns the rate limiter used by the controllers, which delays each item by the larger of
+// its exponential backoff and the delay imposed by an overall token bucket of 10 qps with a burst
+// of 100, just like the controller-runtime default rate limiter. The bucket caps the retries across
+// all items, e.g. if many resources fail at once because their device is unreachable.
+func New[T comparable](b Backoff) workqueue.TypedRateLimiter[T] {
+	return workqueue.NewTypedMaxOfRateLimiter(
+		NewExponentialRateLimiter[T](b),
+		&workqueue.TypedBucketRateLimiter[T]{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+}
+
+var _ workqueue.TypedRateLimiter[string] = (*ExponentialRateLimiter[string])(nil)
+
+// ExponentialRateLimiter is a [workqueue.TypedRateLimiter] that delays each item by the
+// [Backoff.BaseDelay] multiplied by [Backoff.Multiplier] to the power of the number of
+// previous failures of the item, capped at [Backoff.MaxDelay].
+type ExponentialRateLimiter[T comparable] struct {
+	backoff Backoff
+
+	mu       sync.Mutex
+	failures map[T]int
+}
+
+// NewExponentialRateLimiter returns a new [ExponentialRateLimiter] with the given backoff.
+// Each controller must use its own rate limiter, as the failures are tracked per item.
+func NewExponentialRateLimiter[T comparable](b Backoff) *ExponentialRateLimiter[T] {
+	return &ExponentialRateLimiter[T]{
+		backoff:  b,
+		failures: make(map[T]int),
+	}
+}
+
+// When returns the delay before the item should be processed again and records another failure of the item.
+func (r *ExponentialRateLimiter[T]) When(item T) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := r.failures[item]
+	r.failures[item]++
+
+	d := float64(r.backoff.BaseDelay) * math.Pow(r.backoff.Multiplier, float64(n))
+	if d > float64(r.backoff.MaxDelay) {
+		return r.backoff.MaxDelay
+	}
+	return time.Duration(d)
+}
+
+// Forget resets the failures of the item, e.g. after it was reconciled successfully.
+func (r *ExponentialRateLimiter[T]) Forget(item T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.failures, item)
+}
+
+// NumRequeues returns the number of failures of the item since it was last forgotten.
+func (r *ExponentialRateLimiter[T]) NumRequeues(item T) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failures[item]
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestExponentialRateLimiter(t *testing.T) {
+	r := NewExponentialRateLimiter[string](Backoff{
+		BaseDelay:  time.Second,
+		MaxDelay:   10 * time.Second,
+		Multiplier: 3,
+	})
+
+	want := []time.Duration{time.Second, 3 * time.Second, 9 * time.Second, 10 * time.Second, 10 * time.Second}
+	for i, w := range want {
+		if got := r.When("a"); got != w {
+			t.Errorf("When() #%d = %v, want %v", i, got, w)
+		}
+	}
+	if got := r.NumRequeues("a"); got != len(want) {
+		t.Errorf("NumRequeues() = %d, want %d", got, len(want))
+	}
+
+	// Items are tracked independently.
+	if got := r.When("b"); got != time.Second {
+		t.Errorf("When() of other item = %v, want %v", got, time.Second)
+	}
+
+	r.Forget("a")
+	if got := r.NumRequeues("a"); got != 0 {
+		t.Errorf("NumRequeues() after Forget() = %d, want 0", got)
+	}
+	if got := r.When("a"); got != time.Second {
+		t.Errorf("When() after Forget() = %v, want %v", got, time.Second)
+	}
+}
+
+func TestExponentialRateLimiter_Overflow(t *testing.T) {
+	r := NewExponentialRateLimiter[string](DefaultBackoff)
+	for range 1000 {
+		if got := r.When("a"); got < 0 || got > DefaultBackoff.MaxDelay {
+			t.Fatalf("When() = %v, want at most %v", got, DefaultBackoff.MaxDelay)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	r := New[string](DefaultBackoff)
+
+	// The first 100 items are within the burst of the bucket and only delayed by their backoff.
+	for i := range 100 {
+		if got := r.When(strconv.Itoa(i)); got != DefaultBackoff.BaseDelay {
+			t.Fatalf("When() #%d = %v, want %v", i, got, DefaultBackoff.BaseDelay)
+		}
+	}
+	// Further items are delayed by the bucket, even though they have not failed before.
+	if got := r.When("a"); got <= DefaultBackoff.BaseDelay {
+		t.Errorf("When() beyond burst = %v, want more than %v", got, DefaultBackoff.BaseDelay)
+	}
+}
+
+func TestBackoff_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		backoff Backoff
+		wantErr bool
+	}{
+		{name: "default", backoff: DefaultBackoff},
+		{name: "constant", backoff: Backoff{BaseDelay: time.Second, MaxDelay: time.Second, Multiplier: 1}},
+		{name: "zero base delay", backoff: Backoff{MaxDelay: time.Second, Multiplier: 2}, wantErr: true},
+		{name: "max below base", backoff: Backoff{BaseDelay: time.Minute, MaxDelay: time.Second, Multiplier: 2}, wantErr: true},
+		{name: "shrinking", backoff: Backoff{BaseDelay: time.Second, MaxDelay: time.Minute, Multiplier: 0.5}, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.backoff.Validate(); (err != nil) != test.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -31,22 +31,48 @@ type ResourceLocker struct {
 	leaseDurationSeconds int32
 	renewPeriod          time.Duration
 	namespace            string
+	identity             string
 	cancelFuncs          sync.Map // map[string]context.CancelFunc
 }
 
+// Option configures optional settings of a ResourceLocker.
+type Option func(*ResourceLocker)
+
+// WithIdentity sets the identity of the process using the ResourceLocker, e.g. the name of the pod.
+// The identity is combined with the locker IDs passed to AcquireLock and ReleaseLock to form the
+// holder identity of a lease. This ensures that two instances of the operator, which use the same
+// locker IDs, never both consider a lease their own, e.g. while a failover is in progress.
+func WithIdentity(identity string) Option {
+	return func(rl *ResourceLocker) {
+		rl.identity = identity
+	}
+}
+
 // NewResourceLocker creates a new ResourceLocker with the given configuration.
 // The namespace specifies where Lease resources will be created.
 // The renewPeriod must be shorter than leaseDuration to ensure the lease is renewed before expiration.
-func NewResourceLocker(c client.Client, namespace string, leaseDuration, renewPeriod time.Duration) (*ResourceLocker, error) {
+func NewResourceLocker(c client.Client, namespace string, leaseDuration, renewPeriod time.Duration, opts ...Option) (*ResourceLocker, error) {
 	if renewPeriod >= leaseDuration {
 		return nil, fmt.Errorf("resourcelock: renewPeriod (%v) must be shorter than leaseDuration (%v)", renewPeriod, leaseDuration)
 	}
-	return &ResourceLocker{
+	rl := &ResourceLocker{
 		client:               c,
 		leaseDurationSeconds: int32(leaseDuration.Seconds()),
 		renewPeriod:          renewPeriod,
 		namespace:            namespace,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(rl)
+	}
+	return rl, nil
+}
+
+// holderIdentity returns the holder identity of a lease acquired by the given locker.
+func (rl *ResourceLocker) holderIdentity(lockerID string) string {
+	if rl.identity == "" {
+		return lockerID
+	}
+	return lockerID + "_" + rl.identity
 }
 
 // AcquireLock tries to acquire a lock on the specified Kubernetes Lease.
@@ -58,6 +84,7 @@ func NewResourceLocker(c client.Client, namespace string, leaseDuration, renewPe
 // or currently holds the lock. Returns ErrLockAlreadyHeld if the lock is currently held
 // by another locker.
 func (rl *ResourceLocker) AcquireLock(ctx context.Context, name, lockerID string) error {
+	lockerID = rl.holderIdentity(lockerID)
 	log := ctrl.LoggerFrom(ctx).WithValues("namespace", rl.namespace, "lease", name, "locker", lockerID)
 
 	now := metav1.NewMicroTime(time.Now())
@@ -139,6 +166,7 @@ func (rl *ResourceLocker) AcquireLock(ctx context.Context, name, lockerID string
 // unique identifier of the lock holder attempting to release the lock. If the current holder
 // does not match the provided lockerID, the lease is not deleted.
 func (rl *ResourceLocker) ReleaseLock(ctx context.Context, name, lockerID string) error {
+	lockerID = rl.holderIdentity(lockerID)
 	log := ctrl.LoggerFrom(ctx).WithValues("namespace", rl.namespace, "lease", name, "locker", lockerID)
 
 	lease := &coordinationv1.Lease{}
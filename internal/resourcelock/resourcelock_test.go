@@ -236,6 +236,45 @@ func TestAcquireLock_ClaimExpired(t *testing.T) {
 	}
 }
 
+func TestAcquireLock_HeldByAnotherIdentity(t *testing.T) {
+	lockerID := "locker-1"
+	leaseName := "test-lease"
+
+	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+	rl1, err := NewResourceLocker(client, metav1.NamespaceDefault, 15*time.Second, 5*time.Second, WithIdentity("instance-1"))
+	if err != nil {
+		t.Fatalf("NewResourceLocker() error = %v", err)
+	}
+	rl2, err := NewResourceLocker(client, metav1.NamespaceDefault, 15*time.Second, 5*time.Second, WithIdentity("instance-2"))
+	if err != nil {
+		t.Fatalf("NewResourceLocker() error = %v", err)
+	}
+
+	ctx := t.Context()
+	if err := rl1.AcquireLock(ctx, leaseName, lockerID); err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+
+	if err := rl2.AcquireLock(ctx, leaseName, lockerID); !errors.Is(err, ErrLockAlreadyHeld) {
+		t.Errorf("AcquireLock() error = %v, want %v", err, ErrLockAlreadyHeld)
+	}
+
+	if err := rl2.ReleaseLock(ctx, leaseName, lockerID); err != nil {
+		t.Errorf("ReleaseLock() error = %v, expected success (noop) when held by another identity", err)
+	}
+
+	lease := &coordinationv1.Lease{}
+	key := types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: leaseName}
+	if err := client.Get(ctx, key, lease); err != nil {
+		t.Fatalf("Get lease error = %v", err)
+	}
+
+	if want := lockerID + "_instance-1"; lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != want {
+		t.Errorf("Lease holder = %v, want %v", lease.Spec.HolderIdentity, want)
+	}
+}
+
 func TestReleaseLock(t *testing.T) {
 	lockerID := "locker-1"
 	leaseName := "test-lease"
@@ -11,14 +11,17 @@
 	"log/slog"
 	"reflect"
 	"strings"
+	"time"
 
 	cp "github.com/felix-kaestner/copy"
 	"github.com/go-logr/logr"
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/openconfig/ygot/ygot"
 	"github.com/tidwall/gjson"
+	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -39,6 +42,13 @@ type Defaultable interface {
 	Default()
 }
 
+// Sensitive represents a configuration item that contains confidential values,
+// e.g. passwords or shared keys. The payload of such items is never logged.
+type Sensitive interface {
+	// IsSensitive is a marker method.
+	IsSensitive()
+}
+
 // Marshaler provides device-specific marshaling based on capabilities.
 type Marshaler interface {
 	// MarshalYANG serializes the receiver using device capabilities.
@@ -63,11 +73,14 @@ type Capabilities struct {
 
 type Client interface {
 	Capabilities() *Capabilities
+	Ping(context.Context) error
 	GetConfig(context.Context, ...DataElement) error
 	GetState(context.Context, ...DataElement) error
 	Patch(context.Context, ...DataElement) error
 	Update(context.Context, ...DataElement) error
 	Delete(context.Context, ...DataElement) error
+	Subscribe(context.Context, []string, SubscribeMode) (<-chan Notification, error)
+	Transaction(context.Context, func(Client) error) error
 }
 
 // Client is a gNMI client offering convenience methods for device configuration
@@ -77,6 +90,26 @@ type client struct {
 	encoding     gpb.Encoding
 	capabilities *Capabilities
 	logger       logr.Logger
+	md           metadata.MD
+
+	// err is the first error of the options passed to [New], which is returned by it.
+	err error
+
+	// maxPathsPerRequest is the maximum number of paths sent in a single Set RPC.
+	// A value of zero or less means that the number of paths is not limited.
+	maxPathsPerRequest int
+
+	// sem bounds the number of concurrent Get and Set RPCs. It may be shared with other clients.
+	// A nil semaphore means that the number of concurrent RPCs is not limited.
+	sem chan struct{}
+
+	// limiter spaces out the Set RPCs of the client. It may be shared with other clients.
+	// A nil limiter means that the rate of Set RPCs is not limited.
+	limiter *rate.Limiter
+
+	// preferredEncoding is the encoding chosen if the server supports multiple encodings.
+	// A nil value means that the last supported encoding announced by the server is chosen.
+	preferredEncoding *gpb.Encoding
 }
 
 var _ Client = &client{}
@@ -87,8 +120,17 @@ type client struct {
 // By default, the client uses [slog.Default] for logging.
 // Use [WithLogger] to provide a custom logger.
 func New(ctx context.Context, conn grpc.ClientConnInterface, opts ...Option) (Client, error) {
-	gnmi := gpb.NewGNMIClient(conn)
-	res, err := gnmi.Capabilities(ctx, &gpb.CapabilityRequest{})
+	c := &client{
+		gnmi:   gpb.NewGNMIClient(conn),
+		logger: logr.FromSlogHandler(slog.Default().Handler()),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	res, err := c.gnmi.Capabilities(c.outgoing(ctx), &gpb.CapabilityRequest{})
 	if err != nil {
 		return nil, fmt.Errorf("gnmiext: failed to retrieve capabilities: %w", err)
 	}
@@ -96,6 +138,9 @@ func New(ctx context.Context, conn grpc.ClientConnInterface, opts ...Option) (Cl
 	for _, e := range res.GetSupportedEncodings() {
 		switch e {
 		case gpb.Encoding_JSON, gpb.Encoding_JSON_IETF:
+			if c.preferredEncoding != nil && encoding == *c.preferredEncoding {
+				continue
+			}
 			encoding = e
 		default:
 			// Ignore unsupported encodings.
@@ -112,11 +157,8 @@ func New(ctx context.Context, conn grpc.ClientConnInterface, opts ...Option) (Cl
 			Version:      model.GetVersion(),
 		}
 	}
-	logger := logr.FromSlogHandler(slog.Default().Handler())
-	c := &client{gnmi, encoding, capabilities, logger}
-	for _, opt := range opts {
-		opt(c)
-	}
+	c.encoding = encoding
+	c.capabilities = capabilities
 	return c, nil
 }
 
@@ -129,6 +171,113 @@ func WithLogger(logger logr.Logger) Option {
 	}
 }
 
+// WithMetadata attaches the given key-value pairs as metadata to all outbound requests
+// of the client, e.g. to attribute changes to the operator in the device's session logs.
+// The number of arguments must be even; keys are converted to lowercase.
+func WithMetadata(kv ...string) Option {
+	return func(c *client) {
+		if len(kv)%2 == 1 {
+			if c.err == nil {
+				c.err = fmt.Errorf("gnmiext: odd number of metadata arguments: %d", len(kv))
+			}
+			return
+		}
+		c.md = metadata.Join(c.md, metadata.Pairs(kv...))
+	}
+}
+
+// WithMaxPathsPerRequest limits the number of paths sent in a single Set RPC to n, e.g. for devices
+// that reject requests exceeding a per-RPC path limit. Larger requests are split into multiple Set RPCs,
+// which are no longer applied atomically by the device. A value of zero or less disables the limit.
+func WithMaxPathsPerRequest(n int) Option {
+	return func(c *client) {
+		c.maxPathsPerRequest = n
+	}
+}
+
+// WithSemaphore bounds the number of concurrent Get and Set RPCs of the client by the capacity of sem.
+// The semaphore may be shared by multiple clients, e.g. to bound the concurrent RPCs of all clients
+// connected to the same device. A nil semaphore disables the limit.
+func WithSemaphore(sem chan struct{}) Option {
+	return func(c *client) {
+		c.sem = sem
+	}
+}
+
+// WithSetLimiter spaces out the Set RPCs of the client as allowed by lim, e.g. to enforce a minimum
+// interval between configuration changes of a device with a weak management CPU. Set RPCs that are
+// not yet allowed wait for their turn in the order they were requested. The limiter may be shared
+// by multiple clients, e.g. to limit the changes of all clients connected to the same device.
+// A nil limiter disables the limit.
+func WithSetLimiter(lim *rate.Limiter) Option {
+	return func(c *client) {
+		c.limiter = lim
+	}
+}
+
+// WithPreferredEncoding sets the encoding to use if the server supports both [gpb.Encoding_JSON] and
+// [gpb.Encoding_JSON_IETF], e.g. for devices that handle some subtrees better in one of them.
+// If the server does not support the preferred encoding, the client falls back to the other one.
+func WithPreferredEncoding(e gpb.Encoding) Option {
+	return func(c *client) {
+		c.preferredEncoding = &e
+	}
+}
+
+// acquire blocks until the client may perform an RPC, as bounded by its semaphore, or the context is done.
+// The returned function must be called to release the semaphore once the RPC has completed.
+func (c *client) acquire(ctx context.Context) (release func(), err error) {
+	if c.sem == nil {
+		return func() {}, nil
+	}
+	select {
+	case c.sem <- struct{}{}:
+		return func() { <-c.sem }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("gnmiext: failed to acquire semaphore: %w", ctx.Err())
+	}
+}
+
+// wait blocks until the client may perform a Set RPC, as allowed by its limiter, or the context is done.
+func (c *client) wait(ctx context.Context) error {
+	if c.limiter == nil {
+		return nil
+	}
+	if err := c.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("gnmiext: failed to wait for set rate limit: %w", err)
+	}
+	return nil
+}
+
+// outgoing returns a context carrying the configured metadata for outbound requests.
+func (c *client) outgoing(ctx context.Context) context.Context {
+	if len(c.md) == 0 {
+		return ctx
+	}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		return metadata.NewOutgoingContext(ctx, metadata.Join(md, c.md))
+	}
+	return metadata.NewOutgoingContext(ctx, c.md)
+}
+
+type timeoutKey struct{}
+
+// WithTimeout returns a copy of ctx that bounds each call of a [Client] method made with it by the
+// given timeout. For [Client.Update] and [Client.Patch], this includes the retrieval of the current
+// configuration. The timeout takes precedence over the default timeout of the underlying connection,
+// e.g. to allow more time for expensive operations on large configurations.
+func WithTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutKey{}, timeout)
+}
+
+// withTimeout applies the timeout set by [WithTimeout], if any, to the context.
+func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if timeout, ok := ctx.Value(timeoutKey{}).(time.Duration); ok && timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return ctx, func() {}
+}
+
 // ErrNil indicates that the value for a xpath is not defined.
 var ErrNil = errors.New("gnmiext: nil")
 
@@ -137,6 +286,17 @@ func (c *client) Capabilities() *Capabilities {
 	return c.capabilities
 }
 
+// Ping checks whether the gNMI server is reachable and responsive by carrying out a Capabilities RPC,
+// which is cheap compared to retrieving any configuration or state. The response is discarded.
+func (c *client) Ping(ctx context.Context) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+	if _, err := c.gnmi.Capabilities(c.outgoing(ctx), &gpb.CapabilityRequest{}); err != nil {
+		return fmt.Errorf("gnmiext: ping failed: %w", err)
+	}
+	return nil
+}
+
 // GetConfig retrieves config and unmarshals it into the provided targets.
 // If some of the values for the given xpaths are not defined, [ErrNil] is returned.
 func (c *client) GetConfig(ctx context.Context, el ...DataElement) error {
@@ -170,7 +330,85 @@ func (c *client) Delete(ctx context.Context, el ...DataElement) error {
 	if len(el) == 0 {
 		return nil
 	}
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
 	r := new(gpb.SetRequest)
+	if err := c.appendDelete(r, el...); err != nil {
+		return err
+	}
+	return c.send(ctx, r)
+}
+
+// Transaction calls fn with a client that records the configuration changes made through it
+// instead of applying them. Once fn returns, the recorded changes are applied in a single Set RPC,
+// which the target applies atomically as per the [gNMI spec]: either all changes are applied or none.
+// If fn returns an error, no changes are applied. Reads made through the client passed to fn are
+// sent to the target right away and don't observe the recorded changes.
+//
+// As the target processes the deletes of a Set RPC before its replaces and updates, an item must
+// not be deleted after it has been updated within the same transaction. The Set RPC of a transaction
+// is never split, regardless of [WithMaxPathsPerRequest], as that would void its atomicity.
+// Transactions started on the client passed to fn join the enclosing transaction.
+//
+// [gNMI spec]: https://github.com/openconfig/reference/blob/master/rpc/gnmi/gnmi-specification.md#34-modifying-state
+func (c *client) Transaction(ctx context.Context, fn func(Client) error) error {
+	t := &transaction{client: c, r: new(gpb.SetRequest)}
+	if err := fn(t); err != nil {
+		return err
+	}
+	if len(t.r.GetDelete())+len(t.r.GetReplace())+len(t.r.GetUpdate()) == 0 {
+		// All configurations are already up-to-date.
+		return nil
+	}
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+	if err := c.wait(ctx); err != nil {
+		return err
+	}
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	c.logger.V(1).Info("Committing transaction", "deletes", len(t.r.GetDelete()), "replaces", len(t.r.GetReplace()), "updates", len(t.r.GetUpdate()))
+	if _, err := c.gnmi.Set(c.outgoing(ctx), t.r); err != nil {
+		return fmt.Errorf("gnmiext: failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// transaction is the [Client] passed to the function of [Client.Transaction]. It records
+// the configuration changes in a single Set request instead of sending them to the target.
+type transaction struct {
+	*client
+	r *gpb.SetRequest
+}
+
+var _ Client = (*transaction)(nil)
+
+func (t *transaction) Update(ctx context.Context, el ...DataElement) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+	return t.appendSet(ctx, t.r, false, el...)
+}
+
+func (t *transaction) Patch(ctx context.Context, el ...DataElement) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+	return t.appendSet(ctx, t.r, true, el...)
+}
+
+func (t *transaction) Delete(_ context.Context, el ...DataElement) error {
+	return t.appendDelete(t.r, el...)
+}
+
+func (t *transaction) Transaction(_ context.Context, fn func(Client) error) error {
+	return fn(t)
+}
+
+// appendDelete adds the deletion of the given items to the request. Items implementing
+// [Defaultable] are reset to their default value by a replace instead.
+func (c *client) appendDelete(r *gpb.SetRequest, el ...DataElement) error {
 	for _, e := range el {
 		path, err := StringToStructuredPath(e.XPath())
 		if err != nil {
@@ -182,7 +420,7 @@ func (c *client) Delete(ctx context.Context, el ...DataElement) error {
 			if err != nil {
 				return err
 			}
-			c.logger.V(1).Info("Resetting to default", "path", e.XPath(), "payload", string(b))
+			c.logger.V(1).Info("Resetting to default", "path", e.XPath(), "payload", payload(e, b))
 			r.Replace = append(r.Replace, &gpb.Update{
 				Path: path,
 				Val:  c.Encode(b),
@@ -192,9 +430,6 @@ func (c *client) Delete(ctx context.Context, el ...DataElement) error {
 		c.logger.V(1).Info("Deleting", "path", e.XPath())
 		r.Delete = append(r.Delete, path)
 	}
-	if _, err := c.gnmi.Set(ctx, r); err != nil {
-		return fmt.Errorf("gnmiext: failed to perform set rpc: %w", err)
-	}
 	return nil
 }
 
@@ -205,6 +440,8 @@ func (c *client) get(ctx context.Context, dt gpb.GetRequest_DataType, el ...Data
 	if len(el) == 0 {
 		return nil
 	}
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
 	r := &gpb.GetRequest{
 		Type:     dt,
 		Encoding: c.encoding,
@@ -216,7 +453,12 @@ func (c *client) get(ctx context.Context, dt gpb.GetRequest_DataType, el ...Data
 		}
 		r.Path = append(r.Path, path)
 	}
-	res, err := c.gnmi.Get(ctx, r)
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	res, err := c.gnmi.Get(c.outgoing(ctx), r)
+	release()
 	if err != nil {
 		return fmt.Errorf("gnmiext: failed to perform get rpc: %w", err)
 	}
@@ -276,7 +518,23 @@ func (c *client) set(ctx context.Context, patch bool, el ...DataElement) error {
 	if len(el) == 0 {
 		return nil
 	}
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
 	r := new(gpb.SetRequest)
+	if err := c.appendSet(ctx, r, patch, el...); err != nil {
+		return err
+	}
+	if len(r.GetUpdate()) == 0 && len(r.GetReplace()) == 0 {
+		// All configurations are already up-to-date.
+		return nil
+	}
+	return c.send(ctx, r)
+}
+
+// appendSet adds the given items to the updates of the request if patch is true,
+// or to its replaces otherwise. Items whose current configuration equals the
+// desired configuration are skipped.
+func (c *client) appendSet(ctx context.Context, r *gpb.SetRequest, patch bool, el ...DataElement) error {
 	for _, e := range el {
 		path, err := StringToStructuredPath(e.XPath())
 		if err != nil {
@@ -297,7 +555,7 @@ func (c *client) set(ctx context.Context, patch bool, el ...DataElement) error {
 		if err != nil {
 			return err
 		}
-		c.logger.V(1).Info("Updating", "path", e.XPath(), "payload", string(b), "patch", patch)
+		c.logger.V(1).Info("Updating", "path", e.XPath(), "payload", payload(e, b), "patch", patch)
 		u := &gpb.Update{
 			Path: path,
 			Val:  c.Encode(b),
@@ -308,16 +566,80 @@ func (c *client) set(ctx context.Context, patch bool, el ...DataElement) error {
 		}
 		r.Replace = append(r.Replace, u)
 	}
-	if len(r.GetUpdate()) == 0 && len(r.GetReplace()) == 0 {
-		// All configurations are already up-to-date.
-		return nil
-	}
-	if _, err := c.gnmi.Set(ctx, r); err != nil {
-		return fmt.Errorf("gnmiext: failed to perform set rpc: %w", err)
+	return nil
+}
+
+// send performs the Set RPC for the given request. If the number of paths exceeds the
+// configured maximum, the request is split into multiple Set RPCs, see [chunk]. Each Set RPC
+// waits for the limiter of the client, see [WithSetLimiter], before it acquires the semaphore.
+func (c *client) send(ctx context.Context, r *gpb.SetRequest) error {
+	reqs := chunk(r, c.maxPathsPerRequest)
+	for i, req := range reqs {
+		if err := c.wait(ctx); err != nil {
+			return err
+		}
+		if len(reqs) > 1 {
+			c.logger.V(2).Info("Sending chunked set request", "chunk", i+1, "chunks", len(reqs))
+		}
+		release, err := c.acquire(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = c.gnmi.Set(c.outgoing(ctx), req)
+		release()
+		if err != nil {
+			return fmt.Errorf("gnmiext: failed to perform set rpc: %w", err)
+		}
 	}
 	return nil
 }
 
+// chunk splits the request into requests with at most n paths each. As the target processes
+// the deletes of a request before its replaces and updates, the paths are distributed in the
+// same order, so that the chunks sent one after another have the same effect as the original
+// request. The request is returned as is if n is zero or less or it doesn't exceed n paths.
+func chunk(r *gpb.SetRequest, n int) []*gpb.SetRequest {
+	if n <= 0 || len(r.GetDelete())+len(r.GetReplace())+len(r.GetUpdate()) <= n {
+		return []*gpb.SetRequest{r}
+	}
+	var (
+		reqs []*gpb.SetRequest
+		cur  *gpb.SetRequest
+		size int
+	)
+	// next returns the request the next path is added to, starting a new one once the current one is full.
+	next := func() *gpb.SetRequest {
+		if cur == nil || size == n {
+			cur = &gpb.SetRequest{Prefix: r.GetPrefix()}
+			reqs = append(reqs, cur)
+			size = 0
+		}
+		size++
+		return cur
+	}
+	for _, p := range r.GetDelete() {
+		req := next()
+		req.Delete = append(req.Delete, p)
+	}
+	for _, u := range r.GetReplace() {
+		req := next()
+		req.Replace = append(req.Replace, u)
+	}
+	for _, u := range r.GetUpdate() {
+		req := next()
+		req.Update = append(req.Update, u)
+	}
+	return reqs
+}
+
+// payload returns the marshaled data element b in a form that is safe to log.
+func payload(e DataElement, b []byte) string {
+	if _, ok := e.(Sensitive); ok {
+		return "<redacted>"
+	}
+	return string(b)
+}
+
 // Marshal marshals the provided value into a byte slice using the client's encoding.
 // If the value implements the [Marshaler] interface, it will be marshaled using that.
 // Otherwise, [json.Marshal] is used.
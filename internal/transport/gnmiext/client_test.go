@@ -11,12 +11,17 @@
 	"fmt"
 	"reflect"
 	"slices"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr/funcr"
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
@@ -102,6 +107,441 @@ func TestClient_New(t *testing.T) {
 	}
 }
 
+func TestClient_WithPreferredEncoding(t *testing.T) {
+	both := []gpb.Encoding{gpb.Encoding_JSON, gpb.Encoding_JSON_IETF}
+
+	tests := []struct {
+		name      string
+		supported []gpb.Encoding
+		opts      []Option
+		want      gpb.Encoding
+	}{
+		{
+			name:      "no preference",
+			supported: both,
+			want:      gpb.Encoding_JSON_IETF,
+		},
+		{
+			name:      "prefer JSON",
+			supported: both,
+			opts:      []Option{WithPreferredEncoding(gpb.Encoding_JSON)},
+			want:      gpb.Encoding_JSON,
+		},
+		{
+			name:      "prefer JSON_IETF",
+			supported: []gpb.Encoding{gpb.Encoding_JSON_IETF, gpb.Encoding_JSON},
+			opts:      []Option{WithPreferredEncoding(gpb.Encoding_JSON_IETF)},
+			want:      gpb.Encoding_JSON_IETF,
+		},
+		{
+			name:      "preferred encoding not supported by server",
+			supported: []gpb.Encoding{gpb.Encoding_JSON},
+			opts:      []Option{WithPreferredEncoding(gpb.Encoding_JSON_IETF)},
+			want:      gpb.Encoding_JSON,
+		},
+		{
+			name:      "preferred encoding not supported by client",
+			supported: []gpb.Encoding{gpb.Encoding_PROTO, gpb.Encoding_JSON},
+			opts:      []Option{WithPreferredEncoding(gpb.Encoding_PROTO)},
+			want:      gpb.Encoding_JSON,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conn := &MockClientConn{
+				CapabilitiesFunc: func(ctx context.Context, req *gpb.CapabilityRequest) (*gpb.CapabilityResponse, error) {
+					return &gpb.CapabilityResponse{SupportedEncodings: test.supported}, nil
+				},
+			}
+			got, err := New(t.Context(), conn, test.opts...)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			if enc := got.(*client).encoding; enc != test.want {
+				t.Errorf("New() encoding = %v, want %v", enc, test.want)
+			}
+		})
+	}
+}
+
+func TestClient_WithMetadata(t *testing.T) {
+	var got []metadata.MD
+	record := func(ctx context.Context) {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		got = append(got, md)
+	}
+	conn := &MockClientConn{
+		CapabilitiesFunc: func(ctx context.Context, req *gpb.CapabilityRequest) (*gpb.CapabilityResponse, error) {
+			record(ctx)
+			return &gpb.CapabilityResponse{SupportedEncodings: []gpb.Encoding{gpb.Encoding_JSON}}, nil
+		},
+		SetFunc: func(ctx context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+			record(ctx)
+			return &gpb.SetResponse{}, nil
+		},
+	}
+
+	c, err := New(t.Context(), conn, WithMetadata("x-operator-version", "v1.0.0"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx := metadata.AppendToOutgoingContext(t.Context(), "x-request", "default/leaf1")
+	if err := c.Delete(ctx, new(Hostname)); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("got %d requests, want 2", len(got))
+	}
+	for i, md := range got {
+		if v := md.Get("x-operator-version"); len(v) != 1 || v[0] != "v1.0.0" {
+			t.Errorf("request %d: x-operator-version = %v, want [v1.0.0]", i, v)
+		}
+	}
+	if v := got[1].Get("x-request"); len(v) != 1 || v[0] != "default/leaf1" {
+		t.Errorf("x-request = %v, want [default/leaf1]", v)
+	}
+}
+
+func TestClient_WithMetadata_OddArguments(t *testing.T) {
+	conn := &MockClientConn{
+		CapabilitiesFunc: func(ctx context.Context, req *gpb.CapabilityRequest) (*gpb.CapabilityResponse, error) {
+			t.Error("unexpected Capabilities request")
+			return &gpb.CapabilityResponse{SupportedEncodings: []gpb.Encoding{gpb.Encoding_JSON}}, nil
+		},
+	}
+
+	if _, err := New(t.Context(), conn, WithMetadata("x-operator-version")); err == nil {
+		t.Fatal("New() error = nil, want error")
+	}
+}
+
+func TestClient_WithTimeout(t *testing.T) {
+	const timeout = time.Minute
+
+	var deadlines []time.Time
+	record := func(ctx context.Context) {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			t.Errorf("request without deadline")
+		}
+		deadlines = append(deadlines, deadline)
+	}
+	conn := &MockClientConn{
+		GetFunc: func(ctx context.Context, req *gpb.GetRequest) (*gpb.GetResponse, error) {
+			record(ctx)
+			return nil, status.Error(codes.NotFound, "not found")
+		},
+		SetFunc: func(ctx context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+			record(ctx)
+			return &gpb.SetResponse{}, nil
+		},
+	}
+
+	c := &client{
+		encoding: gpb.Encoding_JSON,
+		gnmi:     gpb.NewGNMIClient(conn),
+	}
+
+	start := time.Now()
+	ctx := WithTimeout(t.Context(), timeout)
+	hostname := Hostname("test-hostname")
+	if err := c.Update(ctx, &hostname); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if err := c.Patch(ctx, &hostname); err != nil {
+		t.Fatalf("Patch() error = %v", err)
+	}
+	if err := c.Delete(ctx, &hostname); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := c.GetConfig(ctx, &hostname); status.Code(err) != codes.NotFound {
+		t.Fatalf("GetConfig() error = %v, want NotFound", err)
+	}
+
+	if len(deadlines) != 6 {
+		t.Fatalf("got %d requests, want 6", len(deadlines))
+	}
+	for i, deadline := range deadlines {
+		if deadline.Before(start.Add(timeout)) || deadline.After(time.Now().Add(timeout)) {
+			t.Errorf("request %d: deadline = %v, want %v after %v", i, deadline, timeout, start)
+		}
+	}
+}
+
+func TestClient_WithTimeout_Exceeded(t *testing.T) {
+	wait := func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(5 * time.Second):
+			return errors.New("timeout not applied")
+		}
+	}
+	conn := &MockClientConn{
+		GetFunc: func(ctx context.Context, req *gpb.GetRequest) (*gpb.GetResponse, error) {
+			return nil, wait(ctx)
+		},
+		SetFunc: func(ctx context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+			return nil, wait(ctx)
+		},
+	}
+
+	c := &client{
+		encoding: gpb.Encoding_JSON,
+		gnmi:     gpb.NewGNMIClient(conn),
+	}
+
+	ctx := WithTimeout(t.Context(), 10*time.Millisecond)
+	hostname := Hostname("test-hostname")
+	tests := []struct {
+		name string
+		fn   func() error
+	}{
+		{"GetConfig", func() error { return c.GetConfig(ctx, &hostname) }},
+		{"Update", func() error { return c.Update(ctx, &hostname) }},
+		{"Patch", func() error { return c.Patch(ctx, &hostname) }},
+		{"Delete", func() error { return c.Delete(ctx, &hostname) }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.fn(); !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("%s() error = %v, want %v", test.name, err, context.DeadlineExceeded)
+			}
+		})
+	}
+}
+
+func TestClient_Ping(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(ctx context.Context, req *gpb.CapabilityRequest) (*gpb.CapabilityResponse, error)
+		wantCode codes.Code
+	}{
+		{
+			name: "reachable",
+			fn: func(ctx context.Context, req *gpb.CapabilityRequest) (*gpb.CapabilityResponse, error) {
+				return &gpb.CapabilityResponse{SupportedEncodings: []gpb.Encoding{gpb.Encoding_JSON}}, nil
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "unavailable",
+			fn: func(ctx context.Context, req *gpb.CapabilityRequest) (*gpb.CapabilityResponse, error) {
+				return nil, status.Error(codes.Unavailable, "connection refused")
+			},
+			wantCode: codes.Unavailable,
+		},
+		{
+			name: "timeout",
+			fn: func(ctx context.Context, req *gpb.CapabilityRequest) (*gpb.CapabilityResponse, error) {
+				select {
+				case <-ctx.Done():
+					return nil, status.FromContextError(ctx.Err()).Err()
+				case <-time.After(5 * time.Second):
+					return nil, errors.New("timeout not applied")
+				}
+			},
+			wantCode: codes.DeadlineExceeded,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &client{gnmi: gpb.NewGNMIClient(&MockClientConn{CapabilitiesFunc: test.fn})}
+
+			err := c.Ping(WithTimeout(t.Context(), 10*time.Millisecond))
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("Ping() error = %v, want code %v", err, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestClient_WithSemaphore(t *testing.T) {
+	const (
+		limit = 3
+		calls = 12
+	)
+
+	var (
+		mu      sync.Mutex
+		current int
+		peak    int
+	)
+	track := func() {
+		mu.Lock()
+		current++
+		peak = max(peak, current)
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		current--
+		mu.Unlock()
+	}
+	conn := &MockClientConn{
+		GetFunc: func(ctx context.Context, req *gpb.GetRequest) (*gpb.GetResponse, error) {
+			track()
+			return nil, status.Error(codes.NotFound, "not found")
+		},
+		SetFunc: func(ctx context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+			track()
+			return &gpb.SetResponse{}, nil
+		},
+	}
+
+	// Clients of different providers connected to the same device share the semaphore.
+	sem := make(chan struct{}, limit)
+	clients := make([]*client, 2)
+	for i := range clients {
+		clients[i] = &client{
+			encoding: gpb.Encoding_JSON,
+			gnmi:     gpb.NewGNMIClient(conn),
+			sem:      sem,
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := range calls {
+		wg.Go(func() {
+			c := clients[i%len(clients)]
+			hostname := Hostname("test-hostname")
+			if i%3 == 0 {
+				_ = c.GetConfig(t.Context(), &hostname)
+				return
+			}
+			if err := c.Update(t.Context(), &hostname); err != nil {
+				t.Errorf("Update() error = %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if peak > limit {
+		t.Errorf("got %d concurrent requests, want at most %d", peak, limit)
+	}
+	if peak < 2 {
+		t.Errorf("got %d concurrent requests, want requests to run in parallel", peak)
+	}
+	if len(sem) != 0 {
+		t.Errorf("semaphore holds %d slots after all requests completed, want 0", len(sem))
+	}
+}
+
+func TestClient_WithSemaphore_ContextDone(t *testing.T) {
+	sem := make(chan struct{}, 1)
+	sem <- struct{}{} // occupied by another client
+
+	conn := &MockClientConn{
+		SetFunc: func(ctx context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+			t.Error("Set RPC sent while semaphore is exhausted")
+			return &gpb.SetResponse{}, nil
+		},
+	}
+	c := &client{
+		encoding: gpb.Encoding_JSON,
+		gnmi:     gpb.NewGNMIClient(conn),
+		sem:      sem,
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	hostname := Hostname("test-hostname")
+	if err := c.Update(ctx, &hostname); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Update() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestClient_WithSetLimiter(t *testing.T) {
+	const interval = 20 * time.Millisecond
+
+	var (
+		mu   sync.Mutex
+		sets []time.Time
+	)
+	conn := &MockClientConn{
+		GetFunc: func(ctx context.Context, req *gpb.GetRequest) (*gpb.GetResponse, error) {
+			return nil, status.Error(codes.NotFound, "not found")
+		},
+		SetFunc: func(ctx context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+			mu.Lock()
+			sets = append(sets, time.Now())
+			mu.Unlock()
+			return &gpb.SetResponse{}, nil
+		},
+	}
+
+	// Clients of different providers connected to the same device share the limiter.
+	lim := rate.NewLimiter(rate.Every(interval), 1)
+	clients := make([]*client, 2)
+	for i := range clients {
+		clients[i] = &client{
+			encoding: gpb.Encoding_JSON,
+			gnmi:     gpb.NewGNMIClient(conn),
+			limiter:  lim,
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Go(func() {
+			c := clients[i%len(clients)]
+			hostname := Hostname("test-hostname")
+			var err error
+			switch i {
+			case 0:
+				err = c.Update(t.Context(), &hostname)
+			case 1:
+				err = c.Delete(t.Context(), &hostname)
+			case 2:
+				err = c.Transaction(t.Context(), func(tx Client) error { return tx.Patch(t.Context(), &hostname) })
+			default:
+				// Reads are not limited.
+				_ = c.GetConfig(t.Context(), &hostname)
+				return
+			}
+			if err != nil {
+				t.Errorf("request %d error = %v", i, err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if len(sets) != 3 {
+		t.Fatalf("got %d set requests, want 3", len(sets))
+	}
+	slices.SortFunc(sets, func(a, b time.Time) int { return a.Compare(b) })
+	for i := 1; i < len(sets); i++ {
+		// Allow for some inaccuracy of the timer.
+		if d := sets[i].Sub(sets[i-1]); d < interval-5*time.Millisecond {
+			t.Errorf("set request %d sent %v after the previous one, want at least %v", i, d, interval)
+		}
+	}
+}
+
+func TestClient_WithSetLimiter_ContextDone(t *testing.T) {
+	lim := rate.NewLimiter(rate.Every(time.Hour), 1)
+	lim.Allow() // exhausted by another client
+
+	conn := &MockClientConn{
+		SetFunc: func(ctx context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+			t.Error("Set RPC sent while limiter is exhausted")
+			return &gpb.SetResponse{}, nil
+		},
+	}
+	c := &client{
+		encoding: gpb.Encoding_JSON,
+		gnmi:     gpb.NewGNMIClient(conn),
+		limiter:  lim,
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	if err := c.Delete(ctx, new(Hostname)); err == nil {
+		t.Error("Delete() error = nil, want error")
+	}
+}
+
 func TestClient_GetConfig(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -840,6 +1280,47 @@ func TestClient_Update(t *testing.T) {
 	}
 }
 
+func TestClient_UpdateSensitive(t *testing.T) {
+	var logs strings.Builder
+	logger := funcr.New(func(prefix, args string) {
+		logs.WriteString(args + "\n")
+	}, funcr.Options{Verbosity: 2})
+
+	var sent []byte
+	conn := &MockClientConn{
+		GetFunc: func(ctx context.Context, req *gpb.GetRequest) (*gpb.GetResponse, error) {
+			return nil, status.Error(codes.NotFound, "not found")
+		},
+		SetFunc: func(ctx context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+			if len(req.Replace) != 1 {
+				t.Fatalf("Expected single Replace operation, got %d", len(req.Replace))
+			}
+			sent = req.Replace[0].GetVal().GetJsonVal()
+			return &gpb.SetResponse{}, nil
+		},
+	}
+
+	c := &client{
+		encoding: gpb.Encoding_JSON,
+		gnmi:     gpb.NewGNMIClient(conn),
+		logger:   logger,
+	}
+
+	pwd := Password("s3cr3t")
+	if err := c.Update(t.Context(), &pwd); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if string(sent) != `"s3cr3t"` {
+		t.Errorf("Update() sent = %s, want %q", sent, `"s3cr3t"`)
+	}
+	if strings.Contains(logs.String(), "s3cr3t") {
+		t.Errorf("Update() logged sensitive payload: %s", logs.String())
+	}
+	if !strings.Contains(logs.String(), "<redacted>") {
+		t.Errorf("Update() did not log redacted payload: %s", logs.String())
+	}
+}
+
 func TestClient_Patch(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -1112,6 +1593,198 @@ func TestClient_Delete(t *testing.T) {
 	}
 }
 
+func TestClient_MaxPathsPerRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxPaths  int
+		updates   int
+		wantCalls int
+	}{
+		{name: "unlimited", maxPaths: 0, updates: 200, wantCalls: 1},
+		{name: "negative", maxPaths: -1, updates: 5, wantCalls: 1},
+		{name: "below limit", maxPaths: 10, updates: 5, wantCalls: 1},
+		{name: "equal to limit", maxPaths: 5, updates: 5, wantCalls: 1},
+		{name: "single path", maxPaths: 1, updates: 5, wantCalls: 5},
+		{name: "remainder", maxPaths: 2, updates: 5, wantCalls: 3},
+		{name: "multiple of limit", maxPaths: 20, updates: 200, wantCalls: 10},
+		{name: "large request", maxPaths: 30, updates: 200, wantCalls: 7},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls, paths int
+			conn := &MockClientConn{
+				GetFunc: func(ctx context.Context, req *gpb.GetRequest) (*gpb.GetResponse, error) {
+					return nil, status.Error(codes.NotFound, "not found")
+				},
+				SetFunc: func(ctx context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+					n := len(req.Delete) + len(req.Replace) + len(req.Update)
+					if test.maxPaths > 0 && n > test.maxPaths {
+						t.Errorf("request %d has %d paths, want at most %d", calls, n, test.maxPaths)
+					}
+					calls++
+					paths += n
+					return &gpb.SetResponse{}, nil
+				},
+			}
+
+			c := &client{
+				encoding:           gpb.Encoding_JSON,
+				gnmi:               gpb.NewGNMIClient(conn),
+				maxPathsPerRequest: test.maxPaths,
+			}
+
+			updates := make([]DataElement, test.updates)
+			for i := range updates {
+				h := Hostname(fmt.Sprintf("hostname-%d", i))
+				updates[i] = &h
+			}
+			if err := c.Update(t.Context(), updates...); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			if calls != test.wantCalls {
+				t.Errorf("Update() sent %d set requests, want %d", calls, test.wantCalls)
+			}
+			if paths != test.updates {
+				t.Errorf("Update() sent %d paths, want %d", paths, test.updates)
+			}
+		})
+	}
+}
+
+func TestClient_Transaction(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func(ctx context.Context, c Client) error
+		setErr    error
+		wantCalls int
+		wantPaths [3]int // deletes, replaces, updates
+		wantErr   bool
+	}{
+		{
+			name: "single set request",
+			fn: func(ctx context.Context, c Client) error {
+				h, s := Hostname("leaf1"), HostnameState("leaf1")
+				if err := c.Update(ctx, &h); err != nil {
+					return err
+				}
+				if err := c.Patch(ctx, &s); err != nil {
+					return err
+				}
+				return c.Delete(ctx, new(Hostname), new(DefaultableHostname))
+			},
+			wantCalls: 1,
+			wantPaths: [3]int{1, 2, 1},
+		},
+		{
+			name: "nested transaction",
+			fn: func(ctx context.Context, c Client) error {
+				return c.Transaction(ctx, func(c Client) error {
+					h := Hostname("leaf1")
+					return c.Update(ctx, &h)
+				})
+			},
+			wantCalls: 1,
+			wantPaths: [3]int{0, 1, 0},
+		},
+		{
+			name: "function error",
+			fn: func(ctx context.Context, c Client) error {
+				h := Hostname("leaf1")
+				if err := c.Update(ctx, &h); err != nil {
+					return err
+				}
+				return errors.New("failed")
+			},
+			wantErr: true,
+		},
+		{
+			name: "set rpc error",
+			fn: func(ctx context.Context, c Client) error {
+				h := Hostname("leaf1")
+				return c.Update(ctx, &h)
+			},
+			setErr:    status.Error(codes.Aborted, "validation failed"),
+			wantCalls: 1,
+			wantPaths: [3]int{0, 1, 0},
+			wantErr:   true,
+		},
+		{
+			name:    "no changes",
+			fn:      func(context.Context, Client) error { return nil },
+			wantErr: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls int
+			var paths [3]int
+			conn := &MockClientConn{
+				GetFunc: func(ctx context.Context, req *gpb.GetRequest) (*gpb.GetResponse, error) {
+					return nil, status.Error(codes.NotFound, "not found")
+				},
+				SetFunc: func(ctx context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+					calls++
+					paths = [3]int{len(req.Delete), len(req.Replace), len(req.Update)}
+					return &gpb.SetResponse{}, test.setErr
+				},
+			}
+
+			// The transaction must not be split, even if the number of paths exceeds the limit.
+			c := &client{
+				encoding:           gpb.Encoding_JSON,
+				gnmi:               gpb.NewGNMIClient(conn),
+				maxPathsPerRequest: 1,
+			}
+
+			err := c.Transaction(t.Context(), func(tx Client) error { return test.fn(t.Context(), tx) })
+			if (err != nil) != test.wantErr {
+				t.Errorf("Transaction() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if calls != test.wantCalls {
+				t.Errorf("Transaction() sent %d set requests, want %d", calls, test.wantCalls)
+			}
+			if paths != test.wantPaths {
+				t.Errorf("Transaction() sent %v paths, want %v", paths, test.wantPaths)
+			}
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	path := func(name string) *gpb.Path {
+		return &gpb.Path{Elem: []*gpb.PathElem{{Name: name}}}
+	}
+	update := func(name string) *gpb.Update {
+		return &gpb.Update{Path: path(name)}
+	}
+
+	r := &gpb.SetRequest{
+		Prefix:  &gpb.Path{Origin: "openconfig"},
+		Delete:  []*gpb.Path{path("d1"), path("d2"), path("d3")},
+		Replace: []*gpb.Update{update("r1"), update("r2")},
+		Update:  []*gpb.Update{update("u1")},
+	}
+	want := []*gpb.SetRequest{
+		{Prefix: r.Prefix, Delete: []*gpb.Path{path("d1"), path("d2")}},
+		{Prefix: r.Prefix, Delete: []*gpb.Path{path("d3")}, Replace: []*gpb.Update{update("r1")}},
+		{Prefix: r.Prefix, Replace: []*gpb.Update{update("r2")}, Update: []*gpb.Update{update("u1")}},
+	}
+
+	got := chunk(r, 2)
+	if len(got) != len(want) {
+		t.Fatalf("chunk() returned %d requests, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !proto.Equal(got[i], want[i]) {
+			t.Errorf("chunk() request %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := chunk(r, 6); len(got) != 1 || got[0] != r {
+		t.Errorf("chunk() split request that doesn't exceed the limit")
+	}
+}
+
 func TestStringToStructuredPath(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -1317,6 +1990,20 @@ func (*HostnameState) XPath() string { return "openconfig-system:system/state/ho
 func (*DefaultableHostname) XPath() string { return "openconfig-system:system/config/hostname" }
 func (h *DefaultableHostname) Default()    { *h = "default-hostname" }
 
+// -- Sensitive --
+
+type Password string
+
+var (
+	_ DataElement = (*Password)(nil)
+	_ Sensitive   = (*Password)(nil)
+)
+
+func (*Password) XPath() string {
+	return "openconfig-system:system/aaa/authentication/admin-user/config/admin-password"
+}
+func (*Password) IsSensitive() {}
+
 var _ grpc.ClientConnInterface = (*MockClientConn)(nil)
 
 // MockClientConn provides a mock implementation of [grpc.ClientConnInterface] for testing gNMI clients.
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package gnmiext
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+var (
+	_ DataElement      = (*Raw)(nil)
+	_ json.Marshaler   = (*Raw)(nil)
+	_ json.Unmarshaler = (*Raw)(nil)
+)
+
+// Raw is a [DataElement] for an arbitrary path that holds the value returned by the
+// device as undecoded JSON. It is intended for diagnostic read-only queries where the
+// schema of the requested path is not known in advance.
+type Raw struct {
+	// Path is the YANG path of the data element.
+	Path string
+	// Value is the JSON encoded value at the path.
+	Value json.RawMessage
+}
+
+// XPath implements DataElement for Raw.
+func (r *Raw) XPath() string {
+	return r.Path
+}
+
+// MarshalJSON implements json.Marshaler for Raw.
+func (r *Raw) MarshalJSON() ([]byte, error) {
+	if len(r.Value) == 0 {
+		return []byte("null"), nil
+	}
+	return r.Value, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Raw.
+func (r *Raw) UnmarshalJSON(b []byte) error {
+	if r == nil {
+		return errors.New("gnmiext: UnmarshalJSON on nil pointer")
+	}
+	r.Value = append(r.Value[:0], b...)
+	return nil
+}
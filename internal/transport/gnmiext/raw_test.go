@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package gnmiext
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func TestRaw_GetState(t *testing.T) {
+	conn := &MockClientConn{
+		GetFunc: func(ctx context.Context, req *gpb.GetRequest) (*gpb.GetResponse, error) {
+			if req.Type != gpb.GetRequest_STATE {
+				t.Errorf("Expected GetRequest_STATE, got %v", req.Type)
+			}
+			return &gpb.GetResponse{
+				Notification: []*gpb.Notification{
+					{
+						Update: []*gpb.Update{
+							{
+								Val: &gpb.TypedValue{
+									Value: &gpb.TypedValue_JsonVal{
+										JsonVal: []byte(`[{"id":"eth1/1","operSt":"up"}]`),
+									},
+								},
+							},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+	c := &client{gnmi: gpb.NewGNMIClient(conn), encoding: gpb.Encoding_JSON}
+
+	r := &Raw{Path: "System/intf-items/phys-items/PhysIf-list[id=eth1/1]/phys-items"}
+	if err := c.GetState(t.Context(), r); err != nil {
+		t.Fatalf("GetState() error = %v", err)
+	}
+	if want := `[{"id":"eth1/1","operSt":"up"}]`; string(r.Value) != want {
+		t.Errorf("GetState() value = %s, want %s", r.Value, want)
+	}
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package gnmiext
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/value"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// SubscribeMode is the mode in which the target sends updates for the paths of a subscription.
+type SubscribeMode int
+
+const (
+	// SubscribeModeTargetDefined lets the target choose the most suitable mode for each path.
+	SubscribeModeTargetDefined SubscribeMode = iota
+	// SubscribeModeOnChange sends updates only when the value of a path changes.
+	SubscribeModeOnChange
+	// SubscribeModeSample sends updates periodically, see [DefaultSampleInterval].
+	SubscribeModeSample
+)
+
+// DefaultSampleInterval is the interval at which the target sends updates in [SubscribeModeSample].
+const DefaultSampleInterval = 10 * time.Second
+
+// Notification is a decoded notification received on a subscription.
+type Notification struct {
+	// Timestamp is the time at which the values were collected by the target.
+	Timestamp time.Time
+	// Updates are the values of the paths that have been updated.
+	Updates []Update
+	// Deletes are the paths that have been deleted.
+	Deletes []string
+	// SyncResponse indicates that the target has sent the current values of all subscribed paths.
+	// A notification with SyncResponse set carries no updates or deletes.
+	SyncResponse bool
+	// Err is set if a notification could not be received or decoded. A notification with Err set
+	// carries no updates or deletes. If the stream failed, it is the last notification on the channel.
+	Err error
+}
+
+// Update is a single updated value of a [Notification].
+type Update struct {
+	// Path is the xpath of the value, including the prefix of the notification.
+	Path string
+	// Value is the JSON encoded value. Scalar values are encoded as their JSON equivalent.
+	Value json.RawMessage
+}
+
+// Subscribe opens a streaming subscription for the given xpaths and delivers the decoded notifications on
+// the returned channel. The channel is closed once the context is canceled or the stream ends. Errors are
+// delivered on the channel, see [Notification.Err].
+func (c *client) Subscribe(ctx context.Context, xpaths []string, mode SubscribeMode) (<-chan Notification, error) {
+	if len(xpaths) == 0 {
+		return nil, errors.New("gnmiext: no paths to subscribe to")
+	}
+	list := &gpb.SubscriptionList{
+		Mode:     gpb.SubscriptionList_STREAM,
+		Encoding: c.encoding,
+	}
+	for _, xpath := range xpaths {
+		path, err := StringToStructuredPath(xpath)
+		if err != nil {
+			return nil, err
+		}
+		sub := &gpb.Subscription{Path: path}
+		switch mode {
+		case SubscribeModeTargetDefined:
+			sub.Mode = gpb.SubscriptionMode_TARGET_DEFINED
+		case SubscribeModeOnChange:
+			sub.Mode = gpb.SubscriptionMode_ON_CHANGE
+		case SubscribeModeSample:
+			sub.Mode = gpb.SubscriptionMode_SAMPLE
+			sub.SampleInterval = uint64(DefaultSampleInterval.Nanoseconds())
+		default:
+			return nil, fmt.Errorf("gnmiext: unsupported subscribe mode: %d", mode)
+		}
+		list.Subscription = append(list.Subscription, sub)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.gnmi.Subscribe(c.outgoing(ctx))
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("gnmiext: failed to open subscription: %w", err)
+	}
+	if err := stream.Send(&gpb.SubscribeRequest{Request: &gpb.SubscribeRequest_Subscribe{Subscribe: list}}); err != nil {
+		cancel()
+		return nil, fmt.Errorf("gnmiext: failed to send subscription request: %w", err)
+	}
+
+	ch := make(chan Notification)
+	go func() {
+		defer close(ch)
+		defer cancel()
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				if ctx.Err() == nil && !errors.Is(err, io.EOF) {
+					select {
+					case ch <- Notification{Err: fmt.Errorf("gnmiext: failed to receive subscription response: %w", err)}:
+					case <-ctx.Done():
+					}
+				}
+				return
+			}
+			var n Notification
+			switch {
+			case res.GetSyncResponse():
+				n.SyncResponse = true
+			case res.GetUpdate() != nil:
+				n, err = decodeNotification(res.GetUpdate())
+				if err != nil {
+					n = Notification{Err: err}
+				}
+			default:
+				continue
+			}
+			select {
+			case ch <- n:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// decodeNotification converts a [gpb.Notification] into a [Notification].
+func decodeNotification(n *gpb.Notification) (Notification, error) {
+	res := Notification{Timestamp: time.Unix(0, n.GetTimestamp())}
+	for _, u := range n.GetUpdate() {
+		path, err := pathToString(n.GetPrefix(), u.GetPath())
+		if err != nil {
+			return Notification{}, err
+		}
+		b, err := decodeValue(u.GetVal())
+		if err != nil {
+			return Notification{}, fmt.Errorf("gnmiext: failed to decode value of %s: %w", path, err)
+		}
+		res.Updates = append(res.Updates, Update{Path: path, Value: b})
+	}
+	for _, d := range n.GetDelete() {
+		path, err := pathToString(n.GetPrefix(), d)
+		if err != nil {
+			return Notification{}, err
+		}
+		res.Deletes = append(res.Deletes, path)
+	}
+	return res, nil
+}
+
+// pathToString joins the prefix and the path into an xpath in the same form as accepted by [StringToStructuredPath].
+func pathToString(prefix, path *gpb.Path) (string, error) {
+	p := &gpb.Path{
+		Origin: prefix.GetOrigin(),
+		Elem:   append(append([]*gpb.PathElem{}, prefix.GetElem()...), path.GetElem()...),
+	}
+	if path.GetOrigin() != "" {
+		p.Origin = path.GetOrigin()
+	}
+	s, err := ygot.PathToString(p)
+	if err != nil {
+		return "", fmt.Errorf("gnmiext: failed to convert path to xpath: %w", err)
+	}
+	s = strings.TrimPrefix(s, "/")
+	if p.GetOrigin() != "" {
+		s = p.GetOrigin() + ":" + s
+	}
+	return s, nil
+}
+
+// decodeValue returns the JSON encoding of the given value.
+func decodeValue(val *gpb.TypedValue) ([]byte, error) {
+	switch v := val.GetValue().(type) {
+	case *gpb.TypedValue_JsonVal:
+		return v.JsonVal, nil
+	case *gpb.TypedValue_JsonIetfVal:
+		return v.JsonIetfVal, nil
+	default:
+		s, err := value.ToScalar(val)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(s)
+	}
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package gnmiext
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestClient_Subscribe(t *testing.T) {
+	prefix, err := StringToStructuredPath("System/intf-items/phys-items/PhysIf-list[id=eth1/1]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &subscribeServer{
+		notifications: []*gpb.Notification{
+			{
+				Timestamp: 1,
+				Prefix:    prefix,
+				Update: []*gpb.Update{{
+					Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "dbgIfIn-items"}}},
+					Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonVal{JsonVal: []byte(`{"ucastPkts":42}`)}},
+				}},
+			},
+			{
+				Timestamp: 2,
+				Prefix:    prefix,
+				Update: []*gpb.Update{{
+					Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "dbgIfIn-items"}, {Name: "ucastPkts"}}},
+					Val:  &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 43}},
+				}},
+			},
+		},
+		sync: true,
+	}
+	c := &client{
+		encoding: gpb.Encoding_JSON,
+		gnmi:     gpb.NewGNMIClient(newBufConn(t, srv)),
+	}
+
+	ch, err := c.Subscribe(t.Context(), []string{"System/intf-items/phys-items/PhysIf-list[id=eth1/1]/dbgIfIn-items"}, SubscribeModeSample)
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+
+	var got []Notification
+	for n := range ch {
+		got = append(got, n)
+	}
+
+	want := []Notification{
+		{
+			Timestamp: time.Unix(0, 1),
+			Updates:   []Update{{Path: "System/intf-items/phys-items/PhysIf-list[id=eth1/1]/dbgIfIn-items", Value: json.RawMessage(`{"ucastPkts":42}`)}},
+		},
+		{
+			Timestamp: time.Unix(0, 2),
+			Updates:   []Update{{Path: "System/intf-items/phys-items/PhysIf-list[id=eth1/1]/dbgIfIn-items/ucastPkts", Value: json.RawMessage(`43`)}},
+		},
+		{
+			SyncResponse: true,
+		},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Subscribe() notifications = %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !got[i].Timestamp.Equal(want[i].Timestamp) {
+			t.Errorf("Subscribe() notification %d timestamp = %v, want %v", i, got[i].Timestamp, want[i].Timestamp)
+		}
+		if got[i].SyncResponse != want[i].SyncResponse {
+			t.Errorf("Subscribe() notification %d sync response = %v, want %v", i, got[i].SyncResponse, want[i].SyncResponse)
+		}
+		if !reflect.DeepEqual(got[i].Updates, want[i].Updates) {
+			t.Errorf("Subscribe() notification %d updates = %v, want %v", i, got[i].Updates, want[i].Updates)
+		}
+	}
+
+	sub := srv.req.GetSubscribe()
+	if sub.GetMode() != gpb.SubscriptionList_STREAM {
+		t.Errorf("Subscribe() mode = %v, want STREAM", sub.GetMode())
+	}
+	if len(sub.GetSubscription()) != 1 || sub.GetSubscription()[0].GetMode() != gpb.SubscriptionMode_SAMPLE {
+		t.Errorf("Subscribe() subscriptions = %v, want one SAMPLE subscription", sub.GetSubscription())
+	}
+	if sub.GetSubscription()[0].GetSampleInterval() != uint64(DefaultSampleInterval) {
+		t.Errorf("Subscribe() sample interval = %d, want %d", sub.GetSubscription()[0].GetSampleInterval(), uint64(DefaultSampleInterval))
+	}
+}
+
+func TestClient_Subscribe_ContextCanceled(t *testing.T) {
+	srv := &subscribeServer{block: true}
+	c := &client{
+		encoding: gpb.Encoding_JSON,
+		gnmi:     gpb.NewGNMIClient(newBufConn(t, srv)),
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	ch, err := c.Subscribe(ctx, []string{"System/bgp-items"}, SubscribeModeOnChange)
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Error("Subscribe() delivered a notification, want closed channel")
+		}
+	case <-time.After(time.Second):
+		t.Error("Subscribe() channel not closed after context cancellation")
+	}
+}
+
+func TestClient_Subscribe_Error(t *testing.T) {
+	srv := &subscribeServer{err: status.Error(codes.Unavailable, "device is reloading")}
+	c := &client{
+		encoding: gpb.Encoding_JSON,
+		gnmi:     gpb.NewGNMIClient(newBufConn(t, srv)),
+	}
+
+	ch, err := c.Subscribe(t.Context(), []string{"System/bgp-items"}, SubscribeModeOnChange)
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+
+	var got []Notification
+	for n := range ch {
+		got = append(got, n)
+	}
+	if len(got) != 1 || status.Code(got[0].Err) != codes.Unavailable {
+		t.Errorf("Subscribe() notifications = %v, want a single notification with an Unavailable error", got)
+	}
+}
+
+func TestClient_Subscribe_NoPaths(t *testing.T) {
+	c := &client{gnmi: gpb.NewGNMIClient(&MockClientConn{})}
+	if _, err := c.Subscribe(t.Context(), nil, SubscribeModeOnChange); err == nil {
+		t.Error("Subscribe() error = nil, want error")
+	}
+}
+
+// subscribeServer is a gNMI server that answers a subscription with a fixed set of notifications,
+// followed by a sync response if sync is set, and then closes the stream with err, or blocks until
+// the client goes away if block is set.
+type subscribeServer struct {
+	gpb.UnimplementedGNMIServer
+
+	notifications []*gpb.Notification
+	sync          bool
+	block         bool
+	err           error
+	req           *gpb.SubscribeRequest
+}
+
+func (s *subscribeServer) Subscribe(stream grpc.BidiStreamingServer[gpb.SubscribeRequest, gpb.SubscribeResponse]) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	s.req = req
+	for _, n := range s.notifications {
+		if err := stream.Send(&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: n}}); err != nil {
+			return err
+		}
+	}
+	if s.sync {
+		if err := stream.Send(&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_SyncResponse{SyncResponse: true}}); err != nil {
+			return err
+		}
+	}
+	if s.block {
+		<-stream.Context().Done()
+	}
+	return s.err
+}
+
+func newBufConn(t *testing.T, srv gpb.GNMIServer) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	gpb.RegisterGNMIServer(s, srv)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("grpc.NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
@@ -29,7 +29,7 @@ func NewClient(conn *deviceutil.Connection, o ...Option) (*grpc.ClientConn, erro
 		creds = credentials.NewTLS(conn.TLS)
 	}
 
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds), grpc.WithUnaryInterceptor(TerminalErrorInterceptor())}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds), grpc.WithUnaryInterceptor(TerminalErrorInterceptor()), grpc.WithUserAgent(DefaultUserAgent)}
 	if conn.Username != "" && conn.Password != "" {
 		opts = append(opts, grpc.WithPerRPCCredentials(&auth{
 			Username: conn.Username,
@@ -50,6 +50,20 @@ func NewClient(conn *deviceutil.Connection, o ...Option) (*grpc.ClientConn, erro
 
 type Option func() (grpc.DialOption, error)
 
+// DefaultUserAgent is the user-agent sent with all requests of clients created by [NewClient],
+// unless overridden with [WithUserAgent]. It allows device-side session logs to attribute changes to the operator.
+const DefaultUserAgent = "network-operator"
+
+// WithUserAgent returns a gRPC dial option that sets the user-agent sent with each request.
+func WithUserAgent(ua string) Option {
+	return func() (grpc.DialOption, error) {
+		if ua == "" {
+			return nil, errors.New("user-agent must not be empty")
+		}
+		return grpc.WithUserAgent(ua), nil
+	}
+}
+
 // WithDefaultTimeout returns a gRPC dial option that sets a default timeout for each RPC.
 // If a deadline is already present in the context, it will not be modified.
 func WithDefaultTimeout(timeout time.Duration) Option {
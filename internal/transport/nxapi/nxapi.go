@@ -99,7 +99,8 @@ func NewClient(conn *deviceutil.Connection, opts ...Option) (*Client, error) {
 // Do sends a Request to the device and returns one [json.RawMessage] per
 // command, in the same order as the request. If any command fails, Do returns
 // an [RPCErrors] containing one [RPCError] per failed command; transport and
-// HTTP errors are returned directly.
+// HTTP errors are returned directly. For commands of a request created with
+// [Request.WithASCII], the message is the CLI output encoded as a JSON string.
 func (c *Client) Do(ctx context.Context, r Request) ([]json.RawMessage, error) {
 	b, err := r.Encode()
 	if err != nil {
@@ -148,6 +149,9 @@ func (c *Client) Do(ctx context.Context, r Request) ([]json.RawMessage, error) {
 	msg := make([]json.RawMessage, len(res))
 	for i, r := range res {
 		msg[i] = r.Body.Data
+		if r.Body.Msg != nil {
+			msg[i] = r.Body.Msg
+		}
 	}
 
 	return msg, nil
@@ -163,7 +167,7 @@ func NewRequest(cmds ...string) Request {
 		r[i] = cmd{
 			Jsonrpc: "2.0",
 			// Other possible values are "cli_ascii" and "cli_array".
-			// For now, we only support "cli" which is the default.
+			// Use [Request.WithASCII] to retrieve the plain text output.
 			Method: "cli",
 			Params: params{
 				Cmd: c,
@@ -185,6 +189,15 @@ func (r Request) WithRollback(a ErrorAction) Request {
 	return r
 }
 
+// WithASCII requests the output of each command in the request as
+// plain text, as printed on the CLI, instead of structured data.
+func (r Request) WithASCII() Request {
+	for i := range r {
+		r[i].Method = "cli_ascii"
+	}
+	return r
+}
+
 // Encode serialises the request to JSON.
 func (r Request) Encode() ([]byte, error) {
 	return json.Marshal(r)
@@ -219,6 +232,7 @@ type res struct {
 	Error *RPCError `json:"error"`
 	Body  struct {
 		Data json.RawMessage `json:"body"`
+		Msg  json.RawMessage `json:"msg"`
 	} `json:"result"`
 }
 
@@ -56,9 +56,10 @@ func TestUri(t *testing.T) {
 
 func TestEncode(t *testing.T) {
 	tests := []struct {
-		desc string
-		cmds []string
-		want string
+		desc  string
+		cmds  []string
+		ascii bool
+		want  string
 	}{
 		{
 			desc: "single show command",
@@ -99,12 +100,32 @@ func TestEncode(t *testing.T) {
     },
     "id": 2
   }
+]`,
+		},
+		{
+			desc:  "ascii show command",
+			cmds:  []string{"show running-config"},
+			ascii: true,
+			want: `
+[
+  {
+    "jsonrpc": "2.0",
+    "method": "cli_ascii",
+    "params": {
+      "cmd": "show running-config",
+      "version": 1
+    },
+    "id": 1
+  }
 ]`,
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
 			r := NewRequest(test.cmds...)
+			if test.ascii {
+				r = r.WithASCII()
+			}
 			b, err := r.Encode()
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
@@ -299,6 +320,34 @@ func TestDo(t *testing.T) {
 	}
 }
 
+func TestDo_ASCII(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json-rpc")
+		fmt.Fprint(w, `{"jsonrpc":"2.0","result":{"msg":"hostname leaf1\nfeature bgp\n"},"id":1}`)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(&deviceutil.Connection{Address: srv.Listener.Addr().String()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	results, err := c.Do(t.Context(), NewRequest("show running-config").WithASCII())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("len(results) = %d, want 1", len(results))
+	}
+	var got string
+	if err := json.Unmarshal(results[0], &got); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	if want := "hostname leaf1\nfeature bgp\n"; got != want {
+		t.Errorf("Do() = %q, want %q", got, want)
+	}
+}
+
 func TestIsTransportError(t *testing.T) {
 	tests := []struct {
 		desc string
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/ironcore-dev/network-operator/api/cisco/nx/v1alpha1"
+)
+
+// iclog is for logging in this package.
+var iclog = logf.Log.WithName("interfaceconfig-resource")
+
+// SetupInterfaceConfigWebhookWithManager registers the webhook for InterfaceConfig in the manager.
+func SetupInterfaceConfigWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &v1alpha1.InterfaceConfig{}).
+		WithValidator(&InterfaceConfigCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-nx-cisco-networking-metal-ironcore-dev-v1alpha1-interfaceconfig,mutating=false,failurePolicy=Fail,sideEffects=None,groups=nx.cisco.networking.metal.ironcore.dev,resources=interfaceconfigs,verbs=create;update,versions=v1alpha1,name=interfaceconfig-cisco-nx-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// InterfaceConfigCustomValidator struct is responsible for validating the InterfaceConfig resource
+// when it is created, updated, or deleted.
+type InterfaceConfigCustomValidator struct{}
+
+var _ admission.Validator[*v1alpha1.InterfaceConfig] = &InterfaceConfigCustomValidator{}
+
+// ValidateCreate implements admission.Validator so a webhook will be registered for the type InterfaceConfig.
+func (v *InterfaceConfigCustomValidator) ValidateCreate(_ context.Context, ic *v1alpha1.InterfaceConfig) (admission.Warnings, error) {
+	iclog.Info("Validation for InterfaceConfig upon creation", "name", ic.GetName())
+
+	return validateInterfaceConfigSpec(ic), nil
+}
+
+// ValidateUpdate implements admission.Validator so a webhook will be registered for the type InterfaceConfig.
+func (v *InterfaceConfigCustomValidator) ValidateUpdate(_ context.Context, _, ic *v1alpha1.InterfaceConfig) (admission.Warnings, error) {
+	iclog.Info("Validation for InterfaceConfig upon update", "name", ic.GetName())
+
+	return validateInterfaceConfigSpec(ic), nil
+}
+
+// ValidateDelete implements admission.Validator so a webhook will be registered for the type InterfaceConfig.
+func (v *InterfaceConfigCustomValidator) ValidateDelete(_ context.Context, _ *v1alpha1.InterfaceConfig) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateInterfaceConfigSpec returns warnings for settings that are valid but unlikely to be intended:
+// - BPDU guard is ineffective if BPDU filter is enabled as well, since no BPDUs are received on the port
+func validateInterfaceConfigSpec(ic *v1alpha1.InterfaceConfig) admission.Warnings {
+	var warnings admission.Warnings
+	if stp := ic.Spec.SpanningTree; stp != nil && stp.BPDUFilter != nil && *stp.BPDUFilter && stp.BPDUGuard != nil && *stp.BPDUGuard {
+		warnings = append(warnings, "spec.spanningTree.bpduGuard has no effect while spec.spanningTree.bpduFilter is enabled, as BPDUs are dropped before they reach the guard")
+	}
+	return warnings
+}
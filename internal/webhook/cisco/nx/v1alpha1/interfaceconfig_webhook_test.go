@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	nxv1alpha1 "github.com/ironcore-dev/network-operator/api/cisco/nx/v1alpha1"
+)
+
+var _ = Describe("InterfaceConfig Webhook", func() {
+	var (
+		obj       *nxv1alpha1.InterfaceConfig
+		validator InterfaceConfigCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &nxv1alpha1.InterfaceConfig{
+			Spec: nxv1alpha1.InterfaceConfigSpec{
+				SpanningTree: &nxv1alpha1.SpanningTree{
+					PortType: nxv1alpha1.SpanningTreePortTypeEdge,
+				},
+			},
+		}
+		validator = InterfaceConfigCustomValidator{}
+	})
+
+	Context("ValidateCreate SpanningTree", func() {
+		It("accepts BPDU filter without BPDU guard", func() {
+			obj.Spec.SpanningTree.BPDUFilter = new(true)
+			obj.Spec.SpanningTree.BPDUGuard = new(false)
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("accepts BPDU guard without BPDU filter", func() {
+			obj.Spec.SpanningTree.BPDUGuard = new(true)
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("warns if both BPDU filter and BPDU guard are enabled", func() {
+			obj.Spec.SpanningTree.BPDUFilter = new(true)
+			obj.Spec.SpanningTree.BPDUGuard = new(true)
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+		})
+	})
+
+	Context("ValidateUpdate SpanningTree", func() {
+		It("warns if BPDU guard is enabled in addition to BPDU filter", func() {
+			obj.Spec.SpanningTree.BPDUFilter = new(true)
+			newObj := obj.DeepCopy()
+			newObj.Spec.SpanningTree.BPDUGuard = new(true)
+			warnings, err := validator.ValidateUpdate(ctx, obj, newObj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+		})
+	})
+})
@@ -98,6 +98,9 @@ func TestAPIs(t *testing.T) {
 	err = SetupNetworkVirtualizationEdgeConfigWebhookWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = SetupInterfaceConfigWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
 	// +kubebuilder:scaffold:webhook
 
 	go func() {
@@ -22,9 +22,41 @@
 func SetupInterfaceWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, &v1alpha1.Interface{}).
 		WithValidator(&InterfaceCustomValidator{}).
+		WithDefaulter(&InterfaceCustomDefaulter{}).
 		Complete()
 }
 
+// +kubebuilder:webhook:path=/mutate-networking-metal-ironcore-dev-v1alpha1-interface,mutating=true,failurePolicy=Fail,sideEffects=None,groups=networking.metal.ironcore.dev,resources=interfaces,verbs=create;update,versions=v1alpha1,name=minterface-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// InterfaceCustomDefaulter struct is responsible for setting default values on the Interface resource
+// when it is created or updated.
+type InterfaceCustomDefaulter struct{}
+
+var _ admission.Defaulter[*v1alpha1.Interface] = &InterfaceCustomDefaulter{}
+
+// Default implements admission.Defaulter so a webhook will be registered for the type Interface.
+// It defaults the admin state of all interfaces but loopbacks to Up, unless overridden
+// by the [v1alpha1.DefaultAdminStateAnnotation].
+func (d *InterfaceCustomDefaulter) Default(_ context.Context, intf *v1alpha1.Interface) error {
+	interfacelog.Info("Defaulting for Interface", "name", intf.GetName())
+
+	if intf.Spec.AdminState != "" || intf.Spec.Type == v1alpha1.InterfaceTypeLoopback {
+		return nil
+	}
+
+	state := v1alpha1.AdminStateUp
+	if v, ok := intf.Annotations[v1alpha1.DefaultAdminStateAnnotation]; ok {
+		switch s := v1alpha1.AdminState(v); s {
+		case v1alpha1.AdminStateUp, v1alpha1.AdminStateDown:
+			state = s
+		default:
+			return fmt.Errorf("invalid value %q for annotation %q: must be one of %s or %s", v, v1alpha1.DefaultAdminStateAnnotation, v1alpha1.AdminStateUp, v1alpha1.AdminStateDown)
+		}
+	}
+	intf.Spec.AdminState = state
+	return nil
+}
+
 // +kubebuilder:webhook:path=/validate-networking-metal-ironcore-dev-v1alpha1-interface,mutating=false,failurePolicy=Fail,sideEffects=None,groups=networking.metal.ironcore.dev,resources=interfaces,verbs=create;update,versions=v1alpha1,name=interface-v1alpha1.kb.io,admissionReviewVersions=v1
 
 // InterfaceCustomValidator struct is responsible for validating the Interface resource
@@ -68,6 +100,14 @@ func validateInterfaceSpec(intf *v1alpha1.Interface) error {
 		errAgg = append(errAgg, err)
 	}
 
+	if err := validateInterfaceMTU(intf); err != nil {
+		errAgg = append(errAgg, err)
+	}
+
+	if err := validateInterfaceAccessGroups(intf); err != nil {
+		errAgg = append(errAgg, err)
+	}
+
 	if intf.Spec.IPv4 != nil {
 		if err := validateInterfaceIPv4(intf.Spec.IPv4); err != nil {
 			errAgg = append(errAgg, err)
@@ -115,6 +155,44 @@ func validatePhysicalInterfaceNeighborMutualExclusion(intf *v1alpha1.Interface)
 	return nil
 }
 
+// validateInterfaceAccessGroups validates that access control lists are only bound to routed interfaces
+// and that no access control list is bound more than once in the same direction.
+func validateInterfaceAccessGroups(intf *v1alpha1.Interface) error {
+	if len(intf.Spec.AccessGroups) == 0 {
+		return nil
+	}
+
+	var errAgg []error
+	if intf.Spec.Type == v1alpha1.InterfaceTypeLoopback {
+		errAgg = append(errAgg, fmt.Errorf("access groups are not supported for interfaces of type %s", intf.Spec.Type))
+	}
+	if intf.Spec.IPv4 == nil && intf.Spec.IPv6 == nil {
+		errAgg = append(errAgg, errors.New("access groups require ipv4 or ipv6 configuration"))
+	}
+	if intf.Spec.Switchport != nil {
+		errAgg = append(errAgg, errors.New("switchport and accessGroups are mutually exclusive"))
+	}
+
+	seen := make(map[v1alpha1.InterfaceAccessGroup]struct{}, len(intf.Spec.AccessGroups))
+	for _, ag := range intf.Spec.AccessGroups {
+		if _, ok := seen[ag]; ok {
+			errAgg = append(errAgg, fmt.Errorf("access control list %q is bound more than once in direction %s", ag.AccessControlListRef.Name, ag.Direction))
+			continue
+		}
+		seen[ag] = struct{}{}
+	}
+	return errors.Join(errAgg...)
+}
+
+// validateInterfaceMTU validates that Ethernet interfaces have an even MTU, as the switching hardware
+// rejects odd frame sizes. The range of the MTU and IP MTU is already validated by the CRD.
+func validateInterfaceMTU(intf *v1alpha1.Interface) error {
+	if mtu := intf.Spec.MTU; mtu%2 != 0 && (intf.Spec.Type == v1alpha1.InterfaceTypePhysical || intf.Spec.Type == v1alpha1.InterfaceTypeAggregate) {
+		return fmt.Errorf("invalid MTU %d: must be an even number for interfaces of type %s", mtu, intf.Spec.Type)
+	}
+	return nil
+}
+
 // validateInterfaceIPv4 performs validation on the InterfaceIPv4 spec.
 func validateInterfaceIPv4(ip *v1alpha1.InterfaceIPv4) error {
 	var errAgg []error
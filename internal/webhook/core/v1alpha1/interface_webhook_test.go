@@ -90,6 +90,90 @@
 			Expect(err.Error()).To(ContainSubstring("overlaps with"))
 		})
 
+		It("Should allow switchport configuration on Physical interfaces", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypePhysical
+			obj.Spec.Switchport = &v1alpha1.Switchport{Mode: v1alpha1.SwitchportModeTrunk}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should allow ipv4 configuration on Physical interfaces", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypePhysical
+			obj.Spec.IPv4 = &v1alpha1.InterfaceIPv4{
+				Addresses: []v1alpha1.IPPrefix{{Prefix: netip.MustParsePrefix("10.0.0.1/31")}},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should allow a routed Physical interface with addresses, a VRF and access groups", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypePhysical
+			obj.Spec.IPv4 = &v1alpha1.InterfaceIPv4{
+				Addresses: []v1alpha1.IPPrefix{{Prefix: netip.MustParsePrefix("10.0.0.1/31")}},
+			}
+			obj.Spec.IPv6 = &v1alpha1.InterfaceIPv6{
+				Addresses: []v1alpha1.IPPrefix{{Prefix: netip.MustParsePrefix("2001:db8::1/127")}},
+			}
+			obj.Spec.VrfRef = &v1alpha1.LocalObjectReference{Name: "vrf-tenant"}
+			obj.Spec.AccessGroups = []v1alpha1.InterfaceAccessGroup{
+				{Direction: v1alpha1.AccessGroupDirectionIngress, AccessControlListRef: v1alpha1.LocalObjectReference{Name: "acl-in"}},
+				{Direction: v1alpha1.AccessGroupDirectionEgress, AccessControlListRef: v1alpha1.LocalObjectReference{Name: "acl-out"}},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should reject access groups on interfaces without ip configuration", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypePhysical
+			obj.Spec.AccessGroups = []v1alpha1.InterfaceAccessGroup{
+				{Direction: v1alpha1.AccessGroupDirectionIngress, AccessControlListRef: v1alpha1.LocalObjectReference{Name: "acl-in"}},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("access groups require ipv4 or ipv6 configuration"))
+		})
+
+		It("Should reject access groups on Loopback interfaces", func() {
+			obj.Spec.IPv4 = &v1alpha1.InterfaceIPv4{
+				Addresses: []v1alpha1.IPPrefix{{Prefix: netip.MustParsePrefix("10.0.0.1/32")}},
+			}
+			obj.Spec.AccessGroups = []v1alpha1.InterfaceAccessGroup{
+				{Direction: v1alpha1.AccessGroupDirectionIngress, AccessControlListRef: v1alpha1.LocalObjectReference{Name: "acl-in"}},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("access groups are not supported for interfaces of type Loopback"))
+		})
+
+		It("Should reject binding the same access control list twice in the same direction", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypePhysical
+			obj.Spec.IPv4 = &v1alpha1.InterfaceIPv4{
+				Addresses: []v1alpha1.IPPrefix{{Prefix: netip.MustParsePrefix("10.0.0.1/31")}},
+			}
+			obj.Spec.AccessGroups = []v1alpha1.InterfaceAccessGroup{
+				{Direction: v1alpha1.AccessGroupDirectionEgress, AccessControlListRef: v1alpha1.LocalObjectReference{Name: "acl-out"}},
+				{Direction: v1alpha1.AccessGroupDirectionEgress, AccessControlListRef: v1alpha1.LocalObjectReference{Name: "acl-out"}},
+			}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`access control list "acl-out" is bound more than once in direction Egress`))
+		})
+
+		It("Should allow a jumbo MTU", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypePhysical
+			obj.Spec.MTU = 9216
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should reject an odd MTU on Physical interfaces", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypePhysical
+			obj.Spec.MTU = 9001
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be an even number"))
+		})
+
 		It("Should allow interface-neighbor label on Physical interfaces", func() {
 			obj.Spec.Type = v1alpha1.InterfaceTypePhysical
 			obj.Labels = map[string]string{
@@ -166,4 +250,61 @@
 			Expect(err.Error()).To(ContainSubstring("cannot set both"))
 		})
 	})
+
+	Context("When creating or updating Interfaces under Defaulting Webhook", func() {
+		var defaulter InterfaceCustomDefaulter
+
+		BeforeEach(func() {
+			obj.Spec.AdminState = ""
+			defaulter = InterfaceCustomDefaulter{}
+		})
+
+		It("Should default the admin state of Physical interfaces to Up", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypePhysical
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.AdminState).To(Equal(v1alpha1.AdminStateUp))
+		})
+
+		It("Should default the admin state of RoutedVLAN interfaces to Up", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypeRoutedVLAN
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.AdminState).To(Equal(v1alpha1.AdminStateUp))
+		})
+
+		It("Should preserve an explicit admin state", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypePhysical
+			obj.Spec.AdminState = v1alpha1.AdminStateDown
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.AdminState).To(Equal(v1alpha1.AdminStateDown))
+		})
+
+		It("Should not default the admin state of Loopback interfaces", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypeLoopback
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.AdminState).To(BeEmpty())
+		})
+
+		It("Should default the admin state to the value of the default-admin-state annotation", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypePhysical
+			obj.Annotations = map[string]string{v1alpha1.DefaultAdminStateAnnotation: "Down"}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.AdminState).To(Equal(v1alpha1.AdminStateDown))
+		})
+
+		It("Should not override an explicit admin state with the default-admin-state annotation", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypePhysical
+			obj.Spec.AdminState = v1alpha1.AdminStateUp
+			obj.Annotations = map[string]string{v1alpha1.DefaultAdminStateAnnotation: "Down"}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.AdminState).To(Equal(v1alpha1.AdminStateUp))
+		})
+
+		It("Should reject an invalid default-admin-state annotation", func() {
+			obj.Spec.Type = v1alpha1.InterfaceTypePhysical
+			obj.Annotations = map[string]string{v1alpha1.DefaultAdminStateAnnotation: "shutdown"}
+			err := defaulter.Default(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid value \"shutdown\""))
+		})
+	})
 })
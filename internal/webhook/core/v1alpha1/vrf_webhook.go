@@ -9,6 +9,7 @@
 	"fmt"
 	"math"
 	"net/netip"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -46,7 +47,19 @@ func (v *VRFCustomValidator) ValidateCreate(_ context.Context, vrf *v1alpha1.VRF
 		warnings = append(warnings, "spec.vni is deprecated; use the vni field on the EVPNInstance resource instead")
 	}
 
-	return warnings, validateVRFSpec(vrf)
+	// The name is immutable, so it is only validated upon creation. Otherwise, existing VRFs
+	// with a name that is no longer accepted could not be updated, e.g. to remove their finalizer.
+	var errAgg []error
+	if name := vrf.Spec.Name; name != "" {
+		if err := validateVRFName(name); err != nil {
+			errAgg = append(errAgg, fmt.Errorf("invalid vrf name %q: %w", name, err))
+		}
+	}
+	if err := validateVRFSpec(vrf); err != nil {
+		errAgg = append(errAgg, err)
+	}
+
+	return warnings, errors.Join(errAgg...)
 }
 
 // ValidateUpdate implements admission.Validator so a webhook will be registered for the type VRF.
@@ -84,6 +97,27 @@ func validateVRFSpec(vrf *v1alpha1.VRF) error {
 	return errors.Join(errAgg...)
 }
 
+// vrfNameRegexp matches the characters allowed in a VRF name.
+var vrfNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_.:-]+$`)
+
+// reservedVRFNames are the VRF names that are predefined on network devices
+// and therefore cannot be managed through a VRF resource.
+var reservedVRFNames = []string{"default", "management"}
+
+// validateVRFName validates that the VRF name is accepted by network devices.
+// Its length is already limited by the CRD.
+func validateVRFName(name string) error {
+	if !vrfNameRegexp.MatchString(name) {
+		return errors.New("must only contain alphanumeric characters, '_', '.', ':' or '-'")
+	}
+	for _, reserved := range reservedVRFNames {
+		if strings.EqualFold(name, reserved) {
+			return fmt.Errorf("%q is a reserved name", reserved)
+		}
+	}
+	return nil
+}
+
 // validateRouteDistinguisher validates RFC 4364 RD textual forms:
 //
 //	Type 0: <ASN(1-65534)>:<Number(0-4294967295)>
@@ -35,6 +35,42 @@
 		// TODO (user): Add any teardown logic common to all tests
 	})
 
+	Context("ValidateCreate Name", func() {
+		It("accepts valid name", func() {
+			obj.Spec.Name = "CC-PROD_1.0"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("rejects name with invalid characters", func() {
+			obj.Spec.Name = "TEST VRF"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("alphanumeric"))
+		})
+
+		It("rejects reserved name", func() {
+			obj.Spec.Name = "management"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("reserved"))
+		})
+
+		It("rejects reserved name regardless of case", func() {
+			obj.Spec.Name = "Default"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("reserved"))
+		})
+
+		It("accepts updates of existing VRFs with a reserved name", func() {
+			oldObj.Spec.Name = "management"
+			obj.Spec.Name = "management"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
 	Context("ValidateCreate RouteDistinguisher", func() {
 		It("accepts empty RD", func() {
 			obj.Spec.RouteDistinguisher = ""